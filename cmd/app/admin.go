@@ -0,0 +1,249 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	accountModel "fluencybe/internal/app/model/account"
+	"fluencybe/internal/infrastructure/di"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+func runVerifyConsistency(ctx context.Context, container *di.Container, args []string) int {
+	flags := flag.NewFlagSet("verify-consistency", flag.ContinueOnError)
+	repair := flags.Bool("repair", false, "fix mismatched index documents and cache entries")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	skills, err := selectSkills(container, flags.Args())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+
+	exitCode := 0
+	for _, skill := range skills {
+		index, err := container.SearchIndex.Service.Verify(ctx, skill, *repair)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "verify index %s failed: %v\n", skill, err)
+			exitCode = 1
+			continue
+		}
+		cache, err := container.Admin.Service.VerifyCache(ctx, skill, *repair)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "verify cache %s failed: %v\n", skill, err)
+			exitCode = 1
+			continue
+		}
+		printJSON(map[string]interface{}{
+			"skill": skill,
+			"index": index,
+			"cache": cache,
+		})
+
+		mismatches := index.MissingCount + index.StaleCount + index.OrphanedCount + cache.StaleCount + cache.OrphanedCount
+		if (!*repair && mismatches > 0) || index.RepairFailed > 0 || cache.RepairFailed > 0 {
+			exitCode = 1
+		}
+	}
+	return exitCode
+}
+
+func runCache(ctx context.Context, container *di.Container, args []string) int {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, usage)
+		return 2
+	}
+
+	switch args[0] {
+	case "flush":
+		sets, err := selectSets(container, args[1:])
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 2
+		}
+		exitCode := 0
+		for _, set := range sets {
+			result, err := container.Admin.Service.FlushCache(ctx, set)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "flush %s failed: %v\n", set, err)
+				exitCode = 1
+				continue
+			}
+			printJSON(result)
+		}
+		return exitCode
+	case "warm":
+		skills, err := selectSkills(container, args[1:])
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 2
+		}
+		exitCode := 0
+		for _, skill := range skills {
+			result, err := container.Admin.Service.WarmCache(ctx, skill)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "warm %s failed: %v\n", skill, err)
+				exitCode = 1
+				continue
+			}
+			printJSON(result)
+			if result.Failed > 0 {
+				exitCode = 1
+			}
+		}
+		return exitCode
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown cache command %q\n\n%s", args[0], usage)
+		return 2
+	}
+}
+
+func runSeed(ctx context.Context, container *di.Container, args []string) int {
+	if len(args) == 0 {
+		names, err := container.Admin.Service.Fixtures()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		fmt.Println(strings.Join(names, "\n"))
+		return 0
+	}
+	if len(args) > 1 {
+		fmt.Fprint(os.Stderr, usage)
+		return 2
+	}
+
+	result, err := container.Admin.Service.Seed(ctx, args[0])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "seed %s failed: %v\n", args[0], err)
+		return 1
+	}
+	printJSON(result)
+	return 0
+}
+
+func runExport(ctx context.Context, container *di.Container, args []string) int {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	output := flags.String("o", "", "file to write, stdout when empty")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	sets, err := selectSets(container, flags.Args())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+
+	// The summary goes to stderr when the rows go to stdout
+	var w io.Writer = os.Stdout
+	summary := os.Stderr
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		defer file.Close()
+		w, summary = file, os.Stdout
+	}
+
+	result, err := container.Admin.Service.Export(ctx, sets, w)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "export failed: %v\n", err)
+		return 1
+	}
+	encoder := json.NewEncoder(summary)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(result); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to encode output: %v\n", err)
+	}
+	return 0
+}
+
+func runImport(ctx context.Context, container *di.Container, args []string) int {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	input := flags.String("i", "", "file to read, stdin when empty")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	var r io.Reader = os.Stdin
+	if *input != "" {
+		file, err := os.Open(*input)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		defer file.Close()
+		r = file
+	}
+
+	result, err := container.Admin.Service.Import(ctx, r)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "import failed, nothing was written: %v\n", err)
+		return 1
+	}
+	printJSON(result)
+	return 0
+}
+
+func runCreateDeveloper(ctx context.Context, container *di.Container, args []string) int {
+	flags := flag.NewFlagSet("create-developer", flag.ContinueOnError)
+	email := flags.String("email", "", "email of the developer")
+	username := flags.String("username", "", "username of the developer")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *email == "" || *username == "" {
+		fmt.Fprintln(os.Stderr, "-email and -username are required")
+		return 2
+	}
+
+	// Read from stdin so the password stays out of the shell history and process list
+	password, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && err != io.EOF {
+		fmt.Fprintf(os.Stderr, "failed to read password: %v\n", err)
+		return 1
+	}
+	password = strings.TrimRight(password, "\r\n")
+	if len(password) < 8 {
+		fmt.Fprintln(os.Stderr, accountModel.ErrInvalidPassword)
+		return 2
+	}
+
+	developer := &accountModel.Developer{
+		ID:       uuid.New(),
+		Email:    *email,
+		Username: *username,
+		Password: password,
+	}
+	if err := container.Account.DeveloperService.Register(ctx, developer); err != nil {
+		fmt.Fprintf(os.Stderr, "create developer failed: %v\n", err)
+		return 1
+	}
+	printJSON(developer)
+	return 0
+}
+
+func selectSets(container *di.Container, args []string) ([]string, error) {
+	available := container.Admin.Service.DataSets()
+	if len(args) == 0 || (len(args) == 1 && args[0] == "all") {
+		return available, nil
+	}
+	for _, set := range args {
+		if !slices.Contains(available, set) {
+			return nil, fmt.Errorf("unknown set %q", set)
+		}
+	}
+	return args, nil
+}
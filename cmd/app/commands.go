@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fluencybe/internal/core/constants"
+	"fluencybe/internal/core/status"
+	"fluencybe/internal/infrastructure/di"
+	"fmt"
+	"os"
+	"os/signal"
+	"slices"
+	"syscall"
+)
+
+const usage = `Usage: app [command]
+
+Without a command the HTTP server is started.
+
+Commands:
+  serve                                     Start the HTTP server
+  migrate up|down|status|baseline           Apply or revert schema migrations, see app migrate help
+  reindex [skill...]                        Rebuild search indexes from Postgres behind their alias
+  verify-index [-repair] [skill...]         Compare indexed documents with Postgres, -repair fixes mismatches
+  verify-consistency [-repair] [skill...]   Compare search indexes and cached details with Postgres
+  cache flush [set...]                      Drop cached entries on every replica
+  cache warm [skill...]                     Load question details into the cache
+  seed [fixture]                            Import an embedded fixture, without a name list them
+  export [-o file] [set...]                 Write rows as JSON lines, to stdout by default
+  import [-i file]                          Load an export in one transaction, from stdin by default
+  create-developer -email e -username u     Create a developer, the password is read from stdin
+
+Skills: grammar, listening, reading, speaking, writing (default: all)
+Sets: the skills and course (default: all)
+`
+
+// redisCommands are the commands that read or write the shared cache
+var redisCommands = []string{"verify-consistency", "cache"}
+
+// runCommand runs a maintenance command and returns the process exit code
+func runCommand(container *di.Container, args []string) int {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(ctx, constants.AdminCommandTimeout)
+	defer cancel()
+
+	// The periodic health check has not reported yet, without this the cache commands would
+	// only reach this process's in-memory tier
+	if slices.Contains(redisCommands, args[0]) {
+		if err := status.CheckRedis(container.Redis); err != nil {
+			fmt.Fprintf(os.Stderr, "Redis is unreachable: %v\n", err)
+			return 1
+		}
+	}
+
+	switch args[0] {
+	case "reindex":
+		return runReindex(ctx, container, args[1:])
+	case "verify-index":
+		return runVerifyIndex(ctx, container, args[1:])
+	case "verify-consistency":
+		return runVerifyConsistency(ctx, container, args[1:])
+	case "cache":
+		return runCache(ctx, container, args[1:])
+	case "seed":
+		return runSeed(ctx, container, args[1:])
+	case "export":
+		return runExport(ctx, container, args[1:])
+	case "import":
+		return runImport(ctx, container, args[1:])
+	case "create-developer":
+		return runCreateDeveloper(ctx, container, args[1:])
+	case "help", "-h", "--help":
+		fmt.Print(usage)
+		return 0
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command %q\n\n%s", args[0], usage)
+		return 2
+	}
+}
+
+func runReindex(ctx context.Context, container *di.Container, args []string) int {
+	skills, err := selectSkills(container, args)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+
+	exitCode := 0
+	for _, skill := range skills {
+		result, err := container.SearchIndex.Service.Reindex(ctx, skill)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "reindex %s failed: %v\n", skill, err)
+			exitCode = 1
+			continue
+		}
+		printJSON(result)
+		if result.Failed > 0 {
+			exitCode = 1
+		}
+	}
+	return exitCode
+}
+
+func runVerifyIndex(ctx context.Context, container *di.Container, args []string) int {
+	flags := flag.NewFlagSet("verify-index", flag.ContinueOnError)
+	repair := flags.Bool("repair", false, "reindex missing and stale documents and remove orphaned ones")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	skills, err := selectSkills(container, flags.Args())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+
+	exitCode := 0
+	for _, skill := range skills {
+		report, err := container.SearchIndex.Service.Verify(ctx, skill, *repair)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "verify %s failed: %v\n", skill, err)
+			exitCode = 1
+			continue
+		}
+		printJSON(report)
+
+		mismatches := report.MissingCount + report.StaleCount + report.OrphanedCount
+		if (!*repair && mismatches > 0) || report.RepairFailed > 0 {
+			exitCode = 1
+		}
+	}
+	return exitCode
+}
+
+func selectSkills(container *di.Container, args []string) ([]string, error) {
+	available := container.SearchIndex.Service.Skills()
+	if len(args) == 0 || (len(args) == 1 && args[0] == "all") {
+		return available, nil
+	}
+	for _, skill := range args {
+		if !slices.Contains(available, skill) {
+			return nil, fmt.Errorf("unknown skill %q", skill)
+		}
+	}
+	return args, nil
+}
+
+func printJSON(v interface{}) {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to encode output: %v\n", err)
+	}
+}
@@ -20,6 +20,11 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Migrations run before the container, which refuses to start on a stale schema
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(runMigrate(cfg, os.Args[2:]))
+	}
+
 	// Create dependency injection container
 	container, err := di.NewContainer(cfg)
 	if err != nil {
@@ -27,6 +32,26 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Maintenance commands run against the container and exit without serving
+	if len(os.Args) > 1 && os.Args[1] != "serve" {
+		exit(container, runCommand(container, os.Args[1:]))
+	}
+
+	// Start applying queued cache and search changes
+	container.Outbox.Relay.Start()
+
+	// Start dropping local cache entries changed by other replicas
+	container.Cache.Start()
+
+	// Prebuild the cached details of course-linked and popular questions
+	container.Warmup.Warmer.Start()
+
+	// Start running queued background jobs
+	container.Jobs.Runner.Start()
+
+	// Start deleting expired tokens
+	container.Account.TokenCleaner.Start()
+
 	// Create HTTP server
 	server := &http.Server{
 		Addr:         ":" + cfg.Server.Port,
@@ -49,7 +74,7 @@ func main() {
 				"error": err.Error(),
 				"port":  cfg.Server.Port,
 			}, "Server failed to start")
-			os.Exit(1)
+			exit(container, 1)
 		}
 	}()
 
@@ -71,10 +96,50 @@ func main() {
 		container.Logger.Critical("SERVER_SHUTDOWN_ERROR", map[string]interface{}{
 			"error": err.Error(),
 		}, "Server forced to shutdown")
-		os.Exit(1)
+		exit(container, 1)
+	}
+
+	// Let the jobs in flight finish within the shutdown timeout, the rest go back to the queue
+	if err := container.Jobs.Runner.Stop(ctx); err != nil {
+		container.Logger.Warning("JOB_RUNNER_SHUTDOWN", map[string]interface{}{
+			"error": err.Error(),
+		}, "Interrupted running jobs, they resume on the next start")
+	}
+
+	// Let the outbox relay finish its current batch
+	container.Outbox.Relay.Stop()
+
+	// Abandon the warm-up pass in flight and flush the counted reads
+	container.Warmup.Warmer.Stop()
+
+	container.Account.TokenCleaner.Stop()
+
+	container.Cache.Stop()
+
+	if err := container.ShutdownTracing(ctx); err != nil {
+		container.Logger.Error("TRACING_SHUTDOWN_ERROR", map[string]interface{}{
+			"error": err.Error(),
+		}, "Failed to flush pending spans")
 	}
 
 	container.Logger.Info("SERVER_SHUTDOWN", map[string]interface{}{
 		"status": "completed",
 	}, "Server shutdown successfully")
+
+	// Last, so the entries above still reach the sinks
+	flushLogs(container)
+}
+
+// flushLogs writes the log entries still queued, they are lost once the process exits
+func flushLogs(container *di.Container) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.ShutdownTimeout)
+	defer cancel()
+	if err := container.Logger.Close(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to flush logs: %v\n", err)
+	}
+}
+
+func exit(container *di.Container, code int) {
+	flushLogs(container)
+	os.Exit(code)
 }
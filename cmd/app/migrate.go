@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fluencybe/internal/core/config"
+	"fluencybe/internal/core/constants"
+	"fluencybe/migrations"
+	"fluencybe/pkg/db"
+	"fluencybe/pkg/logger"
+	"fluencybe/pkg/migrate"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"text/tabwriter"
+)
+
+const migrateUsage = `Usage: app migrate <command>
+
+Commands:
+  up [-to version]      Apply pending migrations, up to version when given
+  down [-steps n]       Revert the latest n applied migrations (default 1)
+  status                List migrations and whether they are applied
+  baseline <version>    Record migrations up to version as applied without running them
+`
+
+// runMigrate runs a migration command. It only needs Postgres, so it runs before the container
+// that would refuse to start on pending migrations.
+func runMigrate(cfg *config.Config, args []string) int {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, migrateUsage)
+		return 2
+	}
+
+	log := logger.GetGlobalLogger()
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), constants.ShutdownTimeout)
+		defer cancel()
+		if err := log.Close(ctx); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to flush logs: %v\n", err)
+		}
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(ctx, constants.MigrationTimeout)
+	defer cancel()
+
+	dbConn, err := db.NewDBConnection(cfg.DBConfig, log)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer dbConn.Close()
+
+	runner, err := migrate.NewRunner(dbConn, migrations.FS, log)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	switch args[0] {
+	case "up":
+		return runMigrateUp(ctx, runner, args[1:])
+	case "down":
+		return runMigrateDown(ctx, runner, args[1:])
+	case "status":
+		return runMigrateStatus(ctx, runner)
+	case "baseline":
+		return runMigrateBaseline(ctx, runner, args[1:])
+	case "help", "-h", "--help":
+		fmt.Print(migrateUsage)
+		return 0
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown migrate command %q\n\n%s", args[0], migrateUsage)
+		return 2
+	}
+}
+
+func runMigrateUp(ctx context.Context, runner *migrate.Runner, args []string) int {
+	flags := flag.NewFlagSet("migrate up", flag.ContinueOnError)
+	target := flags.Int64("to", 0, "stop after this version")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	applied, err := runner.Up(ctx, *target)
+	for _, migration := range applied {
+		fmt.Printf("applied  %s\n", migration)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	if len(applied) == 0 {
+		fmt.Println("no pending migrations")
+	}
+	return 0
+}
+
+func runMigrateDown(ctx context.Context, runner *migrate.Runner, args []string) int {
+	flags := flag.NewFlagSet("migrate down", flag.ContinueOnError)
+	steps := flags.Int("steps", 1, "number of migrations to revert")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *steps <= 0 {
+		fmt.Fprintln(os.Stderr, "-steps must be positive")
+		return 2
+	}
+
+	reverted, err := runner.Down(ctx, *steps)
+	for _, migration := range reverted {
+		fmt.Printf("reverted %s\n", migration)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	if len(reverted) == 0 {
+		fmt.Println("no applied migrations")
+	}
+	return 0
+}
+
+func runMigrateStatus(ctx context.Context, runner *migrate.Runner) int {
+	statuses, err := runner.Status(ctx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	exitCode := 0
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+	for _, status := range statuses {
+		state, appliedAt := "pending", "-"
+		if status.AppliedAt != nil {
+			state, appliedAt = "applied", status.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+		switch {
+		case status.Unknown:
+			state, exitCode = "unknown", 1
+		case status.Modified:
+			state, exitCode = "modified", 1
+		}
+		fmt.Fprintf(w, "%04d\t%s\t%s\t%s\n", status.Version, status.Name, state, appliedAt)
+	}
+	w.Flush()
+	return exitCode
+}
+
+func runMigrateBaseline(ctx context.Context, runner *migrate.Runner, args []string) int {
+	if len(args) != 1 {
+		fmt.Fprint(os.Stderr, migrateUsage)
+		return 2
+	}
+	version, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil || version <= 0 {
+		fmt.Fprintf(os.Stderr, "invalid version %q\n", args[0])
+		return 2
+	}
+
+	recorded, err := runner.Baseline(ctx, version)
+	for _, migration := range recorded {
+		fmt.Printf("recorded %s\n", migration)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,8 @@
+// Package fixtures embeds sample content for local and staging databases. Every fixture is a
+// <name>.jsonl file in the format of `app export`, loaded by `app seed <name>`.
+package fixtures
+
+import "embed"
+
+//go:embed *.jsonl
+var FS embed.FS
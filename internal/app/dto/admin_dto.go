@@ -0,0 +1,71 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//==============================================================================
+// * =-=-=-=-=-=-=-=-=-=-=-=-=-=-= Admin =-=-=-=-=-=-=-=-=-=-=-=-=-=-=-= *
+//==============================================================================
+
+// DataRow is one line of an export or fixture file, a table row as Postgres renders it in JSON
+type DataRow struct {
+	Table string          `json:"table"`
+	Row   json.RawMessage `json:"row"`
+}
+
+type ExportResult struct {
+	Sets       []string       `json:"sets"`
+	Rows       map[string]int `json:"rows"`
+	StartedAt  time.Time      `json:"started_at"`
+	FinishedAt time.Time      `json:"finished_at"`
+}
+
+// ImportResult counts rows per table, rows whose key already exists are skipped
+type ImportResult struct {
+	Inserted   map[string]int `json:"inserted"`
+	Skipped    map[string]int `json:"skipped"`
+	StartedAt  time.Time      `json:"started_at"`
+	FinishedAt time.Time      `json:"finished_at"`
+}
+
+type CacheFlushResult struct {
+	Set     string `json:"set"`
+	Pattern string `json:"pattern"`
+}
+
+type CacheWarmResult struct {
+	Skill      string      `json:"skill"`
+	Warmed     int         `json:"warmed"`
+	Failed     int         `json:"failed"`
+	FailedIDs  []uuid.UUID `json:"failed_ids,omitempty"`
+	StartedAt  time.Time   `json:"started_at"`
+	FinishedAt time.Time   `json:"finished_at"`
+}
+
+type StaleCacheEntry struct {
+	Key             string    `json:"key"`
+	ID              uuid.UUID `json:"id"`
+	DatabaseVersion int       `json:"database_version"`
+	CacheVersion    int       `json:"cache_version"`
+}
+
+// CacheConsistencyReport compares the cached question details with Postgres. An entry is stale
+// when its version differs from the row, orphaned when the row is gone. The lists are capped, the
+// counts are not.
+type CacheConsistencyReport struct {
+	Skill         string            `json:"skill"`
+	DatabaseCount int               `json:"database_count"`
+	CachedCount   int               `json:"cached_count"`
+	StaleCount    int               `json:"stale_count"`
+	OrphanedCount int               `json:"orphaned_count"`
+	Stale         []StaleCacheEntry `json:"stale"`
+	Orphaned      []string          `json:"orphaned"`
+	Repair        bool              `json:"repair"`
+	Repaired      int               `json:"repaired"`
+	RepairFailed  int               `json:"repair_failed"`
+	CheckedAt     time.Time         `json:"checked_at"`
+}
@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//==============================================================================
+// * =-=-=-=-=-=-=-=-=-=-=-=-=-= Change Feed =-=-=-=-=-=-=-=-=-=-=-=-=-= *
+//==============================================================================
+
+type QuestionChangeRef struct {
+	ID        uuid.UUID `json:"id"`
+	Skill     string    `json:"skill"`
+	Version   int       `json:"version"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// ChangeFeedResponse is one page of the change feed, clients store NextCursor after applying it
+// and keep requesting while HasMore is true
+type ChangeFeedResponse struct {
+	Created    []QuestionChangeRef `json:"created"`
+	Updated    []QuestionChangeRef `json:"updated"`
+	Deleted    []QuestionChangeRef `json:"deleted"`
+	NextCursor string              `json:"next_cursor"`
+	HasMore    bool                `json:"has_more"`
+}
@@ -155,3 +155,63 @@ type SwapSequenceRequest struct {
 	ID1 uuid.UUID `json:"id1" validate:"required"`
 	ID2 uuid.UUID `json:"id2" validate:"required"`
 }
+
+//! ------------------------------------------------------------------------------
+//! Course Bundle Types
+//! ------------------------------------------------------------------------------
+
+type CourseBundleResponse struct {
+	ID            uuid.UUID  `json:"id"`
+	CourseID      uuid.UUID  `json:"course_id"`
+	Version       int        `json:"version"`
+	Checksum      string     `json:"checksum"`
+	SizeBytes     int64      `json:"size_bytes"`
+	QuestionCount int        `json:"question_count"`
+	MediaCount    int        `json:"media_count"`
+	CreatedBy     *uuid.UUID `json:"created_by,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// CourseBundleManifest is stored as manifest.json at the root of every bundle archive
+type CourseBundleManifest struct {
+	FormatVersion    int                       `json:"format_version"`
+	CourseID         uuid.UUID                 `json:"course_id"`
+	Version          int                       `json:"version"`
+	CreatedAt        time.Time                 `json:"created_at"`
+	CourseChecksum   string                    `json:"course_checksum"`
+	Questions        []CourseBundleQuestion    `json:"questions"`
+	MissingQuestions []CourseBundleQuestionRef `json:"missing_questions,omitempty"`
+	Media            []CourseBundleMedia       `json:"media"`
+}
+
+type CourseBundleQuestion struct {
+	ID       uuid.UUID `json:"id"`
+	Skill    string    `json:"skill"`
+	Version  int       `json:"version"`
+	Checksum string    `json:"checksum"`
+}
+
+// CourseBundleQuestionRef is a lesson question whose content no longer exists
+type CourseBundleQuestionRef struct {
+	ID    uuid.UUID `json:"id"`
+	Skill string    `json:"skill"`
+}
+
+type CourseBundleMedia struct {
+	URL  string `json:"url"`
+	Type string `json:"type"`
+}
+
+// CourseBundleDiff is stored as diff.json in a diff archive, it lists what a client holding
+// FromVersion must apply to reach ToVersion
+type CourseBundleDiff struct {
+	CourseID      uuid.UUID                 `json:"course_id"`
+	FromVersion   int                       `json:"from_version"`
+	ToVersion     int                       `json:"to_version"`
+	CourseChanged bool                      `json:"course_changed"`
+	Added         []CourseBundleQuestion    `json:"added"`
+	Updated       []CourseBundleQuestion    `json:"updated"`
+	Removed       []CourseBundleQuestionRef `json:"removed"`
+	MediaAdded    []CourseBundleMedia       `json:"media_added"`
+	MediaRemoved  []CourseBundleMedia       `json:"media_removed"`
+}
@@ -0,0 +1,58 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//==============================================================================
+// * =-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=- Jobs =-=-=-=-=-=-=-=-=-=-=-=-=-=-=-=- *
+//==============================================================================
+
+type CreateJobRequest struct {
+	Type    string          `json:"type" binding:"required"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// JobResponse is the status of a job. RunAt is when a queued job becomes due, or when the lease
+// of a running one expires.
+type JobResponse struct {
+	ID              uuid.UUID       `json:"id"`
+	Type            string          `json:"type"`
+	Status          string          `json:"status"`
+	Payload         json.RawMessage `json:"payload"`
+	Attempts        int             `json:"attempts"`
+	MaxAttempts     int             `json:"max_attempts"`
+	Progress        int             `json:"progress"`
+	ProgressMessage string          `json:"progress_message,omitempty"`
+	Result          json.RawMessage `json:"result,omitempty"`
+	LastError       string          `json:"last_error,omitempty"`
+	CreatedBy       *uuid.UUID      `json:"created_by,omitempty"`
+	CreatedAt       time.Time       `json:"created_at"`
+	RunAt           time.Time       `json:"run_at"`
+	StartedAt       *time.Time      `json:"started_at,omitempty"`
+	FinishedAt      *time.Time      `json:"finished_at,omitempty"`
+}
+
+type JobPagination struct {
+	Jobs     []JobResponse `json:"jobs"`
+	Total    int64         `json:"total"`
+	Page     int           `json:"page"`
+	PageSize int           `json:"page_size"`
+}
+
+// SkillsJobPayload selects the skills of a reindex or cache warm job, all of them when empty
+type SkillsJobPayload struct {
+	Skills []string `json:"skills"`
+}
+
+type CourseBundleJobPayload struct {
+	CourseID uuid.UUID `json:"course_id"`
+}
+
+// DataImportJobPayload holds the rows of an import, in the format of the export files
+type DataImportJobPayload struct {
+	Rows []DataRow `json:"rows"`
+}
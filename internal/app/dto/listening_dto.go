@@ -88,6 +88,9 @@ type ListeningQuestionSearchFilter struct {
 	Transcript  string `form:"transcript"`
 	MaxTime     string `form:"max_time"`
 	Metadata    string `form:"metadata"`
+	ChildText   string `form:"child_text"`
+	HasChild    string `form:"has_child"`
+	MinOptions  int    `form:"min_options" binding:"min=0"`
 	Page        int    `form:"page" binding:"required,min=1"`
 	PageSize    int    `form:"page_size" binding:"required,min=1,max=100"`
 }
@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//==============================================================================
+// * =-=-=-=-=-=-=-=-=-=-=-=-=-=-=-= Outbox =-=-=-=-=-=-=-=-=-=-=-=-=-=-=-= *
+//==============================================================================
+
+type OutboxEventResponse struct {
+	ID            int64      `json:"id"`
+	Skill         string     `json:"skill"`
+	QuestionID    uuid.UUID  `json:"question_id"`
+	EventType     string     `json:"event_type"`
+	Version       int        `json:"version"`
+	Attempts      int        `json:"attempts"`
+	LastError     string     `json:"last_error,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	NextAttemptAt time.Time  `json:"next_attempt_at"`
+	ProcessedAt   *time.Time `json:"processed_at,omitempty"`
+}
+
+type OutboxDeadLetterPagination struct {
+	Events   []OutboxEventResponse `json:"events"`
+	Total    int64                 `json:"total"`
+	Page     int                   `json:"page"`
+	PageSize int                   `json:"page_size"`
+}
+
+type OutboxStatsResponse struct {
+	Pending int64 `json:"pending"`
+	Done    int64 `json:"done"`
+	Dead    int64 `json:"dead"`
+}
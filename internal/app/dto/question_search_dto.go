@@ -0,0 +1,61 @@
+package dto
+
+import "github.com/google/uuid"
+
+// QuestionSearchFilter is the query of the cross-skill question search, skill, type and topic
+// take comma separated lists
+type QuestionSearchFilter struct {
+	Query string `form:"q"`
+	Skill string `form:"skill"`
+	Type  string `form:"type"`
+	Topic string `form:"topic"`
+	// ChildText, HasChild and MinOptions filter on the nested child documents like the skill searches
+	ChildText  string `form:"child_text"`
+	HasChild   string `form:"has_child"`
+	MinOptions int    `form:"min_options" binding:"min=0"`
+	Page       int    `form:"page" binding:"required,min=1"`
+	PageSize   int    `form:"page_size" binding:"required,min=1,max=100"`
+}
+
+// QuestionSearchHit is a question of any skill, details are read from the skill endpoints
+type QuestionSearchHit struct {
+	ID          uuid.UUID `json:"id"`
+	Skill       string    `json:"skill"`
+	Type        string    `json:"type"`
+	Topic       []string  `json:"topic"`
+	Instruction string    `json:"instruction"`
+	Title       string    `json:"title,omitempty"`
+	MaxTime     int       `json:"max_time"`
+	Version     int       `json:"version"`
+	Status      string    `json:"status"`
+	Score       float64   `json:"score"`
+	// Highlights maps a matched field to snippets with the matched terms wrapped in <em>
+	Highlights map[string][]string `json:"highlights,omitempty"`
+	// Children are the sub-questions, answers or options the query matched
+	Children []QuestionSearchChildHit `json:"children,omitempty"`
+}
+
+type QuestionSearchChildHit struct {
+	Kind string    `json:"kind"`
+	ID   uuid.UUID `json:"id"`
+	Text string    `json:"text"`
+}
+
+type QuestionSearchFacetBucket struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+type QuestionSearchFacets struct {
+	Skill []QuestionSearchFacetBucket `json:"skill"`
+	Type  []QuestionSearchFacetBucket `json:"type"`
+	Topic []QuestionSearchFacetBucket `json:"topic"`
+}
+
+type QuestionSearchResult struct {
+	Questions []QuestionSearchHit  `json:"questions"`
+	Facets    QuestionSearchFacets `json:"facets"`
+	Total     int64                `json:"total"`
+	Page      int                  `json:"page"`
+	PageSize  int                  `json:"page_size"`
+}
@@ -0,0 +1,17 @@
+package dto
+
+import "github.com/google/uuid"
+
+// SimilarQuestion is a question sharing content with another one. Score is the OpenSearch
+// more_like_this score, Similarity the estimated share of word shingles the two have in common.
+type SimilarQuestion struct {
+	ID            uuid.UUID `json:"id"`
+	Skill         string    `json:"skill"`
+	Type          string    `json:"type"`
+	Topic         []string  `json:"topic"`
+	Instruction   string    `json:"instruction"`
+	Title         string    `json:"title,omitempty"`
+	Score         float64   `json:"score"`
+	Similarity    float64   `json:"similarity"`
+	NearDuplicate bool      `json:"near_duplicate"`
+}
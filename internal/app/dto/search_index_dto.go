@@ -0,0 +1,59 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//==============================================================================
+// * =-=-=-=-=-=-=-=-=-=-=-=-=-= Search Index =-=-=-=-=-=-=-=-=-=-=-=-=-= *
+//==============================================================================
+
+type ReindexResult struct {
+	Skill           string      `json:"skill"`
+	Index           string      `json:"index"`
+	PreviousIndices []string    `json:"previous_indices"`
+	Indexed         int         `json:"indexed"`
+	Skipped         int         `json:"skipped"`
+	Failed          int         `json:"failed"`
+	FailedIDs       []uuid.UUID `json:"failed_ids,omitempty"`
+	CaughtUp        int         `json:"caught_up"`
+	StartedAt       time.Time   `json:"started_at"`
+	FinishedAt      time.Time   `json:"finished_at"`
+}
+
+type StaleIndexDocument struct {
+	ID              uuid.UUID `json:"id"`
+	DatabaseVersion int       `json:"database_version"`
+	IndexVersion    int       `json:"index_version"`
+}
+
+// IndexConsistencyReport compares the questions in Postgres with the documents in their index.
+// The ID lists are capped, the counts are not.
+type IndexConsistencyReport struct {
+	Skill         string               `json:"skill"`
+	DatabaseCount int                  `json:"database_count"`
+	IndexCount    int                  `json:"index_count"`
+	MissingCount  int                  `json:"missing_count"`
+	StaleCount    int                  `json:"stale_count"`
+	OrphanedCount int                  `json:"orphaned_count"`
+	Missing       []uuid.UUID          `json:"missing"`
+	Stale         []StaleIndexDocument `json:"stale"`
+	Orphaned      []string             `json:"orphaned"`
+	Repair        bool                 `json:"repair"`
+	Repaired      int                  `json:"repaired"`
+	RepairFailed  int                  `json:"repair_failed"`
+	CheckedAt     time.Time            `json:"checked_at"`
+}
+
+// SearchIndexTask tracks a reindex or verification started from the API
+type SearchIndexTask struct {
+	Kind       string      `json:"kind"`
+	Skill      string      `json:"skill"`
+	State      string      `json:"state"`
+	StartedAt  time.Time   `json:"started_at"`
+	FinishedAt *time.Time  `json:"finished_at,omitempty"`
+	Error      string      `json:"error,omitempty"`
+	Result     interface{} `json:"result,omitempty"`
+}
@@ -68,6 +68,9 @@ type WritingQuestionSearchFilter struct {
 	ImageURLs   string `form:"image_urls"`
 	MaxTime     string `form:"max_time"`
 	Metadata    string `form:"metadata"`
+	ChildText   string `form:"child_text"`
+	HasChild    string `form:"has_child"`
+	MinOptions  int    `form:"min_options" binding:"min=0"`
 	Page        int    `form:"page" binding:"required,min=1"`
 	PageSize    int    `form:"page_size" binding:"required,min=1,max=100"`
 }
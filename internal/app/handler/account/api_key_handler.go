@@ -0,0 +1,164 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	accountDTO "fluencybe/internal/app/dto"
+	accountModel "fluencybe/internal/app/model/account"
+	accountService "fluencybe/internal/app/service/account"
+	"fluencybe/internal/core/constants"
+	"fluencybe/pkg/logger"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type APIKeyHandler struct {
+	service *accountService.APIKeyService
+	logger  *logger.PrettyLogger
+}
+
+func NewAPIKeyHandler(service *accountService.APIKeyService) *APIKeyHandler {
+	return &APIKeyHandler{
+		service: service,
+		logger:  logger.GetGlobalLogger(),
+	}
+}
+
+func toAPIKeyResponse(key *accountModel.DeveloperAPIKey) accountDTO.APIKeyResponse {
+	return accountDTO.APIKeyResponse{
+		ID:         key.ID,
+		Name:       key.Name,
+		KeyPrefix:  key.KeyPrefix,
+		Scopes:     key.Scopes,
+		LastUsedAt: key.LastUsedAt,
+		ExpiresAt:  key.ExpiresAt,
+		RevokedAt:  key.RevokedAt,
+		CreatedAt:  key.CreatedAt,
+	}
+}
+
+func (h *APIKeyHandler) writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, accountService.ErrAPIKeyNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, accountService.ErrInvalidScope),
+		errors.Is(err, accountService.ErrAPIKeyNameRequired),
+		errors.Is(err, accountService.ErrInvalidInput):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, accountService.ErrTooManyAPIKeys):
+		http.Error(w, err.Error(), http.StatusConflict)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// developerID only accepts developers authenticated with a JWT, api keys cannot manage keys
+func (h *APIKeyHandler) developerID(ctx context.Context) (*gin.Context, string, bool) {
+	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
+	if !ok {
+		return nil, "", false
+	}
+	if _, viaAPIKey := ginCtx.Get("api_key_id"); viaAPIKey {
+		return nil, "", false
+	}
+	devID := ginCtx.GetString("user_id")
+	return ginCtx, devID, devID != ""
+}
+
+func (h *APIKeyHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	_, devID, ok := h.developerID(ctx)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req accountDTO.CreateAPIKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	key, plaintext, err := h.service.Create(ctx, devID, req.Name, req.Scopes, req.ExpiresAt)
+	if err != nil {
+		h.logger.WithContext(ctx).Error("api_key_create_failed", map[string]interface{}{
+			"developer_id": devID,
+			"error":        err.Error(),
+		}, "Failed to create api key")
+		h.writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(accountDTO.APIKeySecretResponse{
+		APIKeyResponse: toAPIKeyResponse(key),
+		Key:            plaintext,
+	})
+}
+
+func (h *APIKeyHandler) List(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	_, devID, ok := h.developerID(ctx)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	keys, err := h.service.List(ctx, devID)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	responses := make([]accountDTO.APIKeyResponse, 0, len(keys))
+	for _, key := range keys {
+		responses = append(responses, toAPIKeyResponse(key))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(responses)
+}
+
+func (h *APIKeyHandler) Rotate(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	ginCtx, devID, ok := h.developerID(ctx)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	key, plaintext, err := h.service.Rotate(ctx, devID, ginCtx.Param("key_id"))
+	if err != nil {
+		h.logger.WithContext(ctx).Error("api_key_rotate_failed", map[string]interface{}{
+			"developer_id": devID,
+			"error":        err.Error(),
+		}, "Failed to rotate api key")
+		h.writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(accountDTO.APIKeySecretResponse{
+		APIKeyResponse: toAPIKeyResponse(key),
+		Key:            plaintext,
+	})
+}
+
+func (h *APIKeyHandler) Revoke(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	ginCtx, devID, ok := h.developerID(ctx)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.service.Revoke(ctx, devID, ginCtx.Param("key_id")); err != nil {
+		h.logger.WithContext(ctx).Error("api_key_revoke_failed", map[string]interface{}{
+			"developer_id": devID,
+			"error":        err.Error(),
+		}, "Failed to revoke api key")
+		h.writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,43 @@
+package account
+
+import (
+	"context"
+	accountService "fluencybe/internal/app/service/account"
+	"fluencybe/internal/core/constants"
+	"net"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// clientInfo collects the device details stored with a refresh token
+func clientInfo(ctx context.Context, r *http.Request) accountService.ClientInfo {
+	info := accountService.ClientInfo{UserAgent: r.UserAgent()}
+
+	if ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context); ok {
+		info.IPAddress = ginCtx.ClientIP()
+		return info
+	}
+
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		info.IPAddress = host
+	} else {
+		info.IPAddress = r.RemoteAddr
+	}
+	return info
+}
+
+// sessionFromContext returns the subject and session ids set by the auth middleware
+func sessionFromContext(ctx context.Context) (subjectID string, sessionID string, ok bool) {
+	ginCtx, found := ctx.Value(constants.GinContextKey).(*gin.Context)
+	if !found {
+		return "", "", false
+	}
+
+	subjectID = ginCtx.GetString("user_id")
+	sessionID = ginCtx.GetString("session_id")
+	if subjectID == "" || sessionID == "" {
+		return "", "", false
+	}
+	return subjectID, sessionID, true
+}
@@ -3,6 +3,7 @@ package account
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	accountDTO "fluencybe/internal/app/dto"
 	accountModel "fluencybe/internal/app/model/account"
 	accountService "fluencybe/internal/app/service/account"
@@ -58,7 +59,7 @@ func (h *DeveloperHandler) Login(ctx context.Context, w http.ResponseWriter, r *
 		return
 	}
 
-	dev, token, err := h.service.Login(ctx, req.Email, req.Password)
+	dev, tokens, err := h.service.Login(ctx, req.Email, req.Password, clientInfo(ctx, r))
 	if err != nil {
 		http.Error(w, "Invalid credentials", http.StatusUnauthorized)
 		return
@@ -66,11 +67,70 @@ func (h *DeveloperHandler) Login(ctx context.Context, w http.ResponseWriter, r *
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(accountDTO.LoginResponse{
-		ID:    dev.ID,
-		Token: token,
+		ID:               dev.ID,
+		Token:            tokens.AccessToken,
+		ExpiresAt:        tokens.AccessExpiresAt,
+		RefreshToken:     tokens.RefreshToken,
+		RefreshExpiresAt: tokens.RefreshExpiresAt,
 	})
 }
 
+func (h *DeveloperHandler) Refresh(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	var req accountDTO.RefreshTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.RefreshToken == "" {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	tokens, err := h.service.Refresh(ctx, req.RefreshToken, clientInfo(ctx, r))
+	if err != nil {
+		if errors.Is(err, accountService.ErrInvalidRefreshToken) || errors.Is(err, accountService.ErrRefreshTokenReused) {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(accountDTO.TokenResponse{
+		Token:            tokens.AccessToken,
+		ExpiresAt:        tokens.AccessExpiresAt,
+		RefreshToken:     tokens.RefreshToken,
+		RefreshExpiresAt: tokens.RefreshExpiresAt,
+	})
+}
+
+func (h *DeveloperHandler) Logout(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	_, sessionID, ok := sessionFromContext(ctx)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.service.Logout(ctx, sessionID); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *DeveloperHandler) LogoutAll(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	devID, _, ok := sessionFromContext(ctx)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.service.LogoutAll(ctx, devID); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func (h *DeveloperHandler) GetDeveloper(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	devID := vars["id"]
@@ -0,0 +1,140 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	accountDTO "fluencybe/internal/app/dto"
+	accountService "fluencybe/internal/app/service/account"
+	"fluencybe/internal/core/constants"
+	"fluencybe/pkg/logger"
+	"fluencybe/pkg/oauth"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type OAuthHandler struct {
+	service *accountService.OAuthService
+	logger  *logger.PrettyLogger
+	// secureCookie marks the state cookie Secure, the callback is served over https
+	secureCookie bool
+}
+
+func NewOAuthHandler(service *accountService.OAuthService, secureCookie bool) *OAuthHandler {
+	return &OAuthHandler{
+		service:      service,
+		logger:       logger.GetGlobalLogger(),
+		secureCookie: secureCookie,
+	}
+}
+
+// setStateCookie keeps the verifier of the login in progress in the browser that started it,
+// maxAge -1 deletes it. Lax lets the cookie through the top-level redirect from the provider.
+func (h *OAuthHandler) setStateCookie(w http.ResponseWriter, provider string, value string, maxAge int) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     constants.OAuthStateCookie,
+		Value:    value,
+		Path:     "/v1/user/oauth/" + provider,
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   h.secureCookie,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func (h *OAuthHandler) Authorize(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
+	if !ok {
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	provider := ginCtx.Param("provider")
+	url, verifier, err := h.service.AuthorizationURL(provider)
+	if err != nil {
+		if errors.Is(err, oauth.ErrUnknownProvider) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	h.setStateCookie(w, provider, verifier, int(constants.OAuthStateTTL.Seconds()))
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(accountDTO.OAuthAuthorizeResponse{
+		AuthorizationURL: url,
+	})
+}
+
+func (h *OAuthHandler) Callback(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
+	if !ok {
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	provider := ginCtx.Param("provider")
+	// The state cookie is single use, whatever the outcome
+	var verifier string
+	if cookie, err := r.Cookie(constants.OAuthStateCookie); err == nil {
+		verifier = cookie.Value
+	}
+	h.setStateCookie(w, provider, "", -1)
+
+	if providerErr := r.URL.Query().Get("error"); providerErr != "" {
+		h.logger.WithContext(ctx).Warning("oauth_callback_denied", map[string]interface{}{
+			"provider": provider,
+			"error":    providerErr,
+		}, "Provider returned an error")
+		http.Error(w, "Authorization denied by provider", http.StatusUnauthorized)
+		return
+	}
+
+	code := r.URL.Query().Get("code")
+	state := r.URL.Query().Get("state")
+	if code == "" || state == "" {
+		http.Error(w, "code and state are required", http.StatusBadRequest)
+		return
+	}
+
+	user, tokens, err := h.service.Login(ctx, provider, code, state, verifier, clientInfo(ctx, r))
+	if err != nil {
+		h.logger.WithContext(ctx).Error("oauth_callback_failed", map[string]interface{}{
+			"provider": provider,
+			"error":    err.Error(),
+		}, "OAuth login failed")
+
+		// Only the known failures are reported, anything else may carry provider or database details
+		status, message := http.StatusInternalServerError, "Internal server error"
+		switch {
+		case errors.Is(err, oauth.ErrUnknownProvider):
+			status, message = http.StatusNotFound, oauth.ErrUnknownProvider.Error()
+		case errors.Is(err, accountService.ErrOAuthStateInvalid):
+			status, message = http.StatusUnauthorized, accountService.ErrOAuthStateInvalid.Error()
+		case errors.Is(err, oauth.ErrCodeRejected):
+			status, message = http.StatusUnauthorized, oauth.ErrCodeRejected.Error()
+		case errors.Is(err, oauth.ErrEmailNotVerified):
+			status, message = http.StatusForbidden, oauth.ErrEmailNotVerified.Error()
+		case errors.Is(err, accountService.ErrOAuthIdentityLinked):
+			status, message = http.StatusConflict, accountService.ErrOAuthIdentityLinked.Error()
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(accountDTO.ErrorResponse{
+			Code:    status,
+			Message: message,
+		})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(accountDTO.LoginResponse{
+		ID:               user.ID,
+		Token:            tokens.AccessToken,
+		ExpiresAt:        tokens.AccessExpiresAt,
+		RefreshToken:     tokens.RefreshToken,
+		RefreshExpiresAt: tokens.RefreshExpiresAt,
+	})
+}
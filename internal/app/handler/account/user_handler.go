@@ -3,6 +3,7 @@ package account
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	accountDTO "fluencybe/internal/app/dto"
 	accountModel "fluencybe/internal/app/model/account"
 	accountService "fluencybe/internal/app/service/account"
@@ -34,11 +35,11 @@ func NewUserHandler(service *accountService.UserService) *UserHandler {
 }
 
 func (h *UserHandler) Register(ctx context.Context, w http.ResponseWriter, r *http.Request) {
-	h.logger.Info("register_start", nil, "Starting user registration process")
+	h.logger.WithContext(ctx).Info("register_start", nil, "Starting user registration process")
 
 	var req accountDTO.RegisterRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("register_invalid_body", map[string]interface{}{"error": err.Error()}, "Invalid request body")
+		h.logger.WithContext(ctx).Error("register_invalid_body", map[string]interface{}{"error": err.Error()}, "Invalid request body")
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
@@ -51,14 +52,14 @@ func (h *UserHandler) Register(ctx context.Context, w http.ResponseWriter, r *ht
 		Type:     req.Type,
 	}
 
-	h.logger.Debug("register_attempt", map[string]interface{}{
+	h.logger.WithContext(ctx).Debug("register_attempt", map[string]interface{}{
 		"email":    req.Email,
 		"username": req.Username,
 		"type":     req.Type,
 	}, "Attempting to register new user")
 
 	if err := h.service.Register(ctx, user); err != nil {
-		h.logger.Error("register_failed", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("register_failed", map[string]interface{}{
 			"error":    err.Error(),
 			"email":    req.Email,
 			"username": req.Username,
@@ -67,7 +68,7 @@ func (h *UserHandler) Register(ctx context.Context, w http.ResponseWriter, r *ht
 		return
 	}
 
-	h.logger.Info("register_success", map[string]interface{}{
+	h.logger.WithContext(ctx).Info("register_success", map[string]interface{}{
 		"user_id":  user.ID.String(),
 		"email":    user.Email,
 		"username": user.Username,
@@ -85,52 +86,212 @@ func (h *UserHandler) Register(ctx context.Context, w http.ResponseWriter, r *ht
 }
 
 func (h *UserHandler) Login(ctx context.Context, w http.ResponseWriter, r *http.Request) {
-	h.logger.Info("login_start", nil, "Starting user login process")
+	h.logger.WithContext(ctx).Info("login_start", nil, "Starting user login process")
 
 	var req accountDTO.LoginRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("login_invalid_body", map[string]interface{}{"error": err.Error()}, "Invalid request body")
+		h.logger.WithContext(ctx).Error("login_invalid_body", map[string]interface{}{"error": err.Error()}, "Invalid request body")
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
 
-	h.logger.Debug("login_attempt", map[string]interface{}{
+	h.logger.WithContext(ctx).Debug("login_attempt", map[string]interface{}{
 		"email": req.Email,
 	}, "Attempting user login")
 
-	user, token, err := h.service.Login(ctx, req.Email, req.Password)
+	user, tokens, err := h.service.Login(ctx, req.Email, req.Password, clientInfo(ctx, r))
 	if err != nil {
-		h.logger.Error("login_failed", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("login_failed", map[string]interface{}{
 			"error": err.Error(),
 			"email": req.Email,
 		}, "Login failed")
+		status := http.StatusUnauthorized
+		if errors.Is(err, accountService.ErrEmailNotVerified) {
+			status = http.StatusForbidden
+		}
 		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusUnauthorized)
+		w.WriteHeader(status)
 		json.NewEncoder(w).Encode(accountDTO.ErrorResponse{
-			Code:    http.StatusUnauthorized,
+			Code:    status,
 			Message: err.Error(),
 		})
 		return
 	}
 
-	h.logger.Info("login_success", map[string]interface{}{
+	h.logger.WithContext(ctx).Info("login_success", map[string]interface{}{
 		"email": req.Email,
 	}, "User logged in successfully")
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(accountDTO.LoginResponse{
-		ID:    user.ID,
-		Token: token,
+		ID:               user.ID,
+		Token:            tokens.AccessToken,
+		ExpiresAt:        tokens.AccessExpiresAt,
+		RefreshToken:     tokens.RefreshToken,
+		RefreshExpiresAt: tokens.RefreshExpiresAt,
 	})
 }
 
+func (h *UserHandler) Refresh(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	var req accountDTO.RefreshTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.RefreshToken == "" {
+		h.logger.WithContext(ctx).Error("refresh_invalid_body", nil, "Invalid request body")
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	tokens, err := h.service.Refresh(ctx, req.RefreshToken, clientInfo(ctx, r))
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, accountService.ErrInvalidRefreshToken) || errors.Is(err, accountService.ErrRefreshTokenReused) {
+			status = http.StatusUnauthorized
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(accountDTO.ErrorResponse{
+			Code:    status,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(accountDTO.TokenResponse{
+		Token:            tokens.AccessToken,
+		ExpiresAt:        tokens.AccessExpiresAt,
+		RefreshToken:     tokens.RefreshToken,
+		RefreshExpiresAt: tokens.RefreshExpiresAt,
+	})
+}
+
+func (h *UserHandler) Logout(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	_, sessionID, ok := sessionFromContext(ctx)
+	if !ok {
+		h.logger.WithContext(ctx).Error("logout_invalid_session", nil, "Unauthorized")
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.service.Logout(ctx, sessionID); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	h.logger.WithContext(ctx).Info("logout_success", map[string]interface{}{
+		"session_id": sessionID,
+	}, "User logged out successfully")
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *UserHandler) LogoutAll(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	userID, _, ok := sessionFromContext(ctx)
+	if !ok {
+		h.logger.WithContext(ctx).Error("logout_all_invalid_session", nil, "Unauthorized")
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.service.LogoutAll(ctx, userID); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	h.logger.WithContext(ctx).Info("logout_all_success", map[string]interface{}{
+		"user_id": userID,
+	}, "User logged out from all devices")
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *UserHandler) VerifyEmail(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	var req accountDTO.VerifyEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Token == "" {
+		h.logger.WithContext(ctx).Error("verify_email_invalid_body", nil, "Invalid request body")
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.VerifyEmail(ctx, req.Token); err != nil {
+		h.logger.WithContext(ctx).Error("verify_email_failed", map[string]interface{}{"error": err.Error()}, "Email verification failed")
+		if errors.Is(err, accountService.ErrInvalidActionToken) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *UserHandler) ResendEmailVerification(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	userID, _, ok := sessionFromContext(ctx)
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.service.ResendEmailVerification(ctx, userID); err != nil {
+		h.logger.WithContext(ctx).Error("resend_verification_failed", map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		}, "Failed to resend verification email")
+		if errors.Is(err, accountService.ErrEmailAlreadyVerified) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *UserHandler) ForgotPassword(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	var req accountDTO.ForgotPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Email == "" {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.RequestPasswordReset(ctx, req.Email); err != nil {
+		h.logger.WithContext(ctx).Error("forgot_password_failed", map[string]interface{}{"error": err.Error()}, "Failed to request password reset")
+		http.Error(w, "Failed to request password reset", http.StatusInternalServerError)
+		return
+	}
+
+	// Same answer whether the email exists or not
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *UserHandler) ResetPassword(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	var req accountDTO.ResetPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Token == "" {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.ResetPassword(ctx, req.Token, req.Password); err != nil {
+		h.logger.WithContext(ctx).Error("reset_password_failed", map[string]interface{}{"error": err.Error()}, "Password reset failed")
+		if errors.Is(err, accountService.ErrInvalidActionToken) || errors.Is(err, accountService.ErrPasswordTooShort) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func (h *UserHandler) GetUser(ctx context.Context, w http.ResponseWriter, r *http.Request) {
-	h.logger.Info("get_user_start", nil, "Starting to get user")
+	h.logger.WithContext(ctx).Info("get_user_start", nil, "Starting to get user")
 
 	// Get gin context from request context
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("get_user_invalid_context", nil, "Invalid context")
+		h.logger.WithContext(ctx).Error("get_user_invalid_context", nil, "Invalid context")
 		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
 		return
 	}
@@ -138,18 +299,18 @@ func (h *UserHandler) GetUser(ctx context.Context, w http.ResponseWriter, r *htt
 	// Get user ID from URL parameter
 	userID := ginCtx.Param("id")
 	if userID == "" {
-		h.logger.Error("get_user_invalid_id", nil, "User ID is required")
+		h.logger.WithContext(ctx).Error("get_user_invalid_id", nil, "User ID is required")
 		http.Error(w, "User ID is required", http.StatusBadRequest)
 		return
 	}
 
-	h.logger.Debug("get_user_attempt", map[string]interface{}{
+	h.logger.WithContext(ctx).Debug("get_user_attempt", map[string]interface{}{
 		"user_id": userID,
 	}, "Attempting to get user")
 
 	user, err := h.service.GetUser(ctx, userID)
 	if err != nil {
-		h.logger.Error("get_user_failed", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("get_user_failed", map[string]interface{}{
 			"error":   err.Error(),
 			"user_id": userID,
 		}, "Failed to get user")
@@ -157,33 +318,35 @@ func (h *UserHandler) GetUser(ctx context.Context, w http.ResponseWriter, r *htt
 		return
 	}
 
-	h.logger.Info("get_user_success", map[string]interface{}{
+	h.logger.WithContext(ctx).Info("get_user_success", map[string]interface{}{
 		"user_id": userID,
 	}, "User retrieved successfully")
 
 	w.Header().Set("Content-Type", "application/json")
+	emailVerified := user.IsEmailVerified()
 	json.NewEncoder(w).Encode(accountDTO.UserResponse{
-		ID:        user.ID,
-		Email:     user.Email,
-		Username:  user.Username,
-		CreatedAt: user.CreatedAt,
+		ID:            user.ID,
+		Email:         user.Email,
+		Username:      user.Username,
+		EmailVerified: &emailVerified,
+		CreatedAt:     user.CreatedAt,
 	})
 }
 
 func (h *UserHandler) UpdateUser(ctx context.Context, w http.ResponseWriter, r *http.Request) {
-	h.logger.Info("update_user_start", nil, "Starting to update user")
+	h.logger.WithContext(ctx).Info("update_user_start", nil, "Starting to update user")
 
 	vars := mux.Vars(r)
 	userID := vars["id"]
 
 	var req accountDTO.FieldUpdateRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("update_user_invalid_body", map[string]interface{}{"error": err.Error()}, "Invalid request body")
+		h.logger.WithContext(ctx).Error("update_user_invalid_body", map[string]interface{}{"error": err.Error()}, "Invalid request body")
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
 
-	h.logger.Debug("update_user_attempt", map[string]interface{}{
+	h.logger.WithContext(ctx).Debug("update_user_attempt", map[string]interface{}{
 		"user_id": userID,
 		"field":   req.Field,
 		"value":   req.Value,
@@ -193,7 +356,7 @@ func (h *UserHandler) UpdateUser(ctx context.Context, w http.ResponseWriter, r *
 	updates[req.Field] = req.Value
 
 	if err := h.service.UpdateUser(ctx, userID, updates); err != nil {
-		h.logger.Error("update_user_failed", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("update_user_failed", map[string]interface{}{
 			"error":   err.Error(),
 			"user_id": userID,
 		}, "Failed to update user")
@@ -201,7 +364,7 @@ func (h *UserHandler) UpdateUser(ctx context.Context, w http.ResponseWriter, r *
 		return
 	}
 
-	h.logger.Info("update_user_success", map[string]interface{}{
+	h.logger.WithContext(ctx).Info("update_user_success", map[string]interface{}{
 		"user_id": userID,
 	}, "User updated successfully")
 
@@ -209,17 +372,17 @@ func (h *UserHandler) UpdateUser(ctx context.Context, w http.ResponseWriter, r *
 }
 
 func (h *UserHandler) DeleteUser(ctx context.Context, w http.ResponseWriter, r *http.Request) {
-	h.logger.Info("delete_user_start", nil, "Starting to delete user")
+	h.logger.WithContext(ctx).Info("delete_user_start", nil, "Starting to delete user")
 
 	vars := mux.Vars(r)
 	userID := vars["id"]
 
-	h.logger.Debug("delete_user_attempt", map[string]interface{}{
+	h.logger.WithContext(ctx).Debug("delete_user_attempt", map[string]interface{}{
 		"user_id": userID,
 	}, "Attempting to delete user")
 
 	if err := h.service.DeleteUser(ctx, userID); err != nil {
-		h.logger.Error("delete_user_failed", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("delete_user_failed", map[string]interface{}{
 			"error":   err.Error(),
 			"user_id": userID,
 		}, "Failed to delete user")
@@ -227,7 +390,7 @@ func (h *UserHandler) DeleteUser(ctx context.Context, w http.ResponseWriter, r *
 		return
 	}
 
-	h.logger.Info("delete_user_success", map[string]interface{}{
+	h.logger.WithContext(ctx).Info("delete_user_success", map[string]interface{}{
 		"user_id": userID,
 	}, "User deleted successfully")
 
@@ -235,12 +398,12 @@ func (h *UserHandler) DeleteUser(ctx context.Context, w http.ResponseWriter, r *
 }
 
 func (h *UserHandler) GetMyUser(ctx context.Context, w http.ResponseWriter, r *http.Request) {
-	h.logger.Info("get_my_user_start", nil, "Starting to get my user")
+	h.logger.WithContext(ctx).Info("get_my_user_start", nil, "Starting to get my user")
 
 	// Get gin context from request context
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("get_my_user_invalid_context", nil, "Invalid context")
+		h.logger.WithContext(ctx).Error("get_my_user_invalid_context", nil, "Invalid context")
 		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
 		return
 	}
@@ -248,7 +411,7 @@ func (h *UserHandler) GetMyUser(ctx context.Context, w http.ResponseWriter, r *h
 	// Get user ID from gin context
 	userID, ok := ginCtx.Get("user_id")
 	if !ok {
-		h.logger.Error("get_my_user_invalid_id", nil, "Unauthorized")
+		h.logger.WithContext(ctx).Error("get_my_user_invalid_id", nil, "Unauthorized")
 		http.Error(w, "Unauthorized", http.StatusUnauthorized)
 		return
 	}
@@ -256,18 +419,18 @@ func (h *UserHandler) GetMyUser(ctx context.Context, w http.ResponseWriter, r *h
 	// Convert userID to string
 	userIDStr, ok := userID.(string)
 	if !ok {
-		h.logger.Error("get_my_user_invalid_id_type", nil, "Internal Server Error")
+		h.logger.WithContext(ctx).Error("get_my_user_invalid_id_type", nil, "Internal Server Error")
 		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
 		return
 	}
 
-	h.logger.Debug("get_my_user_attempt", map[string]interface{}{
+	h.logger.WithContext(ctx).Debug("get_my_user_attempt", map[string]interface{}{
 		"user_id": userIDStr,
 	}, "Attempting to get my user")
 
 	user, err := h.service.GetUser(ctx, userIDStr)
 	if err != nil {
-		h.logger.Error("get_my_user_failed", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("get_my_user_failed", map[string]interface{}{
 			"error":   err.Error(),
 			"user_id": userIDStr,
 		}, "Failed to get my user")
@@ -275,26 +438,28 @@ func (h *UserHandler) GetMyUser(ctx context.Context, w http.ResponseWriter, r *h
 		return
 	}
 
-	h.logger.Info("get_my_user_success", map[string]interface{}{
+	h.logger.WithContext(ctx).Info("get_my_user_success", map[string]interface{}{
 		"user_id": userIDStr,
 	}, "My user retrieved successfully")
 
 	w.Header().Set("Content-Type", "application/json")
+	emailVerified := user.IsEmailVerified()
 	json.NewEncoder(w).Encode(accountDTO.UserResponse{
-		ID:        user.ID,
-		Email:     user.Email,
-		Username:  user.Username,
-		CreatedAt: user.CreatedAt,
+		ID:            user.ID,
+		Email:         user.Email,
+		Username:      user.Username,
+		EmailVerified: &emailVerified,
+		CreatedAt:     user.CreatedAt,
 	})
 }
 
 func (h *UserHandler) UpdateMyUser(ctx context.Context, w http.ResponseWriter, r *http.Request) {
-	h.logger.Info("update_my_user_start", nil, "Starting to update my user")
+	h.logger.WithContext(ctx).Info("update_my_user_start", nil, "Starting to update my user")
 
 	// Get gin context from standard context
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("update_my_user_invalid_context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("update_my_user_invalid_context", nil, "Failed to get gin context")
 		http.Error(w, "Internal server error", http.StatusInternalServerError)
 		return
 	}
@@ -302,26 +467,26 @@ func (h *UserHandler) UpdateMyUser(ctx context.Context, w http.ResponseWriter, r
 	// Get user ID from gin context
 	userID, exists := ginCtx.Get("user_id")
 	if !exists {
-		h.logger.Error("update_my_user_invalid_id", nil, "Unauthorized")
+		h.logger.WithContext(ctx).Error("update_my_user_invalid_id", nil, "Unauthorized")
 		http.Error(w, "Unauthorized", http.StatusUnauthorized)
 		return
 	}
 
 	userIDStr, ok := userID.(string)
 	if !ok {
-		h.logger.Error("update_my_user_invalid_id_type", nil, "Invalid user ID type")
+		h.logger.WithContext(ctx).Error("update_my_user_invalid_id_type", nil, "Invalid user ID type")
 		http.Error(w, "Internal server error", http.StatusInternalServerError)
 		return
 	}
 
 	var req accountDTO.FieldUpdateRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("update_my_user_invalid_body", map[string]interface{}{"error": err.Error()}, "Invalid request body")
+		h.logger.WithContext(ctx).Error("update_my_user_invalid_body", map[string]interface{}{"error": err.Error()}, "Invalid request body")
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
 
-	h.logger.Debug("update_my_user_attempt", map[string]interface{}{
+	h.logger.WithContext(ctx).Debug("update_my_user_attempt", map[string]interface{}{
 		"user_id": userIDStr,
 		"field":   req.Field,
 		"value":   req.Value,
@@ -331,7 +496,7 @@ func (h *UserHandler) UpdateMyUser(ctx context.Context, w http.ResponseWriter, r
 	updates[req.Field] = req.Value
 
 	if err := h.service.UpdateUser(ctx, userIDStr, updates); err != nil {
-		h.logger.Error("update_my_user_failed", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("update_my_user_failed", map[string]interface{}{
 			"error":   err.Error(),
 			"user_id": userIDStr,
 		}, "Failed to update my user")
@@ -339,7 +504,7 @@ func (h *UserHandler) UpdateMyUser(ctx context.Context, w http.ResponseWriter, r
 		return
 	}
 
-	h.logger.Info("update_my_user_success", map[string]interface{}{
+	h.logger.WithContext(ctx).Info("update_my_user_success", map[string]interface{}{
 		"user_id": userIDStr,
 	}, "My user updated successfully")
 
@@ -347,22 +512,22 @@ func (h *UserHandler) UpdateMyUser(ctx context.Context, w http.ResponseWriter, r
 }
 
 func (h *UserHandler) DeleteMyUser(ctx context.Context, w http.ResponseWriter, r *http.Request) {
-	h.logger.Info("delete_my_user_start", nil, "Starting to delete my user")
+	h.logger.WithContext(ctx).Info("delete_my_user_start", nil, "Starting to delete my user")
 
 	// Get user ID from context (set by auth middleware)
 	userID, ok := ctx.Value("user_id").(string)
 	if !ok {
-		h.logger.Error("delete_my_user_invalid_id", nil, "Unauthorized")
+		h.logger.WithContext(ctx).Error("delete_my_user_invalid_id", nil, "Unauthorized")
 		http.Error(w, "Unauthorized", http.StatusUnauthorized)
 		return
 	}
 
-	h.logger.Debug("delete_my_user_attempt", map[string]interface{}{
+	h.logger.WithContext(ctx).Debug("delete_my_user_attempt", map[string]interface{}{
 		"user_id": userID,
 	}, "Attempting to delete my user")
 
 	if err := h.service.DeleteUser(ctx, userID); err != nil {
-		h.logger.Error("delete_my_user_failed", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("delete_my_user_failed", map[string]interface{}{
 			"error":   err.Error(),
 			"user_id": userID,
 		}, "Failed to delete my user")
@@ -370,7 +535,7 @@ func (h *UserHandler) DeleteMyUser(ctx context.Context, w http.ResponseWriter, r
 		return
 	}
 
-	h.logger.Info("delete_my_user_success", map[string]interface{}{
+	h.logger.WithContext(ctx).Info("delete_my_user_success", map[string]interface{}{
 		"user_id": userID,
 	}, "My user deleted successfully")
 
@@ -378,7 +543,7 @@ func (h *UserHandler) DeleteMyUser(ctx context.Context, w http.ResponseWriter, r
 }
 
 func (h *UserHandler) GetListUserWithPagination(ctx context.Context, w http.ResponseWriter, r *http.Request) {
-	h.logger.Info("get_list_user_start", nil, "Starting to get list of users")
+	h.logger.WithContext(ctx).Info("get_list_user_start", nil, "Starting to get list of users")
 
 	// Get pagination parameters from query string
 	page := r.URL.Query().Get("page")
@@ -390,32 +555,34 @@ func (h *UserHandler) GetListUserWithPagination(ctx context.Context, w http.Resp
 		limit = "10"
 	}
 
-	h.logger.Debug("get_list_user_attempt", map[string]interface{}{
+	h.logger.WithContext(ctx).Debug("get_list_user_attempt", map[string]interface{}{
 		"page":  page,
 		"limit": limit,
 	}, "Attempting to get list of users")
 
 	users, total, err := h.service.GetUserList(ctx, page, limit)
 	if err != nil {
-		h.logger.Error("get_list_user_failed", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("get_list_user_failed", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get list of users")
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	h.logger.Info("get_list_user_success", map[string]interface{}{
+	h.logger.WithContext(ctx).Info("get_list_user_success", map[string]interface{}{
 		"total": total,
 	}, "List of users retrieved successfully")
 
 	// Convert users to response DTOs
 	var userResponses []accountDTO.UserResponse
 	for _, user := range users {
+		emailVerified := user.IsEmailVerified()
 		userResponses = append(userResponses, accountDTO.UserResponse{
-			ID:        user.ID,
-			Email:     user.Email,
-			Username:  user.Username,
-			CreatedAt: user.CreatedAt,
+			ID:            user.ID,
+			Email:         user.Email,
+			Username:      user.Username,
+			EmailVerified: &emailVerified,
+			CreatedAt:     user.CreatedAt,
 		})
 	}
 
@@ -0,0 +1,70 @@
+package changefeed
+
+import (
+	"context"
+	"errors"
+	changefeedService "fluencybe/internal/app/service/changefeed"
+	"fluencybe/pkg/logger"
+	"fluencybe/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ChangeFeedHandler struct {
+	service *changefeedService.ChangeFeedService
+	logger  *logger.PrettyLogger
+}
+
+func NewChangeFeedHandler(service *changefeedService.ChangeFeedService, logger *logger.PrettyLogger) *ChangeFeedHandler {
+	return &ChangeFeedHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// GetChanges serves the feed across skills, the optional skill query parameter narrows it down
+func (h *ChangeFeedHandler) GetChanges(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	h.writeChanges(ctx, w, r, r.URL.Query().Get("skill"))
+}
+
+// GetSkillChanges serves the feed of a single skill
+func (h *ChangeFeedHandler) GetSkillChanges(skill string) func(context.Context, http.ResponseWriter, *http.Request) {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+		h.writeChanges(ctx, w, r, skill)
+	}
+}
+
+func (h *ChangeFeedHandler) writeChanges(ctx context.Context, w http.ResponseWriter, r *http.Request, skill string) {
+	query := r.URL.Query()
+
+	limit := 0
+	if rawLimit := query.Get("limit"); rawLimit != "" {
+		var err error
+		if limit, err = strconv.Atoi(rawLimit); err != nil || limit < 0 {
+			response.WriteError(w, http.StatusBadRequest, "Invalid limit")
+			return
+		}
+	}
+
+	result, err := h.service.GetChanges(ctx, skill, query.Get("cursor"), limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, changefeedService.ErrUnknownSkill), errors.Is(err, changefeedService.ErrInvalidCursor):
+			response.WriteError(w, http.StatusBadRequest, err.Error())
+		default:
+			h.logger.WithContext(ctx).Error("changefeed_handler.get_changes", map[string]interface{}{
+				"error": err.Error(),
+				"skill": skill,
+			}, "Failed to get question changes")
+			response.WriteError(w, http.StatusInternalServerError, "Failed to get question changes")
+		}
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
@@ -35,7 +35,7 @@ func NewCourseBookHandler(
 func (h *CourseBookHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req courseDTO.CreateCourseBookRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("course_book_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("course_book_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -53,7 +53,7 @@ func (h *CourseBookHandler) Create(ctx context.Context, w http.ResponseWriter, r
 	}
 
 	if err := h.service.Create(ctx, courseBook); err != nil {
-		h.logger.Error("course_book_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("course_book_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create course book")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create course book")
@@ -74,7 +74,7 @@ func (h *CourseBookHandler) Create(ctx context.Context, w http.ResponseWriter, r
 func (h *CourseBookHandler) Update(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req courseDTO.UpdateCourseBookFieldRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("course_book_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("course_book_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -84,7 +84,7 @@ func (h *CourseBookHandler) Update(ctx context.Context, w http.ResponseWriter, r
 	// Get existing record first
 	existingBook, err := h.service.GetByID(ctx, req.CourseBookID)
 	if err != nil {
-		h.logger.Error("course_book_handler.update.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("course_book_handler.update.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.CourseBookID,
 		}, "Failed to get existing course book")
@@ -123,7 +123,7 @@ func (h *CourseBookHandler) Update(ctx context.Context, w http.ResponseWriter, r
 	existingBook.UpdatedAt = time.Now()
 
 	if err := h.service.Update(ctx, existingBook); err != nil {
-		h.logger.Error("course_book_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("course_book_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.CourseBookID,
 		}, "Failed to update course book")
@@ -137,7 +137,7 @@ func (h *CourseBookHandler) Update(ctx context.Context, w http.ResponseWriter, r
 func (h *CourseBookHandler) Delete(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("course_book_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("course_book_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -145,7 +145,7 @@ func (h *CourseBookHandler) Delete(ctx context.Context, w http.ResponseWriter, r
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("course_book_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("course_book_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid course book ID format")
@@ -154,7 +154,7 @@ func (h *CourseBookHandler) Delete(ctx context.Context, w http.ResponseWriter, r
 	}
 
 	if err := h.service.Delete(ctx, id); err != nil {
-		h.logger.Error("course_book_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("course_book_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete course book")
@@ -0,0 +1,207 @@
+package course
+
+import (
+	"context"
+	"errors"
+	courseDTO "fluencybe/internal/app/dto"
+	"fluencybe/internal/app/model/course"
+	courseSer "fluencybe/internal/app/service/course"
+	"fluencybe/pkg/logger"
+	"fluencybe/pkg/response"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	constants "fluencybe/internal/core/constants"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type CourseBundleHandler struct {
+	service *courseSer.CourseBundleService
+	logger  *logger.PrettyLogger
+}
+
+func NewCourseBundleHandler(service *courseSer.CourseBundleService, logger *logger.PrettyLogger) *CourseBundleHandler {
+	return &CourseBundleHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+func toCourseBundleResponse(bundle *course.CourseBundle) courseDTO.CourseBundleResponse {
+	return courseDTO.CourseBundleResponse{
+		ID:            bundle.ID,
+		CourseID:      bundle.CourseID,
+		Version:       bundle.Version,
+		Checksum:      bundle.ArchiveChecksum,
+		SizeBytes:     bundle.SizeBytes,
+		QuestionCount: bundle.QuestionCount,
+		MediaCount:    bundle.MediaCount,
+		CreatedBy:     bundle.CreatedBy,
+		CreatedAt:     bundle.CreatedAt,
+	}
+}
+
+func (h *CourseBundleHandler) courseID(ctx context.Context, w http.ResponseWriter) (*gin.Context, uuid.UUID, bool) {
+	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
+	if !ok {
+		h.logger.WithContext(ctx).Error("course_bundle_handler.context", nil, "Failed to get gin context")
+		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
+		return nil, uuid.Nil, false
+	}
+
+	id, err := uuid.Parse(ginCtx.Param("id"))
+	if err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid course ID")
+		return nil, uuid.Nil, false
+	}
+	return ginCtx, id, true
+}
+
+func (h *CourseBundleHandler) writeError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, courseSer.ErrCourseNotFound), errors.Is(err, courseSer.ErrBundleNotFound):
+		response.WriteError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, courseSer.ErrInvalidBundleRange):
+		response.WriteError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, courseSer.ErrBundleBuildConflict):
+		response.WriteError(w, http.StatusConflict, err.Error())
+	default:
+		response.WriteError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// Build creates a new bundle version, 200 with the latest bundle is returned when nothing changed
+func (h *CourseBundleHandler) Build(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	ginCtx, courseID, ok := h.courseID(ctx, w)
+	if !ok {
+		return
+	}
+
+	var createdBy *uuid.UUID
+	if developerID, err := uuid.Parse(ginCtx.GetString("user_id")); err == nil {
+		createdBy = &developerID
+	}
+
+	bundle, created, err := h.service.Build(ctx, courseID, createdBy)
+	if err != nil {
+		h.logger.WithContext(ctx).Error("course_bundle_handler.build", map[string]interface{}{
+			"error":    err.Error(),
+			"courseID": courseID,
+		}, "Failed to build course bundle")
+		h.writeError(w, err, "Failed to build course bundle")
+		return
+	}
+
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+	response.WriteJSON(w, status, toCourseBundleResponse(bundle))
+}
+
+func (h *CourseBundleHandler) List(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	_, courseID, ok := h.courseID(ctx, w)
+	if !ok {
+		return
+	}
+
+	bundles, err := h.service.List(ctx, courseID)
+	if err != nil {
+		h.writeError(w, err, "Failed to list course bundles")
+		return
+	}
+
+	responseData := make([]courseDTO.CourseBundleResponse, len(bundles))
+	for i, bundle := range bundles {
+		responseData[i] = toCourseBundleResponse(bundle)
+	}
+	response.WriteJSON(w, http.StatusOK, responseData)
+}
+
+// Download serves the archive of a version, "latest" selects the newest one
+func (h *CourseBundleHandler) Download(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	ginCtx, courseID, ok := h.courseID(ctx, w)
+	if !ok {
+		return
+	}
+
+	version, ok := parseBundleVersion(ginCtx.Param("version"))
+	if !ok {
+		response.WriteError(w, http.StatusBadRequest, "Invalid bundle version")
+		return
+	}
+
+	bundle, err := h.service.Get(ctx, courseID, version)
+	if err != nil {
+		h.writeError(w, err, "Failed to get course bundle")
+		return
+	}
+
+	etag := `"` + bundle.ArchiveChecksum + `"`
+	w.Header().Set("ETag", etag)
+	w.Header().Set("X-Bundle-Version", strconv.Itoa(bundle.Version))
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	writeArchive(w, fmt.Sprintf("course-%s-v%d.tar.gz", courseID, bundle.Version), bundle.Archive)
+}
+
+// Diff serves an archive taking a client from the from version to the to version (latest by default)
+func (h *CourseBundleHandler) Diff(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	_, courseID, ok := h.courseID(ctx, w)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	fromVersion, err := strconv.Atoi(query.Get("from"))
+	if err != nil || fromVersion < 1 {
+		response.WriteError(w, http.StatusBadRequest, "Invalid from version")
+		return
+	}
+	toVersion, ok := parseBundleVersion(query.Get("to"))
+	if !ok {
+		response.WriteError(w, http.StatusBadRequest, "Invalid to version")
+		return
+	}
+
+	data, diff, err := h.service.Diff(ctx, courseID, fromVersion, toVersion)
+	if err != nil {
+		h.logger.WithContext(ctx).Error("course_bundle_handler.diff", map[string]interface{}{
+			"error":    err.Error(),
+			"courseID": courseID,
+			"from":     fromVersion,
+			"to":       toVersion,
+		}, "Failed to build course bundle diff")
+		h.writeError(w, err, "Failed to build course bundle diff")
+		return
+	}
+
+	w.Header().Set("X-Bundle-Version", strconv.Itoa(diff.ToVersion))
+	writeArchive(w, fmt.Sprintf("course-%s-v%d-v%d.diff.tar.gz", courseID, diff.FromVersion, diff.ToVersion), data)
+}
+
+// parseBundleVersion maps "" and "latest" to 0
+func parseBundleVersion(raw string) (int, bool) {
+	if raw == "" || raw == "latest" {
+		return 0, true
+	}
+	version, err := strconv.Atoi(raw)
+	if err != nil || version < 1 {
+		return 0, false
+	}
+	return version, true
+}
+
+func writeArchive(w http.ResponseWriter, filename string, data []byte) {
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
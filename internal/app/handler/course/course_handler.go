@@ -47,7 +47,7 @@ func NewCourseHandler(
 func (h *CourseHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req courseDTO.CreateCourseRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("course_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("course_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -67,7 +67,7 @@ func (h *CourseHandler) Create(ctx context.Context, w http.ResponseWriter, r *ht
 	}
 
 	if err := h.service.Create(ctx, course); err != nil {
-		h.logger.Error("course_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("course_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create course")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create course")
@@ -90,7 +90,7 @@ func (h *CourseHandler) Create(ctx context.Context, w http.ResponseWriter, r *ht
 func (h *CourseHandler) GetByID(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("course_handler.get.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("course_handler.get.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -98,7 +98,7 @@ func (h *CourseHandler) GetByID(ctx context.Context, w http.ResponseWriter, r *h
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("course_handler.get.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("course_handler.get.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid course ID format")
@@ -108,7 +108,7 @@ func (h *CourseHandler) GetByID(ctx context.Context, w http.ResponseWriter, r *h
 
 	courseDetail, err := h.service.GetByID(ctx, id)
 	if err != nil {
-		h.logger.Error("course_handler.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("course_handler.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get course")
@@ -122,7 +122,7 @@ func (h *CourseHandler) GetByID(ctx context.Context, w http.ResponseWriter, r *h
 func (h *CourseHandler) Update(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("course_handler.update.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("course_handler.update.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -130,7 +130,7 @@ func (h *CourseHandler) Update(ctx context.Context, w http.ResponseWriter, r *ht
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("course_handler.update.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("course_handler.update.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid course ID format")
@@ -140,7 +140,7 @@ func (h *CourseHandler) Update(ctx context.Context, w http.ResponseWriter, r *ht
 
 	var req courseDTO.UpdateCourseFieldRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("course_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("course_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -148,7 +148,7 @@ func (h *CourseHandler) Update(ctx context.Context, w http.ResponseWriter, r *ht
 	}
 
 	if err := h.service.Update(ctx, id, req); err != nil {
-		h.logger.Error("course_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("course_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to update course")
@@ -162,7 +162,7 @@ func (h *CourseHandler) Update(ctx context.Context, w http.ResponseWriter, r *ht
 func (h *CourseHandler) Delete(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("course_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("course_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -170,7 +170,7 @@ func (h *CourseHandler) Delete(ctx context.Context, w http.ResponseWriter, r *ht
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("course_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("course_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid course ID format")
@@ -179,7 +179,7 @@ func (h *CourseHandler) Delete(ctx context.Context, w http.ResponseWriter, r *ht
 	}
 
 	if err := h.service.Delete(ctx, id); err != nil {
-		h.logger.Error("course_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("course_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete course")
@@ -193,14 +193,14 @@ func (h *CourseHandler) Delete(ctx context.Context, w http.ResponseWriter, r *ht
 func (h *CourseHandler) Search(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("course_handler.search.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("course_handler.search.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
 
 	var filter courseDTO.CourseSearchRequest
 	if err := ginCtx.ShouldBindQuery(&filter); err != nil {
-		h.logger.Error("course_handler.search.bind", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("course_handler.search.bind", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to bind query parameters")
 		response.WriteError(w, http.StatusBadRequest, "Invalid query parameters")
@@ -216,7 +216,7 @@ func (h *CourseHandler) Search(ctx context.Context, w http.ResponseWriter, r *ht
 	}
 
 	// Log search parameters for debugging
-	h.logger.Debug("course_handler.search.params", map[string]interface{}{
+	h.logger.WithContext(ctx).Debug("course_handler.search.params", map[string]interface{}{
 		"type":      filter.Type,
 		"title":     filter.Title,
 		"skills":    filter.Skills,
@@ -227,7 +227,7 @@ func (h *CourseHandler) Search(ctx context.Context, w http.ResponseWriter, r *ht
 
 	result, err := h.service.SearchWithFilter(ctx, filter)
 	if err != nil {
-		h.logger.Error("course_handler.search", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("course_handler.search", map[string]interface{}{
 			"error":  err.Error(),
 			"filter": filter,
 		}, "Failed to search courses")
@@ -248,7 +248,7 @@ func (h *CourseHandler) Search(ctx context.Context, w http.ResponseWriter, r *ht
 
 func (h *CourseHandler) DeleteAllCourseData(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	if err := h.service.DeleteAllCourseData(ctx); err != nil {
-		h.logger.Error("course_handler.delete_all", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("course_handler.delete_all", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to delete all course data")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to delete all course data")
@@ -35,7 +35,7 @@ func NewCourseOtherHandler(
 func (h *CourseOtherHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req courseDTO.CreateCourseOtherRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("course_other_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("course_other_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -50,7 +50,7 @@ func (h *CourseOtherHandler) Create(ctx context.Context, w http.ResponseWriter,
 	}
 
 	if err := h.service.Create(ctx, courseOther); err != nil {
-		h.logger.Error("course_other_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("course_other_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create course other")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create course other")
@@ -68,7 +68,7 @@ func (h *CourseOtherHandler) Create(ctx context.Context, w http.ResponseWriter,
 func (h *CourseOtherHandler) Delete(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("course_other_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("course_other_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -76,7 +76,7 @@ func (h *CourseOtherHandler) Delete(ctx context.Context, w http.ResponseWriter,
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("course_other_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("course_other_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid course other ID format")
@@ -85,7 +85,7 @@ func (h *CourseOtherHandler) Delete(ctx context.Context, w http.ResponseWriter,
 	}
 
 	if err := h.service.Delete(ctx, id); err != nil {
-		h.logger.Error("course_other_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("course_other_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete course other")
@@ -35,7 +35,7 @@ func NewLessonHandler(
 func (h *LessonHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req courseDTO.CreateLessonRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("lesson_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("lesson_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -53,7 +53,7 @@ func (h *LessonHandler) Create(ctx context.Context, w http.ResponseWriter, r *ht
 	}
 
 	if err := h.service.Create(ctx, lesson); err != nil {
-		h.logger.Error("lesson_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("lesson_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create lesson")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create lesson")
@@ -74,7 +74,7 @@ func (h *LessonHandler) Create(ctx context.Context, w http.ResponseWriter, r *ht
 func (h *LessonHandler) Update(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req courseDTO.UpdateLessonFieldRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("lesson_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("lesson_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -84,7 +84,7 @@ func (h *LessonHandler) Update(ctx context.Context, w http.ResponseWriter, r *ht
 	// Get existing record first
 	existingLesson, err := h.service.GetByID(ctx, req.LessonID)
 	if err != nil {
-		h.logger.Error("lesson_handler.update.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("lesson_handler.update.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.LessonID,
 		}, "Failed to get existing lesson")
@@ -123,7 +123,7 @@ func (h *LessonHandler) Update(ctx context.Context, w http.ResponseWriter, r *ht
 	existingLesson.UpdatedAt = time.Now()
 
 	if err := h.service.Update(ctx, existingLesson); err != nil {
-		h.logger.Error("lesson_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("lesson_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.LessonID,
 		}, "Failed to update lesson")
@@ -137,7 +137,7 @@ func (h *LessonHandler) Update(ctx context.Context, w http.ResponseWriter, r *ht
 func (h *LessonHandler) Delete(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("lesson_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("lesson_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -145,7 +145,7 @@ func (h *LessonHandler) Delete(ctx context.Context, w http.ResponseWriter, r *ht
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("lesson_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("lesson_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid lesson ID format")
@@ -154,7 +154,7 @@ func (h *LessonHandler) Delete(ctx context.Context, w http.ResponseWriter, r *ht
 	}
 
 	if err := h.service.Delete(ctx, id); err != nil {
-		h.logger.Error("lesson_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("lesson_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete lesson")
@@ -168,7 +168,7 @@ func (h *LessonHandler) Delete(ctx context.Context, w http.ResponseWriter, r *ht
 func (h *LessonHandler) SwapSequence(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req courseDTO.SwapSequenceRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("lesson_handler.swap.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("lesson_handler.swap.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -176,7 +176,7 @@ func (h *LessonHandler) SwapSequence(ctx context.Context, w http.ResponseWriter,
 	}
 
 	if err := h.service.SwapSequence(ctx, req.ID1, req.ID2); err != nil {
-		h.logger.Error("lesson_handler.swap", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("lesson_handler.swap", map[string]interface{}{
 			"error": err.Error(),
 			"id1":   req.ID1,
 			"id2":   req.ID2,
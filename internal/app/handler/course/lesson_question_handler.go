@@ -35,7 +35,7 @@ func NewLessonQuestionHandler(
 func (h *LessonQuestionHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req courseDTO.CreateLessonQuestionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("lesson_question_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("lesson_question_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -53,7 +53,7 @@ func (h *LessonQuestionHandler) Create(ctx context.Context, w http.ResponseWrite
 	}
 
 	if err := h.service.Create(ctx, lessonQuestion); err != nil {
-		h.logger.Error("lesson_question_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("lesson_question_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create lesson question")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create lesson question")
@@ -74,7 +74,7 @@ func (h *LessonQuestionHandler) Create(ctx context.Context, w http.ResponseWrite
 func (h *LessonQuestionHandler) Update(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req courseDTO.UpdateLessonQuestionFieldRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("lesson_question_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("lesson_question_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -84,7 +84,7 @@ func (h *LessonQuestionHandler) Update(ctx context.Context, w http.ResponseWrite
 	// Get existing record first
 	existingQuestion, err := h.service.GetByID(ctx, req.LessonQuestionID)
 	if err != nil {
-		h.logger.Error("lesson_question_handler.update.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("lesson_question_handler.update.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.LessonQuestionID,
 		}, "Failed to get existing lesson question")
@@ -128,7 +128,7 @@ func (h *LessonQuestionHandler) Update(ctx context.Context, w http.ResponseWrite
 	existingQuestion.UpdatedAt = time.Now()
 
 	if err := h.service.Update(ctx, existingQuestion); err != nil {
-		h.logger.Error("lesson_question_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("lesson_question_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.LessonQuestionID,
 		}, "Failed to update lesson question")
@@ -142,7 +142,7 @@ func (h *LessonQuestionHandler) Update(ctx context.Context, w http.ResponseWrite
 func (h *LessonQuestionHandler) Delete(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("lesson_question_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("lesson_question_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -150,7 +150,7 @@ func (h *LessonQuestionHandler) Delete(ctx context.Context, w http.ResponseWrite
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("lesson_question_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("lesson_question_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid lesson question ID format")
@@ -159,7 +159,7 @@ func (h *LessonQuestionHandler) Delete(ctx context.Context, w http.ResponseWrite
 	}
 
 	if err := h.service.Delete(ctx, id); err != nil {
-		h.logger.Error("lesson_question_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("lesson_question_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete lesson question")
@@ -173,7 +173,7 @@ func (h *LessonQuestionHandler) Delete(ctx context.Context, w http.ResponseWrite
 func (h *LessonQuestionHandler) SwapSequence(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req courseDTO.SwapSequenceRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("lesson_question_handler.swap.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("lesson_question_handler.swap.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -181,7 +181,7 @@ func (h *LessonQuestionHandler) SwapSequence(ctx context.Context, w http.Respons
 	}
 
 	if err := h.service.SwapSequence(ctx, req.ID1, req.ID2); err != nil {
-		h.logger.Error("lesson_question_handler.swap", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("lesson_question_handler.swap", map[string]interface{}{
 			"error": err.Error(),
 			"id1":   req.ID1,
 			"id2":   req.ID2,
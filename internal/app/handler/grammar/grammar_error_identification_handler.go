@@ -37,7 +37,7 @@ func NewGrammarErrorIdentificationHandler(
 func (h *GrammarErrorIdentificationHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req grammarDTO.CreateGrammarErrorIdentificationRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("grammar_error_identification_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_error_identification_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -56,7 +56,7 @@ func (h *GrammarErrorIdentificationHandler) Create(ctx context.Context, w http.R
 	}
 
 	if err := h.service.Create(ctx, identification); err != nil {
-		h.logger.Error("grammar_error_identification_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_error_identification_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create error identification")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create error identification")
@@ -77,7 +77,7 @@ func (h *GrammarErrorIdentificationHandler) Create(ctx context.Context, w http.R
 func (h *GrammarErrorIdentificationHandler) Update(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req grammarDTO.UpdateGrammarErrorIdentificationRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("grammar_error_identification_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_error_identification_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -86,7 +86,7 @@ func (h *GrammarErrorIdentificationHandler) Update(ctx context.Context, w http.R
 
 	identification, err := h.service.GetByID(ctx, req.GrammarErrorIdentificationID)
 	if err != nil {
-		h.logger.Error("grammar_error_identification_handler.update.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_error_identification_handler.update.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.GrammarErrorIdentificationID,
 		}, "Failed to get error identification")
@@ -115,7 +115,7 @@ func (h *GrammarErrorIdentificationHandler) Update(ctx context.Context, w http.R
 	identification.UpdatedAt = time.Now()
 
 	if err := h.service.Update(ctx, identification); err != nil {
-		h.logger.Error("grammar_error_identification_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_error_identification_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.GrammarErrorIdentificationID,
 		}, "Failed to update error identification")
@@ -129,7 +129,7 @@ func (h *GrammarErrorIdentificationHandler) Update(ctx context.Context, w http.R
 func (h *GrammarErrorIdentificationHandler) Delete(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("grammar_error_identification_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("grammar_error_identification_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -137,7 +137,7 @@ func (h *GrammarErrorIdentificationHandler) Delete(ctx context.Context, w http.R
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("grammar_error_identification_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_error_identification_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid error identification ID format")
@@ -146,7 +146,7 @@ func (h *GrammarErrorIdentificationHandler) Delete(ctx context.Context, w http.R
 	}
 
 	if err := h.service.Delete(ctx, id); err != nil {
-		h.logger.Error("grammar_error_identification_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_error_identification_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete error identification")
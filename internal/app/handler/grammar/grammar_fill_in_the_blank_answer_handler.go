@@ -37,7 +37,7 @@ func NewGrammarFillInTheBlankAnswerHandler(
 func (h *GrammarFillInTheBlankAnswerHandler) CreateAnswer(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req grammarDTO.CreateGrammarFillInTheBlankAnswerRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("grammar_fill_in_the_blank_answer_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_answer_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -54,7 +54,7 @@ func (h *GrammarFillInTheBlankAnswerHandler) CreateAnswer(ctx context.Context, w
 	}
 
 	if err := h.service.CreateAnswer(ctx, answer); err != nil {
-		h.logger.Error("grammar_fill_in_the_blank_answer_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_answer_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create answer")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create answer")
@@ -73,7 +73,7 @@ func (h *GrammarFillInTheBlankAnswerHandler) CreateAnswer(ctx context.Context, w
 func (h *GrammarFillInTheBlankAnswerHandler) UpdateAnswer(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req grammarDTO.UpdateGrammarFillInTheBlankAnswerRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("grammar_fill_in_the_blank_answer_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_answer_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -82,7 +82,7 @@ func (h *GrammarFillInTheBlankAnswerHandler) UpdateAnswer(ctx context.Context, w
 
 	answer, err := h.service.GetAnswer(ctx, req.GrammarFillInTheBlankAnswerID)
 	if err != nil {
-		h.logger.Error("grammar_fill_in_the_blank_answer_handler.update.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_answer_handler.update.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.GrammarFillInTheBlankAnswerID,
 		}, "Failed to get answer")
@@ -105,7 +105,7 @@ func (h *GrammarFillInTheBlankAnswerHandler) UpdateAnswer(ctx context.Context, w
 	answer.UpdatedAt = time.Now()
 
 	if err := h.service.UpdateAnswer(ctx, answer); err != nil {
-		h.logger.Error("grammar_fill_in_the_blank_answer_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_answer_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.GrammarFillInTheBlankAnswerID,
 		}, "Failed to update answer")
@@ -119,7 +119,7 @@ func (h *GrammarFillInTheBlankAnswerHandler) UpdateAnswer(ctx context.Context, w
 func (h *GrammarFillInTheBlankAnswerHandler) DeleteAnswer(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("grammar_fill_in_the_blank_answer_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_answer_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -127,7 +127,7 @@ func (h *GrammarFillInTheBlankAnswerHandler) DeleteAnswer(ctx context.Context, w
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("grammar_fill_in_the_blank_answer_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_answer_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid answer ID format")
@@ -136,7 +136,7 @@ func (h *GrammarFillInTheBlankAnswerHandler) DeleteAnswer(ctx context.Context, w
 	}
 
 	if err := h.service.DeleteAnswer(ctx, id); err != nil {
-		h.logger.Error("grammar_fill_in_the_blank_answer_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_answer_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete answer")
@@ -54,7 +54,7 @@ func NewGrammarQuestionHandler(
 func (h *GrammarQuestionHandler) CreateGrammarQuestion(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req grammarDTO.CreateGrammarQuestionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("grammar_question_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_question_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Invalid request format")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request format")
@@ -71,12 +71,13 @@ func (h *GrammarQuestionHandler) CreateGrammarQuestion(ctx context.Context, w ht
 		Version:     1,
 	}
 
-	if err := h.service.CreateQuestion(ctx, question); err != nil {
+	nearDuplicates, err := h.service.CreateQuestion(ctx, question)
+	if err != nil {
 		code := http.StatusInternalServerError
 		if errors.Is(err, grammarService.ErrInvalidInput) {
 			code = http.StatusBadRequest
 		}
-		h.logger.Error("grammar_question_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_question_handler.create", map[string]interface{}{
 			"error":         err.Error(),
 			"question_type": question.Type,
 		}, "Failed to create grammar question")
@@ -84,14 +85,21 @@ func (h *GrammarQuestionHandler) CreateGrammarQuestion(ctx context.Context, w ht
 		return
 	}
 
-	responseData := grammarDTO.GrammarQuestionResponse{
-		ID:          question.ID,
-		Type:        string(question.Type),
-		Topic:       question.Topic,
-		Instruction: question.Instruction,
-		ImageURLs:   question.ImageURLs,
-		MaxTime:     question.MaxTime,
-		Version:     question.Version,
+	// Near-duplicates are a warning for the author, the question is created either way
+	responseData := struct {
+		grammarDTO.GrammarQuestionResponse
+		NearDuplicates []grammarDTO.SimilarQuestion `json:"near_duplicates,omitempty"`
+	}{
+		GrammarQuestionResponse: grammarDTO.GrammarQuestionResponse{
+			ID:          question.ID,
+			Type:        string(question.Type),
+			Topic:       question.Topic,
+			Instruction: question.Instruction,
+			ImageURLs:   question.ImageURLs,
+			MaxTime:     question.MaxTime,
+			Version:     question.Version,
+		},
+		NearDuplicates: nearDuplicates,
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -102,14 +110,14 @@ func (h *GrammarQuestionHandler) CreateGrammarQuestion(ctx context.Context, w ht
 func (h *GrammarQuestionHandler) GetGrammarQuestionDetail(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtxValue := ctx.Value(constants.GinContextKey)
 	if ginCtxValue == nil {
-		h.logger.Error("grammar_question_handler.get", nil, "GinContextKey not found in context")
+		h.logger.WithContext(ctx).Error("grammar_question_handler.get", nil, "GinContextKey not found in context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
 
 	ginCtx, ok := ginCtxValue.(*gin.Context)
 	if !ok {
-		h.logger.Error("grammar_question_handler.get", nil, "Failed to convert context value to *gin.Context")
+		h.logger.WithContext(ctx).Error("grammar_question_handler.get", nil, "Failed to convert context value to *gin.Context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -117,7 +125,7 @@ func (h *GrammarQuestionHandler) GetGrammarQuestionDetail(ctx context.Context, w
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("grammar_question_handler.get.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_question_handler.get.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid question ID format")
@@ -131,7 +139,7 @@ func (h *GrammarQuestionHandler) GetGrammarQuestionDetail(ctx context.Context, w
 		if errors.Is(err, grammarService.ErrQuestionNotFound) {
 			statusCode = http.StatusNotFound
 		}
-		h.logger.Error("grammar_question_handler.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_question_handler.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get grammar question")
@@ -146,14 +154,14 @@ func (h *GrammarQuestionHandler) GetGrammarQuestionDetail(ctx context.Context, w
 func (h *GrammarQuestionHandler) UpdateGrammarQuestion(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtxValue := ctx.Value(constants.GinContextKey)
 	if ginCtxValue == nil {
-		h.logger.Error("grammar_question_handler.update", nil, "GinContextKey not found in context")
+		h.logger.WithContext(ctx).Error("grammar_question_handler.update", nil, "GinContextKey not found in context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
 
 	ginCtx, ok := ginCtxValue.(*gin.Context)
 	if !ok {
-		h.logger.Error("grammar_question_handler.update", nil, "Failed to convert context value to *gin.Context")
+		h.logger.WithContext(ctx).Error("grammar_question_handler.update", nil, "Failed to convert context value to *gin.Context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -161,7 +169,7 @@ func (h *GrammarQuestionHandler) UpdateGrammarQuestion(ctx context.Context, w ht
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("grammar_question_handler.update.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_question_handler.update.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid question ID format")
@@ -171,7 +179,7 @@ func (h *GrammarQuestionHandler) UpdateGrammarQuestion(ctx context.Context, w ht
 
 	var req grammarDTO.UpdateGrammarQuestionFieldRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("grammar_question_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_question_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -179,7 +187,7 @@ func (h *GrammarQuestionHandler) UpdateGrammarQuestion(ctx context.Context, w ht
 	}
 
 	if err := h.service.UpdateQuestion(ctx, id, req); err != nil {
-		h.logger.Error("grammar_question_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_question_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to update grammar question")
@@ -198,14 +206,14 @@ func (h *GrammarQuestionHandler) UpdateGrammarQuestion(ctx context.Context, w ht
 func (h *GrammarQuestionHandler) DeleteGrammarQuestion(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtxValue := ctx.Value(constants.GinContextKey)
 	if ginCtxValue == nil {
-		h.logger.Error("grammar_question_handler.delete", nil, "GinContextKey not found in context")
+		h.logger.WithContext(ctx).Error("grammar_question_handler.delete", nil, "GinContextKey not found in context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
 
 	ginCtx, ok := ginCtxValue.(*gin.Context)
 	if !ok {
-		h.logger.Error("grammar_question_handler.delete", nil, "Failed to convert context value to *gin.Context")
+		h.logger.WithContext(ctx).Error("grammar_question_handler.delete", nil, "Failed to convert context value to *gin.Context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -213,7 +221,7 @@ func (h *GrammarQuestionHandler) DeleteGrammarQuestion(ctx context.Context, w ht
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("grammar_question_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_question_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid question ID format")
@@ -222,7 +230,7 @@ func (h *GrammarQuestionHandler) DeleteGrammarQuestion(ctx context.Context, w ht
 	}
 
 	if err := h.service.DeleteQuestion(ctx, id); err != nil {
-		h.logger.Error("grammar_question_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_question_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete grammar question")
@@ -236,7 +244,7 @@ func (h *GrammarQuestionHandler) DeleteGrammarQuestion(ctx context.Context, w ht
 func (h *GrammarQuestionHandler) GetListNewGrammarQuestionByListVersionAndID(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req grammarDTO.GetNewUpdatesGrammarQuestionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("grammar_question_handler.get_new_updates.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_question_handler.get_new_updates.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -255,7 +263,7 @@ func (h *GrammarQuestionHandler) GetListNewGrammarQuestionByListVersionAndID(ctx
 
 	questions, err := h.service.GetNewUpdatedQuestions(ctx, versionChecks)
 	if err != nil {
-		h.logger.Error("grammar_question_handler.get_new_grammar_questions", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_question_handler.get_new_grammar_questions", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get updated questions")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to get updated questions")
@@ -294,7 +302,7 @@ type GetListGrammarByListIDRequest struct {
 func (h *GrammarQuestionHandler) GetListGrammarByListID(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req GetListGrammarByListIDRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("grammar_question_handler.get_list_by_ids.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_question_handler.get_list_by_ids.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -306,7 +314,7 @@ func (h *GrammarQuestionHandler) GetListGrammarByListID(ctx context.Context, w h
 	for _, idStr := range req.QuestionIDs {
 		id, err := uuid.Parse(idStr)
 		if err != nil {
-			h.logger.Error("grammar_question_handler.get_list_by_ids.parse_id", map[string]interface{}{
+			h.logger.WithContext(ctx).Error("grammar_question_handler.get_list_by_ids.parse_id", map[string]interface{}{
 				"error": err.Error(),
 				"id":    idStr,
 			}, "Invalid question ID format")
@@ -319,7 +327,7 @@ func (h *GrammarQuestionHandler) GetListGrammarByListID(ctx context.Context, w h
 	// Get questions with details
 	questions, err := h.service.GetGrammarByListID(ctx, questionIDs)
 	if err != nil {
-		h.logger.Error("grammar_question_handler.get_list_by_ids", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_question_handler.get_list_by_ids", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get questions")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to get questions")
@@ -335,14 +343,14 @@ func (h *GrammarQuestionHandler) GetListGrammarByListID(ctx context.Context, w h
 func (h *GrammarQuestionHandler) GetListGrammarQuestiondetailPaginationWithFilter(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("grammar_question_handler.search.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("grammar_question_handler.search.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
 
 	var filter grammarDTO.GrammarQuestionSearchFilter
 	if err := ginCtx.ShouldBindQuery(&filter); err != nil {
-		h.logger.Error("grammar_question_handler.search.bind", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_question_handler.search.bind", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to bind query parameters")
 		response.WriteError(w, http.StatusBadRequest, "Invalid query parameters")
@@ -351,7 +359,7 @@ func (h *GrammarQuestionHandler) GetListGrammarQuestiondetailPaginationWithFilte
 
 	questions, err := h.service.SearchQuestionsWithFilter(ctx, filter)
 	if err != nil {
-		h.logger.Error("grammar_question_handler.search", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_question_handler.search", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to search questions")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to search questions")
@@ -366,7 +374,7 @@ func (h *GrammarQuestionHandler) GetListGrammarQuestiondetailPaginationWithFilte
 
 func (h *GrammarQuestionHandler) DeleteAllGrammarData(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	if err := h.service.DeleteAllQuestions(ctx); err != nil {
-		h.logger.Error("grammar_question_handler.delete_all", map[string]interface{}{"error": err.Error()}, "Failed to delete all grammar data")
+		h.logger.WithContext(ctx).Error("grammar_question_handler.delete_all", map[string]interface{}{"error": err.Error()}, "Failed to delete all grammar data")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to delete all grammar data")
 		return
 	}
@@ -37,7 +37,7 @@ func NewGrammarSentenceTransformationHandler(
 func (h *GrammarSentenceTransformationHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req grammarDTO.CreateGrammarSentenceTransformationRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("grammar_sentence_transformation_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_sentence_transformation_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -56,7 +56,7 @@ func (h *GrammarSentenceTransformationHandler) Create(ctx context.Context, w htt
 	}
 
 	if err := h.service.Create(ctx, transformation); err != nil {
-		h.logger.Error("grammar_sentence_transformation_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_sentence_transformation_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create sentence transformation")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create sentence transformation")
@@ -77,7 +77,7 @@ func (h *GrammarSentenceTransformationHandler) Create(ctx context.Context, w htt
 func (h *GrammarSentenceTransformationHandler) Update(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req grammarDTO.UpdateGrammarSentenceTransformationRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("grammar_sentence_transformation_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_sentence_transformation_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -86,7 +86,7 @@ func (h *GrammarSentenceTransformationHandler) Update(ctx context.Context, w htt
 
 	transformation, err := h.service.GetByID(ctx, req.GrammarSentenceTransformationID)
 	if err != nil {
-		h.logger.Error("grammar_sentence_transformation_handler.update.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_sentence_transformation_handler.update.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.GrammarSentenceTransformationID,
 		}, "Failed to get sentence transformation")
@@ -115,7 +115,7 @@ func (h *GrammarSentenceTransformationHandler) Update(ctx context.Context, w htt
 	transformation.UpdatedAt = time.Now()
 
 	if err := h.service.Update(ctx, transformation); err != nil {
-		h.logger.Error("grammar_sentence_transformation_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_sentence_transformation_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.GrammarSentenceTransformationID,
 		}, "Failed to update sentence transformation")
@@ -129,7 +129,7 @@ func (h *GrammarSentenceTransformationHandler) Update(ctx context.Context, w htt
 func (h *GrammarSentenceTransformationHandler) Delete(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("grammar_sentence_transformation_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("grammar_sentence_transformation_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -137,7 +137,7 @@ func (h *GrammarSentenceTransformationHandler) Delete(ctx context.Context, w htt
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("grammar_sentence_transformation_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_sentence_transformation_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid sentence transformation ID format")
@@ -146,7 +146,7 @@ func (h *GrammarSentenceTransformationHandler) Delete(ctx context.Context, w htt
 	}
 
 	if err := h.service.Delete(ctx, id); err != nil {
-		h.logger.Error("grammar_sentence_transformation_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("grammar_sentence_transformation_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete sentence transformation")
@@ -0,0 +1,154 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fluencybe/internal/app/dto"
+	jobModel "fluencybe/internal/app/model/job"
+	jobService "fluencybe/internal/app/service/job"
+	"fluencybe/internal/core/constants"
+	"fluencybe/pkg/logger"
+	"fluencybe/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type JobHandler struct {
+	service *jobService.JobService
+	logger  *logger.PrettyLogger
+}
+
+func NewJobHandler(service *jobService.JobService, logger *logger.PrettyLogger) *JobHandler {
+	return &JobHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// CreateJob queues a job, its progress is read from GetJob
+func (h *JobHandler) CreateJob(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
+	if !ok {
+		response.WriteError(w, http.StatusInternalServerError, "Invalid context")
+		return
+	}
+
+	var req dto.CreateJobRequest
+	// Leave room for the type and the envelope around the largest payload
+	body := http.MaxBytesReader(w, r.Body, constants.JobMaxPayloadSize+1024)
+	if err := json.NewDecoder(body).Decode(&req); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			response.WriteError(w, http.StatusRequestEntityTooLarge, jobService.ErrPayloadTooLarge.Error())
+			return
+		}
+		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if req.Type == "" {
+		response.WriteError(w, http.StatusBadRequest, "Job type is required")
+		return
+	}
+
+	var createdBy *uuid.UUID
+	if developerID, err := uuid.Parse(ginCtx.GetString("user_id")); err == nil {
+		createdBy = &developerID
+	}
+
+	job, err := h.service.Enqueue(ctx, req.Type, req.Payload, createdBy)
+	if err != nil {
+		switch {
+		case errors.Is(err, jobService.ErrUnknownJobType), errors.Is(err, jobService.ErrInvalidPayload):
+			response.WriteError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, jobService.ErrPayloadTooLarge):
+			response.WriteError(w, http.StatusRequestEntityTooLarge, err.Error())
+		default:
+			h.logger.WithContext(ctx).Error("job_handler.create", map[string]interface{}{
+				"error": err.Error(),
+				"type":  req.Type,
+			}, "Failed to queue job")
+			response.WriteError(w, http.StatusInternalServerError, "Failed to queue job")
+		}
+		return
+	}
+
+	response.WriteJSON(w, http.StatusAccepted, gin.H{
+		"success": true,
+		"data":    job,
+	})
+}
+
+func (h *JobHandler) GetJob(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
+	if !ok {
+		response.WriteError(w, http.StatusInternalServerError, "Invalid context")
+		return
+	}
+
+	id, err := uuid.Parse(ginCtx.Param("id"))
+	if err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid job ID")
+		return
+	}
+
+	job, err := h.service.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, jobService.ErrJobNotFound) {
+			response.WriteError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		h.logger.WithContext(ctx).Error("job_handler.get", map[string]interface{}{
+			"error": err.Error(),
+			"id":    id,
+		}, "Failed to get job")
+		response.WriteError(w, http.StatusInternalServerError, "Failed to get job")
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, gin.H{
+		"success": true,
+		"data":    job,
+	})
+}
+
+// ListJobs lists jobs newest first, filtered by ?type= and ?status=
+func (h *JobHandler) ListJobs(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	page, pageSize := 1, 0
+	var err error
+	if raw := query.Get("page"); raw != "" {
+		if page, err = strconv.Atoi(raw); err != nil || page < 1 {
+			response.WriteError(w, http.StatusBadRequest, "Invalid page")
+			return
+		}
+	}
+	if raw := query.Get("page_size"); raw != "" {
+		if pageSize, err = strconv.Atoi(raw); err != nil || pageSize < 1 {
+			response.WriteError(w, http.StatusBadRequest, "Invalid page_size")
+			return
+		}
+	}
+
+	result, err := h.service.List(ctx, query.Get("type"), jobModel.JobStatus(query.Get("status")), page, pageSize)
+	if err != nil {
+		if errors.Is(err, jobService.ErrInvalidStatus) {
+			response.WriteError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		h.logger.WithContext(ctx).Error("job_handler.list", map[string]interface{}{
+			"error": err.Error(),
+		}, "Failed to list jobs")
+		response.WriteError(w, http.StatusInternalServerError, "Failed to list jobs")
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
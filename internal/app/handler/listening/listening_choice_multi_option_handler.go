@@ -38,7 +38,7 @@ func NewListeningChoiceMultiOptionHandler(
 func (h *ListeningChoiceMultiOptionHandler) CreateOption(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req listeningDTO.CreateListeningChoiceMultiOptionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("listening_choice_multi_option_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_choice_multi_option_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -55,7 +55,7 @@ func (h *ListeningChoiceMultiOptionHandler) CreateOption(ctx context.Context, w
 	}
 
 	if err := h.service.CreateOption(ctx, option); err != nil {
-		h.logger.Error("listening_choice_multi_option_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_choice_multi_option_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create option")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create option")
@@ -74,7 +74,7 @@ func (h *ListeningChoiceMultiOptionHandler) CreateOption(ctx context.Context, w
 func (h *ListeningChoiceMultiOptionHandler) UpdateOption(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req listeningDTO.UpdateListeningChoiceMultiOptionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("listening_choice_multi_option_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_choice_multi_option_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -83,7 +83,7 @@ func (h *ListeningChoiceMultiOptionHandler) UpdateOption(ctx context.Context, w
 
 	option, err := h.service.GetOption(ctx, req.ListeningChoiceMultiOptionID)
 	if err != nil {
-		h.logger.Error("listening_choice_multi_option_handler.update.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_choice_multi_option_handler.update.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.ListeningChoiceMultiOptionID,
 		}, "Failed to get option")
@@ -113,7 +113,7 @@ func (h *ListeningChoiceMultiOptionHandler) UpdateOption(ctx context.Context, w
 	option.UpdatedAt = time.Now()
 
 	if err := h.service.UpdateOption(ctx, option); err != nil {
-		h.logger.Error("listening_choice_multi_option_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_choice_multi_option_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.ListeningChoiceMultiOptionID,
 		}, "Failed to update option")
@@ -127,7 +127,7 @@ func (h *ListeningChoiceMultiOptionHandler) UpdateOption(ctx context.Context, w
 func (h *ListeningChoiceMultiOptionHandler) DeleteOption(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("listening_choice_multi_option_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("listening_choice_multi_option_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -135,7 +135,7 @@ func (h *ListeningChoiceMultiOptionHandler) DeleteOption(ctx context.Context, w
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("listening_choice_multi_option_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_choice_multi_option_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid option ID format")
@@ -144,7 +144,7 @@ func (h *ListeningChoiceMultiOptionHandler) DeleteOption(ctx context.Context, w
 	}
 
 	if err := h.service.DeleteOption(ctx, id); err != nil {
-		h.logger.Error("listening_choice_multi_option_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_choice_multi_option_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete option")
@@ -42,7 +42,7 @@ func NewListeningFillInTheBlankQuestionHandler(
 func (h *ListeningFillInTheBlankQuestionHandler) CreateQuestion(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req listeningDTO.CreateListeningFillInTheBlankQuestionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("listening_fill_in_the_blank_question_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_fill_in_the_blank_question_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -58,7 +58,7 @@ func (h *ListeningFillInTheBlankQuestionHandler) CreateQuestion(ctx context.Cont
 	}
 
 	if err := h.questionService.CreateQuestion(ctx, question); err != nil {
-		h.logger.Error("listening_fill_in_the_blank_question_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_fill_in_the_blank_question_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create question")
 
@@ -82,7 +82,7 @@ func (h *ListeningFillInTheBlankQuestionHandler) CreateQuestion(ctx context.Cont
 func (h *ListeningFillInTheBlankQuestionHandler) UpdateQuestion(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req listeningDTO.UpdateListeningFillInTheBlankQuestionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("listening_fill_in_the_blank_question_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_fill_in_the_blank_question_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -91,7 +91,7 @@ func (h *ListeningFillInTheBlankQuestionHandler) UpdateQuestion(ctx context.Cont
 
 	question, err := h.questionService.GetQuestion(ctx, req.ListeningFillInTheBlankQuestionID)
 	if err != nil {
-		h.logger.Error("listening_fill_in_the_blank_question_handler.update.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_fill_in_the_blank_question_handler.update.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.ListeningFillInTheBlankQuestionID,
 		}, "Failed to get question")
@@ -115,7 +115,7 @@ func (h *ListeningFillInTheBlankQuestionHandler) UpdateQuestion(ctx context.Cont
 	question.UpdatedAt = time.Now()
 
 	if err := h.questionService.UpdateQuestion(ctx, question); err != nil {
-		h.logger.Error("listening_fill_in_the_blank_question_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_fill_in_the_blank_question_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.ListeningFillInTheBlankQuestionID,
 		}, "Failed to update question")
@@ -129,7 +129,7 @@ func (h *ListeningFillInTheBlankQuestionHandler) UpdateQuestion(ctx context.Cont
 func (h *ListeningFillInTheBlankQuestionHandler) DeleteQuestion(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("listening_fill_in_the_blank_question_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("listening_fill_in_the_blank_question_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -137,7 +137,7 @@ func (h *ListeningFillInTheBlankQuestionHandler) DeleteQuestion(ctx context.Cont
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("listening_fill_in_the_blank_question_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_fill_in_the_blank_question_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid question ID format")
@@ -146,7 +146,7 @@ func (h *ListeningFillInTheBlankQuestionHandler) DeleteQuestion(ctx context.Cont
 	}
 
 	if err := h.questionService.DeleteQuestion(ctx, id); err != nil {
-		h.logger.Error("listening_fill_in_the_blank_question_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_fill_in_the_blank_question_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete question")
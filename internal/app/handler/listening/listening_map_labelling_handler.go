@@ -35,7 +35,7 @@ func NewListeningMapLabellingHandler(
 func (h *ListeningMapLabellingHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req listeningDTO.CreateListeningMapLabellingRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("listening_map_labelling_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_map_labelling_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -53,7 +53,7 @@ func (h *ListeningMapLabellingHandler) Create(ctx context.Context, w http.Respon
 	}
 
 	if err := h.service.Create(ctx, mapLabelling); err != nil {
-		h.logger.Error("listening_map_labelling_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_map_labelling_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create map labelling")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create map labelling")
@@ -73,7 +73,7 @@ func (h *ListeningMapLabellingHandler) Create(ctx context.Context, w http.Respon
 func (h *ListeningMapLabellingHandler) Update(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req listeningDTO.UpdateListeningMapLabellingRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("listening_map_labelling_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_map_labelling_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -83,7 +83,7 @@ func (h *ListeningMapLabellingHandler) Update(ctx context.Context, w http.Respon
 	// Get existing record first
 	existingLabelling, err := h.service.GetByID(ctx, req.ListeningMapLabellingID)
 	if err != nil {
-		h.logger.Error("listening_map_labelling_handler.update.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_map_labelling_handler.update.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.ListeningMapLabellingID,
 		}, "Failed to get existing labelling")
@@ -107,7 +107,7 @@ func (h *ListeningMapLabellingHandler) Update(ctx context.Context, w http.Respon
 	existingLabelling.UpdatedAt = time.Now()
 
 	if err := h.service.Update(ctx, existingLabelling); err != nil {
-		h.logger.Error("listening_map_labelling_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_map_labelling_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.ListeningMapLabellingID,
 		}, "Failed to update map labelling")
@@ -121,7 +121,7 @@ func (h *ListeningMapLabellingHandler) Update(ctx context.Context, w http.Respon
 func (h *ListeningMapLabellingHandler) Delete(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("listening_map_labelling_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("listening_map_labelling_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -129,7 +129,7 @@ func (h *ListeningMapLabellingHandler) Delete(ctx context.Context, w http.Respon
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("listening_map_labelling_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_map_labelling_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid map labelling ID format")
@@ -138,7 +138,7 @@ func (h *ListeningMapLabellingHandler) Delete(ctx context.Context, w http.Respon
 	}
 
 	if err := h.service.Delete(ctx, id); err != nil {
-		h.logger.Error("listening_map_labelling_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_map_labelling_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete map labelling")
@@ -35,7 +35,7 @@ func NewListeningMatchingHandler(
 func (h *ListeningMatchingHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req listeningDTO.CreateListeningMatchingRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("listening_matching_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_matching_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -53,7 +53,7 @@ func (h *ListeningMatchingHandler) Create(ctx context.Context, w http.ResponseWr
 	}
 
 	if err := h.service.Create(ctx, matching); err != nil {
-		h.logger.Error("listening_matching_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_matching_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create matching")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create matching")
@@ -73,7 +73,7 @@ func (h *ListeningMatchingHandler) Create(ctx context.Context, w http.ResponseWr
 func (h *ListeningMatchingHandler) Update(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req listeningDTO.UpdateListeningMatchingRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("listening_matching_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_matching_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -83,7 +83,7 @@ func (h *ListeningMatchingHandler) Update(ctx context.Context, w http.ResponseWr
 	// Get existing record first
 	existingMatching, err := h.service.GetByID(ctx, req.ListeningMatchingID)
 	if err != nil {
-		h.logger.Error("listening_matching_handler.update.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_matching_handler.update.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.ListeningMatchingID,
 		}, "Failed to get existing matching")
@@ -107,7 +107,7 @@ func (h *ListeningMatchingHandler) Update(ctx context.Context, w http.ResponseWr
 	existingMatching.UpdatedAt = time.Now()
 
 	if err := h.service.Update(ctx, existingMatching); err != nil {
-		h.logger.Error("listening_matching_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_matching_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.ListeningMatchingID,
 		}, "Failed to update matching")
@@ -121,7 +121,7 @@ func (h *ListeningMatchingHandler) Update(ctx context.Context, w http.ResponseWr
 func (h *ListeningMatchingHandler) Delete(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("listening_matching_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("listening_matching_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -129,7 +129,7 @@ func (h *ListeningMatchingHandler) Delete(ctx context.Context, w http.ResponseWr
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("listening_matching_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_matching_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid matching ID format")
@@ -138,7 +138,7 @@ func (h *ListeningMatchingHandler) Delete(ctx context.Context, w http.ResponseWr
 	}
 
 	if err := h.service.Delete(ctx, id); err != nil {
-		h.logger.Error("listening_matching_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_matching_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete matching")
@@ -61,7 +61,7 @@ func NewListeningQuestionHandler(
 func (h *ListeningQuestionHandler) CreateListeningQuestion(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req listeningDTO.CreateListeningQuestionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("listening_question_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_question_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Invalid request format")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request format")
@@ -80,12 +80,13 @@ func (h *ListeningQuestionHandler) CreateListeningQuestion(ctx context.Context,
 		Version:     1,
 	}
 
-	if err := h.service.CreateQuestion(ctx, question); err != nil {
+	nearDuplicates, err := h.service.CreateQuestion(ctx, question)
+	if err != nil {
 		code := http.StatusInternalServerError
 		if errors.Is(err, listeningService.ErrInvalidInput) {
 			code = http.StatusBadRequest
 		}
-		h.logger.Error("listening_question_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_question_handler.create", map[string]interface{}{
 			"error":         err.Error(),
 			"question_type": question.Type,
 		}, "Failed to create listening question")
@@ -93,16 +94,23 @@ func (h *ListeningQuestionHandler) CreateListeningQuestion(ctx context.Context,
 		return
 	}
 
-	responseData := listeningDTO.ListeningQuestionResponse{
-		ID:          question.ID,
-		Type:        question.Type,
-		Topic:       question.Topic,
-		Instruction: question.Instruction,
-		AudioURLs:   question.AudioURLs,
-		ImageURLs:   question.ImageURLs,
-		Transcript:  question.Transcript,
-		MaxTime:     question.MaxTime,
-		Version:     question.Version,
+	// Near-duplicates are a warning for the author, the question is created either way
+	responseData := struct {
+		listeningDTO.ListeningQuestionResponse
+		NearDuplicates []listeningDTO.SimilarQuestion `json:"near_duplicates,omitempty"`
+	}{
+		ListeningQuestionResponse: listeningDTO.ListeningQuestionResponse{
+			ID:          question.ID,
+			Type:        question.Type,
+			Topic:       question.Topic,
+			Instruction: question.Instruction,
+			AudioURLs:   question.AudioURLs,
+			ImageURLs:   question.ImageURLs,
+			Transcript:  question.Transcript,
+			MaxTime:     question.MaxTime,
+			Version:     question.Version,
+		},
+		NearDuplicates: nearDuplicates,
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -113,14 +121,14 @@ func (h *ListeningQuestionHandler) CreateListeningQuestion(ctx context.Context,
 func (h *ListeningQuestionHandler) GetListeningQuestionDetail(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtxValue := ctx.Value(constants.GinContextKey)
 	if ginCtxValue == nil {
-		h.logger.Error("listening_question_handler.get", nil, "GinContextKey not found in context")
+		h.logger.WithContext(ctx).Error("listening_question_handler.get", nil, "GinContextKey not found in context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
 
 	ginCtx, ok := ginCtxValue.(*gin.Context)
 	if !ok {
-		h.logger.Error("listening_question_handler.get", nil, "Failed to convert context value to *gin.Context")
+		h.logger.WithContext(ctx).Error("listening_question_handler.get", nil, "Failed to convert context value to *gin.Context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -128,7 +136,7 @@ func (h *ListeningQuestionHandler) GetListeningQuestionDetail(ctx context.Contex
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("listening_question_handler.get.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_question_handler.get.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid question ID format")
@@ -142,7 +150,7 @@ func (h *ListeningQuestionHandler) GetListeningQuestionDetail(ctx context.Contex
 		if errors.Is(err, listeningService.ErrQuestionNotFound) {
 			statusCode = http.StatusNotFound
 		}
-		h.logger.Error("listening_question_handler.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_question_handler.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get listening question")
@@ -157,14 +165,14 @@ func (h *ListeningQuestionHandler) GetListeningQuestionDetail(ctx context.Contex
 func (h *ListeningQuestionHandler) UpdateListeningQuestion(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtxValue := ctx.Value(constants.GinContextKey)
 	if ginCtxValue == nil {
-		h.logger.Error("listening_question_handler.update", nil, "GinContextKey not found in context")
+		h.logger.WithContext(ctx).Error("listening_question_handler.update", nil, "GinContextKey not found in context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
 
 	ginCtx, ok := ginCtxValue.(*gin.Context)
 	if !ok {
-		h.logger.Error("listening_question_handler.update", nil, "Failed to convert context value to *gin.Context")
+		h.logger.WithContext(ctx).Error("listening_question_handler.update", nil, "Failed to convert context value to *gin.Context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -172,7 +180,7 @@ func (h *ListeningQuestionHandler) UpdateListeningQuestion(ctx context.Context,
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("listening_question_handler.update.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_question_handler.update.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid question ID format")
@@ -182,7 +190,7 @@ func (h *ListeningQuestionHandler) UpdateListeningQuestion(ctx context.Context,
 
 	var req listeningDTO.UpdateListeningQuestionFieldRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("listening_question_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_question_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -190,7 +198,7 @@ func (h *ListeningQuestionHandler) UpdateListeningQuestion(ctx context.Context,
 	}
 
 	if err := h.service.UpdateQuestion(ctx, id, req); err != nil {
-		h.logger.Error("listening_question_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_question_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to update listening question")
@@ -209,14 +217,14 @@ func (h *ListeningQuestionHandler) UpdateListeningQuestion(ctx context.Context,
 func (h *ListeningQuestionHandler) DeleteListeningQuestion(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtxValue := ctx.Value(constants.GinContextKey)
 	if ginCtxValue == nil {
-		h.logger.Error("listening_question_handler.delete", nil, "GinContextKey not found in context")
+		h.logger.WithContext(ctx).Error("listening_question_handler.delete", nil, "GinContextKey not found in context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
 
 	ginCtx, ok := ginCtxValue.(*gin.Context)
 	if !ok {
-		h.logger.Error("listening_question_handler.delete", nil, "Failed to convert context value to *gin.Context")
+		h.logger.WithContext(ctx).Error("listening_question_handler.delete", nil, "Failed to convert context value to *gin.Context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -224,7 +232,7 @@ func (h *ListeningQuestionHandler) DeleteListeningQuestion(ctx context.Context,
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("listening_question_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_question_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid question ID format")
@@ -233,7 +241,7 @@ func (h *ListeningQuestionHandler) DeleteListeningQuestion(ctx context.Context,
 	}
 
 	if err := h.service.DeleteQuestion(ctx, id); err != nil {
-		h.logger.Error("listening_question_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_question_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete listening question")
@@ -247,7 +255,7 @@ func (h *ListeningQuestionHandler) DeleteListeningQuestion(ctx context.Context,
 func (h *ListeningQuestionHandler) GetListNewListeningQuestionByListVersionAndID(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req listeningDTO.GetNewUpdatesListeningQuestionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("listening_question_handler.get_new_updates.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_question_handler.get_new_updates.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -266,7 +274,7 @@ func (h *ListeningQuestionHandler) GetListNewListeningQuestionByListVersionAndID
 
 	questions, err := h.service.GetNewUpdatedQuestions(ctx, versionChecks)
 	if err != nil {
-		h.logger.Error("listening_question_handler.get_new_listening_questions", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_question_handler.get_new_listening_questions", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get updated questions")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to get updated questions")
@@ -307,7 +315,7 @@ type GetListListeningByListIDRequest struct {
 func (h *ListeningQuestionHandler) GetListListeningByListID(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req GetListListeningByListIDRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("listening_question_handler.get_list_by_ids.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_question_handler.get_list_by_ids.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -319,7 +327,7 @@ func (h *ListeningQuestionHandler) GetListListeningByListID(ctx context.Context,
 	for _, idStr := range req.QuestionIDs {
 		id, err := uuid.Parse(idStr)
 		if err != nil {
-			h.logger.Error("listening_question_handler.get_list_by_ids.parse_id", map[string]interface{}{
+			h.logger.WithContext(ctx).Error("listening_question_handler.get_list_by_ids.parse_id", map[string]interface{}{
 				"error": err.Error(),
 				"id":    idStr,
 			}, "Invalid question ID format")
@@ -332,7 +340,7 @@ func (h *ListeningQuestionHandler) GetListListeningByListID(ctx context.Context,
 	// Get questions with details
 	questions, err := h.service.GetListeningByListID(ctx, questionIDs)
 	if err != nil {
-		h.logger.Error("listening_question_handler.get_list_by_ids", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_question_handler.get_list_by_ids", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get questions")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to get questions")
@@ -348,14 +356,14 @@ func (h *ListeningQuestionHandler) GetListListeningByListID(ctx context.Context,
 func (h *ListeningQuestionHandler) GetListListeningQuestiondetailPaganationWithFilter(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("listening_question_handler.search.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("listening_question_handler.search.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
 
 	var filter listeningDTO.ListeningQuestionSearchFilter
 	if err := ginCtx.ShouldBindQuery(&filter); err != nil {
-		h.logger.Error("listening_question_handler.search.bind", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_question_handler.search.bind", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to bind query parameters")
 		response.WriteError(w, http.StatusBadRequest, "Invalid query parameters")
@@ -364,7 +372,7 @@ func (h *ListeningQuestionHandler) GetListListeningQuestiondetailPaganationWithF
 
 	questions, err := h.service.SearchQuestionsWithFilter(ctx, filter)
 	if err != nil {
-		h.logger.Error("listening_question_handler.search", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("listening_question_handler.search", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to search questions")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to search questions")
@@ -379,7 +387,7 @@ func (h *ListeningQuestionHandler) GetListListeningQuestiondetailPaganationWithF
 
 func (h *ListeningQuestionHandler) DeleteAllListeningData(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	if err := h.service.DeleteAllQuestions(ctx); err != nil {
-		h.logger.Error("listening_question_handler.delete_all", map[string]interface{}{"error": err.Error()}, "Failed to delete all listening data")
+		h.logger.WithContext(ctx).Error("listening_question_handler.delete_all", map[string]interface{}{"error": err.Error()}, "Failed to delete all listening data")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to delete all listening data")
 		return
 	}
@@ -0,0 +1,138 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	outboxService "fluencybe/internal/app/service/outbox"
+	"fluencybe/internal/core/constants"
+	"fluencybe/pkg/logger"
+	"fluencybe/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type OutboxHandler struct {
+	service *outboxService.OutboxService
+	logger  *logger.PrettyLogger
+}
+
+func NewOutboxHandler(service *outboxService.OutboxService, logger *logger.PrettyLogger) *OutboxHandler {
+	return &OutboxHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+func (h *OutboxHandler) GetStats(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	stats, err := h.service.GetStats(ctx)
+	if err != nil {
+		h.logger.WithContext(ctx).Error("outbox_handler.get_stats", map[string]interface{}{
+			"error": err.Error(),
+		}, "Failed to get outbox stats")
+		response.WriteError(w, http.StatusInternalServerError, "Failed to get outbox stats")
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, gin.H{
+		"success": true,
+		"data":    stats,
+	})
+}
+
+// ListDeadEvents lists events the relay gave up on, newest first
+func (h *OutboxHandler) ListDeadEvents(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	page, pageSize := 1, 0
+	var err error
+	if raw := query.Get("page"); raw != "" {
+		if page, err = strconv.Atoi(raw); err != nil || page < 1 {
+			response.WriteError(w, http.StatusBadRequest, "Invalid page")
+			return
+		}
+	}
+	if raw := query.Get("page_size"); raw != "" {
+		if pageSize, err = strconv.Atoi(raw); err != nil || pageSize < 1 {
+			response.WriteError(w, http.StatusBadRequest, "Invalid page_size")
+			return
+		}
+	}
+
+	result, err := h.service.ListDeadEvents(ctx, query.Get("skill"), page, pageSize)
+	if err != nil {
+		if errors.Is(err, outboxService.ErrUnknownSkill) {
+			response.WriteError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		h.logger.WithContext(ctx).Error("outbox_handler.list_dead", map[string]interface{}{
+			"error": err.Error(),
+		}, "Failed to list dead outbox events")
+		response.WriteError(w, http.StatusInternalServerError, "Failed to list dead outbox events")
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
+
+func (h *OutboxHandler) RetryDeadEvent(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
+	if !ok {
+		response.WriteError(w, http.StatusInternalServerError, "Invalid context")
+		return
+	}
+
+	id, err := strconv.ParseInt(ginCtx.Param("id"), 10, 64)
+	if err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid event ID")
+		return
+	}
+
+	if err := h.service.RetryDeadEvent(ctx, id); err != nil {
+		if errors.Is(err, outboxService.ErrEventNotFound) {
+			response.WriteError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		h.logger.WithContext(ctx).Error("outbox_handler.retry", map[string]interface{}{
+			"error": err.Error(),
+			"id":    id,
+		}, "Failed to requeue outbox event")
+		response.WriteError(w, http.StatusInternalServerError, "Failed to requeue outbox event")
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, gin.H{
+		"success": true,
+		"message": "Event requeued",
+	})
+}
+
+// RetryAllDeadEvents requeues the whole dead-letter queue, or one skill of it with ?skill=
+func (h *OutboxHandler) RetryAllDeadEvents(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	skill := r.URL.Query().Get("skill")
+
+	count, err := h.service.RetryAllDeadEvents(ctx, skill)
+	if err != nil {
+		if errors.Is(err, outboxService.ErrUnknownSkill) {
+			response.WriteError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		h.logger.WithContext(ctx).Error("outbox_handler.retry_all", map[string]interface{}{
+			"error": err.Error(),
+			"skill": skill,
+		}, "Failed to requeue outbox events")
+		response.WriteError(w, http.StatusInternalServerError, "Failed to requeue outbox events")
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, gin.H{
+		"success": true,
+		"data": gin.H{
+			"requeued": count,
+		},
+	})
+}
@@ -38,7 +38,7 @@ func NewReadingChoiceOneOptionHandler(
 func (h *ReadingChoiceOneOptionHandler) CreateOption(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req readingDTO.CreateReadingChoiceOneOptionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("reading_choice_one_option_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_choice_one_option_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -55,7 +55,7 @@ func (h *ReadingChoiceOneOptionHandler) CreateOption(ctx context.Context, w http
 	}
 
 	if err := h.service.CreateOption(ctx, option); err != nil {
-		h.logger.Error("reading_choice_one_option_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_choice_one_option_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create option")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create option")
@@ -74,7 +74,7 @@ func (h *ReadingChoiceOneOptionHandler) CreateOption(ctx context.Context, w http
 func (h *ReadingChoiceOneOptionHandler) UpdateOption(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req readingDTO.UpdateReadingChoiceOneOptionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("reading_choice_one_option_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_choice_one_option_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -83,7 +83,7 @@ func (h *ReadingChoiceOneOptionHandler) UpdateOption(ctx context.Context, w http
 
 	option, err := h.service.GetOption(ctx, req.ReadingChoiceOneOptionID)
 	if err != nil {
-		h.logger.Error("reading_choice_one_option_handler.update.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_choice_one_option_handler.update.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.ReadingChoiceOneOptionID,
 		}, "Failed to get option")
@@ -113,7 +113,7 @@ func (h *ReadingChoiceOneOptionHandler) UpdateOption(ctx context.Context, w http
 	option.UpdatedAt = time.Now()
 
 	if err := h.service.UpdateOption(ctx, option); err != nil {
-		h.logger.Error("reading_choice_one_option_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_choice_one_option_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.ReadingChoiceOneOptionID,
 		}, "Failed to update option")
@@ -127,7 +127,7 @@ func (h *ReadingChoiceOneOptionHandler) UpdateOption(ctx context.Context, w http
 func (h *ReadingChoiceOneOptionHandler) DeleteOption(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("reading_choice_one_option_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("reading_choice_one_option_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -135,7 +135,7 @@ func (h *ReadingChoiceOneOptionHandler) DeleteOption(ctx context.Context, w http
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("reading_choice_one_option_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_choice_one_option_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid option ID format")
@@ -144,7 +144,7 @@ func (h *ReadingChoiceOneOptionHandler) DeleteOption(ctx context.Context, w http
 	}
 
 	if err := h.service.DeleteOption(ctx, id); err != nil {
-		h.logger.Error("reading_choice_one_option_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_choice_one_option_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete option")
@@ -40,7 +40,7 @@ func NewReadingChoiceOneQuestionHandler(
 func (h *ReadingChoiceOneQuestionHandler) CreateQuestion(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req readingDTO.CreateReadingChoiceOneQuestionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("reading_choice_one_question_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_choice_one_question_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -57,7 +57,7 @@ func (h *ReadingChoiceOneQuestionHandler) CreateQuestion(ctx context.Context, w
 	}
 
 	if err := h.questionService.CreateQuestion(ctx, question); err != nil {
-		h.logger.Error("reading_choice_one_question_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_choice_one_question_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create question")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create question")
@@ -76,7 +76,7 @@ func (h *ReadingChoiceOneQuestionHandler) CreateQuestion(ctx context.Context, w
 func (h *ReadingChoiceOneQuestionHandler) UpdateQuestion(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req readingDTO.UpdateReadingChoiceOneQuestionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("reading_choice_one_question_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_choice_one_question_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -85,7 +85,7 @@ func (h *ReadingChoiceOneQuestionHandler) UpdateQuestion(ctx context.Context, w
 
 	question, err := h.questionService.GetQuestion(ctx, req.ReadingChoiceOneQuestionID)
 	if err != nil {
-		h.logger.Error("reading_choice_one_question_handler.update.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_choice_one_question_handler.update.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.ReadingChoiceOneQuestionID,
 		}, "Failed to get question")
@@ -110,7 +110,7 @@ func (h *ReadingChoiceOneQuestionHandler) UpdateQuestion(ctx context.Context, w
 	question.UpdatedAt = time.Now()
 
 	if err := h.questionService.UpdateQuestion(ctx, question); err != nil {
-		h.logger.Error("reading_choice_one_question_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_choice_one_question_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.ReadingChoiceOneQuestionID,
 		}, "Failed to update question")
@@ -124,7 +124,7 @@ func (h *ReadingChoiceOneQuestionHandler) UpdateQuestion(ctx context.Context, w
 func (h *ReadingChoiceOneQuestionHandler) DeleteQuestion(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("reading_choice_one_question_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("reading_choice_one_question_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -132,7 +132,7 @@ func (h *ReadingChoiceOneQuestionHandler) DeleteQuestion(ctx context.Context, w
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("reading_choice_one_question_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_choice_one_question_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid question ID format")
@@ -141,7 +141,7 @@ func (h *ReadingChoiceOneQuestionHandler) DeleteQuestion(ctx context.Context, w
 	}
 
 	if err := h.questionService.DeleteQuestion(ctx, id); err != nil {
-		h.logger.Error("reading_choice_one_question_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_choice_one_question_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete question")
@@ -35,7 +35,7 @@ func NewReadingMatchingHandler(
 func (h *ReadingMatchingHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req readingDTO.CreateReadingMatchingRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("reading_matching_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_matching_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -53,7 +53,7 @@ func (h *ReadingMatchingHandler) Create(ctx context.Context, w http.ResponseWrit
 	}
 
 	if err := h.service.Create(ctx, matching); err != nil {
-		h.logger.Error("reading_matching_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_matching_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create matching")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create matching")
@@ -73,7 +73,7 @@ func (h *ReadingMatchingHandler) Create(ctx context.Context, w http.ResponseWrit
 func (h *ReadingMatchingHandler) Update(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req readingDTO.UpdateReadingMatchingRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("reading_matching_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_matching_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -83,7 +83,7 @@ func (h *ReadingMatchingHandler) Update(ctx context.Context, w http.ResponseWrit
 	// Get existing record first
 	existingMatching, err := h.service.GetByID(ctx, req.ReadingMatchingID)
 	if err != nil {
-		h.logger.Error("reading_matching_handler.update.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_matching_handler.update.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.ReadingMatchingID,
 		}, "Failed to get existing matching")
@@ -107,7 +107,7 @@ func (h *ReadingMatchingHandler) Update(ctx context.Context, w http.ResponseWrit
 	existingMatching.UpdatedAt = time.Now()
 
 	if err := h.service.Update(ctx, existingMatching); err != nil {
-		h.logger.Error("reading_matching_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_matching_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.ReadingMatchingID,
 		}, "Failed to update matching")
@@ -121,7 +121,7 @@ func (h *ReadingMatchingHandler) Update(ctx context.Context, w http.ResponseWrit
 func (h *ReadingMatchingHandler) Delete(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("reading_matching_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("reading_matching_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -129,7 +129,7 @@ func (h *ReadingMatchingHandler) Delete(ctx context.Context, w http.ResponseWrit
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("reading_matching_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_matching_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid matching ID format")
@@ -138,7 +138,7 @@ func (h *ReadingMatchingHandler) Delete(ctx context.Context, w http.ResponseWrit
 	}
 
 	if err := h.service.Delete(ctx, id); err != nil {
-		h.logger.Error("reading_matching_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_matching_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete matching")
@@ -60,7 +60,7 @@ func NewReadingQuestionHandler(
 func (h *ReadingQuestionHandler) CreateReadingQuestion(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req readingDTO.CreateReadingQuestionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("reading_question_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_question_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Invalid request format")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request format")
@@ -79,12 +79,13 @@ func (h *ReadingQuestionHandler) CreateReadingQuestion(ctx context.Context, w ht
 		Version:     1,
 	}
 
-	if err := h.service.CreateQuestion(ctx, question); err != nil {
+	nearDuplicates, err := h.service.CreateQuestion(ctx, question)
+	if err != nil {
 		code := http.StatusInternalServerError
 		if errors.Is(err, readingService.ErrInvalidInput) {
 			code = http.StatusBadRequest
 		}
-		h.logger.Error("reading_question_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_question_handler.create", map[string]interface{}{
 			"error":         err.Error(),
 			"question_type": question.Type,
 		}, "Failed to create reading question")
@@ -92,16 +93,23 @@ func (h *ReadingQuestionHandler) CreateReadingQuestion(ctx context.Context, w ht
 		return
 	}
 
-	responseData := readingDTO.ReadingQuestionResponse{
-		ID:          question.ID,
-		Type:        question.Type,
-		Topic:       question.Topic,
-		Instruction: question.Instruction,
-		Title:       question.Title,
-		Passages:    question.Passages,
-		ImageURLs:   question.ImageURLs,
-		MaxTime:     question.MaxTime,
-		Version:     question.Version,
+	// Near-duplicates are a warning for the author, the question is created either way
+	responseData := struct {
+		readingDTO.ReadingQuestionResponse
+		NearDuplicates []readingDTO.SimilarQuestion `json:"near_duplicates,omitempty"`
+	}{
+		ReadingQuestionResponse: readingDTO.ReadingQuestionResponse{
+			ID:          question.ID,
+			Type:        question.Type,
+			Topic:       question.Topic,
+			Instruction: question.Instruction,
+			Title:       question.Title,
+			Passages:    question.Passages,
+			ImageURLs:   question.ImageURLs,
+			MaxTime:     question.MaxTime,
+			Version:     question.Version,
+		},
+		NearDuplicates: nearDuplicates,
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -250,7 +258,7 @@ func (h *ReadingQuestionHandler) loadTrueFalseData(ctx context.Context, question
 func (h *ReadingQuestionHandler) loadMatchingData(ctx context.Context, questionID uuid.UUID, response *readingDTO.ReadingQuestionDetail) error {
 	matchings, err := h.matchingService.GetByReadingQuestionID(ctx, questionID)
 	if err != nil {
-		h.logger.Error("loadMatchingData.get_qas", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("loadMatchingData.get_qas", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get matching QAs")
@@ -276,14 +284,14 @@ func (h *ReadingQuestionHandler) loadMatchingData(ctx context.Context, questionI
 func (h *ReadingQuestionHandler) GetReadingQuestionDetail(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtxValue := ctx.Value(constants.GinContextKey)
 	if ginCtxValue == nil {
-		h.logger.Error("reading_question_handler.get", nil, "GinContextKey not found in context")
+		h.logger.WithContext(ctx).Error("reading_question_handler.get", nil, "GinContextKey not found in context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
 
 	ginCtx, ok := ginCtxValue.(*gin.Context)
 	if !ok {
-		h.logger.Error("reading_question_handler.get", nil, "Failed to convert context value to *gin.Context")
+		h.logger.WithContext(ctx).Error("reading_question_handler.get", nil, "Failed to convert context value to *gin.Context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -291,7 +299,7 @@ func (h *ReadingQuestionHandler) GetReadingQuestionDetail(ctx context.Context, w
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("reading_question_handler.get.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_question_handler.get.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid question ID format")
@@ -305,7 +313,7 @@ func (h *ReadingQuestionHandler) GetReadingQuestionDetail(ctx context.Context, w
 		if errors.Is(err, readingService.ErrQuestionNotFound) {
 			statusCode = http.StatusNotFound
 		}
-		h.logger.Error("reading_question_handler.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_question_handler.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get reading question")
@@ -320,14 +328,14 @@ func (h *ReadingQuestionHandler) GetReadingQuestionDetail(ctx context.Context, w
 func (h *ReadingQuestionHandler) UpdateReadingQuestion(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtxValue := ctx.Value(constants.GinContextKey)
 	if ginCtxValue == nil {
-		h.logger.Error("reading_question_handler.update", nil, "GinContextKey not found in context")
+		h.logger.WithContext(ctx).Error("reading_question_handler.update", nil, "GinContextKey not found in context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
 
 	ginCtx, ok := ginCtxValue.(*gin.Context)
 	if !ok {
-		h.logger.Error("reading_question_handler.update", nil, "Failed to convert context value to *gin.Context")
+		h.logger.WithContext(ctx).Error("reading_question_handler.update", nil, "Failed to convert context value to *gin.Context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -335,7 +343,7 @@ func (h *ReadingQuestionHandler) UpdateReadingQuestion(ctx context.Context, w ht
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("reading_question_handler.update.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_question_handler.update.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid question ID format")
@@ -345,7 +353,7 @@ func (h *ReadingQuestionHandler) UpdateReadingQuestion(ctx context.Context, w ht
 
 	var req readingDTO.UpdateReadingQuestionFieldRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("reading_question_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_question_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -353,7 +361,7 @@ func (h *ReadingQuestionHandler) UpdateReadingQuestion(ctx context.Context, w ht
 	}
 
 	if err := h.service.UpdateQuestion(ctx, id, req); err != nil {
-		h.logger.Error("reading_question_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_question_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to update reading question")
@@ -372,14 +380,14 @@ func (h *ReadingQuestionHandler) UpdateReadingQuestion(ctx context.Context, w ht
 func (h *ReadingQuestionHandler) DeleteReadingQuestion(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtxValue := ctx.Value(constants.GinContextKey)
 	if ginCtxValue == nil {
-		h.logger.Error("reading_question_handler.delete", nil, "GinContextKey not found in context")
+		h.logger.WithContext(ctx).Error("reading_question_handler.delete", nil, "GinContextKey not found in context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
 
 	ginCtx, ok := ginCtxValue.(*gin.Context)
 	if !ok {
-		h.logger.Error("reading_question_handler.delete", nil, "Failed to convert context value to *gin.Context")
+		h.logger.WithContext(ctx).Error("reading_question_handler.delete", nil, "Failed to convert context value to *gin.Context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -387,7 +395,7 @@ func (h *ReadingQuestionHandler) DeleteReadingQuestion(ctx context.Context, w ht
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("reading_question_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_question_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid question ID format")
@@ -396,7 +404,7 @@ func (h *ReadingQuestionHandler) DeleteReadingQuestion(ctx context.Context, w ht
 	}
 
 	if err := h.service.DeleteQuestion(ctx, id); err != nil {
-		h.logger.Error("reading_question_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_question_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete reading question")
@@ -410,7 +418,7 @@ func (h *ReadingQuestionHandler) DeleteReadingQuestion(ctx context.Context, w ht
 func (h *ReadingQuestionHandler) GetListNewReadingQuestionByListVersionAndID(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req readingDTO.GetNewUpdatesReadingQuestionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("reading_question_handler.get_new_updates.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_question_handler.get_new_updates.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -429,7 +437,7 @@ func (h *ReadingQuestionHandler) GetListNewReadingQuestionByListVersionAndID(ctx
 
 	questions, err := h.service.GetNewUpdatedQuestions(ctx, versionChecks)
 	if err != nil {
-		h.logger.Error("reading_question_handler.get_new_reading_questions", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_question_handler.get_new_reading_questions", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get updated questions")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to get updated questions")
@@ -453,7 +461,7 @@ func (h *ReadingQuestionHandler) GetListNewReadingQuestionByListVersionAndID(ctx
 			},
 		}
 		if err := h.GetReadingQuestionType(ctx, q.ID, q.Type, &responseData[i]); err != nil {
-			h.logger.Warning("reading_question_handler.get_new_reading_questions.load_type", map[string]interface{}{
+			h.logger.WithContext(ctx).Warning("reading_question_handler.get_new_reading_questions.load_type", map[string]interface{}{
 				"error": err.Error(),
 				"id":    q.ID,
 				"type":  q.Type,
@@ -479,14 +487,14 @@ type GetListReadingByListIDRequest struct {
 func (h *ReadingQuestionHandler) GetListReadingQuestiondetailPaganationWithFilter(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("reading_question_handler.search.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("reading_question_handler.search.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
 
 	var filter readingDTO.ReadingQuestionSearchFilter
 	if err := ginCtx.ShouldBindQuery(&filter); err != nil {
-		h.logger.Error("reading_question_handler.search.bind", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_question_handler.search.bind", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to bind query parameters")
 		response.WriteError(w, http.StatusBadRequest, "Invalid query parameters")
@@ -494,7 +502,7 @@ func (h *ReadingQuestionHandler) GetListReadingQuestiondetailPaganationWithFilte
 	}
 
 	// Add debug logging for filter values
-	h.logger.Debug("reading_question_handler.search.filter", map[string]interface{}{
+	h.logger.WithContext(ctx).Debug("reading_question_handler.search.filter", map[string]interface{}{
 		"type":        filter.Type,
 		"topic":       filter.Topic,
 		"instruction": filter.Instruction,
@@ -506,7 +514,7 @@ func (h *ReadingQuestionHandler) GetListReadingQuestiondetailPaganationWithFilte
 
 	result, err := h.service.SearchQuestionsWithFilter(ctx, filter)
 	if err != nil {
-		h.logger.Error("reading_question_handler.search", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_question_handler.search", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to search questions")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to search questions")
@@ -521,7 +529,7 @@ func (h *ReadingQuestionHandler) GetListReadingQuestiondetailPaganationWithFilte
 
 func (h *ReadingQuestionHandler) DeleteAllReadingData(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	if err := h.service.DeleteAllQuestions(ctx); err != nil {
-		h.logger.Error("reading_question_handler.delete_all", map[string]interface{}{"error": err.Error()}, "Failed to delete all reading data")
+		h.logger.WithContext(ctx).Error("reading_question_handler.delete_all", map[string]interface{}{"error": err.Error()}, "Failed to delete all reading data")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to delete all reading data")
 		return
 	}
@@ -531,7 +539,7 @@ func (h *ReadingQuestionHandler) DeleteAllReadingData(ctx context.Context, w htt
 func (h *ReadingQuestionHandler) GetListReadingByListID(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req GetListReadingByListIDRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("reading_question_handler.get_list_by_ids.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_question_handler.get_list_by_ids.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -543,7 +551,7 @@ func (h *ReadingQuestionHandler) GetListReadingByListID(ctx context.Context, w h
 	for _, idStr := range req.QuestionIDs {
 		id, err := uuid.Parse(idStr)
 		if err != nil {
-			h.logger.Error("reading_question_handler.get_list_by_ids.parse_id", map[string]interface{}{
+			h.logger.WithContext(ctx).Error("reading_question_handler.get_list_by_ids.parse_id", map[string]interface{}{
 				"error": err.Error(),
 				"id":    idStr,
 			}, "Invalid question ID format")
@@ -556,7 +564,7 @@ func (h *ReadingQuestionHandler) GetListReadingByListID(ctx context.Context, w h
 	// Get questions with details
 	questions, err := h.service.GetReadingByListID(ctx, questionIDs)
 	if err != nil {
-		h.logger.Error("reading_question_handler.get_list_by_ids", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_question_handler.get_list_by_ids", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get questions")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to get questions")
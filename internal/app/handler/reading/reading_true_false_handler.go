@@ -35,7 +35,7 @@ func NewReadingTrueFalseHandler(
 func (h *ReadingTrueFalseHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req readingDTO.CreateReadingTrueFalseRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("reading_true_false_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_true_false_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -53,7 +53,7 @@ func (h *ReadingTrueFalseHandler) Create(ctx context.Context, w http.ResponseWri
 	}
 
 	if err := h.service.Create(ctx, trueFalse); err != nil {
-		h.logger.Error("reading_true_false_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_true_false_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create true/false question")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create true/false question")
@@ -73,7 +73,7 @@ func (h *ReadingTrueFalseHandler) Create(ctx context.Context, w http.ResponseWri
 func (h *ReadingTrueFalseHandler) Update(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req readingDTO.UpdateReadingTrueFalseRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("reading_true_false_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_true_false_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -82,7 +82,7 @@ func (h *ReadingTrueFalseHandler) Update(ctx context.Context, w http.ResponseWri
 
 	trueFalse, err := h.service.GetByID(ctx, req.ReadingTrueFalseID)
 	if err != nil {
-		h.logger.Error("reading_true_false_handler.update.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_true_false_handler.update.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.ReadingTrueFalseID,
 		}, "Failed to get true/false question")
@@ -109,7 +109,7 @@ func (h *ReadingTrueFalseHandler) Update(ctx context.Context, w http.ResponseWri
 	trueFalse.UpdatedAt = time.Now()
 
 	if err := h.service.Update(ctx, trueFalse); err != nil {
-		h.logger.Error("reading_true_false_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_true_false_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.ReadingTrueFalseID,
 		}, "Failed to update true/false question")
@@ -123,7 +123,7 @@ func (h *ReadingTrueFalseHandler) Update(ctx context.Context, w http.ResponseWri
 func (h *ReadingTrueFalseHandler) Delete(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("reading_true_false_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("reading_true_false_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -131,7 +131,7 @@ func (h *ReadingTrueFalseHandler) Delete(ctx context.Context, w http.ResponseWri
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("reading_true_false_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_true_false_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid true/false question ID format")
@@ -140,7 +140,7 @@ func (h *ReadingTrueFalseHandler) Delete(ctx context.Context, w http.ResponseWri
 	}
 
 	if err := h.service.Delete(ctx, id); err != nil {
-		h.logger.Error("reading_true_false_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("reading_true_false_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete true/false question")
@@ -0,0 +1,59 @@
+package search
+
+import (
+	"context"
+	"errors"
+	searchDTO "fluencybe/internal/app/dto"
+	searchService "fluencybe/internal/app/service/search"
+	"fluencybe/internal/core/constants"
+	"fluencybe/pkg/logger"
+	"fluencybe/pkg/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type QuestionSearchHandler struct {
+	service *searchService.QuestionSearchService
+	logger  *logger.PrettyLogger
+}
+
+func NewQuestionSearchHandler(service *searchService.QuestionSearchService, logger *logger.PrettyLogger) *QuestionSearchHandler {
+	return &QuestionSearchHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// SearchQuestions searches the questions of all skills, ?skill= narrows it to some of them
+func (h *QuestionSearchHandler) SearchQuestions(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
+	if !ok {
+		response.WriteError(w, http.StatusInternalServerError, "Invalid context")
+		return
+	}
+
+	var filter searchDTO.QuestionSearchFilter
+	if err := ginCtx.ShouldBindQuery(&filter); err != nil {
+		response.WriteError(w, http.StatusBadRequest, "Invalid query parameters")
+		return
+	}
+
+	result, err := h.service.SearchQuestions(ctx, filter)
+	if err != nil {
+		switch {
+		case errors.Is(err, searchService.ErrUnknownSkill), errors.Is(err, searchService.ErrQueryTooLong):
+			response.WriteError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, searchService.ErrSearchUnavailable):
+			response.WriteError(w, http.StatusServiceUnavailable, err.Error())
+		default:
+			response.WriteError(w, http.StatusInternalServerError, "Failed to search questions")
+		}
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, gin.H{
+		"success": true,
+		"data":    result,
+	})
+}
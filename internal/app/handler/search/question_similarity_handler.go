@@ -0,0 +1,72 @@
+package search
+
+import (
+	"context"
+	"errors"
+	searchService "fluencybe/internal/app/service/search"
+	"fluencybe/internal/core/constants"
+	"fluencybe/pkg/logger"
+	"fluencybe/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type QuestionSimilarityHandler struct {
+	service *searchService.QuestionSimilarityService
+	logger  *logger.PrettyLogger
+}
+
+func NewQuestionSimilarityHandler(service *searchService.QuestionSimilarityService, logger *logger.PrettyLogger) *QuestionSimilarityHandler {
+	return &QuestionSimilarityHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// SimilarQuestions lists the questions of a skill most like the question :id, ?size= caps the list
+func (h *QuestionSimilarityHandler) SimilarQuestions(skill string) func(context.Context, http.ResponseWriter, *http.Request) {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+		ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
+		if !ok {
+			response.WriteError(w, http.StatusInternalServerError, "Invalid context")
+			return
+		}
+
+		id, err := uuid.Parse(ginCtx.Param("id"))
+		if err != nil {
+			response.WriteError(w, http.StatusBadRequest, "Invalid ID format")
+			return
+		}
+
+		size := 0
+		if rawSize := r.URL.Query().Get("size"); rawSize != "" {
+			if size, err = strconv.Atoi(rawSize); err != nil || size < 0 {
+				response.WriteError(w, http.StatusBadRequest, "Invalid size")
+				return
+			}
+		}
+
+		questions, err := h.service.SimilarQuestions(ctx, skill, id, size)
+		if err != nil {
+			switch {
+			case errors.Is(err, searchService.ErrUnknownSkill):
+				response.WriteError(w, http.StatusBadRequest, err.Error())
+			case errors.Is(err, searchService.ErrQuestionNotIndexed):
+				response.WriteError(w, http.StatusNotFound, "Question not found")
+			case errors.Is(err, searchService.ErrSearchUnavailable):
+				response.WriteError(w, http.StatusServiceUnavailable, err.Error())
+			default:
+				response.WriteError(w, http.StatusInternalServerError, "Failed to find similar questions")
+			}
+			return
+		}
+
+		response.WriteJSON(w, http.StatusOK, gin.H{
+			"success": true,
+			"data":    questions,
+		})
+	}
+}
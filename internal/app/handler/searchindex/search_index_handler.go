@@ -0,0 +1,114 @@
+package searchindex
+
+import (
+	"context"
+	"errors"
+	searchindexService "fluencybe/internal/app/service/searchindex"
+	"fluencybe/internal/core/constants"
+	"fluencybe/pkg/logger"
+	"fluencybe/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SearchIndexHandler struct {
+	service *searchindexService.SearchIndexService
+	logger  *logger.PrettyLogger
+}
+
+func NewSearchIndexHandler(service *searchindexService.SearchIndexService, logger *logger.PrettyLogger) *SearchIndexHandler {
+	return &SearchIndexHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Reindex starts rebuilding the index of a skill, progress is read from GetTasks
+func (h *SearchIndexHandler) Reindex(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	skill, ok := skillParam(ctx, w)
+	if !ok {
+		return
+	}
+
+	task, err := h.service.StartReindex(skill)
+	if err != nil {
+		h.writeStartError(w, err)
+		return
+	}
+
+	response.WriteJSON(w, http.StatusAccepted, gin.H{
+		"success": true,
+		"data":    task,
+	})
+}
+
+// Verify starts comparing the index of a skill with the database, ?repair=true also fixes mismatches
+func (h *SearchIndexHandler) Verify(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	skill, ok := skillParam(ctx, w)
+	if !ok {
+		return
+	}
+
+	repair := false
+	if raw := r.URL.Query().Get("repair"); raw != "" {
+		var err error
+		if repair, err = strconv.ParseBool(raw); err != nil {
+			response.WriteError(w, http.StatusBadRequest, "Invalid repair flag")
+			return
+		}
+	}
+
+	task, err := h.service.StartVerify(skill, repair)
+	if err != nil {
+		h.writeStartError(w, err)
+		return
+	}
+
+	response.WriteJSON(w, http.StatusAccepted, gin.H{
+		"success": true,
+		"data":    task,
+	})
+}
+
+func (h *SearchIndexHandler) GetTasks(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	skill, ok := skillParam(ctx, w)
+	if !ok {
+		return
+	}
+
+	tasks, err := h.service.GetTasks(skill)
+	if err != nil {
+		response.WriteError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, gin.H{
+		"success": true,
+		"data":    tasks,
+	})
+}
+
+func (h *SearchIndexHandler) writeStartError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, searchindexService.ErrUnknownSkill):
+		response.WriteError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, searchindexService.ErrIndexBusy):
+		response.WriteError(w, http.StatusConflict, err.Error())
+	default:
+		h.logger.Error("search_index_handler.start", map[string]interface{}{
+			"error": err.Error(),
+		}, "Failed to start search index task")
+		response.WriteError(w, http.StatusInternalServerError, "Failed to start search index task")
+	}
+}
+
+func skillParam(ctx context.Context, w http.ResponseWriter) (string, bool) {
+	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
+	if !ok {
+		response.WriteError(w, http.StatusInternalServerError, "Invalid context")
+		return "", false
+	}
+	return ginCtx.Param("skill"), true
+}
@@ -35,7 +35,7 @@ func NewSpeakingConversationalOpenHandler(
 func (h *SpeakingConversationalOpenHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req speakingDTO.CreateSpeakingConversationalOpenRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("speaking_conversational_open_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_conversational_open_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -53,7 +53,7 @@ func (h *SpeakingConversationalOpenHandler) Create(ctx context.Context, w http.R
 	}
 
 	if err := h.service.Create(ctx, conversationalOpen); err != nil {
-		h.logger.Error("speaking_conversational_open_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_conversational_open_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create conversational open")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create conversational open")
@@ -73,7 +73,7 @@ func (h *SpeakingConversationalOpenHandler) Create(ctx context.Context, w http.R
 func (h *SpeakingConversationalOpenHandler) Update(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req speakingDTO.UpdateSpeakingConversationalOpenRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("speaking_conversational_open_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_conversational_open_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -83,7 +83,7 @@ func (h *SpeakingConversationalOpenHandler) Update(ctx context.Context, w http.R
 	// Get existing record first
 	existingOpen, err := h.service.GetByID(ctx, req.SpeakingConversationalOpenID)
 	if err != nil {
-		h.logger.Error("speaking_conversational_open_handler.update.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_conversational_open_handler.update.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.SpeakingConversationalOpenID,
 		}, "Failed to get existing conversational open")
@@ -107,7 +107,7 @@ func (h *SpeakingConversationalOpenHandler) Update(ctx context.Context, w http.R
 	existingOpen.UpdatedAt = time.Now()
 
 	if err := h.service.Update(ctx, existingOpen); err != nil {
-		h.logger.Error("speaking_conversational_open_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_conversational_open_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.SpeakingConversationalOpenID,
 		}, "Failed to update conversational open")
@@ -121,7 +121,7 @@ func (h *SpeakingConversationalOpenHandler) Update(ctx context.Context, w http.R
 func (h *SpeakingConversationalOpenHandler) Delete(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("speaking_conversational_open_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("speaking_conversational_open_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -129,7 +129,7 @@ func (h *SpeakingConversationalOpenHandler) Delete(ctx context.Context, w http.R
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("speaking_conversational_open_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_conversational_open_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid conversational open ID format")
@@ -138,7 +138,7 @@ func (h *SpeakingConversationalOpenHandler) Delete(ctx context.Context, w http.R
 	}
 
 	if err := h.service.Delete(ctx, id); err != nil {
-		h.logger.Error("speaking_conversational_open_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_conversational_open_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete conversational open")
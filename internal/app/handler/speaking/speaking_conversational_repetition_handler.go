@@ -35,7 +35,7 @@ func NewSpeakingConversationalRepetitionHandler(
 func (h *SpeakingConversationalRepetitionHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req speakingDTO.CreateSpeakingConversationalRepetitionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("speaking_conversational_repetition_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_conversational_repetition_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -52,7 +52,7 @@ func (h *SpeakingConversationalRepetitionHandler) Create(ctx context.Context, w
 	}
 
 	if err := h.service.Create(ctx, conversationalRepetition); err != nil {
-		h.logger.Error("speaking_conversational_repetition_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_conversational_repetition_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create conversational repetition")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create conversational repetition")
@@ -71,7 +71,7 @@ func (h *SpeakingConversationalRepetitionHandler) Create(ctx context.Context, w
 func (h *SpeakingConversationalRepetitionHandler) Update(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req speakingDTO.UpdateSpeakingConversationalRepetitionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("speaking_conversational_repetition_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_conversational_repetition_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -81,7 +81,7 @@ func (h *SpeakingConversationalRepetitionHandler) Update(ctx context.Context, w
 	// Get existing record first
 	existingRepetition, err := h.service.GetByID(ctx, req.SpeakingConversationalRepetitionID)
 	if err != nil {
-		h.logger.Error("speaking_conversational_repetition_handler.update.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_conversational_repetition_handler.update.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.SpeakingConversationalRepetitionID,
 		}, "Failed to get existing conversational repetition")
@@ -103,7 +103,7 @@ func (h *SpeakingConversationalRepetitionHandler) Update(ctx context.Context, w
 	existingRepetition.UpdatedAt = time.Now()
 
 	if err := h.service.Update(ctx, existingRepetition); err != nil {
-		h.logger.Error("speaking_conversational_repetition_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_conversational_repetition_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.SpeakingConversationalRepetitionID,
 		}, "Failed to update conversational repetition")
@@ -117,7 +117,7 @@ func (h *SpeakingConversationalRepetitionHandler) Update(ctx context.Context, w
 func (h *SpeakingConversationalRepetitionHandler) Delete(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("speaking_conversational_repetition_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("speaking_conversational_repetition_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -125,7 +125,7 @@ func (h *SpeakingConversationalRepetitionHandler) Delete(ctx context.Context, w
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("speaking_conversational_repetition_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_conversational_repetition_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid conversational repetition ID format")
@@ -134,7 +134,7 @@ func (h *SpeakingConversationalRepetitionHandler) Delete(ctx context.Context, w
 	}
 
 	if err := h.service.Delete(ctx, id); err != nil {
-		h.logger.Error("speaking_conversational_repetition_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_conversational_repetition_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete conversational repetition")
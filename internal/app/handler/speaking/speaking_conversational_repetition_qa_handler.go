@@ -35,7 +35,7 @@ func NewSpeakingConversationalRepetitionQAHandler(
 func (h *SpeakingConversationalRepetitionQAHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req speakingDTO.CreateSpeakingConversationalRepetitionQARequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("speaking_conversational_repetition_qa_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_conversational_repetition_qa_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -55,7 +55,7 @@ func (h *SpeakingConversationalRepetitionQAHandler) Create(ctx context.Context,
 	}
 
 	if err := h.service.Create(ctx, qa); err != nil {
-		h.logger.Error("speaking_conversational_repetition_qa_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_conversational_repetition_qa_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create QA")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create QA")
@@ -77,7 +77,7 @@ func (h *SpeakingConversationalRepetitionQAHandler) Create(ctx context.Context,
 func (h *SpeakingConversationalRepetitionQAHandler) Update(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req speakingDTO.UpdateSpeakingConversationalRepetitionQARequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("speaking_conversational_repetition_qa_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_conversational_repetition_qa_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -87,7 +87,7 @@ func (h *SpeakingConversationalRepetitionQAHandler) Update(ctx context.Context,
 	// Get existing record first
 	existingQA, err := h.service.GetByID(ctx, req.SpeakingConversationalRepetitionQAID)
 	if err != nil {
-		h.logger.Error("speaking_conversational_repetition_qa_handler.update.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_conversational_repetition_qa_handler.update.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.SpeakingConversationalRepetitionQAID,
 		}, "Failed to get existing QA")
@@ -115,7 +115,7 @@ func (h *SpeakingConversationalRepetitionQAHandler) Update(ctx context.Context,
 	existingQA.UpdatedAt = time.Now()
 
 	if err := h.service.Update(ctx, existingQA); err != nil {
-		h.logger.Error("speaking_conversational_repetition_qa_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_conversational_repetition_qa_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.SpeakingConversationalRepetitionQAID,
 		}, "Failed to update QA")
@@ -129,7 +129,7 @@ func (h *SpeakingConversationalRepetitionQAHandler) Update(ctx context.Context,
 func (h *SpeakingConversationalRepetitionQAHandler) Delete(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("speaking_conversational_repetition_qa_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("speaking_conversational_repetition_qa_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -137,7 +137,7 @@ func (h *SpeakingConversationalRepetitionQAHandler) Delete(ctx context.Context,
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("speaking_conversational_repetition_qa_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_conversational_repetition_qa_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid QA ID format")
@@ -146,7 +146,7 @@ func (h *SpeakingConversationalRepetitionQAHandler) Delete(ctx context.Context,
 	}
 
 	if err := h.service.Delete(ctx, id); err != nil {
-		h.logger.Error("speaking_conversational_repetition_qa_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_conversational_repetition_qa_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete QA")
@@ -35,7 +35,7 @@ func NewSpeakingOpenParagraphHandler(
 func (h *SpeakingOpenParagraphHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req speakingDTO.CreateSpeakingOpenParagraphRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("speaking_open_paragraph_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_open_paragraph_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -53,7 +53,7 @@ func (h *SpeakingOpenParagraphHandler) Create(ctx context.Context, w http.Respon
 	}
 
 	if err := h.service.Create(ctx, openParagraph); err != nil {
-		h.logger.Error("speaking_open_paragraph_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_open_paragraph_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create open paragraph")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create open paragraph")
@@ -73,7 +73,7 @@ func (h *SpeakingOpenParagraphHandler) Create(ctx context.Context, w http.Respon
 func (h *SpeakingOpenParagraphHandler) Update(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req speakingDTO.UpdateSpeakingOpenParagraphRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("speaking_open_paragraph_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_open_paragraph_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -83,7 +83,7 @@ func (h *SpeakingOpenParagraphHandler) Update(ctx context.Context, w http.Respon
 	// Get existing record first
 	existingParagraph, err := h.service.GetByID(ctx, req.SpeakingOpenParagraphID)
 	if err != nil {
-		h.logger.Error("speaking_open_paragraph_handler.update.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_open_paragraph_handler.update.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.SpeakingOpenParagraphID,
 		}, "Failed to get existing open paragraph")
@@ -107,7 +107,7 @@ func (h *SpeakingOpenParagraphHandler) Update(ctx context.Context, w http.Respon
 	existingParagraph.UpdatedAt = time.Now()
 
 	if err := h.service.Update(ctx, existingParagraph); err != nil {
-		h.logger.Error("speaking_open_paragraph_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_open_paragraph_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.SpeakingOpenParagraphID,
 		}, "Failed to update open paragraph")
@@ -121,7 +121,7 @@ func (h *SpeakingOpenParagraphHandler) Update(ctx context.Context, w http.Respon
 func (h *SpeakingOpenParagraphHandler) Delete(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("speaking_open_paragraph_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("speaking_open_paragraph_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -129,7 +129,7 @@ func (h *SpeakingOpenParagraphHandler) Delete(ctx context.Context, w http.Respon
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("speaking_open_paragraph_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_open_paragraph_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid open paragraph ID format")
@@ -138,7 +138,7 @@ func (h *SpeakingOpenParagraphHandler) Delete(ctx context.Context, w http.Respon
 	}
 
 	if err := h.service.Delete(ctx, id); err != nil {
-		h.logger.Error("speaking_open_paragraph_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_open_paragraph_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete open paragraph")
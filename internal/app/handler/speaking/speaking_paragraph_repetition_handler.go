@@ -35,7 +35,7 @@ func NewSpeakingParagraphRepetitionHandler(
 func (h *SpeakingParagraphRepetitionHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req speakingDTO.CreateSpeakingParagraphRepetitionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("speaking_paragraph_repetition_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_paragraph_repetition_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -52,7 +52,7 @@ func (h *SpeakingParagraphRepetitionHandler) Create(ctx context.Context, w http.
 	}
 
 	if err := h.service.Create(ctx, paragraphRepetition); err != nil {
-		h.logger.Error("speaking_paragraph_repetition_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_paragraph_repetition_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create paragraph repetition")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create paragraph repetition")
@@ -71,7 +71,7 @@ func (h *SpeakingParagraphRepetitionHandler) Create(ctx context.Context, w http.
 func (h *SpeakingParagraphRepetitionHandler) Update(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req speakingDTO.UpdateSpeakingParagraphRepetitionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("speaking_paragraph_repetition_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_paragraph_repetition_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -81,7 +81,7 @@ func (h *SpeakingParagraphRepetitionHandler) Update(ctx context.Context, w http.
 	// Get existing record first
 	existingParagraph, err := h.service.GetByID(ctx, req.SpeakingParagraphRepetitionID)
 	if err != nil {
-		h.logger.Error("speaking_paragraph_repetition_handler.update.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_paragraph_repetition_handler.update.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.SpeakingParagraphRepetitionID,
 		}, "Failed to get existing paragraph repetition")
@@ -103,7 +103,7 @@ func (h *SpeakingParagraphRepetitionHandler) Update(ctx context.Context, w http.
 	existingParagraph.UpdatedAt = time.Now()
 
 	if err := h.service.Update(ctx, existingParagraph); err != nil {
-		h.logger.Error("speaking_paragraph_repetition_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_paragraph_repetition_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.SpeakingParagraphRepetitionID,
 		}, "Failed to update paragraph repetition")
@@ -117,7 +117,7 @@ func (h *SpeakingParagraphRepetitionHandler) Update(ctx context.Context, w http.
 func (h *SpeakingParagraphRepetitionHandler) Delete(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("speaking_paragraph_repetition_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("speaking_paragraph_repetition_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -125,7 +125,7 @@ func (h *SpeakingParagraphRepetitionHandler) Delete(ctx context.Context, w http.
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("speaking_paragraph_repetition_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_paragraph_repetition_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid paragraph repetition ID format")
@@ -134,7 +134,7 @@ func (h *SpeakingParagraphRepetitionHandler) Delete(ctx context.Context, w http.
 	}
 
 	if err := h.service.Delete(ctx, id); err != nil {
-		h.logger.Error("speaking_paragraph_repetition_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_paragraph_repetition_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete paragraph repetition")
@@ -35,7 +35,7 @@ func NewSpeakingPhraseRepetitionHandler(
 func (h *SpeakingPhraseRepetitionHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req speakingDTO.CreateSpeakingPhraseRepetitionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("speaking_phrase_repetition_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_phrase_repetition_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -52,7 +52,7 @@ func (h *SpeakingPhraseRepetitionHandler) Create(ctx context.Context, w http.Res
 	}
 
 	if err := h.service.Create(ctx, phraseRepetition); err != nil {
-		h.logger.Error("speaking_phrase_repetition_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_phrase_repetition_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create phrase repetition")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create phrase repetition")
@@ -71,7 +71,7 @@ func (h *SpeakingPhraseRepetitionHandler) Create(ctx context.Context, w http.Res
 func (h *SpeakingPhraseRepetitionHandler) Update(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req speakingDTO.UpdateSpeakingPhraseRepetitionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("speaking_phrase_repetition_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_phrase_repetition_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -81,7 +81,7 @@ func (h *SpeakingPhraseRepetitionHandler) Update(ctx context.Context, w http.Res
 	// Get existing record first
 	existingPhrase, err := h.service.GetByID(ctx, req.SpeakingPhraseRepetitionID)
 	if err != nil {
-		h.logger.Error("speaking_phrase_repetition_handler.update.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_phrase_repetition_handler.update.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.SpeakingPhraseRepetitionID,
 		}, "Failed to get existing phrase repetition")
@@ -103,7 +103,7 @@ func (h *SpeakingPhraseRepetitionHandler) Update(ctx context.Context, w http.Res
 	existingPhrase.UpdatedAt = time.Now()
 
 	if err := h.service.Update(ctx, existingPhrase); err != nil {
-		h.logger.Error("speaking_phrase_repetition_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_phrase_repetition_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.SpeakingPhraseRepetitionID,
 		}, "Failed to update phrase repetition")
@@ -117,7 +117,7 @@ func (h *SpeakingPhraseRepetitionHandler) Update(ctx context.Context, w http.Res
 func (h *SpeakingPhraseRepetitionHandler) Delete(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("speaking_phrase_repetition_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("speaking_phrase_repetition_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -125,7 +125,7 @@ func (h *SpeakingPhraseRepetitionHandler) Delete(ctx context.Context, w http.Res
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("speaking_phrase_repetition_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_phrase_repetition_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid phrase repetition ID format")
@@ -134,7 +134,7 @@ func (h *SpeakingPhraseRepetitionHandler) Delete(ctx context.Context, w http.Res
 	}
 
 	if err := h.service.Delete(ctx, id); err != nil {
-		h.logger.Error("speaking_phrase_repetition_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_phrase_repetition_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete phrase repetition")
@@ -56,7 +56,7 @@ func NewSpeakingQuestionHandler(
 func (h *SpeakingQuestionHandler) CreateSpeakingQuestion(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req speakingDTO.CreateSpeakingQuestionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("speaking_question_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_question_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -72,22 +72,30 @@ func (h *SpeakingQuestionHandler) CreateSpeakingQuestion(ctx context.Context, w
 		MaxTime:     req.MaxTime,
 	}
 
-	if err := h.service.CreateQuestion(ctx, question); err != nil {
-		h.logger.Error("speaking_question_handler.create", map[string]interface{}{
+	nearDuplicates, err := h.service.CreateQuestion(ctx, question)
+	if err != nil {
+		h.logger.WithContext(ctx).Error("speaking_question_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create speaking question")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create speaking question")
 		return
 	}
 
-	responseData := speakingDTO.SpeakingQuestionResponse{
-		ID:          question.ID,
-		Type:        question.Type,
-		Topic:       question.Topic,
-		Instruction: question.Instruction,
-		ImageURLs:   question.ImageURLs,
-		MaxTime:     question.MaxTime,
-		Version:     question.Version,
+	// Near-duplicates are a warning for the author, the question is created either way
+	responseData := struct {
+		speakingDTO.SpeakingQuestionResponse
+		NearDuplicates []speakingDTO.SimilarQuestion `json:"near_duplicates,omitempty"`
+	}{
+		SpeakingQuestionResponse: speakingDTO.SpeakingQuestionResponse{
+			ID:          question.ID,
+			Type:        question.Type,
+			Topic:       question.Topic,
+			Instruction: question.Instruction,
+			ImageURLs:   question.ImageURLs,
+			MaxTime:     question.MaxTime,
+			Version:     question.Version,
+		},
+		NearDuplicates: nearDuplicates,
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -98,7 +106,7 @@ func (h *SpeakingQuestionHandler) CreateSpeakingQuestion(ctx context.Context, w
 func (h *SpeakingQuestionHandler) GetSpeakingQuestionDetail(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("speaking_question_handler.get.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("speaking_question_handler.get.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -106,7 +114,7 @@ func (h *SpeakingQuestionHandler) GetSpeakingQuestionDetail(ctx context.Context,
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("speaking_question_handler.get.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_question_handler.get.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid question ID format")
@@ -116,7 +124,7 @@ func (h *SpeakingQuestionHandler) GetSpeakingQuestionDetail(ctx context.Context,
 
 	questionDetail, err := h.service.GetSpeakingQuestionDetail(ctx, id)
 	if err != nil {
-		h.logger.Error("speaking_question_handler.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_question_handler.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get speaking question")
@@ -131,7 +139,7 @@ func (h *SpeakingQuestionHandler) GetSpeakingQuestionDetail(ctx context.Context,
 func (h *SpeakingQuestionHandler) UpdateSpeakingQuestion(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("speaking_question_handler.update.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("speaking_question_handler.update.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -139,7 +147,7 @@ func (h *SpeakingQuestionHandler) UpdateSpeakingQuestion(ctx context.Context, w
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("speaking_question_handler.update.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_question_handler.update.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid question ID format")
@@ -149,7 +157,7 @@ func (h *SpeakingQuestionHandler) UpdateSpeakingQuestion(ctx context.Context, w
 
 	var req speakingDTO.UpdateSpeakingQuestionFieldRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("speaking_question_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_question_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -157,7 +165,7 @@ func (h *SpeakingQuestionHandler) UpdateSpeakingQuestion(ctx context.Context, w
 	}
 
 	if err := h.service.UpdateQuestion(ctx, id, req); err != nil {
-		h.logger.Error("speaking_question_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_question_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to update speaking question")
@@ -171,7 +179,7 @@ func (h *SpeakingQuestionHandler) UpdateSpeakingQuestion(ctx context.Context, w
 func (h *SpeakingQuestionHandler) DeleteSpeakingQuestion(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("speaking_question_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("speaking_question_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -179,7 +187,7 @@ func (h *SpeakingQuestionHandler) DeleteSpeakingQuestion(ctx context.Context, w
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("speaking_question_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_question_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid question ID format")
@@ -188,7 +196,7 @@ func (h *SpeakingQuestionHandler) DeleteSpeakingQuestion(ctx context.Context, w
 	}
 
 	if err := h.service.DeleteQuestion(ctx, id); err != nil {
-		h.logger.Error("speaking_question_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_question_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete speaking question")
@@ -202,7 +210,7 @@ func (h *SpeakingQuestionHandler) DeleteSpeakingQuestion(ctx context.Context, w
 func (h *SpeakingQuestionHandler) GetListNewSpeakingQuestionByListVersionAndID(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req speakingDTO.GetNewUpdatesSpeakingQuestionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("speaking_question_handler.get_new_updates.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_question_handler.get_new_updates.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -220,7 +228,7 @@ func (h *SpeakingQuestionHandler) GetListNewSpeakingQuestionByListVersionAndID(c
 
 	questions, err := h.service.GetNewUpdatedQuestions(ctx, versionChecks)
 	if err != nil {
-		h.logger.Error("speaking_question_handler.get_new_speaking_questions", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_question_handler.get_new_speaking_questions", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get updated questions")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to get updated questions")
@@ -255,7 +263,7 @@ type GetListSpeakingByListIDRequest struct {
 func (h *SpeakingQuestionHandler) GetListSpeakingByListID(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req GetListSpeakingByListIDRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("speaking_question_handler.get_list_by_ids.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_question_handler.get_list_by_ids.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -266,7 +274,7 @@ func (h *SpeakingQuestionHandler) GetListSpeakingByListID(ctx context.Context, w
 	for _, idStr := range req.QuestionIDs {
 		id, err := uuid.Parse(idStr)
 		if err != nil {
-			h.logger.Error("speaking_question_handler.get_list_by_ids.parse_id", map[string]interface{}{
+			h.logger.WithContext(ctx).Error("speaking_question_handler.get_list_by_ids.parse_id", map[string]interface{}{
 				"error": err.Error(),
 				"id":    idStr,
 			}, "Invalid question ID format")
@@ -278,7 +286,7 @@ func (h *SpeakingQuestionHandler) GetListSpeakingByListID(ctx context.Context, w
 
 	questions, err := h.service.GetSpeakingByListID(ctx, questionIDs)
 	if err != nil {
-		h.logger.Error("speaking_question_handler.get_list_by_ids", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_question_handler.get_list_by_ids", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get questions")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to get questions")
@@ -294,14 +302,14 @@ func (h *SpeakingQuestionHandler) GetListSpeakingByListID(ctx context.Context, w
 func (h *SpeakingQuestionHandler) GetListSpeakingQuestiondetailPaganationWithFilter(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("speaking_question_handler.search.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("speaking_question_handler.search.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
 
 	var filter speakingDTO.SpeakingQuestionSearchFilter
 	if err := ginCtx.ShouldBindQuery(&filter); err != nil {
-		h.logger.Error("speaking_question_handler.search.bind", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_question_handler.search.bind", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to bind query parameters")
 		response.WriteError(w, http.StatusBadRequest, "Invalid query parameters")
@@ -317,20 +325,23 @@ func (h *SpeakingQuestionHandler) GetListSpeakingQuestiondetailPaganationWithFil
 	}
 
 	// Log search parameters for debugging
-	h.logger.Debug("speaking_question_handler.search.params", map[string]interface{}{
+	h.logger.WithContext(ctx).Debug("speaking_question_handler.search.params", map[string]interface{}{
 		"type":        filter.Type,
 		"topic":       filter.Topic,
 		"instruction": filter.Instruction,
 		"image_urls":  filter.ImageURLs,
 		"max_time":    filter.MaxTime,
 		"metadata":    filter.Metadata,
+		"child_text":  filter.ChildText,
+		"has_child":   filter.HasChild,
+		"min_options": filter.MinOptions,
 		"page":        filter.Page,
 		"page_size":   filter.PageSize,
 	}, "Search parameters")
 
 	questions, err := h.service.SearchQuestionsWithFilter(ctx, filter)
 	if err != nil {
-		h.logger.Error("speaking_question_handler.search", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_question_handler.search", map[string]interface{}{
 			"error":  err.Error(),
 			"filter": filter,
 		}, "Failed to search questions")
@@ -339,7 +350,7 @@ func (h *SpeakingQuestionHandler) GetListSpeakingQuestiondetailPaganationWithFil
 	}
 
 	// Add debug logging for results
-	h.logger.Debug("speaking_question_handler.search.results", map[string]interface{}{
+	h.logger.WithContext(ctx).Debug("speaking_question_handler.search.results", map[string]interface{}{
 		"total_results": questions.Total,
 		"page":          questions.Page,
 		"page_size":     questions.PageSize,
@@ -359,7 +370,7 @@ func (h *SpeakingQuestionHandler) GetListSpeakingQuestiondetailPaganationWithFil
 
 func (h *SpeakingQuestionHandler) DeleteAllSpeakingData(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	if err := h.service.DeleteAllQuestions(ctx); err != nil {
-		h.logger.Error("speaking_question_handler.delete_all", map[string]interface{}{"error": err.Error()}, "Failed to delete all speaking data")
+		h.logger.WithContext(ctx).Error("speaking_question_handler.delete_all", map[string]interface{}{"error": err.Error()}, "Failed to delete all speaking data")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to delete all speaking data")
 		return
 	}
@@ -35,7 +35,7 @@ func NewSpeakingWordRepetitionHandler(
 func (h *SpeakingWordRepetitionHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req speakingDTO.CreateSpeakingWordRepetitionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("speaking_word_repetition_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_word_repetition_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -52,7 +52,7 @@ func (h *SpeakingWordRepetitionHandler) Create(ctx context.Context, w http.Respo
 	}
 
 	if err := h.service.Create(ctx, wordRepetition); err != nil {
-		h.logger.Error("speaking_word_repetition_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_word_repetition_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create word repetition")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create word repetition")
@@ -71,7 +71,7 @@ func (h *SpeakingWordRepetitionHandler) Create(ctx context.Context, w http.Respo
 func (h *SpeakingWordRepetitionHandler) Update(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req speakingDTO.UpdateSpeakingWordRepetitionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("speaking_word_repetition_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_word_repetition_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -81,7 +81,7 @@ func (h *SpeakingWordRepetitionHandler) Update(ctx context.Context, w http.Respo
 	// Get existing record first
 	existingWord, err := h.service.GetByID(ctx, req.SpeakingWordRepetitionID)
 	if err != nil {
-		h.logger.Error("speaking_word_repetition_handler.update.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_word_repetition_handler.update.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.SpeakingWordRepetitionID,
 		}, "Failed to get existing word repetition")
@@ -103,7 +103,7 @@ func (h *SpeakingWordRepetitionHandler) Update(ctx context.Context, w http.Respo
 	existingWord.UpdatedAt = time.Now()
 
 	if err := h.service.Update(ctx, existingWord); err != nil {
-		h.logger.Error("speaking_word_repetition_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_word_repetition_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.SpeakingWordRepetitionID,
 		}, "Failed to update word repetition")
@@ -117,7 +117,7 @@ func (h *SpeakingWordRepetitionHandler) Update(ctx context.Context, w http.Respo
 func (h *SpeakingWordRepetitionHandler) Delete(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("speaking_word_repetition_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("speaking_word_repetition_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -125,7 +125,7 @@ func (h *SpeakingWordRepetitionHandler) Delete(ctx context.Context, w http.Respo
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("speaking_word_repetition_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_word_repetition_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid word repetition ID format")
@@ -134,7 +134,7 @@ func (h *SpeakingWordRepetitionHandler) Delete(ctx context.Context, w http.Respo
 	}
 
 	if err := h.service.Delete(ctx, id); err != nil {
-		h.logger.Error("speaking_word_repetition_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("speaking_word_repetition_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete word repetition")
@@ -35,7 +35,7 @@ func NewWikiPhraseDefinitionSampleHandler(
 func (h *WikiPhraseDefinitionSampleHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req dto.CreateWikiPhraseDefinitionSampleRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("wiki_phrase_definition_sample_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_phrase_definition_sample_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -52,7 +52,7 @@ func (h *WikiPhraseDefinitionSampleHandler) Create(ctx context.Context, w http.R
 	}
 
 	if err := h.service.Create(ctx, sample); err != nil {
-		h.logger.Error("wiki_phrase_definition_sample_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_phrase_definition_sample_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create sample")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create sample")
@@ -72,7 +72,7 @@ func (h *WikiPhraseDefinitionSampleHandler) Create(ctx context.Context, w http.R
 func (h *WikiPhraseDefinitionSampleHandler) GetByID(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("wiki_phrase_definition_sample_handler.get.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("wiki_phrase_definition_sample_handler.get.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -80,7 +80,7 @@ func (h *WikiPhraseDefinitionSampleHandler) GetByID(ctx context.Context, w http.
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("wiki_phrase_definition_sample_handler.get.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_phrase_definition_sample_handler.get.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid sample ID format")
@@ -90,7 +90,7 @@ func (h *WikiPhraseDefinitionSampleHandler) GetByID(ctx context.Context, w http.
 
 	sample, err := h.service.GetByID(ctx, id)
 	if err != nil {
-		h.logger.Error("wiki_phrase_definition_sample_handler.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_phrase_definition_sample_handler.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get sample")
@@ -111,7 +111,7 @@ func (h *WikiPhraseDefinitionSampleHandler) GetByID(ctx context.Context, w http.
 func (h *WikiPhraseDefinitionSampleHandler) GetByDefinitionID(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("wiki_phrase_definition_sample_handler.get_by_definition.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("wiki_phrase_definition_sample_handler.get_by_definition.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -119,7 +119,7 @@ func (h *WikiPhraseDefinitionSampleHandler) GetByDefinitionID(ctx context.Contex
 	definitionIDStr := ginCtx.Param("definition_id")
 	definitionID, err := uuid.Parse(definitionIDStr)
 	if err != nil {
-		h.logger.Error("wiki_phrase_definition_sample_handler.get_by_definition.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_phrase_definition_sample_handler.get_by_definition.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    definitionIDStr,
 		}, "Invalid definition ID format")
@@ -129,7 +129,7 @@ func (h *WikiPhraseDefinitionSampleHandler) GetByDefinitionID(ctx context.Contex
 
 	samples, err := h.service.GetByDefinitionID(ctx, definitionID)
 	if err != nil {
-		h.logger.Error("wiki_phrase_definition_sample_handler.get_by_definition", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_phrase_definition_sample_handler.get_by_definition", map[string]interface{}{
 			"error":         err.Error(),
 			"definition_id": definitionID,
 		}, "Failed to get samples")
@@ -156,7 +156,7 @@ func (h *WikiPhraseDefinitionSampleHandler) GetByDefinitionID(ctx context.Contex
 func (h *WikiPhraseDefinitionSampleHandler) Update(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("wiki_phrase_definition_sample_handler.update.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("wiki_phrase_definition_sample_handler.update.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -164,7 +164,7 @@ func (h *WikiPhraseDefinitionSampleHandler) Update(ctx context.Context, w http.R
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("wiki_phrase_definition_sample_handler.update.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_phrase_definition_sample_handler.update.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid sample ID format")
@@ -174,7 +174,7 @@ func (h *WikiPhraseDefinitionSampleHandler) Update(ctx context.Context, w http.R
 
 	var req dto.UpdateWikiPhraseDefinitionSampleRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("wiki_phrase_definition_sample_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_phrase_definition_sample_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -182,7 +182,7 @@ func (h *WikiPhraseDefinitionSampleHandler) Update(ctx context.Context, w http.R
 	}
 
 	if err := h.service.Update(ctx, id, req); err != nil {
-		h.logger.Error("wiki_phrase_definition_sample_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_phrase_definition_sample_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to update sample")
@@ -196,7 +196,7 @@ func (h *WikiPhraseDefinitionSampleHandler) Update(ctx context.Context, w http.R
 func (h *WikiPhraseDefinitionSampleHandler) Delete(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("wiki_phrase_definition_sample_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("wiki_phrase_definition_sample_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -204,7 +204,7 @@ func (h *WikiPhraseDefinitionSampleHandler) Delete(ctx context.Context, w http.R
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("wiki_phrase_definition_sample_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_phrase_definition_sample_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid sample ID format")
@@ -213,7 +213,7 @@ func (h *WikiPhraseDefinitionSampleHandler) Delete(ctx context.Context, w http.R
 	}
 
 	if err := h.service.Delete(ctx, id); err != nil {
-		h.logger.Error("wiki_phrase_definition_sample_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_phrase_definition_sample_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete sample")
@@ -36,7 +36,7 @@ func NewWikiPhraseHandler(
 func (h *WikiPhraseHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req dto.CreateWikiPhraseRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("wiki_phrase_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_phrase_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -53,7 +53,7 @@ func (h *WikiPhraseHandler) Create(ctx context.Context, w http.ResponseWriter, r
 	}
 
 	if err := h.service.Create(ctx, phrase); err != nil {
-		h.logger.Error("wiki_phrase_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_phrase_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create phrase")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create phrase")
@@ -73,7 +73,7 @@ func (h *WikiPhraseHandler) Create(ctx context.Context, w http.ResponseWriter, r
 func (h *WikiPhraseHandler) GetByID(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("wiki_phrase_handler.get.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("wiki_phrase_handler.get.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -81,7 +81,7 @@ func (h *WikiPhraseHandler) GetByID(ctx context.Context, w http.ResponseWriter,
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("wiki_phrase_handler.get.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_phrase_handler.get.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid phrase ID format")
@@ -91,7 +91,7 @@ func (h *WikiPhraseHandler) GetByID(ctx context.Context, w http.ResponseWriter,
 
 	phrase, err := h.service.GetByID(ctx, id)
 	if err != nil {
-		h.logger.Error("wiki_phrase_handler.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_phrase_handler.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get phrase")
@@ -112,7 +112,7 @@ func (h *WikiPhraseHandler) GetByID(ctx context.Context, w http.ResponseWriter,
 func (h *WikiPhraseHandler) Update(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("wiki_phrase_handler.update.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("wiki_phrase_handler.update.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -120,7 +120,7 @@ func (h *WikiPhraseHandler) Update(ctx context.Context, w http.ResponseWriter, r
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("wiki_phrase_handler.update.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_phrase_handler.update.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid phrase ID format")
@@ -130,7 +130,7 @@ func (h *WikiPhraseHandler) Update(ctx context.Context, w http.ResponseWriter, r
 
 	var req dto.UpdateWikiPhraseRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("wiki_phrase_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_phrase_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -138,7 +138,7 @@ func (h *WikiPhraseHandler) Update(ctx context.Context, w http.ResponseWriter, r
 	}
 
 	if err := h.service.Update(ctx, id, req); err != nil {
-		h.logger.Error("wiki_phrase_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_phrase_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to update phrase")
@@ -152,7 +152,7 @@ func (h *WikiPhraseHandler) Update(ctx context.Context, w http.ResponseWriter, r
 func (h *WikiPhraseHandler) Delete(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("wiki_phrase_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("wiki_phrase_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -160,7 +160,7 @@ func (h *WikiPhraseHandler) Delete(ctx context.Context, w http.ResponseWriter, r
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("wiki_phrase_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_phrase_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid phrase ID format")
@@ -169,7 +169,7 @@ func (h *WikiPhraseHandler) Delete(ctx context.Context, w http.ResponseWriter, r
 	}
 
 	if err := h.service.Delete(ctx, id); err != nil {
-		h.logger.Error("wiki_phrase_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_phrase_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete phrase")
@@ -183,14 +183,14 @@ func (h *WikiPhraseHandler) Delete(ctx context.Context, w http.ResponseWriter, r
 func (h *WikiPhraseHandler) Search(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("wiki_phrase_handler.search.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("wiki_phrase_handler.search.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
 
 	var params dto.WikiSearchParams
 	if err := ginCtx.ShouldBindQuery(&params); err != nil {
-		h.logger.Error("wiki_phrase_handler.search.bind", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_phrase_handler.search.bind", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to bind query parameters")
 		response.WriteError(w, http.StatusBadRequest, "Invalid query parameters")
@@ -205,7 +205,7 @@ func (h *WikiPhraseHandler) Search(ctx context.Context, w http.ResponseWriter, r
 	if difficultyLevelStr != "" {
 		level, err := strconv.Atoi(difficultyLevelStr)
 		if err != nil {
-			h.logger.Error("wiki_phrase_handler.search.parse_difficulty", map[string]interface{}{
+			h.logger.WithContext(ctx).Error("wiki_phrase_handler.search.parse_difficulty", map[string]interface{}{
 				"error": err.Error(),
 				"value": difficultyLevelStr,
 			}, "Invalid difficulty level format")
@@ -217,7 +217,7 @@ func (h *WikiPhraseHandler) Search(ctx context.Context, w http.ResponseWriter, r
 
 	phrases, total, err := h.service.SearchWithFilter(ctx, params, phraseType, difficultyLevel)
 	if err != nil {
-		h.logger.Error("wiki_phrase_handler.search", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_phrase_handler.search", map[string]interface{}{
 			"error":  err.Error(),
 			"params": params,
 		}, "Failed to search phrases")
@@ -35,7 +35,7 @@ func NewWikiWordAntonymHandler(
 func (h *WikiWordAntonymHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req dto.CreateWikiWordAntonymRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("wiki_word_antonym_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_antonym_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -51,7 +51,7 @@ func (h *WikiWordAntonymHandler) Create(ctx context.Context, w http.ResponseWrit
 	}
 
 	if err := h.service.Create(ctx, antonym); err != nil {
-		h.logger.Error("wiki_word_antonym_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_antonym_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create antonym")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create antonym")
@@ -70,7 +70,7 @@ func (h *WikiWordAntonymHandler) Create(ctx context.Context, w http.ResponseWrit
 func (h *WikiWordAntonymHandler) GetByID(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("wiki_word_antonym_handler.get.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("wiki_word_antonym_handler.get.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -78,7 +78,7 @@ func (h *WikiWordAntonymHandler) GetByID(ctx context.Context, w http.ResponseWri
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("wiki_word_antonym_handler.get.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_antonym_handler.get.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid antonym ID format")
@@ -88,7 +88,7 @@ func (h *WikiWordAntonymHandler) GetByID(ctx context.Context, w http.ResponseWri
 
 	antonym, err := h.service.GetByID(ctx, id)
 	if err != nil {
-		h.logger.Error("wiki_word_antonym_handler.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_antonym_handler.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get antonym")
@@ -108,7 +108,7 @@ func (h *WikiWordAntonymHandler) GetByID(ctx context.Context, w http.ResponseWri
 func (h *WikiWordAntonymHandler) GetByDefinitionID(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("wiki_word_antonym_handler.get_by_definition.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("wiki_word_antonym_handler.get_by_definition.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -116,7 +116,7 @@ func (h *WikiWordAntonymHandler) GetByDefinitionID(ctx context.Context, w http.R
 	definitionIDStr := ginCtx.Param("definition_id")
 	definitionID, err := uuid.Parse(definitionIDStr)
 	if err != nil {
-		h.logger.Error("wiki_word_antonym_handler.get_by_definition.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_antonym_handler.get_by_definition.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    definitionIDStr,
 		}, "Invalid definition ID format")
@@ -126,7 +126,7 @@ func (h *WikiWordAntonymHandler) GetByDefinitionID(ctx context.Context, w http.R
 
 	antonyms, err := h.service.GetByDefinitionID(ctx, definitionID)
 	if err != nil {
-		h.logger.Error("wiki_word_antonym_handler.get_by_definition", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_antonym_handler.get_by_definition", map[string]interface{}{
 			"error":         err.Error(),
 			"definition_id": definitionID,
 		}, "Failed to get antonyms")
@@ -152,7 +152,7 @@ func (h *WikiWordAntonymHandler) GetByDefinitionID(ctx context.Context, w http.R
 func (h *WikiWordAntonymHandler) Delete(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("wiki_word_antonym_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("wiki_word_antonym_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -160,7 +160,7 @@ func (h *WikiWordAntonymHandler) Delete(ctx context.Context, w http.ResponseWrit
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("wiki_word_antonym_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_antonym_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid antonym ID format")
@@ -169,7 +169,7 @@ func (h *WikiWordAntonymHandler) Delete(ctx context.Context, w http.ResponseWrit
 	}
 
 	if err := h.service.Delete(ctx, id); err != nil {
-		h.logger.Error("wiki_word_antonym_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_antonym_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete antonym")
@@ -35,7 +35,7 @@ func NewWikiWordDefinitionHandler(
 func (h *WikiWordDefinitionHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req dto.CreateWikiWordDefinitionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("wiki_word_definition_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_definition_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -52,7 +52,7 @@ func (h *WikiWordDefinitionHandler) Create(ctx context.Context, w http.ResponseW
 	}
 
 	if err := h.service.Create(ctx, definition); err != nil {
-		h.logger.Error("wiki_word_definition_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_definition_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create definition")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create definition")
@@ -72,7 +72,7 @@ func (h *WikiWordDefinitionHandler) Create(ctx context.Context, w http.ResponseW
 func (h *WikiWordDefinitionHandler) GetByID(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("wiki_word_definition_handler.get.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("wiki_word_definition_handler.get.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -80,7 +80,7 @@ func (h *WikiWordDefinitionHandler) GetByID(ctx context.Context, w http.Response
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("wiki_word_definition_handler.get.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_definition_handler.get.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid definition ID format")
@@ -90,7 +90,7 @@ func (h *WikiWordDefinitionHandler) GetByID(ctx context.Context, w http.Response
 
 	definition, err := h.service.GetByID(ctx, id)
 	if err != nil {
-		h.logger.Error("wiki_word_definition_handler.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_definition_handler.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get definition")
@@ -111,7 +111,7 @@ func (h *WikiWordDefinitionHandler) GetByID(ctx context.Context, w http.Response
 func (h *WikiWordDefinitionHandler) GetByWordID(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("wiki_word_definition_handler.get_by_word.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("wiki_word_definition_handler.get_by_word.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -119,7 +119,7 @@ func (h *WikiWordDefinitionHandler) GetByWordID(ctx context.Context, w http.Resp
 	wordIDStr := ginCtx.Param("word_id")
 	wordID, err := uuid.Parse(wordIDStr)
 	if err != nil {
-		h.logger.Error("wiki_word_definition_handler.get_by_word.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_definition_handler.get_by_word.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    wordIDStr,
 		}, "Invalid word ID format")
@@ -129,7 +129,7 @@ func (h *WikiWordDefinitionHandler) GetByWordID(ctx context.Context, w http.Resp
 
 	definitions, err := h.service.GetByWordID(ctx, wordID)
 	if err != nil {
-		h.logger.Error("wiki_word_definition_handler.get_by_word", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_definition_handler.get_by_word", map[string]interface{}{
 			"error":   err.Error(),
 			"word_id": wordID,
 		}, "Failed to get definitions")
@@ -156,7 +156,7 @@ func (h *WikiWordDefinitionHandler) GetByWordID(ctx context.Context, w http.Resp
 func (h *WikiWordDefinitionHandler) Update(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("wiki_word_definition_handler.update.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("wiki_word_definition_handler.update.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -164,7 +164,7 @@ func (h *WikiWordDefinitionHandler) Update(ctx context.Context, w http.ResponseW
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("wiki_word_definition_handler.update.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_definition_handler.update.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid definition ID format")
@@ -174,7 +174,7 @@ func (h *WikiWordDefinitionHandler) Update(ctx context.Context, w http.ResponseW
 
 	var req dto.UpdateWikiWordDefinitionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("wiki_word_definition_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_definition_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -182,7 +182,7 @@ func (h *WikiWordDefinitionHandler) Update(ctx context.Context, w http.ResponseW
 	}
 
 	if err := h.service.Update(ctx, id, req); err != nil {
-		h.logger.Error("wiki_word_definition_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_definition_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to update definition")
@@ -196,7 +196,7 @@ func (h *WikiWordDefinitionHandler) Update(ctx context.Context, w http.ResponseW
 func (h *WikiWordDefinitionHandler) Delete(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("wiki_word_definition_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("wiki_word_definition_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -204,7 +204,7 @@ func (h *WikiWordDefinitionHandler) Delete(ctx context.Context, w http.ResponseW
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("wiki_word_definition_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_definition_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid definition ID format")
@@ -213,7 +213,7 @@ func (h *WikiWordDefinitionHandler) Delete(ctx context.Context, w http.ResponseW
 	}
 
 	if err := h.service.Delete(ctx, id); err != nil {
-		h.logger.Error("wiki_word_definition_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_definition_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete definition")
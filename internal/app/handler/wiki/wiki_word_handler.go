@@ -47,7 +47,7 @@ func NewWikiWordHandler(
 func (h *WikiWordHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req dto.CreateWikiWordRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("wiki_word_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -63,7 +63,7 @@ func (h *WikiWordHandler) Create(ctx context.Context, w http.ResponseWriter, r *
 	}
 
 	if err := h.service.Create(ctx, word); err != nil {
-		h.logger.Error("wiki_word_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create word")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create word")
@@ -82,7 +82,7 @@ func (h *WikiWordHandler) Create(ctx context.Context, w http.ResponseWriter, r *
 func (h *WikiWordHandler) GetByID(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("wiki_word_handler.get.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("wiki_word_handler.get.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -90,7 +90,7 @@ func (h *WikiWordHandler) GetByID(ctx context.Context, w http.ResponseWriter, r
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("wiki_word_handler.get.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_handler.get.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid word ID format")
@@ -100,7 +100,7 @@ func (h *WikiWordHandler) GetByID(ctx context.Context, w http.ResponseWriter, r
 
 	word, err := h.service.GetByID(ctx, id)
 	if err != nil {
-		h.logger.Error("wiki_word_handler.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_handler.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get word")
@@ -120,7 +120,7 @@ func (h *WikiWordHandler) GetByID(ctx context.Context, w http.ResponseWriter, r
 func (h *WikiWordHandler) Update(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("wiki_word_handler.update.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("wiki_word_handler.update.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -128,7 +128,7 @@ func (h *WikiWordHandler) Update(ctx context.Context, w http.ResponseWriter, r *
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("wiki_word_handler.update.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_handler.update.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid word ID format")
@@ -138,7 +138,7 @@ func (h *WikiWordHandler) Update(ctx context.Context, w http.ResponseWriter, r *
 
 	var req dto.UpdateWikiWordRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("wiki_word_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -146,7 +146,7 @@ func (h *WikiWordHandler) Update(ctx context.Context, w http.ResponseWriter, r *
 	}
 
 	if err := h.service.Update(ctx, id, req); err != nil {
-		h.logger.Error("wiki_word_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to update word")
@@ -160,7 +160,7 @@ func (h *WikiWordHandler) Update(ctx context.Context, w http.ResponseWriter, r *
 func (h *WikiWordHandler) Delete(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("wiki_word_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("wiki_word_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -168,7 +168,7 @@ func (h *WikiWordHandler) Delete(ctx context.Context, w http.ResponseWriter, r *
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("wiki_word_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid word ID format")
@@ -177,7 +177,7 @@ func (h *WikiWordHandler) Delete(ctx context.Context, w http.ResponseWriter, r *
 	}
 
 	if err := h.service.Delete(ctx, id); err != nil {
-		h.logger.Error("wiki_word_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete word")
@@ -191,14 +191,14 @@ func (h *WikiWordHandler) Delete(ctx context.Context, w http.ResponseWriter, r *
 func (h *WikiWordHandler) Search(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("wiki_word_handler.search.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("wiki_word_handler.search.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
 
 	var params dto.WikiSearchParams
 	if err := ginCtx.ShouldBindQuery(&params); err != nil {
-		h.logger.Error("wiki_word_handler.search.bind", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_handler.search.bind", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to bind query parameters")
 		response.WriteError(w, http.StatusBadRequest, "Invalid query parameters")
@@ -215,7 +215,7 @@ func (h *WikiWordHandler) Search(ctx context.Context, w http.ResponseWriter, r *
 
 	words, total, err := h.service.Search(ctx, params)
 	if err != nil {
-		h.logger.Error("wiki_word_handler.search", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("wiki_word_handler.search", map[string]interface{}{
 			"error":  err.Error(),
 			"params": params,
 		}, "Failed to search words")
@@ -35,7 +35,7 @@ func NewWritingEssayHandler(
 func (h *WritingEssayHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req writingDTO.CreateWritingEssayRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("writing_essay_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_essay_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -56,7 +56,7 @@ func (h *WritingEssayHandler) Create(ctx context.Context, w http.ResponseWriter,
 	}
 
 	if err := h.service.Create(ctx, essay); err != nil {
-		h.logger.Error("writing_essay_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_essay_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create essay")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create essay")
@@ -79,7 +79,7 @@ func (h *WritingEssayHandler) Create(ctx context.Context, w http.ResponseWriter,
 func (h *WritingEssayHandler) Update(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req writingDTO.UpdateWritingEssayRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("writing_essay_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_essay_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -89,7 +89,7 @@ func (h *WritingEssayHandler) Update(ctx context.Context, w http.ResponseWriter,
 	// Get existing record first
 	existingEssay, err := h.service.GetByID(ctx, req.WritingEssayID)
 	if err != nil {
-		h.logger.Error("writing_essay_handler.update.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_essay_handler.update.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.WritingEssayID,
 		}, "Failed to get existing essay")
@@ -119,7 +119,7 @@ func (h *WritingEssayHandler) Update(ctx context.Context, w http.ResponseWriter,
 	existingEssay.UpdatedAt = time.Now()
 
 	if err := h.service.Update(ctx, existingEssay); err != nil {
-		h.logger.Error("writing_essay_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_essay_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.WritingEssayID,
 		}, "Failed to update essay")
@@ -133,7 +133,7 @@ func (h *WritingEssayHandler) Update(ctx context.Context, w http.ResponseWriter,
 func (h *WritingEssayHandler) Delete(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("writing_essay_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("writing_essay_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -141,7 +141,7 @@ func (h *WritingEssayHandler) Delete(ctx context.Context, w http.ResponseWriter,
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("writing_essay_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_essay_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid essay ID format")
@@ -150,7 +150,7 @@ func (h *WritingEssayHandler) Delete(ctx context.Context, w http.ResponseWriter,
 	}
 
 	if err := h.service.Delete(ctx, id); err != nil {
-		h.logger.Error("writing_essay_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_essay_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete essay")
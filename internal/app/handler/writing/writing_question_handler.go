@@ -41,7 +41,7 @@ func NewWritingQuestionHandler(
 func (h *WritingQuestionHandler) CreateWritingQuestion(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req writingDTO.CreateWritingQuestionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("writing_question_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_question_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -57,22 +57,30 @@ func (h *WritingQuestionHandler) CreateWritingQuestion(ctx context.Context, w ht
 		MaxTime:     req.MaxTime,
 	}
 
-	if err := h.service.CreateQuestion(ctx, question); err != nil {
-		h.logger.Error("writing_question_handler.create", map[string]interface{}{
+	nearDuplicates, err := h.service.CreateQuestion(ctx, question)
+	if err != nil {
+		h.logger.WithContext(ctx).Error("writing_question_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create writing question")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create writing question")
 		return
 	}
 
-	responseData := writingDTO.WritingQuestionResponse{
-		ID:          question.ID,
-		Type:        question.Type,
-		Topic:       question.Topic,
-		Instruction: question.Instruction,
-		ImageURLs:   question.ImageURLs,
-		MaxTime:     question.MaxTime,
-		Version:     question.Version,
+	// Near-duplicates are a warning for the author, the question is created either way
+	responseData := struct {
+		writingDTO.WritingQuestionResponse
+		NearDuplicates []writingDTO.SimilarQuestion `json:"near_duplicates,omitempty"`
+	}{
+		WritingQuestionResponse: writingDTO.WritingQuestionResponse{
+			ID:          question.ID,
+			Type:        question.Type,
+			Topic:       question.Topic,
+			Instruction: question.Instruction,
+			ImageURLs:   question.ImageURLs,
+			MaxTime:     question.MaxTime,
+			Version:     question.Version,
+		},
+		NearDuplicates: nearDuplicates,
 	}
 
 	response.WriteJSON(w, http.StatusCreated, responseData)
@@ -81,7 +89,7 @@ func (h *WritingQuestionHandler) CreateWritingQuestion(ctx context.Context, w ht
 func (h *WritingQuestionHandler) GetWritingQuestionDetail(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("writing_question_handler.get.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("writing_question_handler.get.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -89,7 +97,7 @@ func (h *WritingQuestionHandler) GetWritingQuestionDetail(ctx context.Context, w
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("writing_question_handler.get.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_question_handler.get.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid question ID format")
@@ -99,7 +107,7 @@ func (h *WritingQuestionHandler) GetWritingQuestionDetail(ctx context.Context, w
 
 	questionDetail, err := h.service.GetWritingQuestionDetail(ctx, id)
 	if err != nil {
-		h.logger.Error("writing_question_handler.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_question_handler.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get writing question")
@@ -113,7 +121,7 @@ func (h *WritingQuestionHandler) GetWritingQuestionDetail(ctx context.Context, w
 func (h *WritingQuestionHandler) UpdateWritingQuestion(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("writing_question_handler.update.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("writing_question_handler.update.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -121,7 +129,7 @@ func (h *WritingQuestionHandler) UpdateWritingQuestion(ctx context.Context, w ht
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("writing_question_handler.update.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_question_handler.update.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid question ID format")
@@ -131,7 +139,7 @@ func (h *WritingQuestionHandler) UpdateWritingQuestion(ctx context.Context, w ht
 
 	var req writingDTO.UpdateWritingQuestionFieldRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("writing_question_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_question_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -139,7 +147,7 @@ func (h *WritingQuestionHandler) UpdateWritingQuestion(ctx context.Context, w ht
 	}
 
 	if err := h.service.UpdateQuestion(ctx, id, req); err != nil {
-		h.logger.Error("writing_question_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_question_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to update writing question")
@@ -153,7 +161,7 @@ func (h *WritingQuestionHandler) UpdateWritingQuestion(ctx context.Context, w ht
 func (h *WritingQuestionHandler) DeleteWritingQuestion(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("writing_question_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("writing_question_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -161,7 +169,7 @@ func (h *WritingQuestionHandler) DeleteWritingQuestion(ctx context.Context, w ht
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("writing_question_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_question_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid question ID format")
@@ -170,7 +178,7 @@ func (h *WritingQuestionHandler) DeleteWritingQuestion(ctx context.Context, w ht
 	}
 
 	if err := h.service.DeleteQuestion(ctx, id); err != nil {
-		h.logger.Error("writing_question_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_question_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete writing question")
@@ -184,7 +192,7 @@ func (h *WritingQuestionHandler) DeleteWritingQuestion(ctx context.Context, w ht
 func (h *WritingQuestionHandler) GetListNewWritingQuestionByListVersionAndID(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req writingDTO.GetNewUpdatesWritingQuestionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("writing_question_handler.get_new_updates.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_question_handler.get_new_updates.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -202,7 +210,7 @@ func (h *WritingQuestionHandler) GetListNewWritingQuestionByListVersionAndID(ctx
 
 	questions, err := h.service.GetNewUpdatedQuestions(ctx, versionChecks)
 	if err != nil {
-		h.logger.Error("writing_question_handler.get_new_writing_questions", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_question_handler.get_new_writing_questions", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get updated questions")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to get updated questions")
@@ -237,7 +245,7 @@ type GetListWritingByListIDRequest struct {
 func (h *WritingQuestionHandler) GetListWritingByListID(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req GetListWritingByListIDRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("writing_question_handler.get_list_by_ids.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_question_handler.get_list_by_ids.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -248,7 +256,7 @@ func (h *WritingQuestionHandler) GetListWritingByListID(ctx context.Context, w h
 	for _, idStr := range req.QuestionIDs {
 		id, err := uuid.Parse(idStr)
 		if err != nil {
-			h.logger.Error("writing_question_handler.get_list_by_ids.parse_id", map[string]interface{}{
+			h.logger.WithContext(ctx).Error("writing_question_handler.get_list_by_ids.parse_id", map[string]interface{}{
 				"error": err.Error(),
 				"id":    idStr,
 			}, "Invalid question ID format")
@@ -260,7 +268,7 @@ func (h *WritingQuestionHandler) GetListWritingByListID(ctx context.Context, w h
 
 	questions, err := h.service.GetWritingByListID(ctx, questionIDs)
 	if err != nil {
-		h.logger.Error("writing_question_handler.get_list_by_ids", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_question_handler.get_list_by_ids", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get questions")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to get questions")
@@ -276,14 +284,14 @@ func (h *WritingQuestionHandler) GetListWritingByListID(ctx context.Context, w h
 func (h *WritingQuestionHandler) GetListWritingQuestiondetailPaganationWithFilter(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("writing_question_handler.search.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("writing_question_handler.search.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
 
 	var filter writingDTO.WritingQuestionSearchFilter
 	if err := ginCtx.ShouldBindQuery(&filter); err != nil {
-		h.logger.Error("writing_question_handler.search.bind", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_question_handler.search.bind", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to bind query parameters")
 		response.WriteError(w, http.StatusBadRequest, "Invalid query parameters")
@@ -297,20 +305,23 @@ func (h *WritingQuestionHandler) GetListWritingQuestiondetailPaganationWithFilte
 		filter.PageSize = 10
 	}
 
-	h.logger.Debug("writing_question_handler.search.params", map[string]interface{}{
+	h.logger.WithContext(ctx).Debug("writing_question_handler.search.params", map[string]interface{}{
 		"type":        filter.Type,
 		"topic":       filter.Topic,
 		"instruction": filter.Instruction,
 		"image_urls":  filter.ImageURLs,
 		"max_time":    filter.MaxTime,
 		"metadata":    filter.Metadata,
+		"child_text":  filter.ChildText,
+		"has_child":   filter.HasChild,
+		"min_options": filter.MinOptions,
 		"page":        filter.Page,
 		"page_size":   filter.PageSize,
 	}, "Search parameters")
 
 	questions, err := h.service.SearchQuestionsWithFilter(ctx, filter)
 	if err != nil {
-		h.logger.Error("writing_question_handler.search", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_question_handler.search", map[string]interface{}{
 			"error":  err.Error(),
 			"filter": filter,
 		}, "Failed to search questions")
@@ -318,7 +329,7 @@ func (h *WritingQuestionHandler) GetListWritingQuestiondetailPaganationWithFilte
 		return
 	}
 
-	h.logger.Debug("writing_question_handler.search.results", map[string]interface{}{
+	h.logger.WithContext(ctx).Debug("writing_question_handler.search.results", map[string]interface{}{
 		"total_results": questions.Total,
 		"page":          questions.Page,
 		"page_size":     questions.PageSize,
@@ -338,7 +349,7 @@ func (h *WritingQuestionHandler) GetListWritingQuestiondetailPaganationWithFilte
 
 func (h *WritingQuestionHandler) DeleteAllWritingData(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	if err := h.service.DeleteAllQuestions(ctx); err != nil {
-		h.logger.Error("writing_question_handler.delete_all", map[string]interface{}{"error": err.Error()}, "Failed to delete all writing data")
+		h.logger.WithContext(ctx).Error("writing_question_handler.delete_all", map[string]interface{}{"error": err.Error()}, "Failed to delete all writing data")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to delete all writing data")
 		return
 	}
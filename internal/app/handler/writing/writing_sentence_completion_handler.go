@@ -35,7 +35,7 @@ func NewWritingSentenceCompletionHandler(
 func (h *WritingSentenceCompletionHandler) Create(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req writingDTO.CreateWritingSentenceCompletionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("writing_sentence_completion_handler.create.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_sentence_completion_handler.create.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -57,7 +57,7 @@ func (h *WritingSentenceCompletionHandler) Create(ctx context.Context, w http.Re
 	}
 
 	if err := h.service.Create(ctx, sentence); err != nil {
-		h.logger.Error("writing_sentence_completion_handler.create", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_sentence_completion_handler.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create sentence completion")
 		response.WriteError(w, http.StatusInternalServerError, "Failed to create sentence completion")
@@ -81,7 +81,7 @@ func (h *WritingSentenceCompletionHandler) Create(ctx context.Context, w http.Re
 func (h *WritingSentenceCompletionHandler) Update(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var req writingDTO.UpdateWritingSentenceCompletionRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		h.logger.Error("writing_sentence_completion_handler.update.decode", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_sentence_completion_handler.update.decode", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to decode request body")
 		response.WriteError(w, http.StatusBadRequest, "Invalid request body")
@@ -91,7 +91,7 @@ func (h *WritingSentenceCompletionHandler) Update(ctx context.Context, w http.Re
 	// Get existing record first
 	existingSentence, err := h.service.GetByID(ctx, req.WritingSentenceCompletionID)
 	if err != nil {
-		h.logger.Error("writing_sentence_completion_handler.update.get", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_sentence_completion_handler.update.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.WritingSentenceCompletionID,
 		}, "Failed to get existing sentence completion")
@@ -123,7 +123,7 @@ func (h *WritingSentenceCompletionHandler) Update(ctx context.Context, w http.Re
 	existingSentence.UpdatedAt = time.Now()
 
 	if err := h.service.Update(ctx, existingSentence); err != nil {
-		h.logger.Error("writing_sentence_completion_handler.update", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_sentence_completion_handler.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    req.WritingSentenceCompletionID,
 		}, "Failed to update sentence completion")
@@ -137,7 +137,7 @@ func (h *WritingSentenceCompletionHandler) Update(ctx context.Context, w http.Re
 func (h *WritingSentenceCompletionHandler) Delete(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	ginCtx, ok := ctx.Value(constants.GinContextKey).(*gin.Context)
 	if !ok {
-		h.logger.Error("writing_sentence_completion_handler.delete.context", nil, "Failed to get gin context")
+		h.logger.WithContext(ctx).Error("writing_sentence_completion_handler.delete.context", nil, "Failed to get gin context")
 		response.WriteError(w, http.StatusInternalServerError, "Internal server error")
 		return
 	}
@@ -145,7 +145,7 @@ func (h *WritingSentenceCompletionHandler) Delete(ctx context.Context, w http.Re
 	idStr := ginCtx.Param("id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		h.logger.Error("writing_sentence_completion_handler.delete.parse_id", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_sentence_completion_handler.delete.parse_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    idStr,
 		}, "Invalid sentence completion ID format")
@@ -154,7 +154,7 @@ func (h *WritingSentenceCompletionHandler) Delete(ctx context.Context, w http.Re
 	}
 
 	if err := h.service.Delete(ctx, id); err != nil {
-		h.logger.Error("writing_sentence_completion_handler.delete", map[string]interface{}{
+		h.logger.WithContext(ctx).Error("writing_sentence_completion_handler.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete sentence completion")
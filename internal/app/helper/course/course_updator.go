@@ -53,14 +53,14 @@ func (u *CourseUpdator) UpdateCacheAndSearch(ctx context.Context, course *course
 	}
 
 	if err := u.redis.UpdateCachedCourse(ctx, courseDetail); err != nil {
-		u.logger.Error("course_updator.update_cache", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("course_updator.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    course.ID,
 		}, "Failed to update cache")
 	}
 
 	if err := u.search.UpsertCourse(ctx, courseDetail); err != nil {
-		u.logger.Error("course_updator.update_search", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("course_updator.update_search", map[string]interface{}{
 			"error": err.Error(),
 			"id":    course.ID,
 		}, "Failed to update course in OpenSearch")
@@ -89,7 +89,7 @@ func (u *CourseUpdator) buildCourseDetail(ctx context.Context, course *course.Co
 	case "OTHER":
 		err = u.loadCourseOtherData(ctx, course.ID, response)
 	default:
-		u.logger.Error("buildCourseDetail.unknown_type", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("buildCourseDetail.unknown_type", map[string]interface{}{
 			"type": course.Type,
 		}, "Unknown course type")
 		return nil, fmt.Errorf("unknown course type: %s", course.Type)
@@ -61,7 +61,7 @@ func (u *GrammarQuestionUpdator) UpdateCacheAndSearch(ctx context.Context, quest
 	isComplete := u.completion.IsQuestionComplete(questionDetail)
 
 	if err := u.redis.UpdateCachedGrammarQuestion(ctx, questionDetail, isComplete); err != nil {
-		u.logger.Error("grammar_question_updator.update_cache", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("grammar_question_updator.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to update cache")
@@ -69,7 +69,7 @@ func (u *GrammarQuestionUpdator) UpdateCacheAndSearch(ctx context.Context, quest
 
 	status := map[bool]string{true: "complete", false: "uncomplete"}[isComplete]
 	if err := u.search.UpsertGrammarQuestion(ctx, questionDetail, status); err != nil {
-		u.logger.Error("grammar_question_updator.update_search", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("grammar_question_updator.update_search", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to update question in OpenSearch")
@@ -78,6 +78,52 @@ func (u *GrammarQuestionUpdator) UpdateCacheAndSearch(ctx context.Context, quest
 	return nil
 }
 
+// SyncQuestion does the same work as UpdateCacheAndSearch but reports cache and search
+// failures instead of logging them, so the outbox relay can retry
+func (u *GrammarQuestionUpdator) SyncQuestion(ctx context.Context, question *grammar.GrammarQuestion) error {
+	questionDetail, err := u.buildQuestionDetail(ctx, question)
+	if err != nil {
+		return fmt.Errorf("failed to build question detail: %w", err)
+	}
+
+	isComplete := u.completion.IsQuestionComplete(questionDetail)
+
+	if err := u.redis.UpdateCachedGrammarQuestion(ctx, questionDetail, isComplete); err != nil {
+		return fmt.Errorf("failed to update cache: %w", err)
+	}
+
+	status := map[bool]string{true: "complete", false: "uncomplete"}[isComplete]
+	if err := u.search.UpsertGrammarQuestion(ctx, questionDetail, status); err != nil {
+		return fmt.Errorf("failed to update search: %w", err)
+	}
+
+	return nil
+}
+
+// BuildSearchDocument returns the search document of a question, used to bulk load an index
+func (u *GrammarQuestionUpdator) BuildSearchDocument(ctx context.Context, question *grammar.GrammarQuestion) (map[string]interface{}, error) {
+	questionDetail, err := u.buildQuestionDetail(ctx, question)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build question detail: %w", err)
+	}
+
+	status := map[bool]string{true: "complete", false: "uncomplete"}[u.completion.IsQuestionComplete(questionDetail)]
+	return searchClient.GrammarQuestionDocument(questionDetail, status), nil
+}
+
+// RemoveQuestion drops the cache entries and search document of a deleted question
+func (u *GrammarQuestionUpdator) RemoveQuestion(ctx context.Context, id uuid.UUID) error {
+	if err := u.redis.RemoveGrammarQuestionCacheEntries(ctx, id); err != nil {
+		return fmt.Errorf("failed to remove cache entries: %w", err)
+	}
+
+	if err := u.search.DeleteGrammarQuestionFromIndex(ctx, id); err != nil {
+		return fmt.Errorf("failed to remove from search: %w", err)
+	}
+
+	return nil
+}
+
 func (u *GrammarQuestionUpdator) buildQuestionDetail(ctx context.Context, question *grammar.GrammarQuestion) (*grammarDTO.GrammarQuestionDetail, error) {
 	response := &grammarDTO.GrammarQuestionDetail{
 		GrammarQuestionResponse: grammarDTO.GrammarQuestionResponse{
@@ -102,7 +148,7 @@ func (u *GrammarQuestionUpdator) buildQuestionDetail(ctx context.Context, questi
 	case grammar.SentenceTransformation:
 		err = u.loadSentenceTransformationData(ctx, question.ID, response)
 	default:
-		u.logger.Error("buildQuestionDetail.unknown_type", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("buildQuestionDetail.unknown_type", map[string]interface{}{
 			"type": question.Type,
 		}, "Unknown question type")
 		return nil, fmt.Errorf("unknown question type: %s", question.Type)
@@ -70,7 +70,7 @@ func (u *ListeningQuestionUpdator) UpdateCacheAndSearch(ctx context.Context, que
 
 	// Update Redis cache with the correct key structure
 	if err := u.redis.UpdateCachedListeningQuestion(ctx, questionDetail, isComplete); err != nil {
-		u.logger.Error("listening_question_updator.update_cache", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("listening_question_updator.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to update cache")
@@ -79,7 +79,7 @@ func (u *ListeningQuestionUpdator) UpdateCacheAndSearch(ctx context.Context, que
 	// Update OpenSearch
 	status := map[bool]string{true: "complete", false: "uncomplete"}[isComplete]
 	if err := u.search.UpsertListeningQuestion(ctx, questionDetail, status); err != nil {
-		u.logger.Error("listening_question_updator.update_search", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("listening_question_updator.update_search", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to update question in OpenSearch")
@@ -88,6 +88,52 @@ func (u *ListeningQuestionUpdator) UpdateCacheAndSearch(ctx context.Context, que
 	return nil
 }
 
+// SyncQuestion does the same work as UpdateCacheAndSearch but reports cache and search
+// failures instead of logging them, so the outbox relay can retry
+func (u *ListeningQuestionUpdator) SyncQuestion(ctx context.Context, question *listening.ListeningQuestion) error {
+	questionDetail, err := u.buildQuestionDetail(ctx, question)
+	if err != nil {
+		return fmt.Errorf("failed to build question detail: %w", err)
+	}
+
+	isComplete := u.completion.IsQuestionComplete(questionDetail)
+
+	if err := u.redis.UpdateCachedListeningQuestion(ctx, questionDetail, isComplete); err != nil {
+		return fmt.Errorf("failed to update cache: %w", err)
+	}
+
+	status := map[bool]string{true: "complete", false: "uncomplete"}[isComplete]
+	if err := u.search.UpsertListeningQuestion(ctx, questionDetail, status); err != nil {
+		return fmt.Errorf("failed to update search: %w", err)
+	}
+
+	return nil
+}
+
+// BuildSearchDocument returns the search document of a question, used to bulk load an index
+func (u *ListeningQuestionUpdator) BuildSearchDocument(ctx context.Context, question *listening.ListeningQuestion) (map[string]interface{}, error) {
+	questionDetail, err := u.buildQuestionDetail(ctx, question)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build question detail: %w", err)
+	}
+
+	status := map[bool]string{true: "complete", false: "uncomplete"}[u.completion.IsQuestionComplete(questionDetail)]
+	return searchClient.ListeningQuestionDocument(questionDetail, status), nil
+}
+
+// RemoveQuestion drops the cache entries and search document of a deleted question
+func (u *ListeningQuestionUpdator) RemoveQuestion(ctx context.Context, id uuid.UUID) error {
+	if err := u.redis.RemoveListeningQuestionCacheEntries(ctx, id); err != nil {
+		return fmt.Errorf("failed to remove cache entries: %w", err)
+	}
+
+	if err := u.search.DeleteListeningQuestionFromIndex(ctx, id); err != nil {
+		return fmt.Errorf("failed to remove from search: %w", err)
+	}
+
+	return nil
+}
+
 func (u *ListeningQuestionUpdator) buildQuestionDetail(ctx context.Context, question *listening.ListeningQuestion) (*listeningDTO.ListeningQuestionDetail, error) {
 	response := &listeningDTO.ListeningQuestionDetail{
 
@@ -117,7 +163,7 @@ func (u *ListeningQuestionUpdator) buildQuestionDetail(ctx context.Context, ques
 	case "MATCHING":
 		err = u.loadMatchingData(ctx, question.ID, response)
 	default:
-		u.logger.Error("buildQuestionDetail.unknown_type", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("buildQuestionDetail.unknown_type", map[string]interface{}{
 			"type": question.Type,
 		}, "Unknown question type")
 		return nil, fmt.Errorf("unknown question type: %s", question.Type)
@@ -131,14 +177,14 @@ func (u *ListeningQuestionUpdator) buildQuestionDetail(ctx context.Context, ques
 }
 
 func (u *ListeningQuestionUpdator) loadFillInTheBlankData(ctx context.Context, questionID uuid.UUID, response *listeningDTO.ListeningQuestionDetail) error {
-	u.logger.Debug("loadFillInTheBlankData.start", map[string]interface{}{
+	u.logger.WithContext(ctx).Debug("loadFillInTheBlankData.start", map[string]interface{}{
 		"questionID": questionID,
 		"type":       response.Type,
 	}, "Starting to load fill in blank data")
 
 	questions, err := u.fillInBlankQuestionService.GetQuestionsByListeningQuestionID(ctx, questionID)
 	if err != nil {
-		u.logger.Error("loadFillInTheBlankData.get_questions", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("loadFillInTheBlankData.get_questions", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get fill in blank questions")
@@ -157,7 +203,7 @@ func (u *ListeningQuestionUpdator) loadFillInTheBlankData(ctx context.Context, q
 
 	answers, err := u.fillInBlankAnswerService.GetAnswersByListeningFillInTheBlankQuestionID(ctx, question.ID)
 	if err != nil {
-		u.logger.Error("loadFillInTheBlankData.get_answers", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("loadFillInTheBlankData.get_answers", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": question.ID,
 		}, "Failed to get fill in blank answers")
@@ -177,14 +223,14 @@ func (u *ListeningQuestionUpdator) loadFillInTheBlankData(ctx context.Context, q
 }
 
 func (u *ListeningQuestionUpdator) loadChoiceOneData(ctx context.Context, questionID uuid.UUID, response *listeningDTO.ListeningQuestionDetail) error {
-	u.logger.Debug("loadChoiceOneData.start", map[string]interface{}{
+	u.logger.WithContext(ctx).Debug("loadChoiceOneData.start", map[string]interface{}{
 		"questionID": questionID,
 		"type":       response.Type,
 	}, "Starting to load choice one data")
 
 	questions, err := u.choiceOneQuestionService.GetQuestionsByListeningQuestionID(ctx, questionID)
 	if err != nil {
-		u.logger.Error("loadChoiceOneData.get_questions", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("loadChoiceOneData.get_questions", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get choice one questions")
@@ -204,7 +250,7 @@ func (u *ListeningQuestionUpdator) loadChoiceOneData(ctx context.Context, questi
 
 	options, err := u.choiceOneOptionService.GetOptionsByQuestionID(ctx, question.ID)
 	if err != nil {
-		u.logger.Error("loadChoiceOneData.get_options", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("loadChoiceOneData.get_options", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": question.ID,
 		}, "Failed to get choice one options")
@@ -224,14 +270,14 @@ func (u *ListeningQuestionUpdator) loadChoiceOneData(ctx context.Context, questi
 }
 
 func (u *ListeningQuestionUpdator) loadChoiceMultiData(ctx context.Context, questionID uuid.UUID, response *listeningDTO.ListeningQuestionDetail) error {
-	u.logger.Debug("loadChoiceMultiData.start", map[string]interface{}{
+	u.logger.WithContext(ctx).Debug("loadChoiceMultiData.start", map[string]interface{}{
 		"questionID": questionID,
 		"type":       response.Type,
 	}, "Starting to load choice multi data")
 
 	questions, err := u.choiceMultiQuestionService.GetQuestionsByListeningQuestionID(ctx, questionID)
 	if err != nil {
-		u.logger.Error("loadChoiceMultiData.get_questions", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("loadChoiceMultiData.get_questions", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get choice multi questions")
@@ -251,7 +297,7 @@ func (u *ListeningQuestionUpdator) loadChoiceMultiData(ctx context.Context, ques
 
 	options, err := u.choiceMultiOptionService.GetOptionsByQuestionID(ctx, question.ID)
 	if err != nil {
-		u.logger.Error("loadChoiceMultiData.get_options", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("loadChoiceMultiData.get_options", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": question.ID,
 		}, "Failed to get choice multi options")
@@ -271,7 +317,7 @@ func (u *ListeningQuestionUpdator) loadChoiceMultiData(ctx context.Context, ques
 }
 
 func (u *ListeningQuestionUpdator) loadMapLabellingData(ctx context.Context, questionID uuid.UUID, response *listeningDTO.ListeningQuestionDetail) error {
-	u.logger.Debug("loadMapLabellingData.start", map[string]interface{}{
+	u.logger.WithContext(ctx).Debug("loadMapLabellingData.start", map[string]interface{}{
 		"questionID": questionID,
 		"type":       response.Type,
 	}, "Starting to load map labelling data")
@@ -279,7 +325,7 @@ func (u *ListeningQuestionUpdator) loadMapLabellingData(ctx context.Context, que
 	// Get map labelling QAs directly
 	qas, err := u.mapLabellingService.GetByListeningQuestionID(ctx, questionID)
 	if err != nil {
-		u.logger.Error("loadMapLabellingData.get_qas", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("loadMapLabellingData.get_qas", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get map labelling QAs")
@@ -287,7 +333,7 @@ func (u *ListeningQuestionUpdator) loadMapLabellingData(ctx context.Context, que
 	}
 
 	if len(qas) == 0 {
-		u.logger.Debug("loadMapLabellingData.not_found", map[string]interface{}{
+		u.logger.WithContext(ctx).Debug("loadMapLabellingData.not_found", map[string]interface{}{
 			"questionID": questionID,
 		}, "No map labelling QAs found")
 		return nil
@@ -302,7 +348,7 @@ func (u *ListeningQuestionUpdator) loadMapLabellingData(ctx context.Context, que
 			Answer:   qa.Answer,
 			Explain:  qa.Explain,
 		}
-		u.logger.Debug("loadMapLabellingData.qa_detail", map[string]interface{}{
+		u.logger.WithContext(ctx).Debug("loadMapLabellingData.qa_detail", map[string]interface{}{
 			"index":    i,
 			"qaID":     qa.ID,
 			"question": qa.Question,
@@ -310,7 +356,7 @@ func (u *ListeningQuestionUpdator) loadMapLabellingData(ctx context.Context, que
 		}, "Processed QA item")
 	}
 
-	u.logger.Debug("loadMapLabellingData.success", map[string]interface{}{
+	u.logger.WithContext(ctx).Debug("loadMapLabellingData.success", map[string]interface{}{
 		"questionID": questionID,
 		"qasCount":   len(qas),
 	}, "Successfully loaded map labelling data")
@@ -319,7 +365,7 @@ func (u *ListeningQuestionUpdator) loadMapLabellingData(ctx context.Context, que
 }
 
 func (u *ListeningQuestionUpdator) loadMatchingData(ctx context.Context, questionID uuid.UUID, response *listeningDTO.ListeningQuestionDetail) error {
-	u.logger.Debug("loadMatchingData.start", map[string]interface{}{
+	u.logger.WithContext(ctx).Debug("loadMatchingData.start", map[string]interface{}{
 		"questionID": questionID,
 		"type":       response.Type,
 	}, "Starting to load matching data")
@@ -327,7 +373,7 @@ func (u *ListeningQuestionUpdator) loadMatchingData(ctx context.Context, questio
 	// Get matching QAs directly
 	qas, err := u.matchingService.GetByListeningQuestionID(ctx, questionID)
 	if err != nil {
-		u.logger.Error("loadMatchingData.get_qas", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("loadMatchingData.get_qas", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get matching QAs")
@@ -335,7 +381,7 @@ func (u *ListeningQuestionUpdator) loadMatchingData(ctx context.Context, questio
 	}
 
 	if len(qas) == 0 {
-		u.logger.Debug("loadMatchingData.not_found", map[string]interface{}{
+		u.logger.WithContext(ctx).Debug("loadMatchingData.not_found", map[string]interface{}{
 			"questionID": questionID,
 		}, "No matching QAs found")
 		return nil
@@ -350,7 +396,7 @@ func (u *ListeningQuestionUpdator) loadMatchingData(ctx context.Context, questio
 			Answer:   qa.Answer,
 			Explain:  qa.Explain,
 		}
-		u.logger.Debug("loadMatchingData.qa_detail", map[string]interface{}{
+		u.logger.WithContext(ctx).Debug("loadMatchingData.qa_detail", map[string]interface{}{
 			"index":    i,
 			"qaID":     qa.ID,
 			"question": qa.Question,
@@ -358,7 +404,7 @@ func (u *ListeningQuestionUpdator) loadMatchingData(ctx context.Context, questio
 		}, "Processed QA item")
 	}
 
-	u.logger.Debug("loadMatchingData.success", map[string]interface{}{
+	u.logger.WithContext(ctx).Debug("loadMatchingData.success", map[string]interface{}{
 		"questionID": questionID,
 		"qasCount":   len(qas),
 	}, "Successfully loaded matching data")
@@ -70,7 +70,7 @@ func (u *ReadingQuestionUpdator) UpdateCacheAndSearch(ctx context.Context, quest
 
 	// Update Redis cache with the correct key structure
 	if err := u.redis.UpdateCachedReadingQuestion(ctx, questionDetail, isComplete); err != nil {
-		u.logger.Error("reading_question_updator.update_cache", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("reading_question_updator.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to update cache")
@@ -79,7 +79,7 @@ func (u *ReadingQuestionUpdator) UpdateCacheAndSearch(ctx context.Context, quest
 	// Update OpenSearch
 	status := map[bool]string{true: "complete", false: "uncomplete"}[isComplete]
 	if err := u.search.IndexReadingQuestionDetail(ctx, questionDetail, status); err != nil {
-		u.logger.Error("reading_question_updator.update_search", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("reading_question_updator.update_search", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to update question in OpenSearch")
@@ -88,6 +88,52 @@ func (u *ReadingQuestionUpdator) UpdateCacheAndSearch(ctx context.Context, quest
 	return nil
 }
 
+// SyncQuestion does the same work as UpdateCacheAndSearch but reports cache and search
+// failures instead of logging them, so the outbox relay can retry
+func (u *ReadingQuestionUpdator) SyncQuestion(ctx context.Context, question *reading.ReadingQuestion) error {
+	questionDetail, err := u.buildQuestionDetail(ctx, question)
+	if err != nil {
+		return fmt.Errorf("failed to build question detail: %w", err)
+	}
+
+	isComplete := u.completion.IsQuestionComplete(questionDetail)
+
+	if err := u.redis.UpdateCachedReadingQuestion(ctx, questionDetail, isComplete); err != nil {
+		return fmt.Errorf("failed to update cache: %w", err)
+	}
+
+	status := map[bool]string{true: "complete", false: "uncomplete"}[isComplete]
+	if err := u.search.IndexReadingQuestionDetail(ctx, questionDetail, status); err != nil {
+		return fmt.Errorf("failed to update search: %w", err)
+	}
+
+	return nil
+}
+
+// BuildSearchDocument returns the search document of a question, used to bulk load an index
+func (u *ReadingQuestionUpdator) BuildSearchDocument(ctx context.Context, question *reading.ReadingQuestion) (map[string]interface{}, error) {
+	questionDetail, err := u.buildQuestionDetail(ctx, question)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build question detail: %w", err)
+	}
+
+	status := map[bool]string{true: "complete", false: "uncomplete"}[u.completion.IsQuestionComplete(questionDetail)]
+	return searchClient.ReadingQuestionDocument(questionDetail, status), nil
+}
+
+// RemoveQuestion drops the cache entries and search document of a deleted question
+func (u *ReadingQuestionUpdator) RemoveQuestion(ctx context.Context, id uuid.UUID) error {
+	if err := u.redis.RemoveReadingQuestionCacheEntries(ctx, id); err != nil {
+		return fmt.Errorf("failed to remove cache entries: %w", err)
+	}
+
+	if err := u.search.DeleteReadingQuestionFromIndex(ctx, id); err != nil {
+		return fmt.Errorf("failed to remove from search: %w", err)
+	}
+
+	return nil
+}
+
 func (u *ReadingQuestionUpdator) buildQuestionDetail(ctx context.Context, question *reading.ReadingQuestion) (*readingDTO.ReadingQuestionDetail, error) {
 	response := &readingDTO.ReadingQuestionDetail{
 		ReadingQuestionResponse: readingDTO.ReadingQuestionResponse{
@@ -116,7 +162,7 @@ func (u *ReadingQuestionUpdator) buildQuestionDetail(ctx context.Context, questi
 	case "MATCHING":
 		err = u.loadMatchingData(ctx, question.ID, response)
 	default:
-		u.logger.Error("buildQuestionDetail.unknown_type", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("buildQuestionDetail.unknown_type", map[string]interface{}{
 			"type": question.Type,
 		}, "Unknown question type")
 		return nil, fmt.Errorf("unknown question type: %s", question.Type)
@@ -130,14 +176,14 @@ func (u *ReadingQuestionUpdator) buildQuestionDetail(ctx context.Context, questi
 }
 
 func (u *ReadingQuestionUpdator) loadFillInTheBlankData(ctx context.Context, questionID uuid.UUID, response *readingDTO.ReadingQuestionDetail) error {
-	u.logger.Debug("loadFillInTheBlankData.start", map[string]interface{}{
+	u.logger.WithContext(ctx).Debug("loadFillInTheBlankData.start", map[string]interface{}{
 		"questionID": questionID,
 		"type":       response.Type,
 	}, "Starting to load fill in blank data")
 
 	questions, err := u.fillInBlankQuestionService.GetQuestionsByReadingQuestionID(ctx, questionID)
 	if err != nil {
-		u.logger.Error("loadFillInTheBlankData.get_questions", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("loadFillInTheBlankData.get_questions", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get fill in blank questions")
@@ -156,7 +202,7 @@ func (u *ReadingQuestionUpdator) loadFillInTheBlankData(ctx context.Context, que
 
 	answers, err := u.fillInBlankAnswerService.GetAnswersByReadingFillInTheBlankQuestionID(ctx, question.ID)
 	if err != nil {
-		u.logger.Error("loadFillInTheBlankData.get_answers", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("loadFillInTheBlankData.get_answers", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": question.ID,
 		}, "Failed to get fill in blank answers")
@@ -176,14 +222,14 @@ func (u *ReadingQuestionUpdator) loadFillInTheBlankData(ctx context.Context, que
 }
 
 func (u *ReadingQuestionUpdator) loadChoiceOneData(ctx context.Context, questionID uuid.UUID, response *readingDTO.ReadingQuestionDetail) error {
-	u.logger.Debug("loadChoiceOneData.start", map[string]interface{}{
+	u.logger.WithContext(ctx).Debug("loadChoiceOneData.start", map[string]interface{}{
 		"questionID": questionID,
 		"type":       response.Type,
 	}, "Starting to load choice one data")
 
 	questions, err := u.choiceOneQuestionService.GetQuestionsByReadingQuestionID(ctx, questionID)
 	if err != nil {
-		u.logger.Error("loadChoiceOneData.get_questions", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("loadChoiceOneData.get_questions", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get choice one questions")
@@ -200,7 +246,7 @@ func (u *ReadingQuestionUpdator) loadChoiceOneData(ctx context.Context, question
 
 		options, err := u.choiceOneOptionService.GetOptionsByQuestionID(ctx, question.ID)
 		if err != nil {
-			u.logger.Error("loadChoiceOneData.get_options", map[string]interface{}{
+			u.logger.WithContext(ctx).Error("loadChoiceOneData.get_options", map[string]interface{}{
 				"error":      err.Error(),
 				"questionID": question.ID,
 			}, "Failed to get choice one options")
@@ -221,14 +267,14 @@ func (u *ReadingQuestionUpdator) loadChoiceOneData(ctx context.Context, question
 }
 
 func (u *ReadingQuestionUpdator) loadChoiceMultiData(ctx context.Context, questionID uuid.UUID, response *readingDTO.ReadingQuestionDetail) error {
-	u.logger.Debug("loadChoiceMultiData.start", map[string]interface{}{
+	u.logger.WithContext(ctx).Debug("loadChoiceMultiData.start", map[string]interface{}{
 		"questionID": questionID,
 		"type":       response.Type,
 	}, "Starting to load choice multi data")
 
 	questions, err := u.choiceMultiQuestionService.GetQuestionsByReadingQuestionID(ctx, questionID)
 	if err != nil {
-		u.logger.Error("loadChoiceMultiData.get_questions", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("loadChoiceMultiData.get_questions", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get choice multi questions")
@@ -245,7 +291,7 @@ func (u *ReadingQuestionUpdator) loadChoiceMultiData(ctx context.Context, questi
 
 		options, err := u.choiceMultiOptionService.GetOptionsByQuestionID(ctx, question.ID)
 		if err != nil {
-			u.logger.Error("loadChoiceMultiData.get_options", map[string]interface{}{
+			u.logger.WithContext(ctx).Error("loadChoiceMultiData.get_options", map[string]interface{}{
 				"error":      err.Error(),
 				"questionID": question.ID,
 			}, "Failed to get choice multi options")
@@ -266,14 +312,14 @@ func (u *ReadingQuestionUpdator) loadChoiceMultiData(ctx context.Context, questi
 }
 
 func (u *ReadingQuestionUpdator) loadTrueFalseData(ctx context.Context, questionID uuid.UUID, response *readingDTO.ReadingQuestionDetail) error {
-	u.logger.Debug("loadTrueFalseData.start", map[string]interface{}{
+	u.logger.WithContext(ctx).Debug("loadTrueFalseData.start", map[string]interface{}{
 		"questionID": questionID,
 		"type":       response.Type,
 	}, "Starting to load true/false data")
 
 	trueFalses, err := u.trueFalseService.GetByReadingQuestionID(ctx, questionID)
 	if err != nil {
-		u.logger.Error("loadTrueFalseData.get_questions", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("loadTrueFalseData.get_questions", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get true/false questions")
@@ -294,14 +340,14 @@ func (u *ReadingQuestionUpdator) loadTrueFalseData(ctx context.Context, question
 }
 
 func (u *ReadingQuestionUpdator) loadMatchingData(ctx context.Context, questionID uuid.UUID, response *readingDTO.ReadingQuestionDetail) error {
-	u.logger.Debug("loadMatchingData.start", map[string]interface{}{
+	u.logger.WithContext(ctx).Debug("loadMatchingData.start", map[string]interface{}{
 		"questionID": questionID,
 		"type":       response.Type,
 	}, "Starting to load matching data")
 
 	matchings, err := u.matchingService.GetByReadingQuestionID(ctx, questionID)
 	if err != nil {
-		u.logger.Error("loadMatchingData.get_matchings", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("loadMatchingData.get_matchings", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get matching questions")
@@ -350,7 +396,7 @@ func (u *ReadingQuestionUpdator) BuildQuestionDetail(ctx context.Context, questi
 	case "MATCHING":
 		err = u.loadMatchingData(ctx, question.ID, response)
 	default:
-		u.logger.Error("buildQuestionDetail.unknown_type", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("buildQuestionDetail.unknown_type", map[string]interface{}{
 			"type": question.Type,
 		}, "Unknown question type")
 		return nil, fmt.Errorf("unknown question type: %s", question.Type)
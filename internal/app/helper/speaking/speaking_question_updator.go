@@ -64,7 +64,7 @@ func (u *SpeakingQuestionUpdator) UpdateCacheAndSearch(ctx context.Context, ques
 	isComplete := u.completion.IsQuestionComplete(questionDetail)
 
 	if err := u.redis.UpdateCachedSpeakingQuestion(ctx, questionDetail, isComplete); err != nil {
-		u.logger.Error("speaking_question_updator.update_cache", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("speaking_question_updator.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to update cache")
@@ -72,7 +72,7 @@ func (u *SpeakingQuestionUpdator) UpdateCacheAndSearch(ctx context.Context, ques
 
 	status := map[bool]string{true: "complete", false: "uncomplete"}[isComplete]
 	if err := u.search.UpsertSpeakingQuestion(ctx, questionDetail, status); err != nil {
-		u.logger.Error("speaking_question_updator.update_search", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("speaking_question_updator.update_search", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to update question in OpenSearch")
@@ -81,6 +81,52 @@ func (u *SpeakingQuestionUpdator) UpdateCacheAndSearch(ctx context.Context, ques
 	return nil
 }
 
+// SyncQuestion does the same work as UpdateCacheAndSearch but reports cache and search
+// failures instead of logging them, so the outbox relay can retry
+func (u *SpeakingQuestionUpdator) SyncQuestion(ctx context.Context, question *speaking.SpeakingQuestion) error {
+	questionDetail, err := u.buildQuestionDetail(ctx, question)
+	if err != nil {
+		return fmt.Errorf("failed to build question detail: %w", err)
+	}
+
+	isComplete := u.completion.IsQuestionComplete(questionDetail)
+
+	if err := u.redis.UpdateCachedSpeakingQuestion(ctx, questionDetail, isComplete); err != nil {
+		return fmt.Errorf("failed to update cache: %w", err)
+	}
+
+	status := map[bool]string{true: "complete", false: "uncomplete"}[isComplete]
+	if err := u.search.UpsertSpeakingQuestion(ctx, questionDetail, status); err != nil {
+		return fmt.Errorf("failed to update search: %w", err)
+	}
+
+	return nil
+}
+
+// BuildSearchDocument returns the search document of a question, used to bulk load an index
+func (u *SpeakingQuestionUpdator) BuildSearchDocument(ctx context.Context, question *speaking.SpeakingQuestion) (map[string]interface{}, error) {
+	questionDetail, err := u.buildQuestionDetail(ctx, question)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build question detail: %w", err)
+	}
+
+	status := map[bool]string{true: "complete", false: "uncomplete"}[u.completion.IsQuestionComplete(questionDetail)]
+	return searchClient.SpeakingQuestionDocument(questionDetail, status), nil
+}
+
+// RemoveQuestion drops the cache entries and search document of a deleted question
+func (u *SpeakingQuestionUpdator) RemoveQuestion(ctx context.Context, id uuid.UUID) error {
+	if err := u.redis.RemoveSpeakingQuestionCacheEntries(ctx, id); err != nil {
+		return fmt.Errorf("failed to remove cache entries: %w", err)
+	}
+
+	if err := u.search.DeleteSpeakingQuestionFromIndex(ctx, id); err != nil {
+		return fmt.Errorf("failed to remove from search: %w", err)
+	}
+
+	return nil
+}
+
 func (u *SpeakingQuestionUpdator) buildQuestionDetail(ctx context.Context, question *speaking.SpeakingQuestion) (*speakingDTO.SpeakingQuestionDetail, error) {
 	response := &speakingDTO.SpeakingQuestionDetail{
 		SpeakingQuestionResponse: speakingDTO.SpeakingQuestionResponse{
@@ -109,7 +155,7 @@ func (u *SpeakingQuestionUpdator) buildQuestionDetail(ctx context.Context, quest
 	case "CONVERSATIONAL_OPEN":
 		err = u.loadConversationalOpenData(ctx, question.ID, response)
 	default:
-		u.logger.Error("buildQuestionDetail.unknown_type", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("buildQuestionDetail.unknown_type", map[string]interface{}{
 			"type": question.Type,
 		}, "Unknown question type")
 		return nil, fmt.Errorf("unknown question type: %s", question.Type)
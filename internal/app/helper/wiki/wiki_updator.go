@@ -85,13 +85,13 @@ func NewWikiUpdator(
 
 // Word update methods
 func (u *WikiUpdator) UpdateWordCache(ctx context.Context, word *wikiModel.WikiWord) error {
-	wordResponse, err := u.buildWordResponse(ctx, word)
+	wordResponse, err := u.BuildWordResponse(ctx, word)
 	if err != nil {
 		return fmt.Errorf("failed to build word response: %w", err)
 	}
 
 	if err := u.redis.UpdateCachedWord(ctx, wordResponse); err != nil {
-		u.logger.Error("wiki_updator.update_word_cache", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("wiki_updator.update_word_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    word.ID,
 		}, "Failed to update word cache")
@@ -101,7 +101,8 @@ func (u *WikiUpdator) UpdateWordCache(ctx context.Context, word *wikiModel.WikiW
 	return nil
 }
 
-func (u *WikiUpdator) buildWordResponse(ctx context.Context, word *wikiModel.WikiWord) (*dto.WikiWordResponse, error) {
+// BuildWordResponse builds the cached form of a word
+func (u *WikiUpdator) BuildWordResponse(ctx context.Context, word *wikiModel.WikiWord) (*dto.WikiWordResponse, error) {
 	response := &dto.WikiWordResponse{
 		ID:            word.ID,
 		Word:          word.Word,
@@ -115,13 +116,13 @@ func (u *WikiUpdator) buildWordResponse(ctx context.Context, word *wikiModel.Wik
 
 // Phrase update methods
 func (u *WikiUpdator) UpdatePhraseCache(ctx context.Context, phrase *wikiModel.WikiPhrase) error {
-	phraseResponse, err := u.buildPhraseResponse(ctx, phrase)
+	phraseResponse, err := u.BuildPhraseResponse(ctx, phrase)
 	if err != nil {
 		return fmt.Errorf("failed to build phrase response: %w", err)
 	}
 
 	if err := u.redis.UpdateCachedPhrase(ctx, phraseResponse); err != nil {
-		u.logger.Error("wiki_updator.update_phrase_cache", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("wiki_updator.update_phrase_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    phrase.ID,
 		}, "Failed to update phrase cache")
@@ -131,7 +132,8 @@ func (u *WikiUpdator) UpdatePhraseCache(ctx context.Context, phrase *wikiModel.W
 	return nil
 }
 
-func (u *WikiUpdator) buildPhraseResponse(ctx context.Context, phrase *wikiModel.WikiPhrase) (*dto.WikiPhraseResponse, error) {
+// BuildPhraseResponse builds the cached form of a phrase
+func (u *WikiUpdator) BuildPhraseResponse(ctx context.Context, phrase *wikiModel.WikiPhrase) (*dto.WikiPhraseResponse, error) {
 	response := &dto.WikiPhraseResponse{
 		ID:              phrase.ID,
 		Phrase:          phrase.Phrase,
@@ -49,7 +49,7 @@ func (u *WritingQuestionUpdator) UpdateCacheAndSearch(ctx context.Context, quest
 	isComplete := u.completion.IsQuestionComplete(questionDetail)
 
 	if err := u.redis.UpdateCachedWritingQuestion(ctx, questionDetail, isComplete); err != nil {
-		u.logger.Error("writing_question_updator.update_cache", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("writing_question_updator.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to update cache")
@@ -57,7 +57,7 @@ func (u *WritingQuestionUpdator) UpdateCacheAndSearch(ctx context.Context, quest
 
 	status := map[bool]string{true: "complete", false: "uncomplete"}[isComplete]
 	if err := u.search.UpsertWritingQuestion(ctx, questionDetail, status); err != nil {
-		u.logger.Error("writing_question_updator.update_search", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("writing_question_updator.update_search", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to update question in OpenSearch")
@@ -66,6 +66,52 @@ func (u *WritingQuestionUpdator) UpdateCacheAndSearch(ctx context.Context, quest
 	return nil
 }
 
+// SyncQuestion does the same work as UpdateCacheAndSearch but reports cache and search
+// failures instead of logging them, so the outbox relay can retry
+func (u *WritingQuestionUpdator) SyncQuestion(ctx context.Context, question *writing.WritingQuestion) error {
+	questionDetail, err := u.buildQuestionDetail(ctx, question)
+	if err != nil {
+		return fmt.Errorf("failed to build question detail: %w", err)
+	}
+
+	isComplete := u.completion.IsQuestionComplete(questionDetail)
+
+	if err := u.redis.UpdateCachedWritingQuestion(ctx, questionDetail, isComplete); err != nil {
+		return fmt.Errorf("failed to update cache: %w", err)
+	}
+
+	status := map[bool]string{true: "complete", false: "uncomplete"}[isComplete]
+	if err := u.search.UpsertWritingQuestion(ctx, questionDetail, status); err != nil {
+		return fmt.Errorf("failed to update search: %w", err)
+	}
+
+	return nil
+}
+
+// BuildSearchDocument returns the search document of a question, used to bulk load an index
+func (u *WritingQuestionUpdator) BuildSearchDocument(ctx context.Context, question *writing.WritingQuestion) (map[string]interface{}, error) {
+	questionDetail, err := u.buildQuestionDetail(ctx, question)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build question detail: %w", err)
+	}
+
+	status := map[bool]string{true: "complete", false: "uncomplete"}[u.completion.IsQuestionComplete(questionDetail)]
+	return searchClient.WritingQuestionDocument(questionDetail, status), nil
+}
+
+// RemoveQuestion drops the cache entries and search document of a deleted question
+func (u *WritingQuestionUpdator) RemoveQuestion(ctx context.Context, id uuid.UUID) error {
+	if err := u.redis.RemoveWritingQuestionCacheEntries(ctx, id); err != nil {
+		return fmt.Errorf("failed to remove cache entries: %w", err)
+	}
+
+	if err := u.search.DeleteWritingQuestionFromIndex(ctx, id); err != nil {
+		return fmt.Errorf("failed to remove from search: %w", err)
+	}
+
+	return nil
+}
+
 func (u *WritingQuestionUpdator) buildQuestionDetail(ctx context.Context, question *writing.WritingQuestion) (*writingDTO.WritingQuestionDetail, error) {
 	response := &writingDTO.WritingQuestionDetail{
 		WritingQuestionResponse: writingDTO.WritingQuestionResponse{
@@ -86,7 +132,7 @@ func (u *WritingQuestionUpdator) buildQuestionDetail(ctx context.Context, questi
 	case "ESSAY":
 		err = u.loadEssayData(ctx, question.ID, response)
 	default:
-		u.logger.Error("buildQuestionDetail.unknown_type", map[string]interface{}{
+		u.logger.WithContext(ctx).Error("buildQuestionDetail.unknown_type", map[string]interface{}{
 			"type": question.Type,
 		}, "Unknown question type")
 		return nil, fmt.Errorf("unknown question type: %s", question.Type)
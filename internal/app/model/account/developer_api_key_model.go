@@ -0,0 +1,21 @@
+package account
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DeveloperAPIKey lets content pipelines call the API on behalf of a developer
+type DeveloperAPIKey struct {
+	ID          uuid.UUID  `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()" json:"id"`
+	DeveloperID uuid.UUID  `gorm:"type:uuid;not null;index" json:"developer_id"`
+	Name        string     `gorm:"type:varchar(100);not null" json:"name"`
+	KeyPrefix   string     `gorm:"type:varchar(16);not null" json:"key_prefix"`
+	KeyHash     string     `gorm:"type:text;not null;uniqueIndex" json:"-"`
+	Scopes      []string   `gorm:"type:text[];not null" json:"scopes"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt   time.Time  `gorm:"autoCreateTime" json:"created_at"`
+}
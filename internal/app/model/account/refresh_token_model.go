@@ -0,0 +1,21 @@
+package account
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RefreshToken is one link of a rotating refresh token chain, all links of a login share SessionID
+type RefreshToken struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()" json:"id"`
+	SessionID uuid.UUID  `gorm:"type:uuid;not null;index" json:"session_id"`
+	SubjectID uuid.UUID  `gorm:"type:uuid;not null;index" json:"subject_id"`
+	Role      string     `gorm:"type:varchar(20);not null" json:"role"`
+	TokenHash string     `gorm:"type:text;not null;uniqueIndex" json:"-"`
+	UserAgent string     `gorm:"type:text" json:"user_agent"`
+	IPAddress string     `gorm:"type:varchar(64)" json:"ip_address"`
+	ExpiresAt time.Time  `gorm:"not null" json:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt time.Time  `gorm:"autoCreateTime" json:"created_at"`
+}
@@ -0,0 +1,18 @@
+package account
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserActionToken is a single-use emailed token, only its hash is stored
+type UserActionToken struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()" json:"id"`
+	UserID    uuid.UUID  `gorm:"type:uuid;not null;index" json:"user_id"`
+	Purpose   string     `gorm:"type:varchar(25);not null" json:"purpose"`
+	TokenHash string     `gorm:"type:text;not null;uniqueIndex" json:"-"`
+	ExpiresAt time.Time  `gorm:"not null" json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	CreatedAt time.Time  `gorm:"autoCreateTime" json:"created_at"`
+}
@@ -0,0 +1,17 @@
+package account
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserIdentity links a user to an account at a third-party identity provider
+type UserIdentity struct {
+	ID              uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()" json:"id"`
+	UserID          uuid.UUID `gorm:"type:uuid;not null;index" json:"user_id"`
+	Provider        string    `gorm:"type:varchar(25);not null" json:"provider"`
+	ProviderSubject string    `gorm:"type:text;not null" json:"provider_subject"`
+	Email           string    `gorm:"type:text;not null" json:"email"`
+	CreatedAt       time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
@@ -8,13 +8,18 @@ import (
 )
 
 type User struct {
-	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()" json:"id"`
-	Email     string    `gorm:"uniqueIndex;not null;size:255" json:"email"`
-	Username  string    `gorm:"uniqueIndex;not null;size:255" json:"username"`
-	Password  string    `gorm:"not null;type:text" json:"-"`
-	Type      string    `gorm:"type:ENUM('basic', 'google', 'facebook', 'github');not null" json:"type"`
-	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
-	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+	ID              uuid.UUID  `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()" json:"id"`
+	Email           string     `gorm:"uniqueIndex;not null;size:255" json:"email"`
+	Username        string     `gorm:"uniqueIndex;not null;size:255" json:"username"`
+	Password        string     `gorm:"not null;type:text" json:"-"`
+	Type            string     `gorm:"type:varchar(25);not null" json:"type"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	CreatedAt       time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
 }
 
 func (u *User) Validate() error {
@@ -0,0 +1,30 @@
+package changefeed
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ChangeOperation string
+
+const (
+	OperationCreated ChangeOperation = "created"
+	OperationUpdated ChangeOperation = "updated"
+	OperationDeleted ChangeOperation = "deleted"
+)
+
+// QuestionChange is a row of the change log written by the question triggers
+type QuestionChange struct {
+	Seq        int64           `gorm:"column:seq;primaryKey" json:"seq"`
+	Skill      string          `gorm:"type:varchar(20);not null" json:"skill"`
+	QuestionID uuid.UUID       `gorm:"type:uuid;not null" json:"question_id"`
+	Operation  ChangeOperation `gorm:"type:varchar(10);not null" json:"operation"`
+	Version    int             `gorm:"not null" json:"version"`
+	TxID       uint64          `gorm:"column:txid;->" json:"-"`
+	ChangedAt  time.Time       `gorm:"not null;default:CURRENT_TIMESTAMP" json:"changed_at"`
+}
+
+func (QuestionChange) TableName() string {
+	return "question_changes"
+}
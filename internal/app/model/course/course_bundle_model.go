@@ -0,0 +1,25 @@
+package course
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CourseBundle is one immutable, versioned offline archive of a course
+type CourseBundle struct {
+	ID              uuid.UUID  `gorm:"type:uuid;primaryKey;default:uuid_generate_v4()" json:"id"`
+	CourseID        uuid.UUID  `gorm:"type:uuid;not null" json:"course_id"`
+	Version         int        `gorm:"not null" json:"version"`
+	ContentChecksum string     `gorm:"type:varchar(64);not null" json:"content_checksum"`
+	ArchiveChecksum string     `gorm:"type:varchar(64);not null" json:"archive_checksum"`
+	SizeBytes       int64      `gorm:"not null" json:"size_bytes"`
+	QuestionCount   int        `gorm:"not null;default:0" json:"question_count"`
+	MediaCount      int        `gorm:"not null;default:0" json:"media_count"`
+	Manifest        string     `gorm:"type:jsonb;not null" json:"-"`
+	Archive         []byte     `gorm:"type:bytea;not null" json:"-"`
+	CreatedBy       *uuid.UUID `gorm:"type:uuid" json:"created_by,omitempty"`
+	CreatedAt       time.Time  `gorm:"autoCreateTime" json:"created_at"`
+
+	_ struct{} `gorm:"uniqueIndex:unique_course_bundle_version,composite:course_id,version"`
+}
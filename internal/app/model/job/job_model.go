@@ -0,0 +1,40 @@
+package job
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type JobStatus string
+
+const (
+	StatusQueued    JobStatus = "queued"
+	StatusRunning   JobStatus = "running"
+	StatusSucceeded JobStatus = "succeeded"
+	StatusFailed    JobStatus = "failed"
+)
+
+// Job is a unit of background work, payload and result are JSON documents whose shape depends
+// on the type
+type Job struct {
+	ID              uuid.UUID  `gorm:"type:uuid;primaryKey" json:"id"`
+	Type            string     `gorm:"type:varchar(50);not null" json:"type"`
+	Payload         string     `gorm:"type:jsonb;not null" json:"-"`
+	Status          JobStatus  `gorm:"type:varchar(10);not null;default:queued" json:"status"`
+	Attempts        int        `gorm:"not null;default:0" json:"attempts"`
+	MaxAttempts     int        `gorm:"not null" json:"max_attempts"`
+	RunAt           time.Time  `gorm:"not null;default:CURRENT_TIMESTAMP" json:"run_at"`
+	Progress        int        `gorm:"not null;default:0" json:"progress"`
+	ProgressMessage *string    `gorm:"type:text" json:"progress_message,omitempty"`
+	Result          *string    `gorm:"type:jsonb" json:"-"`
+	LastError       *string    `gorm:"type:text" json:"last_error,omitempty"`
+	CreatedBy       *uuid.UUID `gorm:"type:uuid" json:"created_by,omitempty"`
+	CreatedAt       time.Time  `gorm:"not null;default:CURRENT_TIMESTAMP" json:"created_at"`
+	StartedAt       *time.Time `json:"started_at,omitempty"`
+	FinishedAt      *time.Time `json:"finished_at,omitempty"`
+}
+
+func (Job) TableName() string {
+	return "jobs"
+}
@@ -0,0 +1,41 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EventType string
+
+const (
+	EventUpsert EventType = "upsert"
+	EventDelete EventType = "delete"
+)
+
+type EventStatus string
+
+const (
+	StatusPending EventStatus = "pending"
+	StatusDone    EventStatus = "done"
+	StatusDead    EventStatus = "dead"
+)
+
+// OutboxEvent is a pending cache and search sync written by the question triggers
+type OutboxEvent struct {
+	ID            int64       `gorm:"primaryKey" json:"id"`
+	AggregateType string      `gorm:"type:varchar(20);not null" json:"aggregate_type"`
+	AggregateID   uuid.UUID   `gorm:"type:uuid;not null" json:"aggregate_id"`
+	EventType     EventType   `gorm:"type:varchar(10);not null" json:"event_type"`
+	Version       int         `gorm:"not null" json:"version"`
+	Status        EventStatus `gorm:"type:varchar(10);not null;default:pending" json:"status"`
+	Attempts      int         `gorm:"not null;default:0" json:"attempts"`
+	NextAttemptAt time.Time   `gorm:"not null;default:CURRENT_TIMESTAMP" json:"next_attempt_at"`
+	LastError     *string     `gorm:"type:text" json:"last_error,omitempty"`
+	CreatedAt     time.Time   `gorm:"not null;default:CURRENT_TIMESTAMP" json:"created_at"`
+	ProcessedAt   *time.Time  `json:"processed_at,omitempty"`
+}
+
+func (OutboxEvent) TableName() string {
+	return "outbox_events"
+}
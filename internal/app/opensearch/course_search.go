@@ -189,7 +189,7 @@ func (s *CourseSearch) DeleteCourseFromIndex(ctx context.Context, id uuid.UUID)
 func (s *CourseSearch) UpsertCourse(ctx context.Context, course *courseDTO.CourseDetail) error {
 	// Remove index if it exists
 	if err := s.RemoveCoursesIndex(ctx); err != nil {
-		s.logger.Warning("upsert_course.remove_index", map[string]interface{}{
+		s.logger.WithContext(ctx).Warning("upsert_course.remove_index", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to remove existing index")
 	}
@@ -14,6 +14,9 @@ import (
 	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
 )
 
+// GrammarQuestionsIndex is the alias clients read and write through, it points at a versioned index
+const GrammarQuestionsIndex = "grammar_questions"
+
 type GrammarSearchResult struct {
 	Questions []grammarDTO.GrammarQuestionDetail
 	Total     int64
@@ -38,8 +41,12 @@ func (s *GrammarQuestionSearch) GetClient() *opensearch.Client {
 }
 
 func (s *GrammarQuestionSearch) CreateGrammarQuestionsIndex(ctx context.Context) error {
+	return s.createQuestionsIndex(ctx, GrammarQuestionsIndex)
+}
+
+func (s *GrammarQuestionSearch) createQuestionsIndex(ctx context.Context, index string) error {
 	createReq := opensearchapi.IndicesCreateRequest{
-		Index: "grammar_questions",
+		Index: index,
 		Body: strings.NewReader(`{
             "settings": {
                 "analysis": {
@@ -62,6 +69,16 @@ func (s *GrammarQuestionSearch) CreateGrammarQuestionsIndex(ctx context.Context)
             },
             "mappings": {
                 "properties": {
+                    "children": {
+                        "type": "nested",
+                        "properties": {
+                            "kind": { "type": "keyword" },
+                            "id": { "type": "keyword" },
+                            "text": { "type": "text", "analyzer": "case_insensitive" },
+                            "is_correct": { "type": "boolean" }
+                        }
+                    },
+                    "option_count": { "type": "integer" },
                     "choice_one_options": {
                         "analyzer": "case_insensitive",
                         "type": "text"
@@ -143,8 +160,17 @@ func (s *GrammarQuestionSearch) CreateGrammarQuestionsIndex(ctx context.Context)
 }
 
 func (s *GrammarQuestionSearch) RemoveGrammarQuestionsIndex(ctx context.Context) error {
+	// Indices cannot be deleted through an alias, so delete the ones behind it
+	indices, err := ResolveIndices(ctx, s.client, GrammarQuestionsIndex)
+	if err != nil {
+		return err
+	}
+	if len(indices) == 0 {
+		return nil
+	}
+
 	deleteReq := opensearchapi.IndicesDeleteRequest{
-		Index: []string{"grammar_questions"},
+		Index: indices,
 	}
 
 	res, err := deleteReq.Do(ctx, s.client)
@@ -162,7 +188,7 @@ func (s *GrammarQuestionSearch) RemoveGrammarQuestionsIndex(ctx context.Context)
 
 func (s *GrammarQuestionSearch) DeleteGrammarQuestionFromIndex(ctx context.Context, id uuid.UUID) error {
 	req := opensearchapi.DeleteRequest{
-		Index:      "grammar_questions",
+		Index:      GrammarQuestionsIndex,
 		DocumentID: id.String(),
 	}
 	res, err := req.Do(ctx, s.client)
@@ -170,17 +196,31 @@ func (s *GrammarQuestionSearch) DeleteGrammarQuestionFromIndex(ctx context.Conte
 		return fmt.Errorf("failed to delete question: %w", err)
 	}
 	defer res.Body.Close()
-	if res.IsError() {
+	if res.IsError() && res.StatusCode != 404 {
 		return fmt.Errorf("error deleting question: %s", res.String())
 	}
 	return nil
 }
 
 func (s *GrammarQuestionSearch) UpdateGrammarQuestionsMapping(ctx context.Context) error {
+	return s.putQuestionsMapping(ctx, GrammarQuestionsIndex)
+}
+
+func (s *GrammarQuestionSearch) putQuestionsMapping(ctx context.Context, index string) error {
 	putMappingReq := opensearchapi.IndicesPutMappingRequest{
-		Index: []string{"grammar_questions"},
+		Index: []string{index},
 		Body: strings.NewReader(`{
 			"properties": {
+				"children": {
+					"type": "nested",
+					"properties": {
+						"kind": { "type": "keyword" },
+						"id": { "type": "keyword" },
+						"text": { "type": "text", "analyzer": "case_insensitive" },
+						"is_correct": { "type": "boolean" }
+					}
+				},
+				"option_count": { "type": "integer" },
 				"choice_one_options": {
 					"analyzer": "case_insensitive",
 					"type": "text"
@@ -263,7 +303,7 @@ func (s *GrammarQuestionSearch) UpdateGrammarQuestionsMapping(ctx context.Contex
 func (s *GrammarQuestionSearch) UpsertGrammarQuestion(ctx context.Context, question *grammarDTO.GrammarQuestionDetail, status string) error {
 	// First check if index exists
 	existsReq := opensearchapi.IndicesExistsRequest{
-		Index: []string{"grammar_questions"},
+		Index: []string{GrammarQuestionsIndex},
 	}
 
 	existsRes, err := existsReq.Do(ctx, s.client)
@@ -279,25 +319,10 @@ func (s *GrammarQuestionSearch) UpsertGrammarQuestion(ctx context.Context, quest
 	}
 
 	// Add status and version to the document
-	doc := map[string]interface{}{
-		"id":                         question.ID,
-		"type":                       question.Type,
-		"topic":                      question.Topic,
-		"instruction":                question.Instruction,
-		"image_urls":                 question.ImageURLs,
-		"max_time":                   question.MaxTime,
-		"status":                     status,
-		"version":                    question.Version,
-		"fill_in_the_blank_question": ConvertGrammarQuestionToJSON(question.FillInTheBlankQuestion),
-		"fill_in_the_blank_answers":  ConvertGrammarQuestionToJSON(question.FillInTheBlankAnswers),
-		"choice_one_question":        ConvertGrammarQuestionToJSON(question.ChoiceOneQuestion),
-		"choice_one_options":         ConvertGrammarQuestionToJSON(question.ChoiceOneOptions),
-		"error_identification":       ConvertGrammarQuestionToJSON(question.ErrorIdentification),
-		"sentence_transformation":    ConvertGrammarQuestionToJSON(question.SentenceTransformation),
-	}
+	doc := GrammarQuestionDocument(question, status)
 
 	// Add debug logging for document
-	s.logger.Debug("index_document", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("index_document", map[string]interface{}{
 		"id":       question.ID,
 		"max_time": question.MaxTime,
 		"type":     question.Type,
@@ -310,7 +335,7 @@ func (s *GrammarQuestionSearch) UpsertGrammarQuestion(ctx context.Context, quest
 	}
 
 	req := opensearchapi.IndexRequest{
-		Index:      "grammar_questions",
+		Index:      GrammarQuestionsIndex,
 		DocumentID: question.ID.String(),
 		Body:       bytes.NewReader(docJSON),
 	}
@@ -328,6 +353,67 @@ func (s *GrammarQuestionSearch) UpsertGrammarQuestion(ctx context.Context, quest
 	return nil
 }
 
+// GrammarQuestionDocument is the search document stored for a question
+func GrammarQuestionDocument(question *grammarDTO.GrammarQuestionDetail, status string) map[string]interface{} {
+	children := grammarQuestionChildren(question)
+	return map[string]interface{}{
+		"id":                         question.ID,
+		"type":                       question.Type,
+		"topic":                      question.Topic,
+		"instruction":                question.Instruction,
+		"image_urls":                 question.ImageURLs,
+		"max_time":                   question.MaxTime,
+		"status":                     status,
+		"version":                    question.Version,
+		"children":                   children,
+		"option_count":               optionCount(children),
+		"fill_in_the_blank_question": ConvertGrammarQuestionToJSON(question.FillInTheBlankQuestion),
+		"fill_in_the_blank_answers":  ConvertGrammarQuestionToJSON(question.FillInTheBlankAnswers),
+		"choice_one_question":        ConvertGrammarQuestionToJSON(question.ChoiceOneQuestion),
+		"choice_one_options":         ConvertGrammarQuestionToJSON(question.ChoiceOneOptions),
+		"error_identification":       ConvertGrammarQuestionToJSON(question.ErrorIdentification),
+		"sentence_transformation":    ConvertGrammarQuestionToJSON(question.SentenceTransformation),
+	}
+}
+
+// grammarQuestionChildren flattens the child rows of a question into nested documents
+func grammarQuestionChildren(question *grammarDTO.GrammarQuestionDetail) []QuestionChild {
+	children := []QuestionChild{}
+	if q := question.FillInTheBlankQuestion; q != nil {
+		children = append(children, newQuestionChild(ChildFillInTheBlankQuestion, q.ID, q.Question))
+	}
+	for _, a := range question.FillInTheBlankAnswers {
+		children = append(children, newQuestionChild(ChildFillInTheBlankAnswer, a.ID, a.Answer, a.Explain))
+	}
+	if q := question.ChoiceOneQuestion; q != nil {
+		children = append(children, newQuestionChild(ChildChoiceOneQuestion, q.ID, q.Question, q.Explain))
+	}
+	for _, o := range question.ChoiceOneOptions {
+		children = append(children, newQuestionOption(ChildChoiceOneOption, o.ID, o.Options, o.IsCorrect))
+	}
+	if e := question.ErrorIdentification; e != nil {
+		children = append(children, newQuestionChild(ChildErrorIdentification, e.ID, e.ErrorSentence, e.ErrorWord, e.CorrectWord, e.Explain))
+	}
+	if t := question.SentenceTransformation; t != nil {
+		children = append(children, newQuestionChild(ChildSentenceTransformation, t.ID, t.OriginalSentence, t.BeginningWord, t.ExampleCorrectSentence, t.Explain))
+	}
+	return children
+}
+
+// IndexAlias returns the alias the question index is served under
+func (s *GrammarQuestionSearch) IndexAlias() string {
+	return GrammarQuestionsIndex
+}
+
+// CreateVersionedIndex creates a physical index with the settings and mapping of the question
+// index, used when the index is rebuilt behind its alias
+func (s *GrammarQuestionSearch) CreateVersionedIndex(ctx context.Context, index string) error {
+	if err := s.createQuestionsIndex(ctx, index); err != nil {
+		return err
+	}
+	return s.putQuestionsMapping(ctx, index)
+}
+
 func ConvertGrammarQuestionToJSON(v interface{}) string {
 	if v == nil {
 		return ""
@@ -442,6 +528,12 @@ func (s *GrammarQuestionSearch) SearchQuestions(ctx context.Context, filter gram
 		}
 	}
 
+	// Add child filters, matched against the nested child documents
+	boolQuery["bool"].(map[string]interface{})["must"] = append(
+		boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
+		childFilterClauses(filter.ChildText, filter.HasChild, filter.MinOptions)...,
+	)
+
 	// Build final search body
 	searchBody := map[string]interface{}{
 		"query":            boolQuery,
@@ -457,7 +549,7 @@ func (s *GrammarQuestionSearch) SearchQuestions(ctx context.Context, filter gram
 
 	// Execute search
 	searchReq := opensearchapi.SearchRequest{
-		Index: []string{"grammar_questions"},
+		Index: []string{GrammarQuestionsIndex},
 		Body:  bytes.NewReader(searchJSON),
 	}
 
@@ -0,0 +1,308 @@
+package opensearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fluencybe/pkg/logger"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+)
+
+// IndexedVersion is the version and status stored in a search document
+type IndexedVersion struct {
+	Version int    `json:"version"`
+	Status  string `json:"status"`
+}
+
+// IndexManager handles the index level operations used to rebuild an index behind its alias
+type IndexManager struct {
+	client *opensearch.Client
+	logger *logger.PrettyLogger
+}
+
+func NewIndexManager(client *opensearch.Client, logger *logger.PrettyLogger) *IndexManager {
+	return &IndexManager{
+		client: client,
+		logger: logger,
+	}
+}
+
+// ResolveIndices returns the indices a name refers to: the targets when it is an alias, the
+// name itself when it is a concrete index, and nothing when it does not exist
+func ResolveIndices(ctx context.Context, client *opensearch.Client, name string) ([]string, error) {
+	aliasReq := opensearchapi.IndicesGetAliasRequest{
+		Name: []string{name},
+	}
+	res, err := aliasReq.Do(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alias: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != 404 {
+		if res.IsError() {
+			return nil, fmt.Errorf("error getting alias: %s", res.String())
+		}
+		var targets map[string]interface{}
+		if err := json.NewDecoder(res.Body).Decode(&targets); err != nil {
+			return nil, fmt.Errorf("failed to decode alias response: %w", err)
+		}
+		indices := make([]string, 0, len(targets))
+		for index := range targets {
+			indices = append(indices, index)
+		}
+		return indices, nil
+	}
+
+	existsReq := opensearchapi.IndicesExistsRequest{
+		Index: []string{name},
+	}
+	existsRes, err := existsReq.Do(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check index existence: %w", err)
+	}
+	defer existsRes.Body.Close()
+
+	if existsRes.StatusCode == 404 {
+		return nil, nil
+	}
+	return []string{name}, nil
+}
+
+// VersionedIndexName returns a fresh physical index name for an alias
+func VersionedIndexName(alias string, now time.Time) string {
+	return fmt.Sprintf("%s_%s", alias, now.UTC().Format("20060102150405"))
+}
+
+// BulkIndex writes documents keyed by ID into index and returns the IDs OpenSearch rejected
+func (m *IndexManager) BulkIndex(ctx context.Context, index string, docs map[string]map[string]interface{}) (map[string]string, error) {
+	if len(docs) == 0 {
+		return nil, nil
+	}
+
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	for id, doc := range docs {
+		action := map[string]interface{}{
+			"index": map[string]interface{}{
+				"_index": index,
+				"_id":    id,
+			},
+		}
+		if err := encoder.Encode(action); err != nil {
+			return nil, fmt.Errorf("failed to marshal action: %w", err)
+		}
+		if err := encoder.Encode(doc); err != nil {
+			return nil, fmt.Errorf("failed to marshal document %s: %w", id, err)
+		}
+	}
+
+	bulkReq := opensearchapi.BulkRequest{
+		Body: &body,
+	}
+	res, err := bulkReq.Do(ctx, m.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bulk index documents: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return nil, fmt.Errorf("error bulk indexing documents: %s", res.String())
+	}
+
+	var result struct {
+		Errors bool `json:"errors"`
+		Items  []map[string]struct {
+			ID    string          `json:"_id"`
+			Error json.RawMessage `json:"error"`
+		} `json:"items"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode bulk response: %w", err)
+	}
+	if !result.Errors {
+		return nil, nil
+	}
+
+	failed := make(map[string]string)
+	for _, item := range result.Items {
+		for _, op := range item {
+			if len(op.Error) > 0 && string(op.Error) != "null" {
+				failed[op.ID] = string(op.Error)
+			}
+		}
+	}
+	return failed, nil
+}
+
+func (m *IndexManager) Refresh(ctx context.Context, index string) error {
+	req := opensearchapi.IndicesRefreshRequest{
+		Index: []string{index},
+	}
+	res, err := req.Do(ctx, m.client)
+	if err != nil {
+		return fmt.Errorf("failed to refresh index: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("error refreshing index: %s", res.String())
+	}
+	return nil
+}
+
+// SwapAlias points alias at newIndex in a single atomic update and returns the indices it
+// pointed at before. A concrete index still occupying the alias name, as created before
+// indices were versioned, is dropped in the same update.
+func (m *IndexManager) SwapAlias(ctx context.Context, alias, newIndex string) ([]string, error) {
+	current, err := ResolveIndices(ctx, m.client, alias)
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []map[string]interface{}
+	var previous []string
+	for _, index := range current {
+		if index == newIndex {
+			continue
+		}
+		if index == alias {
+			actions = append(actions, map[string]interface{}{
+				"remove_index": map[string]interface{}{"index": index},
+			})
+			continue
+		}
+		actions = append(actions, map[string]interface{}{
+			"remove": map[string]interface{}{"index": index, "alias": alias},
+		})
+		previous = append(previous, index)
+	}
+	actions = append(actions, map[string]interface{}{
+		"add": map[string]interface{}{"index": newIndex, "alias": alias},
+	})
+
+	body, err := json.Marshal(map[string]interface{}{"actions": actions})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal alias actions: %w", err)
+	}
+
+	req := opensearchapi.IndicesUpdateAliasesRequest{
+		Body: bytes.NewReader(body),
+	}
+	res, err := req.Do(ctx, m.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update aliases: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return nil, fmt.Errorf("error updating aliases: %s", res.String())
+	}
+
+	m.logger.WithContext(ctx).Info("index_manager.swap_alias", map[string]interface{}{
+		"alias":    alias,
+		"index":    newIndex,
+		"previous": previous,
+	}, "Alias switched to new index")
+
+	return previous, nil
+}
+
+func (m *IndexManager) DeleteIndices(ctx context.Context, indices []string) error {
+	if len(indices) == 0 {
+		return nil
+	}
+	req := opensearchapi.IndicesDeleteRequest{
+		Index: indices,
+	}
+	res, err := req.Do(ctx, m.client)
+	if err != nil {
+		return fmt.Errorf("failed to delete indices: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() && res.StatusCode != 404 {
+		return fmt.Errorf("error deleting indices: %s", res.String())
+	}
+	return nil
+}
+
+// ScanVersions reads the version and status of every document behind name with a scroll,
+// pageSize documents at a time
+func (m *IndexManager) ScanVersions(ctx context.Context, name string, pageSize int) (map[string]IndexedVersion, error) {
+	versions := make(map[string]IndexedVersion)
+
+	indices, err := ResolveIndices(ctx, m.client, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(indices) == 0 {
+		return versions, nil
+	}
+
+	query := fmt.Sprintf(`{"size": %d, "_source": ["version", "status"], "sort": ["_doc"]}`, pageSize)
+	searchReq := opensearchapi.SearchRequest{
+		Index:  []string{name},
+		Body:   strings.NewReader(query),
+		Scroll: time.Minute,
+	}
+	res, err := searchReq.Do(ctx, m.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan index: %w", err)
+	}
+
+	var scrollID string
+	defer func() {
+		if scrollID == "" {
+			return
+		}
+		clearReq := opensearchapi.ClearScrollRequest{ScrollID: []string{scrollID}}
+		if clearRes, err := clearReq.Do(context.WithoutCancel(ctx), m.client); err == nil {
+			clearRes.Body.Close()
+		}
+	}()
+
+	for {
+		var page struct {
+			ScrollID string `json:"_scroll_id"`
+			Hits     struct {
+				Hits []struct {
+					ID     string         `json:"_id"`
+					Source IndexedVersion `json:"_source"`
+				} `json:"hits"`
+			} `json:"hits"`
+		}
+
+		if res.IsError() {
+			message := res.String()
+			res.Body.Close()
+			return nil, fmt.Errorf("error scanning index: %s", message)
+		}
+		err := json.NewDecoder(res.Body).Decode(&page)
+		res.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode scan response: %w", err)
+		}
+
+		scrollID = page.ScrollID
+		if len(page.Hits.Hits) == 0 {
+			return versions, nil
+		}
+		for _, hit := range page.Hits.Hits {
+			versions[hit.ID] = hit.Source
+		}
+
+		scrollReq := opensearchapi.ScrollRequest{
+			ScrollID: scrollID,
+			Scroll:   time.Minute,
+		}
+		if res, err = scrollReq.Do(ctx, m.client); err != nil {
+			return nil, fmt.Errorf("failed to continue scan: %w", err)
+		}
+	}
+}
@@ -7,6 +7,8 @@ import (
 	listeningDTO "fluencybe/internal/app/dto"
 	"fluencybe/pkg/logger"
 	"fmt"
+	"io"
+	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
@@ -14,6 +16,9 @@ import (
 	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
 )
 
+// ListeningQuestionsIndex is the alias clients read and write through, it points at a versioned index
+const ListeningQuestionsIndex = "listening_questions"
+
 type ListeningSearchResult struct {
 	Questions []listeningDTO.ListeningQuestionDetail
 	Total     int64
@@ -38,8 +43,12 @@ func (s *ListeningQuestionSearch) GetClient() *opensearch.Client {
 }
 
 func (s *ListeningQuestionSearch) CreateListeningQuestionsIndex(ctx context.Context) error {
+	return s.createQuestionsIndex(ctx, ListeningQuestionsIndex)
+}
+
+func (s *ListeningQuestionSearch) createQuestionsIndex(ctx context.Context, index string) error {
 	createReq := opensearchapi.IndicesCreateRequest{
-		Index: "listening_questions",
+		Index: index,
 		Body: strings.NewReader(`{
             "settings": {
                 "analysis": {
@@ -81,8 +90,17 @@ func (s *ListeningQuestionSearch) CreateListeningQuestionsIndex(ctx context.Cont
 }
 
 func (s *ListeningQuestionSearch) RemoveListeningQuestionsIndex(ctx context.Context) error {
+	// Indices cannot be deleted through an alias, so delete the ones behind it
+	indices, err := ResolveIndices(ctx, s.client, ListeningQuestionsIndex)
+	if err != nil {
+		return err
+	}
+	if len(indices) == 0 {
+		return nil
+	}
+
 	deleteReq := opensearchapi.IndicesDeleteRequest{
-		Index: []string{"listening_questions"},
+		Index: indices,
 	}
 
 	res, err := deleteReq.Do(ctx, s.client)
@@ -100,7 +118,7 @@ func (s *ListeningQuestionSearch) RemoveListeningQuestionsIndex(ctx context.Cont
 
 func (s *ListeningQuestionSearch) DeleteListeningQuestionFromIndex(ctx context.Context, id uuid.UUID) error {
 	req := opensearchapi.DeleteRequest{
-		Index:      "listening_questions",
+		Index:      ListeningQuestionsIndex,
 		DocumentID: id.String(),
 	}
 	res, err := req.Do(ctx, s.client)
@@ -108,17 +126,31 @@ func (s *ListeningQuestionSearch) DeleteListeningQuestionFromIndex(ctx context.C
 		return fmt.Errorf("failed to delete question: %w", err)
 	}
 	defer res.Body.Close()
-	if res.IsError() {
+	if res.IsError() && res.StatusCode != 404 {
 		return fmt.Errorf("error deleting question: %s", res.String())
 	}
 	return nil
 }
 
 func (s *ListeningQuestionSearch) UpdateListeningQuestionsMapping(ctx context.Context) error {
+	return s.putQuestionsMapping(ctx, ListeningQuestionsIndex)
+}
+
+func (s *ListeningQuestionSearch) putQuestionsMapping(ctx context.Context, index string) error {
 	putMappingReq := opensearchapi.IndicesPutMappingRequest{
-		Index: []string{"listening_questions"},
+		Index: []string{index},
 		Body: strings.NewReader(`{
 			"properties": {
+				"children": {
+					"type": "nested",
+					"properties": {
+						"kind": { "type": "keyword" },
+						"id": { "type": "keyword" },
+						"text": { "type": "text", "analyzer": "case_insensitive" },
+						"is_correct": { "type": "boolean" }
+					}
+				},
+				"option_count": { "type": "integer" },
 				"id": { "type": "keyword" },
 				"type": {
 					"type": "text",
@@ -180,7 +212,7 @@ func (s *ListeningQuestionSearch) UpdateListeningQuestionsMapping(ctx context.Co
 func (s *ListeningQuestionSearch) UpsertListeningQuestion(ctx context.Context, question *listeningDTO.ListeningQuestionDetail, status string) error {
 	// Check if index exists
 	existsReq := opensearchapi.IndicesExistsRequest{
-		Index: []string{"listening_questions"},
+		Index: []string{ListeningQuestionsIndex},
 	}
 	existsRes, err := existsReq.Do(ctx, s.client)
 	if err != nil {
@@ -199,29 +231,10 @@ func (s *ListeningQuestionSearch) UpsertListeningQuestion(ctx context.Context, q
 	}
 
 	// Add status and version to the document
-	doc := map[string]interface{}{
-		"id":                         question.ID,
-		"type":                       question.Type,
-		"topic":                      question.Topic,
-		"instruction":                question.Instruction,
-		"audio_urls":                 question.AudioURLs,
-		"image_urls":                 question.ImageURLs,
-		"transcript":                 question.Transcript,
-		"max_time":                   question.MaxTime, // Explicitly include max_time
-		"status":                     status,
-		"version":                    question.Version,
-		"fill_in_the_blank_question": ConvertListeningQuestionToJSON(question.FillInTheBlankQuestion),
-		"fill_in_the_blank_answers":  ConvertListeningQuestionToJSON(question.FillInTheBlankAnswers),
-		"choice_one_question":        ConvertListeningQuestionToJSON(question.ChoiceOneQuestion),
-		"choice_one_options":         ConvertListeningQuestionToJSON(question.ChoiceOneOptions),
-		"choice_multi_question":      ConvertListeningQuestionToJSON(question.ChoiceMultiQuestion),
-		"choice_multi_options":       ConvertListeningQuestionToJSON(question.ChoiceMultiOptions),
-		"map_labelling":              ConvertListeningQuestionToJSON(question.MapLabelling),
-		"MATCHING":                   ConvertListeningQuestionToJSON(question.Matching),
-	}
+	doc := ListeningQuestionDocument(question, status)
 
 	// Add debug logging for document
-	s.logger.Debug("index_document", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("index_document", map[string]interface{}{
 		"id":       question.ID,
 		"max_time": question.MaxTime,
 		"type":     question.Type,
@@ -239,7 +252,7 @@ func (s *ListeningQuestionSearch) UpsertListeningQuestion(ctx context.Context, q
 	}
 
 	req := opensearchapi.IndexRequest{
-		Index:      "listening_questions",
+		Index:      ListeningQuestionsIndex,
 		DocumentID: question.ID.String(),
 		Body:       bytes.NewReader(docJSON),
 	}
@@ -257,6 +270,77 @@ func (s *ListeningQuestionSearch) UpsertListeningQuestion(ctx context.Context, q
 	return nil
 }
 
+// ListeningQuestionDocument is the search document stored for a question
+func ListeningQuestionDocument(question *listeningDTO.ListeningQuestionDetail, status string) map[string]interface{} {
+	children := listeningQuestionChildren(question)
+	return map[string]interface{}{
+		"id":                         question.ID,
+		"type":                       question.Type,
+		"topic":                      question.Topic,
+		"instruction":                question.Instruction,
+		"audio_urls":                 question.AudioURLs,
+		"image_urls":                 question.ImageURLs,
+		"transcript":                 question.Transcript,
+		"max_time":                   question.MaxTime, // Explicitly include max_time
+		"status":                     status,
+		"version":                    question.Version,
+		"children":                   children,
+		"option_count":               optionCount(children),
+		"fill_in_the_blank_question": ConvertListeningQuestionToJSON(question.FillInTheBlankQuestion),
+		"fill_in_the_blank_answers":  ConvertListeningQuestionToJSON(question.FillInTheBlankAnswers),
+		"choice_one_question":        ConvertListeningQuestionToJSON(question.ChoiceOneQuestion),
+		"choice_one_options":         ConvertListeningQuestionToJSON(question.ChoiceOneOptions),
+		"choice_multi_question":      ConvertListeningQuestionToJSON(question.ChoiceMultiQuestion),
+		"choice_multi_options":       ConvertListeningQuestionToJSON(question.ChoiceMultiOptions),
+		"map_labelling":              ConvertListeningQuestionToJSON(question.MapLabelling),
+		"MATCHING":                   ConvertListeningQuestionToJSON(question.Matching),
+	}
+}
+
+// listeningQuestionChildren flattens the child rows of a question into nested documents
+func listeningQuestionChildren(question *listeningDTO.ListeningQuestionDetail) []QuestionChild {
+	children := []QuestionChild{}
+	if q := question.FillInTheBlankQuestion; q != nil {
+		children = append(children, newQuestionChild(ChildFillInTheBlankQuestion, q.ID, q.Question))
+	}
+	for _, a := range question.FillInTheBlankAnswers {
+		children = append(children, newQuestionChild(ChildFillInTheBlankAnswer, a.ID, a.Answer, a.Explain))
+	}
+	if q := question.ChoiceOneQuestion; q != nil {
+		children = append(children, newQuestionChild(ChildChoiceOneQuestion, q.ID, q.Question, q.Explain))
+	}
+	for _, o := range question.ChoiceOneOptions {
+		children = append(children, newQuestionOption(ChildChoiceOneOption, o.ID, o.Options, o.IsCorrect))
+	}
+	if q := question.ChoiceMultiQuestion; q != nil {
+		children = append(children, newQuestionChild(ChildChoiceMultiQuestion, q.ID, q.Question, q.Explain))
+	}
+	for _, o := range question.ChoiceMultiOptions {
+		children = append(children, newQuestionOption(ChildChoiceMultiOption, o.ID, o.Options, o.IsCorrect))
+	}
+	for _, m := range question.MapLabelling {
+		children = append(children, newQuestionChild(ChildMapLabelling, m.ID, m.Question, m.Answer, m.Explain))
+	}
+	for _, m := range question.Matching {
+		children = append(children, newQuestionChild(ChildMatching, m.ID, m.Question, m.Answer, m.Explain))
+	}
+	return children
+}
+
+// IndexAlias returns the alias the question index is served under
+func (s *ListeningQuestionSearch) IndexAlias() string {
+	return ListeningQuestionsIndex
+}
+
+// CreateVersionedIndex creates a physical index with the settings and mapping of the question
+// index, used when the index is rebuilt behind its alias
+func (s *ListeningQuestionSearch) CreateVersionedIndex(ctx context.Context, index string) error {
+	if err := s.createQuestionsIndex(ctx, index); err != nil {
+		return err
+	}
+	return s.putQuestionsMapping(ctx, index)
+}
+
 func ConvertListeningQuestionToJSON(v interface{}) string {
 	if v == nil {
 		return ""
@@ -267,3 +351,326 @@ func ConvertListeningQuestionToJSON(v interface{}) string {
 	}
 	return string(b)
 }
+
+func (s *ListeningQuestionSearch) SearchQuestions(ctx context.Context, filter listeningDTO.ListeningQuestionSearchFilter) (*listeningDTO.ListListeningQuestionsPagination, error) {
+	// Calculate offset
+	from := (filter.Page - 1) * filter.PageSize
+
+	// Build bool query
+	boolQuery := map[string]interface{}{
+		"bool": map[string]interface{}{
+			"must": []map[string]interface{}{},
+		},
+	}
+
+	// Add type filter
+	if filter.Type != "" {
+		boolQuery["bool"].(map[string]interface{})["must"] = append(
+			boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
+			map[string]interface{}{
+				"match": map[string]interface{}{
+					"type": filter.Type,
+				},
+			},
+		)
+	}
+
+	// Add topic filter
+	if filter.Topic != "" {
+		topics := strings.Split(filter.Topic, ",")
+		topicTerms := make([]interface{}, len(topics))
+		for i, topic := range topics {
+			topicTerms[i] = strings.TrimSpace(topic)
+		}
+		boolQuery["bool"].(map[string]interface{})["must"] = append(
+			boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
+			map[string]interface{}{
+				"terms": map[string]interface{}{
+					"topic.keyword": topicTerms,
+				},
+			},
+		)
+	}
+
+	// Add instruction filter
+	if filter.Instruction != "" {
+		boolQuery["bool"].(map[string]interface{})["must"] = append(
+			boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
+			map[string]interface{}{
+				"match": map[string]interface{}{
+					"instruction": filter.Instruction,
+				},
+			},
+		)
+	}
+
+	// Add audio_urls filter
+	if filter.AudioURLs != "" {
+		boolQuery["bool"].(map[string]interface{})["must"] = append(
+			boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
+			map[string]interface{}{
+				"term": map[string]interface{}{
+					"audio_urls": filter.AudioURLs,
+				},
+			},
+		)
+	}
+
+	// Add image_urls filter
+	if filter.ImageURLs != "" {
+		boolQuery["bool"].(map[string]interface{})["must"] = append(
+			boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
+			map[string]interface{}{
+				"term": map[string]interface{}{
+					"image_urls": filter.ImageURLs,
+				},
+			},
+		)
+	}
+
+	// Add transcript filter
+	if filter.Transcript != "" {
+		boolQuery["bool"].(map[string]interface{})["must"] = append(
+			boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
+			map[string]interface{}{
+				"match": map[string]interface{}{
+					"transcript": filter.Transcript,
+				},
+			},
+		)
+	}
+
+	// Add max_time range filter
+	if filter.MaxTime != "" {
+		parts := strings.Split(filter.MaxTime, "-")
+		if len(parts) == 2 {
+			minTime, err1 := strconv.Atoi(strings.TrimSpace(parts[0]))
+			maxTime, err2 := strconv.Atoi(strings.TrimSpace(parts[1]))
+			if err1 == nil && err2 == nil {
+				rangeQuery := map[string]interface{}{
+					"range": map[string]interface{}{
+						"max_time": map[string]interface{}{
+							"gte": minTime,
+							"lte": maxTime,
+						},
+					},
+				}
+				boolQuery["bool"].(map[string]interface{})["must"] = append(
+					boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
+					rangeQuery,
+				)
+			}
+		}
+	}
+
+	// Add metadata filter based on type
+	if filter.Metadata != "" && filter.Type != "" {
+		metadataQuery := map[string]interface{}{}
+
+		switch filter.Type {
+		case "FILL_IN_THE_BLANK":
+			metadataQuery = map[string]interface{}{
+				"multi_match": map[string]interface{}{
+					"query": filter.Metadata,
+					"fields": []string{
+						"fill_in_the_blank_question",
+						"fill_in_the_blank_answers",
+					},
+				},
+			}
+		case "CHOICE_ONE", "CHOICE_MULTI":
+			metadataQuery = map[string]interface{}{
+				"multi_match": map[string]interface{}{
+					"query": filter.Metadata,
+					"fields": []string{
+						filter.Type + "_question",
+						filter.Type + "_options",
+					},
+				},
+			}
+		case "MAP_LABELLING", "MATCHING":
+			metadataQuery = map[string]interface{}{
+				"multi_match": map[string]interface{}{
+					"query": filter.Metadata,
+					"fields": []string{
+						filter.Type + "_questions_and_answers",
+					},
+				},
+			}
+		}
+
+		if len(metadataQuery) > 0 {
+			boolQuery["bool"].(map[string]interface{})["must"] = append(
+				boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
+				metadataQuery,
+			)
+		}
+	}
+
+	// Add child filters, matched against the nested child documents
+	boolQuery["bool"].(map[string]interface{})["must"] = append(
+		boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
+		childFilterClauses(filter.ChildText, filter.HasChild, filter.MinOptions)...,
+	)
+
+	// Build final search body
+	searchBody := map[string]interface{}{
+		"query":            boolQuery,
+		"from":             from,
+		"size":             filter.PageSize,
+		"track_total_hits": true,
+	}
+
+	// Add debug logging
+	searchJSON, err := json.Marshal(searchBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal search body: %w", err)
+	}
+
+	s.logger.WithContext(ctx).Debug("opensearch_query_details", map[string]interface{}{
+		"query_json": string(searchJSON),
+		"type":       filter.Type,
+		"topics":     filter.Topic,
+		"max_time":   filter.MaxTime,
+		"page":       filter.Page,
+		"page_size":  filter.PageSize,
+		"bool_query": boolQuery,
+	}, "OpenSearch query details")
+
+	// Execute search
+	searchReq := opensearchapi.SearchRequest{
+		Index: []string{ListeningQuestionsIndex},
+		Body:  bytes.NewReader(searchJSON),
+	}
+
+	searchRes, err := searchReq.Do(ctx, s.client)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("opensearch_search_error", map[string]interface{}{
+			"error": err.Error(),
+			"query": string(searchJSON),
+		}, "Failed to execute OpenSearch query")
+		return nil, fmt.Errorf("failed to execute search: %w", err)
+	}
+	defer searchRes.Body.Close()
+
+	// Add more detailed debug logging for response
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(searchRes.Body); err != nil {
+		s.logger.WithContext(ctx).Error("opensearch_read_error", map[string]interface{}{
+			"error": err.Error(),
+		}, "Failed to read OpenSearch response")
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	searchRes.Body = io.NopCloser(&buf)
+
+	s.logger.WithContext(ctx).Debug("opensearch_response_details", map[string]interface{}{
+		"response": buf.String(),
+	}, "OpenSearch response details")
+
+	var searchResult struct {
+		Hits struct {
+			Total struct {
+				Value int64 `json:"value"`
+			} `json:"total"`
+			Hits []struct {
+				Source struct {
+					ID                              uuid.UUID `json:"id"`
+					Type                            string    `json:"type"`
+					Topic                           []string  `json:"topic"`
+					Instruction                     string    `json:"instruction"`
+					AudioURLs                       []string  `json:"audio_urls"`
+					ImageURLs                       []string  `json:"image_urls"`
+					Transcript                      string    `json:"transcript"`
+					MaxTime                         int       `json:"max_time"`
+					Version                         int       `json:"version"`
+					Status                          string    `json:"status"`
+					ChoiceMultiQuestion             string    `json:"choice_multi_question"`
+					ChoiceMultiOptions              string    `json:"choice_multi_options"`
+					ChoiceOneQuestion               string    `json:"choice_one_question"`
+					ChoiceOneOptions                string    `json:"choice_one_options"`
+					FillInTheBlankQuestion          string    `json:"fill_in_the_blank_question"`
+					FillInTheBlankAnswers           string    `json:"fill_in_the_blank_answers"`
+					MapLabelling                    string    `json:"map_labelling"`
+					MapLabellingQuestionsAndAnswers string    `json:"map_labelling_questions_and_answers"`
+					Matching                        string    `json:"MATCHING"`
+					MatchingQuestionsAndAnswers     string    `json:"matching_questions_and_answers"`
+				} `json:"_source"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+
+	if err := json.NewDecoder(&buf).Decode(&searchResult); err != nil {
+		return nil, fmt.Errorf("failed to decode search response: %w", err)
+	}
+
+	var questions []listeningDTO.ListeningQuestionDetail
+	for _, hit := range searchResult.Hits.Hits {
+		question := listeningDTO.ListeningQuestionDetail{
+			ListeningQuestionResponse: listeningDTO.ListeningQuestionResponse{
+				ID:          hit.Source.ID,
+				Type:        hit.Source.Type,
+				Topic:       hit.Source.Topic,
+				Instruction: hit.Source.Instruction,
+				AudioURLs:   hit.Source.AudioURLs,
+				ImageURLs:   hit.Source.ImageURLs,
+				Transcript:  hit.Source.Transcript,
+				MaxTime:     hit.Source.MaxTime,
+				Version:     hit.Source.Version,
+			},
+		}
+
+		// Parse additional fields based on question type
+		switch hit.Source.Type {
+		case "CHOICE_MULTI":
+			if hit.Source.ChoiceMultiQuestion != "" && hit.Source.ChoiceMultiQuestion != "null" {
+				var choiceMultiQuestion listeningDTO.ListeningChoiceMultiQuestionResponse
+				if err := json.Unmarshal([]byte(hit.Source.ChoiceMultiQuestion), &choiceMultiQuestion); err == nil {
+					question.ChoiceMultiQuestion = &choiceMultiQuestion
+				}
+			}
+			if hit.Source.ChoiceMultiOptions != "" && hit.Source.ChoiceMultiOptions != "null" {
+				var choiceMultiOptions []listeningDTO.ListeningChoiceMultiOptionResponse
+				if err := json.Unmarshal([]byte(hit.Source.ChoiceMultiOptions), &choiceMultiOptions); err == nil {
+					question.ChoiceMultiOptions = choiceMultiOptions
+				}
+			}
+		case "CHOICE_ONE":
+			if hit.Source.ChoiceOneQuestion != "" && hit.Source.ChoiceOneQuestion != "null" {
+				var choiceOneQuestion listeningDTO.ListeningChoiceOneQuestionResponse
+				if err := json.Unmarshal([]byte(hit.Source.ChoiceOneQuestion), &choiceOneQuestion); err == nil {
+					question.ChoiceOneQuestion = &choiceOneQuestion
+				}
+			}
+			if hit.Source.ChoiceOneOptions != "" && hit.Source.ChoiceOneOptions != "null" {
+				var choiceOneOptions []listeningDTO.ListeningChoiceOneOptionResponse
+				if err := json.Unmarshal([]byte(hit.Source.ChoiceOneOptions), &choiceOneOptions); err == nil {
+					question.ChoiceOneOptions = choiceOneOptions
+				}
+			}
+		case "FILL_IN_THE_BLANK":
+			if hit.Source.FillInTheBlankQuestion != "" && hit.Source.FillInTheBlankQuestion != "null" {
+				var fillInBlankQuestion listeningDTO.ListeningFillInTheBlankQuestionResponse
+				if err := json.Unmarshal([]byte(hit.Source.FillInTheBlankQuestion), &fillInBlankQuestion); err == nil {
+					question.FillInTheBlankQuestion = &fillInBlankQuestion
+				}
+			}
+			if hit.Source.FillInTheBlankAnswers != "" && hit.Source.FillInTheBlankAnswers != "null" {
+				var fillInBlankAnswers []listeningDTO.ListeningFillInTheBlankAnswerResponse
+				if err := json.Unmarshal([]byte(hit.Source.FillInTheBlankAnswers), &fillInBlankAnswers); err == nil {
+					question.FillInTheBlankAnswers = fillInBlankAnswers
+				}
+			}
+		}
+
+		questions = append(questions, question)
+	}
+
+	result := &listeningDTO.ListListeningQuestionsPagination{
+		Questions: questions,
+		Total:     searchResult.Hits.Total.Value,
+		Page:      filter.Page,
+		PageSize:  filter.PageSize,
+	}
+
+	return result, nil
+}
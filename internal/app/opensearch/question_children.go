@@ -0,0 +1,113 @@
+package opensearch
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// QuestionChild is a child row of a question (a sub-question, answer or option) indexed as a
+// nested document, so queries can match inside a single child
+type QuestionChild struct {
+	Kind      string    `json:"kind"`
+	ID        uuid.UUID `json:"id"`
+	Text      string    `json:"text"`
+	IsCorrect *bool     `json:"is_correct,omitempty"`
+}
+
+// Child kinds, named after the detail field the row is read from
+const (
+	ChildFillInTheBlankQuestion     = "fill_in_the_blank_question"
+	ChildFillInTheBlankAnswer       = "fill_in_the_blank_answer"
+	ChildChoiceOneQuestion          = "choice_one_question"
+	ChildChoiceOneOption            = "choice_one_option"
+	ChildChoiceMultiQuestion        = "choice_multi_question"
+	ChildChoiceMultiOption          = "choice_multi_option"
+	ChildErrorIdentification        = "error_identification"
+	ChildSentenceTransformation     = "sentence_transformation"
+	ChildTrueFalse                  = "true_false"
+	ChildMapLabelling               = "map_labelling"
+	ChildMatching                   = "matching"
+	ChildWordRepetition             = "word_repetition"
+	ChildPhraseRepetition           = "phrase_repetition"
+	ChildParagraphRepetition        = "paragraph_repetition"
+	ChildOpenParagraph              = "open_paragraph"
+	ChildConversationalRepetition   = "conversational_repetition"
+	ChildConversationalRepetitionQA = "conversational_repetition_qa"
+	ChildConversationalOpen         = "conversational_open"
+	ChildSentenceCompletion         = "sentence_completion"
+	ChildEssay                      = "essay"
+)
+
+func newQuestionChild(kind string, id uuid.UUID, parts ...string) QuestionChild {
+	text := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			text = append(text, part)
+		}
+	}
+	return QuestionChild{Kind: kind, ID: id, Text: strings.Join(text, "\n")}
+}
+
+func newQuestionOption(kind string, id uuid.UUID, option string, isCorrect bool) QuestionChild {
+	child := newQuestionChild(kind, id, option)
+	child.IsCorrect = &isCorrect
+	return child
+}
+
+// optionCount counts the choice options among the children, it backs the min_options filter
+func optionCount(children []QuestionChild) int {
+	count := 0
+	for _, child := range children {
+		if child.Kind == ChildChoiceOneOption || child.Kind == ChildChoiceMultiOption {
+			count++
+		}
+	}
+	return count
+}
+
+// childFilterClauses returns the clauses of the child filters shared by the skill searches,
+// every returned clause has to match
+func childFilterClauses(childText, hasChild string, minOptions int) []map[string]interface{} {
+	var clauses []map[string]interface{}
+
+	if childText != "" {
+		clauses = append(clauses, map[string]interface{}{
+			"nested": map[string]interface{}{
+				"path": "children",
+				"query": map[string]interface{}{
+					"match": map[string]interface{}{
+						"children.text": childText,
+					},
+				},
+				"ignore_unmapped": true,
+			},
+		})
+	}
+
+	if hasChild != "" {
+		clauses = append(clauses, map[string]interface{}{
+			"nested": map[string]interface{}{
+				"path": "children",
+				"query": map[string]interface{}{
+					"terms": map[string]interface{}{
+						"children.kind": splitList(hasChild),
+					},
+				},
+				"ignore_unmapped": true,
+			},
+		})
+	}
+
+	if minOptions > 0 {
+		clauses = append(clauses, map[string]interface{}{
+			"range": map[string]interface{}{
+				"option_count": map[string]interface{}{
+					"gte": minOptions,
+				},
+			},
+		})
+	}
+
+	return clauses
+}
@@ -0,0 +1,326 @@
+package opensearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	searchDTO "fluencybe/internal/app/dto"
+	"fluencybe/internal/core/constants"
+	"fluencybe/pkg/logger"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+)
+
+// QuestionIndexes maps each skill to the alias of its question index
+var QuestionIndexes = map[string]string{
+	"grammar":   GrammarQuestionsIndex,
+	"listening": ListeningQuestionsIndex,
+	"reading":   ReadingQuestionsIndex,
+	"speaking":  SpeakingQuestionsIndex,
+	"writing":   WritingQuestionsIndex,
+}
+
+// questionTextFields are the fields the cross-skill search matches, a field missing from an
+// index is skipped for that index
+var questionTextFields = []string{
+	"instruction^3",
+	"title^3",
+	"topic^2",
+	"passages",
+	"transcript",
+}
+
+// subQuestionFields hold the JSON of the child rows of each question type
+var subQuestionFields = []string{
+	"fill_in_the_blank_question",
+	"fill_in_the_blank_answers",
+	"choice_one_question",
+	"choice_one_options",
+	"choice_multi_question",
+	"choice_multi_options",
+	"error_identification",
+	"sentence_transformation",
+	"true_false",
+	"map_labelling",
+	"MATCHING",
+	"word_repetition",
+	"phrase_repetition",
+	"paragraph_repetition",
+	"open_paragraph",
+	"conversational_repetition",
+	"conversational_repetition_qas",
+	"conversational_open",
+	"sentence_completion",
+	"essay",
+}
+
+// highlightFields are the fields snippets are returned from
+var highlightFields = append([]string{"instruction", "title", "passages", "transcript"}, subQuestionFields...)
+
+// QuestionSearch queries the question indexes of several skills in one request
+type QuestionSearch struct {
+	client *opensearch.Client
+	logger *logger.PrettyLogger
+}
+
+func NewQuestionSearch(client *opensearch.Client, logger *logger.PrettyLogger) *QuestionSearch {
+	return &QuestionSearch{
+		client: client,
+		logger: logger,
+	}
+}
+
+// Search returns one page of questions of the given skills ranked by relevance, with skill,
+// type and topic facets counted over every match
+func (s *QuestionSearch) Search(ctx context.Context, filter searchDTO.QuestionSearchFilter, skills []string) (*searchDTO.QuestionSearchResult, error) {
+	indexes := make([]string, 0, len(skills))
+	for _, skill := range skills {
+		indexes = append(indexes, QuestionIndexes[skill])
+	}
+
+	query := map[string]interface{}{
+		"match_all": map[string]interface{}{},
+	}
+	if filter.Query != "" {
+		// A question matches on its own fields or on any of its nested children, the matching
+		// children are returned as inner hits so clients can show which answer or option matched
+		query = map[string]interface{}{
+			"bool": map[string]interface{}{
+				"should": []map[string]interface{}{
+					{
+						"multi_match": map[string]interface{}{
+							"query":   filter.Query,
+							"fields":  append(append([]string{}, questionTextFields...), subQuestionFields...),
+							"lenient": true,
+						},
+					},
+					{
+						"nested": map[string]interface{}{
+							"path": "children",
+							"query": map[string]interface{}{
+								"match": map[string]interface{}{
+									"children.text": filter.Query,
+								},
+							},
+							"score_mode":      "max",
+							"ignore_unmapped": true,
+							"inner_hits": map[string]interface{}{
+								"size": constants.QuestionSearchFragmentsPerHit,
+							},
+						},
+					},
+				},
+				"minimum_should_match": 1,
+			},
+		}
+	}
+
+	filters := []map[string]interface{}{}
+	if types := splitList(filter.Type); len(types) > 0 {
+		filters = append(filters, map[string]interface{}{
+			"terms": map[string]interface{}{"type.keyword": types},
+		})
+	}
+	if topics := splitList(filter.Topic); len(topics) > 0 {
+		filters = append(filters, map[string]interface{}{
+			"terms": map[string]interface{}{"topic.keyword": topics},
+		})
+	}
+	filters = append(filters, childFilterClauses(filter.ChildText, filter.HasChild, filter.MinOptions)...)
+
+	highlight := map[string]interface{}{}
+	for _, field := range highlightFields {
+		highlight[field] = map[string]interface{}{}
+	}
+
+	searchBody := map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must":   query,
+				"filter": filters,
+			},
+		},
+		"from":             (filter.Page - 1) * filter.PageSize,
+		"size":             filter.PageSize,
+		"track_total_hits": true,
+		"_source":          []string{"id", "type", "topic", "instruction", "title", "max_time", "version", "status"},
+		"highlight": map[string]interface{}{
+			"pre_tags":            []string{"<em>"},
+			"post_tags":           []string{"</em>"},
+			"fragment_size":       constants.QuestionSearchFragmentSize,
+			"number_of_fragments": constants.QuestionSearchFragmentsPerHit,
+			"fields":              highlight,
+		},
+		"aggs": map[string]interface{}{
+			"skill": map[string]interface{}{
+				"terms": map[string]interface{}{"field": "_index", "size": constants.QuestionSearchFacetSize},
+			},
+			"type": map[string]interface{}{
+				"terms": map[string]interface{}{"field": "type.keyword", "size": constants.QuestionSearchFacetSize},
+			},
+			"topic": map[string]interface{}{
+				"terms": map[string]interface{}{"field": "topic.keyword", "size": constants.QuestionSearchFacetSize},
+			},
+		},
+	}
+
+	searchJSON, err := json.Marshal(searchBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal search body: %w", err)
+	}
+
+	ignoreUnavailable := true
+	searchReq := opensearchapi.SearchRequest{
+		Index:             indexes,
+		Body:              bytes.NewReader(searchJSON),
+		IgnoreUnavailable: &ignoreUnavailable,
+	}
+
+	searchRes, err := searchReq.Do(ctx, s.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute search: %w", err)
+	}
+	defer searchRes.Body.Close()
+
+	if searchRes.IsError() {
+		return nil, fmt.Errorf("error searching questions: %s", searchRes.String())
+	}
+
+	type bucket struct {
+		Key      string `json:"key"`
+		DocCount int64  `json:"doc_count"`
+	}
+	type terms struct {
+		Buckets []bucket `json:"buckets"`
+	}
+
+	var searchResult struct {
+		Hits struct {
+			Total struct {
+				Value int64 `json:"value"`
+			} `json:"total"`
+			Hits []struct {
+				Index  string  `json:"_index"`
+				Score  float64 `json:"_score"`
+				Source struct {
+					ID          uuid.UUID `json:"id"`
+					Type        string    `json:"type"`
+					Topic       []string  `json:"topic"`
+					Instruction string    `json:"instruction"`
+					Title       string    `json:"title"`
+					MaxTime     int       `json:"max_time"`
+					Version     int       `json:"version"`
+					Status      string    `json:"status"`
+				} `json:"_source"`
+				Highlight map[string][]string `json:"highlight"`
+				InnerHits struct {
+					Children struct {
+						Hits struct {
+							Hits []struct {
+								Source QuestionChild `json:"_source"`
+							} `json:"hits"`
+						} `json:"hits"`
+					} `json:"children"`
+				} `json:"inner_hits"`
+			} `json:"hits"`
+		} `json:"hits"`
+		Aggregations struct {
+			Skill terms `json:"skill"`
+			Type  terms `json:"type"`
+			Topic terms `json:"topic"`
+		} `json:"aggregations"`
+	}
+
+	if err := json.NewDecoder(searchRes.Body).Decode(&searchResult); err != nil {
+		return nil, fmt.Errorf("failed to decode search response: %w", err)
+	}
+
+	questions := make([]searchDTO.QuestionSearchHit, 0, len(searchResult.Hits.Hits))
+	for _, hit := range searchResult.Hits.Hits {
+		var children []searchDTO.QuestionSearchChildHit
+		for _, child := range hit.InnerHits.Children.Hits.Hits {
+			children = append(children, searchDTO.QuestionSearchChildHit{
+				Kind: child.Source.Kind,
+				ID:   child.Source.ID,
+				Text: child.Source.Text,
+			})
+		}
+
+		questions = append(questions, searchDTO.QuestionSearchHit{
+			ID:          hit.Source.ID,
+			Skill:       skillOfIndex(hit.Index),
+			Type:        hit.Source.Type,
+			Topic:       hit.Source.Topic,
+			Instruction: hit.Source.Instruction,
+			Title:       hit.Source.Title,
+			MaxTime:     hit.Source.MaxTime,
+			Version:     hit.Source.Version,
+			Status:      hit.Source.Status,
+			Score:       hit.Score,
+			Highlights:  hit.Highlight,
+			Children:    children,
+		})
+	}
+
+	// The skill facet is counted per concrete index, which is versioned behind the alias
+	skillCounts := map[string]int64{}
+	for _, b := range searchResult.Aggregations.Skill.Buckets {
+		skillCounts[skillOfIndex(b.Key)] += b.DocCount
+	}
+	skillFacet := make([]searchDTO.QuestionSearchFacetBucket, 0, len(skillCounts))
+	for skill, count := range skillCounts {
+		skillFacet = append(skillFacet, searchDTO.QuestionSearchFacetBucket{Value: skill, Count: count})
+	}
+	sort.Slice(skillFacet, func(i, j int) bool {
+		if skillFacet[i].Count != skillFacet[j].Count {
+			return skillFacet[i].Count > skillFacet[j].Count
+		}
+		return skillFacet[i].Value < skillFacet[j].Value
+	})
+
+	facet := func(t terms) []searchDTO.QuestionSearchFacetBucket {
+		buckets := make([]searchDTO.QuestionSearchFacetBucket, 0, len(t.Buckets))
+		for _, b := range t.Buckets {
+			buckets = append(buckets, searchDTO.QuestionSearchFacetBucket{Value: b.Key, Count: b.DocCount})
+		}
+		return buckets
+	}
+
+	return &searchDTO.QuestionSearchResult{
+		Questions: questions,
+		Facets: searchDTO.QuestionSearchFacets{
+			Skill: skillFacet,
+			Type:  facet(searchResult.Aggregations.Type),
+			Topic: facet(searchResult.Aggregations.Topic),
+		},
+		Total:    searchResult.Hits.Total.Value,
+		Page:     filter.Page,
+		PageSize: filter.PageSize,
+	}, nil
+}
+
+// skillOfIndex returns the skill of a concrete index, which is either the alias name itself or
+// a versioned index named after it
+func skillOfIndex(index string) string {
+	for skill, alias := range QuestionIndexes {
+		if index == alias || strings.HasPrefix(index, alias+"_") {
+			return skill
+		}
+	}
+	return index
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
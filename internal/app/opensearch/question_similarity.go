@@ -0,0 +1,222 @@
+package opensearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fluencybe/pkg/logger"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/opensearch-project/opensearch-go/v2"
+	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+)
+
+// similarityFields are the top-level fields holding the content of a question, the rest of the
+// content lives in the children
+var similarityFields = map[string][]string{
+	"grammar":   {"instruction"},
+	"listening": {"instruction", "transcript"},
+	"reading":   {"instruction", "title", "passages"},
+	"speaking":  {"instruction"},
+	"writing":   {"instruction"},
+}
+
+// SimilarCandidate is a question returned by more_like_this, Text is its content for a closer
+// comparison
+type SimilarCandidate struct {
+	ID          uuid.UUID
+	Skill       string
+	Type        string
+	Topic       []string
+	Instruction string
+	Title       string
+	Score       float64
+	Text        string
+}
+
+// QuestionSimilarity finds questions sharing content with a question or a piece of text
+type QuestionSimilarity struct {
+	client *opensearch.Client
+	logger *logger.PrettyLogger
+}
+
+func NewQuestionSimilarity(client *opensearch.Client, logger *logger.PrettyLogger) *QuestionSimilarity {
+	return &QuestionSimilarity{
+		client: client,
+		logger: logger,
+	}
+}
+
+// QuestionText returns the content of an indexed question, found is false when it is not indexed
+func (s *QuestionSimilarity) QuestionText(ctx context.Context, skill string, id uuid.UUID) (string, bool, error) {
+	index, ok := QuestionIndexes[skill]
+	if !ok {
+		return "", false, fmt.Errorf("unknown skill %q", skill)
+	}
+
+	req := opensearchapi.GetRequest{
+		Index:          index,
+		DocumentID:     id.String(),
+		SourceIncludes: append(append([]string{}, similarityFields[skill]...), "children.text"),
+	}
+	res, err := req.Do(ctx, s.client)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to get question: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == 404 {
+		return "", false, nil
+	}
+	if res.IsError() {
+		return "", false, fmt.Errorf("error getting question: %s", res.String())
+	}
+
+	var doc struct {
+		Source map[string]interface{} `json:"_source"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&doc); err != nil {
+		return "", false, fmt.Errorf("failed to decode question: %w", err)
+	}
+	return similarityText(skill, doc.Source), true, nil
+}
+
+// LikeQuestion returns the questions of a skill sharing the most terms with an indexed question
+func (s *QuestionSimilarity) LikeQuestion(ctx context.Context, skill string, id uuid.UUID, size int) ([]SimilarCandidate, error) {
+	like := []map[string]interface{}{
+		{"_index": QuestionIndexes[skill], "_id": id.String()},
+	}
+	return s.moreLikeThis(ctx, skill, like, id, size)
+}
+
+// LikeText returns the questions of a skill sharing the most terms with text, exclude is left out
+// so a question that was just indexed does not find itself
+func (s *QuestionSimilarity) LikeText(ctx context.Context, skill string, text string, exclude uuid.UUID, size int) ([]SimilarCandidate, error) {
+	return s.moreLikeThis(ctx, skill, []interface{}{text}, exclude, size)
+}
+
+func (s *QuestionSimilarity) moreLikeThis(ctx context.Context, skill string, like interface{}, exclude uuid.UUID, size int) ([]SimilarCandidate, error) {
+	index, ok := QuestionIndexes[skill]
+	if !ok {
+		return nil, fmt.Errorf("unknown skill %q", skill)
+	}
+
+	// The child rows are matched through their JSON fields, more_like_this cannot reach into
+	// nested documents
+	fields := append(append([]string{}, similarityFields[skill]...), subQuestionFields...)
+
+	searchBody := map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": map[string]interface{}{
+					"more_like_this": map[string]interface{}{
+						"fields":               fields,
+						"like":                 like,
+						"min_term_freq":        1,
+						"min_doc_freq":         1,
+						"max_query_terms":      50,
+						"minimum_should_match": "30%",
+					},
+				},
+				"must_not": map[string]interface{}{
+					"ids": map[string]interface{}{"values": []string{exclude.String()}},
+				},
+			},
+		},
+		"size":    size,
+		"_source": append([]string{"id", "type", "topic", "instruction", "title", "children.text"}, similarityFields[skill]...),
+	}
+
+	searchJSON, err := json.Marshal(searchBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal search body: %w", err)
+	}
+
+	ignoreUnavailable := true
+	searchReq := opensearchapi.SearchRequest{
+		Index:             []string{index},
+		Body:              bytes.NewReader(searchJSON),
+		IgnoreUnavailable: &ignoreUnavailable,
+	}
+
+	searchRes, err := searchReq.Do(ctx, s.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute search: %w", err)
+	}
+	defer searchRes.Body.Close()
+
+	if searchRes.IsError() {
+		return nil, fmt.Errorf("error searching similar questions: %s", searchRes.String())
+	}
+
+	var searchResult struct {
+		Hits struct {
+			Hits []struct {
+				Score  float64                `json:"_score"`
+				Source map[string]interface{} `json:"_source"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	if err := json.NewDecoder(searchRes.Body).Decode(&searchResult); err != nil {
+		return nil, fmt.Errorf("failed to decode search response: %w", err)
+	}
+
+	candidates := make([]SimilarCandidate, 0, len(searchResult.Hits.Hits))
+	for _, hit := range searchResult.Hits.Hits {
+		id, err := uuid.Parse(stringField(hit.Source, "id"))
+		if err != nil {
+			continue
+		}
+		candidates = append(candidates, SimilarCandidate{
+			ID:          id,
+			Skill:       skill,
+			Type:        stringField(hit.Source, "type"),
+			Topic:       stringsField(hit.Source, "topic"),
+			Instruction: stringField(hit.Source, "instruction"),
+			Title:       stringField(hit.Source, "title"),
+			Score:       hit.Score,
+			Text:        similarityText(skill, hit.Source),
+		})
+	}
+	return candidates, nil
+}
+
+// similarityText joins the content fields of a document and the text of its children
+func similarityText(skill string, source map[string]interface{}) string {
+	var parts []string
+	for _, field := range similarityFields[skill] {
+		parts = append(parts, stringsField(source, field)...)
+	}
+	if children, ok := source["children"].([]interface{}); ok {
+		for _, child := range children {
+			if child, ok := child.(map[string]interface{}); ok {
+				parts = append(parts, stringField(child, "text"))
+			}
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+func stringField(source map[string]interface{}, field string) string {
+	value, _ := source[field].(string)
+	return value
+}
+
+// stringsField reads a field that holds either a string or a list of strings
+func stringsField(source map[string]interface{}, field string) []string {
+	switch value := source[field].(type) {
+	case string:
+		return []string{value}
+	case []interface{}:
+		values := make([]string, 0, len(value))
+		for _, v := range value {
+			if v, ok := v.(string); ok {
+				values = append(values, v)
+			}
+		}
+		return values
+	}
+	return nil
+}
@@ -7,6 +7,8 @@ import (
 	readingDTO "fluencybe/internal/app/dto"
 	"fluencybe/pkg/logger"
 	"fmt"
+	"io"
+	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
@@ -14,6 +16,9 @@ import (
 	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
 )
 
+// ReadingQuestionsIndex is the alias clients read and write through, it points at a versioned index
+const ReadingQuestionsIndex = "reading_questions"
+
 type ReadingSearchResult struct {
 	Questions []readingDTO.ReadingQuestionDetail
 	Total     int64
@@ -38,8 +43,12 @@ func (s *ReadingQuestionSearch) GetClient() *opensearch.Client {
 }
 
 func (s *ReadingQuestionSearch) CreateReadingQuestionsIndex(ctx context.Context) error {
+	return s.createQuestionsIndex(ctx, ReadingQuestionsIndex)
+}
+
+func (s *ReadingQuestionSearch) createQuestionsIndex(ctx context.Context, index string) error {
 	createReq := opensearchapi.IndicesCreateRequest{
-		Index: "reading_questions",
+		Index: index,
 		Body: strings.NewReader(`{
             "settings": {
                 "analysis": {
@@ -61,6 +70,16 @@ func (s *ReadingQuestionSearch) CreateReadingQuestionsIndex(ctx context.Context)
             },
             "mappings": {
                 "properties": {
+                    "children": {
+                        "type": "nested",
+                        "properties": {
+                            "kind": { "type": "keyword" },
+                            "id": { "type": "keyword" },
+                            "text": { "type": "text", "analyzer": "case_insensitive" },
+                            "is_correct": { "type": "boolean" }
+                        }
+                    },
+                    "option_count": { "type": "integer" },
                     "id": { "type": "text" },
                     "type": { 
                         "type": "text",
@@ -115,8 +134,17 @@ func (s *ReadingQuestionSearch) CreateReadingQuestionsIndex(ctx context.Context)
 	return nil
 }
 func (s *ReadingQuestionSearch) RemoveReadingQuestionsIndex(ctx context.Context) error {
+	// Indices cannot be deleted through an alias, so delete the ones behind it
+	indices, err := ResolveIndices(ctx, s.client, ReadingQuestionsIndex)
+	if err != nil {
+		return err
+	}
+	if len(indices) == 0 {
+		return nil
+	}
+
 	deleteReq := opensearchapi.IndicesDeleteRequest{
-		Index: []string{"reading_questions"},
+		Index: indices,
 	}
 
 	res, err := deleteReq.Do(ctx, s.client)
@@ -134,7 +162,7 @@ func (s *ReadingQuestionSearch) RemoveReadingQuestionsIndex(ctx context.Context)
 
 func (s *ReadingQuestionSearch) DeleteReadingQuestionFromIndex(ctx context.Context, id uuid.UUID) error {
 	req := opensearchapi.DeleteRequest{
-		Index:      "reading_questions",
+		Index:      ReadingQuestionsIndex,
 		DocumentID: id.String(),
 	}
 	res, err := req.Do(ctx, s.client)
@@ -142,127 +170,362 @@ func (s *ReadingQuestionSearch) DeleteReadingQuestionFromIndex(ctx context.Conte
 		return fmt.Errorf("failed to delete question: %w", err)
 	}
 	defer res.Body.Close()
-	if res.IsError() {
+	if res.IsError() && res.StatusCode != 404 {
 		return fmt.Errorf("error deleting question: %s", res.String())
 	}
 	return nil
 }
 
 func (s *ReadingQuestionSearch) SearchQuestions(ctx context.Context, filter readingDTO.ReadingQuestionSearchFilter) (*readingDTO.ListReadingQuestionsPagination, error) {
+	// Calculate offset
 	from := (filter.Page - 1) * filter.PageSize
 
-	// Build search query
-	searchBody := map[string]interface{}{
-		"query": map[string]interface{}{
-			"bool": map[string]interface{}{
-				"should": []map[string]interface{}{
-					{
-						"multi_match": map[string]interface{}{
-							"query":    filter.Query,
-							"fields":   []string{"instruction", "title", "passages"},
-							"operator": "and",
+	// Build bool query
+	boolQuery := map[string]interface{}{
+		"bool": map[string]interface{}{
+			"must": []map[string]interface{}{},
+		},
+	}
+
+	// Add free text query, every term has to appear in one of the text fields
+	if filter.Query != "" {
+		boolQuery["bool"].(map[string]interface{})["must"] = append(
+			boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
+			map[string]interface{}{
+				"multi_match": map[string]interface{}{
+					"query":    filter.Query,
+					"fields":   []string{"instruction", "title", "passages", "topic"},
+					"operator": "and",
+				},
+			},
+		)
+	}
+
+	// Add type filter
+	if filter.Type != "" {
+		boolQuery["bool"].(map[string]interface{})["must"] = append(
+			boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
+			map[string]interface{}{
+				"match": map[string]interface{}{
+					"type": filter.Type,
+				},
+			},
+		)
+	}
+
+	// Add topic filter
+	if filter.Topic != "" {
+		topics := strings.Split(filter.Topic, ",")
+		topicTerms := make([]interface{}, len(topics))
+		for i, topic := range topics {
+			topicTerms[i] = strings.TrimSpace(topic)
+		}
+		boolQuery["bool"].(map[string]interface{})["must"] = append(
+			boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
+			map[string]interface{}{
+				"terms": map[string]interface{}{
+					"topic.keyword": topicTerms,
+				},
+			},
+		)
+	}
+
+	// Add instruction filter
+	if filter.Instruction != "" {
+		boolQuery["bool"].(map[string]interface{})["must"] = append(
+			boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
+			map[string]interface{}{
+				"match": map[string]interface{}{
+					"instruction": filter.Instruction,
+				},
+			},
+		)
+	}
+
+	// Add title filter
+	if filter.Title != "" {
+		boolQuery["bool"].(map[string]interface{})["must"] = append(
+			boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
+			map[string]interface{}{
+				"match": map[string]interface{}{
+					"title": filter.Title,
+				},
+			},
+		)
+	}
+
+	// Add passages filter
+	if filter.Passages != "" {
+		boolQuery["bool"].(map[string]interface{})["must"] = append(
+			boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
+			map[string]interface{}{
+				"match": map[string]interface{}{
+					"passages": filter.Passages,
+				},
+			},
+		)
+	}
+
+	// Add image_urls filter
+	if filter.ImageURLs != "" {
+		boolQuery["bool"].(map[string]interface{})["must"] = append(
+			boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
+			map[string]interface{}{
+				"term": map[string]interface{}{
+					"image_urls": filter.ImageURLs,
+				},
+			},
+		)
+	}
+
+	// Add max_time range filter
+	if filter.MaxTime != "" {
+		parts := strings.Split(filter.MaxTime, "-")
+		if len(parts) == 2 {
+			minTime, err1 := strconv.Atoi(strings.TrimSpace(parts[0]))
+			maxTime, err2 := strconv.Atoi(strings.TrimSpace(parts[1]))
+			if err1 == nil && err2 == nil {
+				rangeQuery := map[string]interface{}{
+					"range": map[string]interface{}{
+						"max_time": map[string]interface{}{
+							"gte": minTime,
+							"lte": maxTime,
 						},
 					},
-					{
-						"match": map[string]interface{}{
-							"topic": map[string]interface{}{
-								"query":    filter.Query,
-								"operator": "and",
-								"analyzer": "case_insensitive",
-							},
-						},
+				}
+				boolQuery["bool"].(map[string]interface{})["must"] = append(
+					boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
+					rangeQuery,
+				)
+			}
+		}
+	}
+
+	// Add metadata filter based on type
+	if filter.Metadata != "" && filter.Type != "" {
+		metadataQuery := map[string]interface{}{}
+
+		switch filter.Type {
+		case "FILL_IN_THE_BLANK":
+			metadataQuery = map[string]interface{}{
+				"multi_match": map[string]interface{}{
+					"query": filter.Metadata,
+					"fields": []string{
+						"fill_in_the_blank_question",
+						"fill_in_the_blank_answers",
 					},
 				},
-			},
-		},
-		"from": from,
-		"size": filter.PageSize,
+			}
+		case "CHOICE_ONE", "CHOICE_MULTI":
+			metadataQuery = map[string]interface{}{
+				"multi_match": map[string]interface{}{
+					"query": filter.Metadata,
+					"fields": []string{
+						filter.Type + "_question",
+						filter.Type + "_options",
+					},
+				},
+			}
+		case "TRUE_FALSE":
+			metadataQuery = map[string]interface{}{
+				"multi_match": map[string]interface{}{
+					"query": filter.Metadata,
+					"fields": []string{
+						"true_false_question",
+						"true_false_answer",
+					},
+				},
+			}
+		case "MATCHING":
+			metadataQuery = map[string]interface{}{
+				"multi_match": map[string]interface{}{
+					"query": filter.Metadata,
+					"fields": []string{
+						filter.Type + "_questions_and_answers",
+					},
+				},
+			}
+		}
+
+		if len(metadataQuery) > 0 {
+			boolQuery["bool"].(map[string]interface{})["must"] = append(
+				boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
+				metadataQuery,
+			)
+		}
+	}
+
+	// Add child filters, matched against the nested child documents
+	boolQuery["bool"].(map[string]interface{})["must"] = append(
+		boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
+		childFilterClauses(filter.ChildText, filter.HasChild, filter.MinOptions)...,
+	)
+
+	// Build final search body
+	searchBody := map[string]interface{}{
+		"query":            boolQuery,
+		"from":             from,
+		"size":             filter.PageSize,
+		"track_total_hits": true,
 	}
 
+	// Add debug logging
 	searchJSON, err := json.Marshal(searchBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal search body: %w", err)
 	}
 
+	s.logger.WithContext(ctx).Debug("opensearch_query_details", map[string]interface{}{
+		"query_json": string(searchJSON),
+		"type":       filter.Type,
+		"topics":     filter.Topic,
+		"max_time":   filter.MaxTime,
+		"page":       filter.Page,
+		"page_size":  filter.PageSize,
+		"bool_query": boolQuery,
+	}, "OpenSearch query details")
+
+	// Execute search
 	searchReq := opensearchapi.SearchRequest{
-		Index: []string{"reading_questions"},
+		Index: []string{ReadingQuestionsIndex},
 		Body:  bytes.NewReader(searchJSON),
 	}
 
 	searchRes, err := searchReq.Do(ctx, s.client)
 	if err != nil {
+		s.logger.WithContext(ctx).Error("opensearch_search_error", map[string]interface{}{
+			"error": err.Error(),
+			"query": string(searchJSON),
+		}, "Failed to execute OpenSearch query")
 		return nil, fmt.Errorf("failed to execute search: %w", err)
 	}
 	defer searchRes.Body.Close()
 
+	// Add more detailed debug logging for response
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(searchRes.Body); err != nil {
+		s.logger.WithContext(ctx).Error("opensearch_read_error", map[string]interface{}{
+			"error": err.Error(),
+		}, "Failed to read OpenSearch response")
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	searchRes.Body = io.NopCloser(&buf)
+
+	s.logger.WithContext(ctx).Debug("opensearch_response_details", map[string]interface{}{
+		"response": buf.String(),
+	}, "OpenSearch response details")
+
 	var searchResult struct {
 		Hits struct {
 			Total struct {
 				Value int64 `json:"value"`
 			} `json:"total"`
 			Hits []struct {
-				Source readingDTO.ReadingQuestionDetail `json:"_source"`
+				Source struct {
+					ID                     uuid.UUID `json:"id"`
+					Type                   string    `json:"type"`
+					Topic                  []string  `json:"topic"`
+					Instruction            string    `json:"instruction"`
+					Title                  string    `json:"title"`
+					Passages               []string  `json:"passages"`
+					ImageURLs              []string  `json:"image_urls"`
+					MaxTime                int       `json:"max_time"`
+					Version                int       `json:"version"`
+					Status                 string    `json:"status"`
+					TrueFalse              string    `json:"true_false"`
+					ChoiceMultiQuestion    string    `json:"choice_multi_question"`
+					ChoiceMultiOptions     string    `json:"choice_multi_options"`
+					ChoiceOneQuestion      string    `json:"choice_one_question"`
+					ChoiceOneOptions       string    `json:"choice_one_options"`
+					FillInTheBlankQuestion string    `json:"fill_in_the_blank_question"`
+					FillInTheBlankAnswers  string    `json:"fill_in_the_blank_answers"`
+					Matching               string    `json:"MATCHING"`
+				} `json:"_source"`
 			} `json:"hits"`
 		} `json:"hits"`
 	}
 
-	if err := json.NewDecoder(searchRes.Body).Decode(&searchResult); err != nil {
+	if err := json.NewDecoder(&buf).Decode(&searchResult); err != nil {
 		return nil, fmt.Errorf("failed to decode search response: %w", err)
 	}
 
 	var questions []readingDTO.ReadingQuestionDetail
 	for _, hit := range searchResult.Hits.Hits {
-		question := hit.Source // Copy the base fields directly
-
-		// Handle TrueFalse
-		if len(hit.Source.TrueFalse) > 0 {
-			question.TrueFalse = hit.Source.TrueFalse
-		}
-
-		// Handle FillInTheBlankQuestion
-		if hit.Source.FillInTheBlankQuestion != nil {
-			question.FillInTheBlankQuestion = hit.Source.FillInTheBlankQuestion
-		}
-
-		// Handle FillInTheBlankAnswers
-		if len(hit.Source.FillInTheBlankAnswers) > 0 {
-			question.FillInTheBlankAnswers = hit.Source.FillInTheBlankAnswers
-		}
-
-		// Handle ChoiceOneQuestion
-		if hit.Source.ChoiceOneQuestion != nil {
-			question.ChoiceOneQuestion = hit.Source.ChoiceOneQuestion
-		}
-
-		// Handle ChoiceOneOptions
-		if len(hit.Source.ChoiceOneOptions) > 0 {
-			question.ChoiceOneOptions = hit.Source.ChoiceOneOptions
-		}
-
-		// Handle ChoiceMultiQuestion
-		if hit.Source.ChoiceMultiQuestion != nil {
-			question.ChoiceMultiQuestion = hit.Source.ChoiceMultiQuestion
-		}
-
-		// Handle ChoiceMultiOptions
-		if len(hit.Source.ChoiceMultiOptions) > 0 {
-			question.ChoiceMultiOptions = hit.Source.ChoiceMultiOptions
+		question := readingDTO.ReadingQuestionDetail{
+			ReadingQuestionResponse: readingDTO.ReadingQuestionResponse{
+				ID:          hit.Source.ID,
+				Type:        hit.Source.Type,
+				Topic:       hit.Source.Topic,
+				Instruction: hit.Source.Instruction,
+				Title:       hit.Source.Title,
+				Passages:    hit.Source.Passages,
+				ImageURLs:   hit.Source.ImageURLs,
+				MaxTime:     hit.Source.MaxTime,
+				Version:     hit.Source.Version,
+			},
 		}
 
-		// Handle Matching
-		if len(hit.Source.Matching) > 0 {
-			question.Matching = hit.Source.Matching
+		// Parse additional fields based on question type
+		switch hit.Source.Type {
+		case "TRUE_FALSE":
+			if hit.Source.TrueFalse != "" && hit.Source.TrueFalse != "null" {
+				var trueFalse []readingDTO.ReadingTrueFalseResponse
+				if err := json.Unmarshal([]byte(hit.Source.TrueFalse), &trueFalse); err == nil {
+					question.TrueFalse = trueFalse
+				}
+			}
+		case "CHOICE_MULTI":
+			if hit.Source.ChoiceMultiQuestion != "" && hit.Source.ChoiceMultiQuestion != "null" {
+				var choiceMultiQuestion readingDTO.ReadingChoiceMultiQuestionResponse
+				if err := json.Unmarshal([]byte(hit.Source.ChoiceMultiQuestion), &choiceMultiQuestion); err == nil {
+					question.ChoiceMultiQuestion = &choiceMultiQuestion
+				}
+			}
+			if hit.Source.ChoiceMultiOptions != "" && hit.Source.ChoiceMultiOptions != "null" {
+				var choiceMultiOptions []readingDTO.ReadingChoiceMultiOptionResponse
+				if err := json.Unmarshal([]byte(hit.Source.ChoiceMultiOptions), &choiceMultiOptions); err == nil {
+					question.ChoiceMultiOptions = choiceMultiOptions
+				}
+			}
+		case "CHOICE_ONE":
+			if hit.Source.ChoiceOneQuestion != "" && hit.Source.ChoiceOneQuestion != "null" {
+				var choiceOneQuestion readingDTO.ReadingChoiceOneQuestionResponse
+				if err := json.Unmarshal([]byte(hit.Source.ChoiceOneQuestion), &choiceOneQuestion); err == nil {
+					question.ChoiceOneQuestion = &choiceOneQuestion
+				}
+			}
+			if hit.Source.ChoiceOneOptions != "" && hit.Source.ChoiceOneOptions != "null" {
+				var choiceOneOptions []readingDTO.ReadingChoiceOneOptionResponse
+				if err := json.Unmarshal([]byte(hit.Source.ChoiceOneOptions), &choiceOneOptions); err == nil {
+					question.ChoiceOneOptions = choiceOneOptions
+				}
+			}
+		case "FILL_IN_THE_BLANK":
+			if hit.Source.FillInTheBlankQuestion != "" && hit.Source.FillInTheBlankQuestion != "null" {
+				var fillInBlankQuestion readingDTO.ReadingFillInTheBlankQuestionResponse
+				if err := json.Unmarshal([]byte(hit.Source.FillInTheBlankQuestion), &fillInBlankQuestion); err == nil {
+					question.FillInTheBlankQuestion = &fillInBlankQuestion
+				}
+			}
+			if hit.Source.FillInTheBlankAnswers != "" && hit.Source.FillInTheBlankAnswers != "null" {
+				var fillInBlankAnswers []readingDTO.ReadingFillInTheBlankAnswerResponse
+				if err := json.Unmarshal([]byte(hit.Source.FillInTheBlankAnswers), &fillInBlankAnswers); err == nil {
+					question.FillInTheBlankAnswers = fillInBlankAnswers
+				}
+			}
 		}
 
 		questions = append(questions, question)
 	}
 
-	return &readingDTO.ListReadingQuestionsPagination{
+	result := &readingDTO.ListReadingQuestionsPagination{
 		Questions: questions,
 		Total:     searchResult.Hits.Total.Value,
 		Page:      filter.Page,
 		PageSize:  filter.PageSize,
-	}, nil
+	}
+
+	return result, nil
 }
 
 func (s *ReadingQuestionSearch) IndexQuestions(ctx context.Context, questions []readingDTO.ReadingQuestionDetail) error {
@@ -275,7 +538,7 @@ func (s *ReadingQuestionSearch) IndexQuestions(ctx context.Context, questions []
 		// Create action line
 		action := map[string]interface{}{
 			"index": map[string]interface{}{
-				"_index": "reading_questions",
+				"_index": ReadingQuestionsIndex,
 				"_id":    q.ID.String(),
 			},
 		}
@@ -311,10 +574,24 @@ func (s *ReadingQuestionSearch) IndexQuestions(ctx context.Context, questions []
 }
 
 func (s *ReadingQuestionSearch) UpdateReadingQuestionsMapping(ctx context.Context) error {
+	return s.putQuestionsMapping(ctx, ReadingQuestionsIndex)
+}
+
+func (s *ReadingQuestionSearch) putQuestionsMapping(ctx context.Context, index string) error {
 	putMappingReq := opensearchapi.IndicesPutMappingRequest{
-		Index: []string{"reading_questions"},
+		Index: []string{index},
 		Body: strings.NewReader(`{
             "properties": {
+                "children": {
+                    "type": "nested",
+                    "properties": {
+                        "kind": { "type": "keyword" },
+                        "id": { "type": "keyword" },
+                        "text": { "type": "text", "analyzer": "case_insensitive" },
+                        "is_correct": { "type": "boolean" }
+                    }
+                },
+                "option_count": { "type": "integer" },
                 "id": { "type": "text" },
                 "type": { 
                     "type": "text",
@@ -371,7 +648,7 @@ func (s *ReadingQuestionSearch) UpdateReadingQuestionsMapping(ctx context.Contex
 func (s *ReadingQuestionSearch) IndexReadingQuestionDetail(ctx context.Context, question *readingDTO.ReadingQuestionDetail, status string) error {
 	// Check if index exists
 	existsReq := opensearchapi.IndicesExistsRequest{
-		Index: []string{"reading_questions"},
+		Index: []string{ReadingQuestionsIndex},
 	}
 
 	res, err := existsReq.Do(ctx, s.client)
@@ -389,29 +666,10 @@ func (s *ReadingQuestionSearch) IndexReadingQuestionDetail(ctx context.Context,
 	}
 
 	// Add status and version to the document
-	doc := map[string]interface{}{
-		"id":                         question.ID,
-		"type":                       question.Type,
-		"topic":                      question.Topic,
-		"instruction":                question.Instruction,
-		"title":                      question.Title,
-		"passages":                   question.Passages,
-		"image_urls":                 question.ImageURLs,
-		"max_time":                   question.MaxTime,
-		"status":                     status,
-		"version":                    question.Version,
-		"true_false":                 marshalReadingQuestionToString(question.TrueFalse),
-		"fill_in_the_blank_question": marshalReadingQuestionToString(question.FillInTheBlankQuestion),
-		"fill_in_the_blank_answers":  marshalReadingQuestionToString(question.FillInTheBlankAnswers),
-		"choice_one_question":        marshalReadingQuestionToString(question.ChoiceOneQuestion),
-		"choice_one_options":         marshalReadingQuestionToString(question.ChoiceOneOptions),
-		"choice_multi_question":      marshalReadingQuestionToString(question.ChoiceMultiQuestion),
-		"choice_multi_options":       marshalReadingQuestionToString(question.ChoiceMultiOptions),
-		"MATCHING":                   marshalReadingQuestionToString(question.Matching),
-	}
+	doc := ReadingQuestionDocument(question, status)
 
 	// Add debug logging for document
-	s.logger.Debug("index_document", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("index_document", map[string]interface{}{
 		"id":       question.ID,
 		"max_time": question.MaxTime,
 		"type":     question.Type,
@@ -429,7 +687,7 @@ func (s *ReadingQuestionSearch) IndexReadingQuestionDetail(ctx context.Context,
 	}
 
 	req := opensearchapi.IndexRequest{
-		Index:      "reading_questions",
+		Index:      ReadingQuestionsIndex,
 		DocumentID: question.ID.String(),
 		Body:       bytes.NewReader(docJSON),
 	}
@@ -447,6 +705,77 @@ func (s *ReadingQuestionSearch) IndexReadingQuestionDetail(ctx context.Context,
 	return nil
 }
 
+// ReadingQuestionDocument is the search document stored for a question
+func ReadingQuestionDocument(question *readingDTO.ReadingQuestionDetail, status string) map[string]interface{} {
+	children := readingQuestionChildren(question)
+	return map[string]interface{}{
+		"id":                         question.ID,
+		"type":                       question.Type,
+		"topic":                      question.Topic,
+		"instruction":                question.Instruction,
+		"title":                      question.Title,
+		"passages":                   question.Passages,
+		"image_urls":                 question.ImageURLs,
+		"max_time":                   question.MaxTime,
+		"status":                     status,
+		"version":                    question.Version,
+		"children":                   children,
+		"option_count":               optionCount(children),
+		"true_false":                 marshalReadingQuestionToString(question.TrueFalse),
+		"fill_in_the_blank_question": marshalReadingQuestionToString(question.FillInTheBlankQuestion),
+		"fill_in_the_blank_answers":  marshalReadingQuestionToString(question.FillInTheBlankAnswers),
+		"choice_one_question":        marshalReadingQuestionToString(question.ChoiceOneQuestion),
+		"choice_one_options":         marshalReadingQuestionToString(question.ChoiceOneOptions),
+		"choice_multi_question":      marshalReadingQuestionToString(question.ChoiceMultiQuestion),
+		"choice_multi_options":       marshalReadingQuestionToString(question.ChoiceMultiOptions),
+		"MATCHING":                   marshalReadingQuestionToString(question.Matching),
+	}
+}
+
+// readingQuestionChildren flattens the child rows of a question into nested documents
+func readingQuestionChildren(question *readingDTO.ReadingQuestionDetail) []QuestionChild {
+	children := []QuestionChild{}
+	for _, t := range question.TrueFalse {
+		children = append(children, newQuestionChild(ChildTrueFalse, t.ID, t.Question, t.Answer, t.Explain))
+	}
+	if q := question.FillInTheBlankQuestion; q != nil {
+		children = append(children, newQuestionChild(ChildFillInTheBlankQuestion, q.ID, q.Question))
+	}
+	for _, a := range question.FillInTheBlankAnswers {
+		children = append(children, newQuestionChild(ChildFillInTheBlankAnswer, a.ID, a.Answer, a.Explain))
+	}
+	if q := question.ChoiceOneQuestion; q != nil {
+		children = append(children, newQuestionChild(ChildChoiceOneQuestion, q.ID, q.Question, q.Explain))
+	}
+	for _, o := range question.ChoiceOneOptions {
+		children = append(children, newQuestionOption(ChildChoiceOneOption, o.ID, o.Options, o.IsCorrect))
+	}
+	if q := question.ChoiceMultiQuestion; q != nil {
+		children = append(children, newQuestionChild(ChildChoiceMultiQuestion, q.ID, q.Question, q.Explain))
+	}
+	for _, o := range question.ChoiceMultiOptions {
+		children = append(children, newQuestionOption(ChildChoiceMultiOption, o.ID, o.Options, o.IsCorrect))
+	}
+	for _, m := range question.Matching {
+		children = append(children, newQuestionChild(ChildMatching, m.ID, m.Question, m.Answer, m.Explain))
+	}
+	return children
+}
+
+// IndexAlias returns the alias the question index is served under
+func (s *ReadingQuestionSearch) IndexAlias() string {
+	return ReadingQuestionsIndex
+}
+
+// CreateVersionedIndex creates a physical index with the settings and mapping of the question
+// index, used when the index is rebuilt behind its alias
+func (s *ReadingQuestionSearch) CreateVersionedIndex(ctx context.Context, index string) error {
+	if err := s.createQuestionsIndex(ctx, index); err != nil {
+		return err
+	}
+	return s.putQuestionsMapping(ctx, index)
+}
+
 func marshalReadingQuestionToString(v interface{}) string {
 	if v == nil {
 		return ""
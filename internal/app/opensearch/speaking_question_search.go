@@ -14,6 +14,9 @@ import (
 	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
 )
 
+// SpeakingQuestionsIndex is the alias clients read and write through, it points at a versioned index
+const SpeakingQuestionsIndex = "speaking_questions"
+
 type SpeakingSearchResult struct {
 	Questions []speakingDTO.SpeakingQuestionDetail
 	Total     int64
@@ -38,8 +41,12 @@ func (s *SpeakingQuestionSearch) GetClient() *opensearch.Client {
 }
 
 func (s *SpeakingQuestionSearch) CreateSpeakingQuestionsIndex(ctx context.Context) error {
+	return s.createQuestionsIndex(ctx, SpeakingQuestionsIndex)
+}
+
+func (s *SpeakingQuestionSearch) createQuestionsIndex(ctx context.Context, index string) error {
 	createReq := opensearchapi.IndicesCreateRequest{
-		Index: "speaking_questions",
+		Index: index,
 		Body: strings.NewReader(`{
             "settings": {
                 "analysis": {
@@ -60,6 +67,16 @@ func (s *SpeakingQuestionSearch) CreateSpeakingQuestionsIndex(ctx context.Contex
             },
             "mappings": {
                 "properties": {
+                    "children": {
+                        "type": "nested",
+                        "properties": {
+                            "kind": { "type": "keyword" },
+                            "id": { "type": "keyword" },
+                            "text": { "type": "text", "analyzer": "case_insensitive" },
+                            "is_correct": { "type": "boolean" }
+                        }
+                    },
+                    "option_count": { "type": "integer" },
                     "id": {
                         "type": "keyword"
                     },
@@ -145,8 +162,17 @@ func (s *SpeakingQuestionSearch) CreateSpeakingQuestionsIndex(ctx context.Contex
 }
 
 func (s *SpeakingQuestionSearch) RemoveSpeakingQuestionsIndex(ctx context.Context) error {
+	// Indices cannot be deleted through an alias, so delete the ones behind it
+	indices, err := ResolveIndices(ctx, s.client, SpeakingQuestionsIndex)
+	if err != nil {
+		return err
+	}
+	if len(indices) == 0 {
+		return nil
+	}
+
 	deleteReq := opensearchapi.IndicesDeleteRequest{
-		Index: []string{"speaking_questions"},
+		Index: indices,
 	}
 
 	res, err := deleteReq.Do(ctx, s.client)
@@ -164,7 +190,7 @@ func (s *SpeakingQuestionSearch) RemoveSpeakingQuestionsIndex(ctx context.Contex
 
 func (s *SpeakingQuestionSearch) DeleteSpeakingQuestionFromIndex(ctx context.Context, id uuid.UUID) error {
 	req := opensearchapi.DeleteRequest{
-		Index:      "speaking_questions",
+		Index:      SpeakingQuestionsIndex,
 		DocumentID: id.String(),
 	}
 	res, err := req.Do(ctx, s.client)
@@ -172,7 +198,7 @@ func (s *SpeakingQuestionSearch) DeleteSpeakingQuestionFromIndex(ctx context.Con
 		return fmt.Errorf("failed to delete question: %w", err)
 	}
 	defer res.Body.Close()
-	if res.IsError() {
+	if res.IsError() && res.StatusCode != 404 {
 		return fmt.Errorf("error deleting question: %s", res.String())
 	}
 	return nil
@@ -181,7 +207,7 @@ func (s *SpeakingQuestionSearch) DeleteSpeakingQuestionFromIndex(ctx context.Con
 func (s *SpeakingQuestionSearch) UpsertSpeakingQuestion(ctx context.Context, question *speakingDTO.SpeakingQuestionDetail, status string) error {
 	// Check if index exists
 	existsReq := opensearchapi.IndicesExistsRequest{
-		Index: []string{"speaking_questions"},
+		Index: []string{SpeakingQuestionsIndex},
 	}
 	existsRes, err := existsReq.Do(ctx, s.client)
 	if err != nil {
@@ -201,26 +227,10 @@ func (s *SpeakingQuestionSearch) UpsertSpeakingQuestion(ctx context.Context, que
 	}
 
 	// Add status and version to the document
-	doc := map[string]interface{}{
-		"id":                            question.ID,
-		"type":                          question.Type,
-		"topic":                         question.Topic,
-		"instruction":                   question.Instruction,
-		"image_urls":                    question.ImageURLs,
-		"max_time":                      question.MaxTime,
-		"status":                        status,
-		"version":                       question.Version,
-		"word_repetition":               marshalSpeakingQuestionToString(question.WordRepetition),
-		"phrase_repetition":             marshalSpeakingQuestionToString(question.PhraseRepetition),
-		"paragraph_repetition":          marshalSpeakingQuestionToString(question.ParagraphRepetition),
-		"open_paragraph":                marshalSpeakingQuestionToString(question.OpenParagraph),
-		"conversational_repetition":     marshalSpeakingQuestionToString(question.ConversationalRepetition),
-		"conversational_repetition_qas": marshalSpeakingQuestionToString(question.ConversationalRepetitionQAs),
-		"conversational_open":           marshalSpeakingQuestionToString(question.ConversationalOpen),
-	}
+	doc := SpeakingQuestionDocument(question, status)
 
 	// Add debug logging for document
-	s.logger.Debug("index_document", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("index_document", map[string]interface{}{
 		"id":       question.ID,
 		"max_time": question.MaxTime,
 		"type":     question.Type,
@@ -233,7 +243,7 @@ func (s *SpeakingQuestionSearch) UpsertSpeakingQuestion(ctx context.Context, que
 	}
 
 	req := opensearchapi.IndexRequest{
-		Index:      "speaking_questions",
+		Index:      SpeakingQuestionsIndex,
 		DocumentID: question.ID.String(),
 		Body:       bytes.NewReader(docJSON),
 	}
@@ -251,6 +261,71 @@ func (s *SpeakingQuestionSearch) UpsertSpeakingQuestion(ctx context.Context, que
 	return nil
 }
 
+// SpeakingQuestionDocument is the search document stored for a question
+func SpeakingQuestionDocument(question *speakingDTO.SpeakingQuestionDetail, status string) map[string]interface{} {
+	children := speakingQuestionChildren(question)
+	return map[string]interface{}{
+		"id":                            question.ID,
+		"type":                          question.Type,
+		"topic":                         question.Topic,
+		"instruction":                   question.Instruction,
+		"image_urls":                    question.ImageURLs,
+		"max_time":                      question.MaxTime,
+		"status":                        status,
+		"version":                       question.Version,
+		"children":                      children,
+		"option_count":                  optionCount(children),
+		"word_repetition":               marshalSpeakingQuestionToString(question.WordRepetition),
+		"phrase_repetition":             marshalSpeakingQuestionToString(question.PhraseRepetition),
+		"paragraph_repetition":          marshalSpeakingQuestionToString(question.ParagraphRepetition),
+		"open_paragraph":                marshalSpeakingQuestionToString(question.OpenParagraph),
+		"conversational_repetition":     marshalSpeakingQuestionToString(question.ConversationalRepetition),
+		"conversational_repetition_qas": marshalSpeakingQuestionToString(question.ConversationalRepetitionQAs),
+		"conversational_open":           marshalSpeakingQuestionToString(question.ConversationalOpen),
+	}
+}
+
+// speakingQuestionChildren flattens the child rows of a question into nested documents
+func speakingQuestionChildren(question *speakingDTO.SpeakingQuestionDetail) []QuestionChild {
+	children := []QuestionChild{}
+	for _, w := range question.WordRepetition {
+		children = append(children, newQuestionChild(ChildWordRepetition, w.ID, w.Word, w.Mean))
+	}
+	for _, p := range question.PhraseRepetition {
+		children = append(children, newQuestionChild(ChildPhraseRepetition, p.ID, p.Phrase, p.Mean))
+	}
+	for _, p := range question.ParagraphRepetition {
+		children = append(children, newQuestionChild(ChildParagraphRepetition, p.ID, p.Paragraph, p.Mean))
+	}
+	for _, p := range question.OpenParagraph {
+		children = append(children, newQuestionChild(ChildOpenParagraph, p.ID, p.Question, p.ExamplePassage, p.MeanOfExamplePassage))
+	}
+	if c := question.ConversationalRepetition; c != nil {
+		children = append(children, newQuestionChild(ChildConversationalRepetition, c.ID, c.Title, c.Overview))
+	}
+	for _, qa := range question.ConversationalRepetitionQAs {
+		children = append(children, newQuestionChild(ChildConversationalRepetitionQA, qa.ID, qa.Question, qa.Answer, qa.MeanOfQuestion, qa.MeanOfAnswer, qa.Explain))
+	}
+	if c := question.ConversationalOpen; c != nil {
+		children = append(children, newQuestionChild(ChildConversationalOpen, c.ID, c.Title, c.Overview, c.ExampleConversation))
+	}
+	return children
+}
+
+// IndexAlias returns the alias the question index is served under
+func (s *SpeakingQuestionSearch) IndexAlias() string {
+	return SpeakingQuestionsIndex
+}
+
+// CreateVersionedIndex creates a physical index with the settings and mapping of the question
+// index, used when the index is rebuilt behind its alias
+func (s *SpeakingQuestionSearch) CreateVersionedIndex(ctx context.Context, index string) error {
+	if err := s.createQuestionsIndex(ctx, index); err != nil {
+		return err
+	}
+	return s.putQuestionsMapping(ctx, index)
+}
+
 func marshalSpeakingQuestionToString(v interface{}) string {
 	if v == nil {
 		return ""
@@ -305,13 +380,19 @@ func (s *SpeakingQuestionSearch) SearchQuestions(ctx context.Context, filter spe
 		)
 	}
 
+	// Add child filters, matched against the nested child documents
+	searchBody["query"].(map[string]interface{})["bool"].(map[string]interface{})["must"] = append(
+		searchBody["query"].(map[string]interface{})["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
+		childFilterClauses(filter.ChildText, filter.HasChild, filter.MinOptions)...,
+	)
+
 	searchJSON, err := json.Marshal(searchBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal search body: %w", err)
 	}
 
 	searchReq := opensearchapi.SearchRequest{
-		Index: []string{"speaking_questions"},
+		Index: []string{SpeakingQuestionsIndex},
 		Body:  bytes.NewReader(searchJSON),
 	}
 
@@ -433,10 +514,24 @@ func (s *SpeakingQuestionSearch) SearchQuestions(ctx context.Context, filter spe
 }
 
 func (s *SpeakingQuestionSearch) UpdateSpeakingQuestionsMapping(ctx context.Context) error {
+	return s.putQuestionsMapping(ctx, SpeakingQuestionsIndex)
+}
+
+func (s *SpeakingQuestionSearch) putQuestionsMapping(ctx context.Context, index string) error {
 	putMappingReq := opensearchapi.IndicesPutMappingRequest{
-		Index: []string{"speaking_questions"},
+		Index: []string{index},
 		Body: strings.NewReader(`{
             "properties": {
+                "children": {
+                    "type": "nested",
+                    "properties": {
+                        "kind": { "type": "keyword" },
+                        "id": { "type": "keyword" },
+                        "text": { "type": "text", "analyzer": "case_insensitive" },
+                        "is_correct": { "type": "boolean" }
+                    }
+                },
+                "option_count": { "type": "integer" },
                 "id": {
                     "type": "keyword"
                 },
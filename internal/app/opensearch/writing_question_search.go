@@ -14,6 +14,9 @@ import (
 	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
 )
 
+// WritingQuestionsIndex is the alias clients read and write through, it points at a versioned index
+const WritingQuestionsIndex = "writing_questions"
+
 type WritingQuestionSearch struct {
 	client *opensearch.Client
 	logger *logger.PrettyLogger
@@ -31,8 +34,12 @@ func (s *WritingQuestionSearch) GetClient() *opensearch.Client {
 }
 
 func (s *WritingQuestionSearch) CreateWritingQuestionsIndex(ctx context.Context) error {
+	return s.createQuestionsIndex(ctx, WritingQuestionsIndex)
+}
+
+func (s *WritingQuestionSearch) createQuestionsIndex(ctx context.Context, index string) error {
 	createReq := opensearchapi.IndicesCreateRequest{
-		Index: "writing_questions",
+		Index: index,
 		Body: strings.NewReader(`{
             "settings": {
                 "analysis": {
@@ -53,6 +60,16 @@ func (s *WritingQuestionSearch) CreateWritingQuestionsIndex(ctx context.Context)
             },
             "mappings": {
                 "properties": {
+                    "children": {
+                        "type": "nested",
+                        "properties": {
+                            "kind": { "type": "keyword" },
+                            "id": { "type": "keyword" },
+                            "text": { "type": "text", "analyzer": "case_insensitive" },
+                            "is_correct": { "type": "boolean" }
+                        }
+                    },
+                    "option_count": { "type": "integer" },
                     "id": {
                         "type": "keyword"
                     },
@@ -118,8 +135,17 @@ func (s *WritingQuestionSearch) CreateWritingQuestionsIndex(ctx context.Context)
 }
 
 func (s *WritingQuestionSearch) RemoveWritingQuestionsIndex(ctx context.Context) error {
+	// Indices cannot be deleted through an alias, so delete the ones behind it
+	indices, err := ResolveIndices(ctx, s.client, WritingQuestionsIndex)
+	if err != nil {
+		return err
+	}
+	if len(indices) == 0 {
+		return nil
+	}
+
 	deleteReq := opensearchapi.IndicesDeleteRequest{
-		Index: []string{"writing_questions"},
+		Index: indices,
 	}
 
 	res, err := deleteReq.Do(ctx, s.client)
@@ -137,7 +163,7 @@ func (s *WritingQuestionSearch) RemoveWritingQuestionsIndex(ctx context.Context)
 
 func (s *WritingQuestionSearch) DeleteWritingQuestionFromIndex(ctx context.Context, id uuid.UUID) error {
 	req := opensearchapi.DeleteRequest{
-		Index:      "writing_questions",
+		Index:      WritingQuestionsIndex,
 		DocumentID: id.String(),
 	}
 	res, err := req.Do(ctx, s.client)
@@ -145,7 +171,7 @@ func (s *WritingQuestionSearch) DeleteWritingQuestionFromIndex(ctx context.Conte
 		return fmt.Errorf("failed to delete question: %w", err)
 	}
 	defer res.Body.Close()
-	if res.IsError() {
+	if res.IsError() && res.StatusCode != 404 {
 		return fmt.Errorf("error deleting question: %s", res.String())
 	}
 	return nil
@@ -154,7 +180,7 @@ func (s *WritingQuestionSearch) DeleteWritingQuestionFromIndex(ctx context.Conte
 func (s *WritingQuestionSearch) UpsertWritingQuestion(ctx context.Context, question *writingDTO.WritingQuestionDetail, status string) error {
 	// Check if index exists first
 	exists := opensearchapi.IndicesExistsRequest{
-		Index: []string{"writing_questions"},
+		Index: []string{WritingQuestionsIndex},
 	}
 
 	res, err := exists.Do(ctx, s.client)
@@ -173,18 +199,7 @@ func (s *WritingQuestionSearch) UpsertWritingQuestion(ctx context.Context, quest
 		}
 	}
 
-	doc := map[string]interface{}{
-		"id":                  question.ID,
-		"type":                question.Type,
-		"topic":               question.Topic,
-		"instruction":         question.Instruction,
-		"image_urls":          question.ImageURLs,
-		"max_time":            question.MaxTime,
-		"status":              status,
-		"version":             question.Version,
-		"sentence_completion": marshalWritingQuestionToString(question.SentenceCompletion),
-		"essay":               marshalWritingQuestionToString(question.Essay),
-	}
+	doc := WritingQuestionDocument(question, status)
 
 	docJSON, err := json.Marshal(doc)
 	if err != nil {
@@ -192,7 +207,7 @@ func (s *WritingQuestionSearch) UpsertWritingQuestion(ctx context.Context, quest
 	}
 
 	req := opensearchapi.IndexRequest{
-		Index:      "writing_questions",
+		Index:      WritingQuestionsIndex,
 		DocumentID: question.ID.String(),
 		Body:       bytes.NewReader(docJSON),
 	}
@@ -210,6 +225,51 @@ func (s *WritingQuestionSearch) UpsertWritingQuestion(ctx context.Context, quest
 	return nil
 }
 
+// WritingQuestionDocument is the search document stored for a question
+func WritingQuestionDocument(question *writingDTO.WritingQuestionDetail, status string) map[string]interface{} {
+	children := writingQuestionChildren(question)
+	return map[string]interface{}{
+		"id":                  question.ID,
+		"type":                question.Type,
+		"topic":               question.Topic,
+		"instruction":         question.Instruction,
+		"image_urls":          question.ImageURLs,
+		"max_time":            question.MaxTime,
+		"status":              status,
+		"version":             question.Version,
+		"children":            children,
+		"option_count":        optionCount(children),
+		"sentence_completion": marshalWritingQuestionToString(question.SentenceCompletion),
+		"essay":               marshalWritingQuestionToString(question.Essay),
+	}
+}
+
+// writingQuestionChildren flattens the child rows of a question into nested documents
+func writingQuestionChildren(question *writingDTO.WritingQuestionDetail) []QuestionChild {
+	children := []QuestionChild{}
+	for _, s := range question.SentenceCompletion {
+		children = append(children, newQuestionChild(ChildSentenceCompletion, s.ID, append([]string{s.ExampleSentence, s.GivenPartSentence, s.Explain}, s.RequiredWords...)...))
+	}
+	for _, e := range question.Essay {
+		children = append(children, newQuestionChild(ChildEssay, e.ID, append([]string{e.EssayType, e.SampleEssay, e.Explain}, e.RequiredPoints...)...))
+	}
+	return children
+}
+
+// IndexAlias returns the alias the question index is served under
+func (s *WritingQuestionSearch) IndexAlias() string {
+	return WritingQuestionsIndex
+}
+
+// CreateVersionedIndex creates a physical index with the settings and mapping of the question
+// index, used when the index is rebuilt behind its alias
+func (s *WritingQuestionSearch) CreateVersionedIndex(ctx context.Context, index string) error {
+	if err := s.createQuestionsIndex(ctx, index); err != nil {
+		return err
+	}
+	return s.putQuestionsMapping(ctx, index)
+}
+
 func marshalWritingQuestionToString(v interface{}) string {
 	if v == nil {
 		return ""
@@ -261,13 +321,19 @@ func (s *WritingQuestionSearch) SearchQuestions(ctx context.Context, filter writ
 		)
 	}
 
+	// Add child filters, matched against the nested child documents
+	searchBody["query"].(map[string]interface{})["bool"].(map[string]interface{})["must"] = append(
+		searchBody["query"].(map[string]interface{})["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
+		childFilterClauses(filter.ChildText, filter.HasChild, filter.MinOptions)...,
+	)
+
 	searchJSON, err := json.Marshal(searchBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal search body: %w", err)
 	}
 
 	searchReq := opensearchapi.SearchRequest{
-		Index: []string{"writing_questions"},
+		Index: []string{WritingQuestionsIndex},
 		Body:  bytes.NewReader(searchJSON),
 	}
 
@@ -347,10 +413,24 @@ func (s *WritingQuestionSearch) SearchQuestions(ctx context.Context, filter writ
 }
 
 func (s *WritingQuestionSearch) UpdateWritingQuestionsMapping(ctx context.Context) error {
+	return s.putQuestionsMapping(ctx, WritingQuestionsIndex)
+}
+
+func (s *WritingQuestionSearch) putQuestionsMapping(ctx context.Context, index string) error {
 	putMappingReq := opensearchapi.IndicesPutMappingRequest{
-		Index: []string{"writing_questions"},
+		Index: []string{index},
 		Body: strings.NewReader(`{
             "properties": {
+                "children": {
+                    "type": "nested",
+                    "properties": {
+                        "kind": { "type": "keyword" },
+                        "id": { "type": "keyword" },
+                        "text": { "type": "text", "analyzer": "case_insensitive" },
+                        "is_correct": { "type": "boolean" }
+                    }
+                },
+                "option_count": { "type": "integer" },
                 "id": {
                     "type": "keyword"
                 },
@@ -0,0 +1,15 @@
+package redis
+
+import (
+	"fluencybe/internal/core/status"
+	"fluencybe/pkg/cache"
+)
+
+// cacheAvailable reports whether the adapters can use c. A tiered cache keeps serving from memory
+// while Redis is down, Redis alone does not.
+func cacheAvailable(c cache.Cache) bool {
+	if _, ok := c.(*cache.TieredCache); ok {
+		return true
+	}
+	return status.GetRedisStatus()
+}
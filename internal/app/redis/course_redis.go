@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fluencybe/internal/app/dto"
-	"fluencybe/internal/core/status"
 	"fluencybe/pkg/cache"
 	"fluencybe/pkg/logger"
 	"fmt"
@@ -14,23 +13,34 @@ import (
 )
 
 type CourseRedis struct {
-	cache  cache.Cache
-	logger *logger.PrettyLogger
+	cache   cache.Cache
+	logger  *logger.PrettyLogger
+	details *detailCache[dto.CourseDetail]
 }
 
 func NewCourseRedis(cache cache.Cache, logger *logger.PrettyLogger) *CourseRedis {
-	return &CourseRedis{
+	r := &CourseRedis{
 		cache:  cache,
 		logger: logger,
 	}
+	r.details = newDetailCache(cache, logger, "course", func(ctx context.Context, course *dto.CourseDetail, _ bool) error {
+		return r.SetCacheCourseDetail(ctx, course)
+	})
+	return r
 }
 
 func (r *CourseRedis) GetCache() cache.Cache {
 	return r.cache
 }
 
+// GetCourseDetail reads a course through the cache, concurrent misses share one call to load. It
+// returns nil without an error when the course does not exist.
+func (r *CourseRedis) GetCourseDetail(ctx context.Context, id uuid.UUID, load DetailLoader[dto.CourseDetail]) (*dto.CourseDetail, error) {
+	return r.details.get(ctx, id, load)
+}
+
 func (r *CourseRedis) SetCacheCourseDetail(ctx context.Context, course *dto.CourseDetail) error {
-	if !status.GetRedisStatus() {
+	if !cacheAvailable(r.cache) {
 		return nil
 	}
 
@@ -41,18 +51,20 @@ func (r *CourseRedis) SetCacheCourseDetail(ctx context.Context, course *dto.Cour
 	}
 
 	if err := r.cache.Set(ctx, cacheKey, string(courseJSON), 24*time.Hour); err != nil {
-		r.logger.Error("course_redis.cache", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("course_redis.cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    course.ID,
 		}, "Failed to cache course")
 		return err
 	}
 
+	r.details.remember(ctx, course.ID, cacheKey, 24*time.Hour)
+
 	return nil
 }
 
 func (r *CourseRedis) GetCacheCourseDetail(ctx context.Context, id uuid.UUID) (*dto.CourseDetail, error) {
-	if !status.GetRedisStatus() {
+	if !cacheAvailable(r.cache) {
 		return nil, fmt.Errorf("redis disabled")
 	}
 
@@ -75,7 +87,7 @@ func (r *CourseRedis) GenerateCacheKeyForCourse(id uuid.UUID) string {
 }
 
 func (r *CourseRedis) RemoveCourseCacheEntries(ctx context.Context, id uuid.UUID) error {
-	if !status.GetRedisStatus() {
+	if !cacheAvailable(r.cache) {
 		return nil
 	}
 
@@ -84,7 +96,7 @@ func (r *CourseRedis) RemoveCourseCacheEntries(ctx context.Context, id uuid.UUID
 }
 
 func (r *CourseRedis) UpdateCachedCourse(ctx context.Context, course *dto.CourseDetail) error {
-	if !status.GetRedisStatus() {
+	if !cacheAvailable(r.cache) {
 		return nil
 	}
 
@@ -96,7 +108,7 @@ func (r *CourseRedis) UpdateCachedCourse(ctx context.Context, course *dto.Course
 	}
 
 	if err := r.cache.Set(ctx, newCacheKey, string(courseJSON), 24*time.Hour); err != nil {
-		r.logger.Error("course_redis.update_cache", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("course_redis.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    course.ID,
 		}, "Failed to set new cache entry")
@@ -112,7 +124,7 @@ func (r *CourseRedis) UpdateCachedCourse(ctx context.Context, course *dto.Course
 	for _, key := range keys {
 		if key != newCacheKey {
 			if err := r.cache.Delete(ctx, key); err != nil {
-				r.logger.Error("course_redis.update_cache.delete_old", map[string]interface{}{
+				r.logger.WithContext(ctx).Error("course_redis.update_cache.delete_old", map[string]interface{}{
 					"error": err.Error(),
 					"key":   key,
 				}, "Failed to delete old cache entry")
@@ -120,5 +132,7 @@ func (r *CourseRedis) UpdateCachedCourse(ctx context.Context, course *dto.Course
 		}
 	}
 
+	r.details.remember(ctx, course.ID, newCacheKey, 24*time.Hour)
+
 	return nil
 }
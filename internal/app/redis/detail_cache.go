@@ -0,0 +1,197 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fluencybe/internal/core/constants"
+	"fluencybe/internal/infrastructure/metrics"
+	"fluencybe/pkg/cache"
+	"fluencybe/pkg/logger"
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/singleflight"
+)
+
+// DetailLoader rebuilds a detail from the database. A nil detail with a nil error means the id
+// does not exist.
+type DetailLoader[T any] func(ctx context.Context) (detail *T, isComplete bool, err error)
+
+// detailCache reads details through the cache for the adapters of this package:
+//   - every id has a current entry that holds the key of its detail and when that detail expires,
+//     or that the id does not exist, so a hit is two reads the local tier of a TieredCache serves
+//     without a pattern scan or a TTL round trip to Redis
+//   - concurrent misses of one id share a single load
+//   - a hit close to expiry refreshes the entry in the background, with a probability that grows
+//     as the expiry nears, so a popular entry is rebuilt once instead of by every request after it
+//     expires
+//   - ids that do not exist are remembered for constants.CacheNegativeTTL
+type detailCache[T any] struct {
+	cache  cache.Cache
+	logger *logger.PrettyLogger
+	prefix string
+	// adapter labels the read metrics
+	adapter string
+	store   func(ctx context.Context, detail *T, isComplete bool) error
+	group   singleflight.Group
+	// rebuild is the duration of the latest load in nanoseconds
+	rebuild atomic.Int64
+}
+
+// detailEntry is the value of the current entry of an id, Key is empty when the id does not exist
+type detailEntry struct {
+	Key       string    `json:"key,omitempty"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func newDetailCache[T any](
+	cache cache.Cache,
+	logger *logger.PrettyLogger,
+	prefix string,
+	store func(ctx context.Context, detail *T, isComplete bool) error,
+) *detailCache[T] {
+	return &detailCache[T]{
+		cache:   cache,
+		logger:  logger,
+		prefix:  prefix,
+		adapter: strings.ReplaceAll(prefix, ":", "_"),
+		store:   store,
+	}
+}
+
+// get returns the detail of id from the cache, or from load on a miss. It returns nil without an
+// error when the id does not exist.
+func (c *detailCache[T]) get(ctx context.Context, id uuid.UUID, load DetailLoader[T]) (*T, error) {
+	if cacheAvailable(c.cache) {
+		if entry, ok := c.current(ctx, id); ok {
+			if entry.Key == "" {
+				metrics.ObserveCacheRead(c.adapter, metrics.CacheNegative)
+				return nil, nil
+			}
+
+			if detail, ok := c.read(ctx, entry.Key); ok {
+				metrics.ObserveCacheRead(c.adapter, metrics.CacheHit)
+				if c.expiresSoon(entry.ExpiresAt) {
+					go c.load(ctx, id, load)
+				}
+				return detail, nil
+			}
+		}
+	}
+
+	metrics.ObserveCacheRead(c.adapter, metrics.CacheMiss)
+	return c.load(ctx, id, load)
+}
+
+// remember makes key the current detail of id for ttl, it is called whenever the detail is cached
+func (c *detailCache[T]) remember(ctx context.Context, id uuid.UUID, key string, ttl time.Duration) {
+	c.setCurrent(ctx, id, detailEntry{Key: key, ExpiresAt: time.Now().Add(ttl)}, ttl)
+}
+
+func (c *detailCache[T]) setCurrent(ctx context.Context, id uuid.UUID, entry detailEntry, ttl time.Duration) {
+	value, err := json.Marshal(entry)
+	if err == nil {
+		err = c.cache.Set(ctx, c.currentKey(id), string(value), ttl)
+	}
+	if err != nil {
+		c.logger.WithContext(ctx).Warning("detail_cache.set_current", map[string]interface{}{
+			"error":  err.Error(),
+			"prefix": c.prefix,
+			"id":     id,
+		}, "Failed to cache current entry")
+	}
+}
+
+func (c *detailCache[T]) current(ctx context.Context, id uuid.UUID) (detailEntry, bool) {
+	var entry detailEntry
+	data, err := c.cache.Get(ctx, c.currentKey(id))
+	if err != nil {
+		return entry, false
+	}
+	if err := json.Unmarshal([]byte(data), &entry); err != nil {
+		return entry, false
+	}
+	return entry, true
+}
+
+func (c *detailCache[T]) read(ctx context.Context, key string) (*T, bool) {
+	data, err := c.cache.Get(ctx, key)
+	if err != nil {
+		return nil, false
+	}
+
+	var detail T
+	if err := json.Unmarshal([]byte(data), &detail); err != nil {
+		return nil, false
+	}
+	return &detail, true
+}
+
+// load runs one load per id at a time, callers arriving while it runs get its result
+func (c *detailCache[T]) load(ctx context.Context, id uuid.UUID, load DetailLoader[T]) (*T, error) {
+	result, err, _ := c.group.Do(id.String(), func() (interface{}, error) {
+		// The result is shared with every waiting caller, so the first one leaving must not cancel it
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), constants.CacheLoadTimeout)
+		defer cancel()
+
+		start := time.Now()
+		detail, isComplete, err := load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.rebuild.Store(int64(time.Since(start)))
+
+		if detail == nil {
+			if cacheAvailable(c.cache) {
+				c.setCurrent(ctx, id, detailEntry{ExpiresAt: time.Now().Add(constants.CacheNegativeTTL)}, constants.CacheNegativeTTL)
+			}
+			return (*T)(nil), nil
+		}
+
+		if err := c.store(ctx, detail, isComplete); err != nil {
+			c.logger.WithContext(ctx).Error("detail_cache.store", map[string]interface{}{
+				"error":  err.Error(),
+				"prefix": c.prefix,
+				"id":     id,
+			}, "Failed to cache detail")
+		}
+		return detail, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*T), nil
+}
+
+// expiresSoon decides whether a hit refreshes its entry: it does once the time left is below the
+// rebuild time scaled by a random factor, which spreads refreshes out instead of having every
+// request miss at the same instant
+func (c *detailCache[T]) expiresSoon(expiresAt time.Time) bool {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return false
+	}
+
+	rebuild := time.Duration(c.rebuild.Load())
+	if rebuild <= 0 {
+		rebuild = constants.CacheRebuildEstimate
+	}
+	return float64(rebuild)*constants.CacheEarlyRefreshBeta*-math.Log(rand.Float64()) >= float64(ttl)
+}
+
+// currentKey sits outside the <prefix>:<id>:* pattern of the detail entries so removing those
+// leaves it in place, a current entry pointing at a removed detail reads as a miss
+func (c *detailCache[T]) currentKey(id uuid.UUID) string {
+	return fmt.Sprintf("%s:current:%s", c.prefix, id)
+}
+
+// IsMissingDetail reports whether the value of a current entry records an id that does not exist
+func IsMissingDetail(value string) bool {
+	var entry detailEntry
+	return json.Unmarshal([]byte(value), &entry) == nil && entry.Key == ""
+}
@@ -3,7 +3,6 @@ package redis
 import (
 	"context"
 	"encoding/json"
-	"fluencybe/internal/core/status"
 	"fluencybe/pkg/cache"
 	"fluencybe/pkg/logger"
 	"fmt"
@@ -15,23 +14,32 @@ import (
 )
 
 type GrammarQuestionRedis struct {
-	cache  cache.Cache
-	logger *logger.PrettyLogger
+	cache   cache.Cache
+	logger  *logger.PrettyLogger
+	details *detailCache[grammarDTO.GrammarQuestionDetail]
 }
 
 func NewGrammarQuestionRedis(cache cache.Cache, logger *logger.PrettyLogger) *GrammarQuestionRedis {
-	return &GrammarQuestionRedis{
+	r := &GrammarQuestionRedis{
 		cache:  cache,
 		logger: logger,
 	}
+	r.details = newDetailCache(cache, logger, "grammar_question", r.SetCacheGrammarQuestionDetail)
+	return r
 }
 
 func (r *GrammarQuestionRedis) GetCache() cache.Cache {
 	return r.cache
 }
 
+// GetGrammarQuestionDetail reads a question through the cache, concurrent misses share one call to
+// load. It returns nil without an error when the question does not exist.
+func (r *GrammarQuestionRedis) GetGrammarQuestionDetail(ctx context.Context, id uuid.UUID, load DetailLoader[grammarDTO.GrammarQuestionDetail]) (*grammarDTO.GrammarQuestionDetail, error) {
+	return r.details.get(ctx, id, load)
+}
+
 func (r *GrammarQuestionRedis) SetCacheGrammarQuestionDetail(ctx context.Context, question *grammarDTO.GrammarQuestionDetail, isComplete bool) error {
-	if !status.GetRedisStatus() {
+	if !cacheAvailable(r.cache) {
 		return nil
 	}
 
@@ -42,13 +50,15 @@ func (r *GrammarQuestionRedis) SetCacheGrammarQuestionDetail(ctx context.Context
 	}
 
 	if err := r.cache.Set(ctx, cacheKey, string(questionJSON), 24*time.Hour); err != nil {
-		r.logger.Error("grammar_question_redis.cache", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_question_redis.cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to cache question")
 		return err
 	}
 
+	r.details.remember(ctx, question.ID, cacheKey, 24*time.Hour)
+
 	return nil
 }
 
@@ -61,7 +71,7 @@ func (r *GrammarQuestionRedis) GenerateCacheKeyForGrammarQuestion(id uuid.UUID,
 }
 
 func (r *GrammarQuestionRedis) RemoveGrammarQuestionCacheEntries(ctx context.Context, id uuid.UUID) error {
-	if !status.GetRedisStatus() {
+	if !cacheAvailable(r.cache) {
 		return nil
 	}
 
@@ -70,7 +80,7 @@ func (r *GrammarQuestionRedis) RemoveGrammarQuestionCacheEntries(ctx context.Con
 }
 
 func (r *GrammarQuestionRedis) UpdateCachedGrammarQuestion(ctx context.Context, question *grammarDTO.GrammarQuestionDetail, isComplete bool) error {
-	if !status.GetRedisStatus() {
+	if !cacheAvailable(r.cache) {
 		return nil
 	}
 
@@ -82,7 +92,7 @@ func (r *GrammarQuestionRedis) UpdateCachedGrammarQuestion(ctx context.Context,
 	}
 
 	if err := r.cache.Set(ctx, newCacheKey, string(questionJSON), 24*time.Hour); err != nil {
-		r.logger.Error("grammar_question_redis.update_cache", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_question_redis.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to set new cache entry")
@@ -98,7 +108,7 @@ func (r *GrammarQuestionRedis) UpdateCachedGrammarQuestion(ctx context.Context,
 	for _, key := range keys {
 		if key != newCacheKey {
 			if err := r.cache.Delete(ctx, key); err != nil {
-				r.logger.Error("grammar_question_redis.update_cache.delete_old", map[string]interface{}{
+				r.logger.WithContext(ctx).Error("grammar_question_redis.update_cache.delete_old", map[string]interface{}{
 					"error": err.Error(),
 					"key":   key,
 				}, "Failed to delete old cache entry")
@@ -106,5 +116,7 @@ func (r *GrammarQuestionRedis) UpdateCachedGrammarQuestion(ctx context.Context,
 		}
 	}
 
+	r.details.remember(ctx, question.ID, newCacheKey, 24*time.Hour)
+
 	return nil
 }
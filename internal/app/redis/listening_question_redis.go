@@ -3,7 +3,6 @@ package redis
 import (
 	"context"
 	"encoding/json"
-	"fluencybe/internal/core/status"
 	"fluencybe/pkg/cache"
 	"fluencybe/pkg/logger"
 	"fmt"
@@ -15,23 +14,32 @@ import (
 )
 
 type ListeningQuestionRedis struct {
-	cache  cache.Cache
-	logger *logger.PrettyLogger
+	cache   cache.Cache
+	logger  *logger.PrettyLogger
+	details *detailCache[listeningDTO.ListeningQuestionDetail]
 }
 
 func NewListeningQuestionRedis(cache cache.Cache, logger *logger.PrettyLogger) *ListeningQuestionRedis {
-	return &ListeningQuestionRedis{
+	r := &ListeningQuestionRedis{
 		cache:  cache,
 		logger: logger,
 	}
+	r.details = newDetailCache(cache, logger, "listening_question", r.SetCacheListeningQuestionDetail)
+	return r
 }
 
 func (r *ListeningQuestionRedis) GetCache() cache.Cache {
 	return r.cache
 }
 
+// GetListeningQuestionDetail reads a question through the cache, concurrent misses share one call to
+// load. It returns nil without an error when the question does not exist.
+func (r *ListeningQuestionRedis) GetListeningQuestionDetail(ctx context.Context, id uuid.UUID, load DetailLoader[listeningDTO.ListeningQuestionDetail]) (*listeningDTO.ListeningQuestionDetail, error) {
+	return r.details.get(ctx, id, load)
+}
+
 func (r *ListeningQuestionRedis) SetCacheListeningQuestionDetail(ctx context.Context, question *listeningDTO.ListeningQuestionDetail, isComplete bool) error {
-	if !status.GetRedisStatus() {
+	if !cacheAvailable(r.cache) {
 		return nil
 	}
 
@@ -42,13 +50,15 @@ func (r *ListeningQuestionRedis) SetCacheListeningQuestionDetail(ctx context.Con
 	}
 
 	if err := r.cache.Set(ctx, cacheKey, string(questionJSON), 24*time.Hour); err != nil {
-		r.logger.Error("listening_question_redis.cache", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_question_redis.cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to cache question")
 		return err
 	}
 
+	r.details.remember(ctx, question.ID, cacheKey, 24*time.Hour)
+
 	return nil
 }
 
@@ -61,7 +71,7 @@ func (r *ListeningQuestionRedis) GenerateCacheKeyForListeningQuestion(id uuid.UU
 }
 
 func (r *ListeningQuestionRedis) RemoveListeningQuestionCacheEntries(ctx context.Context, id uuid.UUID) error {
-	if !status.GetRedisStatus() {
+	if !cacheAvailable(r.cache) {
 		return nil
 	}
 
@@ -70,7 +80,7 @@ func (r *ListeningQuestionRedis) RemoveListeningQuestionCacheEntries(ctx context
 }
 
 func (r *ListeningQuestionRedis) UpdateCachedListeningQuestion(ctx context.Context, question *listeningDTO.ListeningQuestionDetail, isComplete bool) error {
-	if !status.GetRedisStatus() {
+	if !cacheAvailable(r.cache) {
 		return nil
 	}
 
@@ -82,7 +92,7 @@ func (r *ListeningQuestionRedis) UpdateCachedListeningQuestion(ctx context.Conte
 	}
 
 	if err := r.cache.Set(ctx, newCacheKey, string(questionJSON), 24*time.Hour); err != nil {
-		r.logger.Error("listening_question_redis.update_cache", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_question_redis.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to set new cache entry")
@@ -98,7 +108,7 @@ func (r *ListeningQuestionRedis) UpdateCachedListeningQuestion(ctx context.Conte
 	for _, key := range keys {
 		if key != newCacheKey {
 			if err := r.cache.Delete(ctx, key); err != nil {
-				r.logger.Error("listening_question_redis.update_cache.delete_old", map[string]interface{}{
+				r.logger.WithContext(ctx).Error("listening_question_redis.update_cache.delete_old", map[string]interface{}{
 					"error": err.Error(),
 					"key":   key,
 				}, "Failed to delete old cache entry")
@@ -106,5 +116,7 @@ func (r *ListeningQuestionRedis) UpdateCachedListeningQuestion(ctx context.Conte
 		}
 	}
 
+	r.details.remember(ctx, question.ID, newCacheKey, 24*time.Hour)
+
 	return nil
 }
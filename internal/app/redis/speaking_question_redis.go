@@ -3,7 +3,6 @@ package redis
 import (
 	"context"
 	"encoding/json"
-	"fluencybe/internal/core/status"
 	"fluencybe/pkg/cache"
 	"fluencybe/pkg/logger"
 	"fmt"
@@ -15,23 +14,32 @@ import (
 )
 
 type SpeakingQuestionRedis struct {
-	cache  cache.Cache
-	logger *logger.PrettyLogger
+	cache   cache.Cache
+	logger  *logger.PrettyLogger
+	details *detailCache[speakingDTO.SpeakingQuestionDetail]
 }
 
 func NewSpeakingQuestionRedis(cache cache.Cache, logger *logger.PrettyLogger) *SpeakingQuestionRedis {
-	return &SpeakingQuestionRedis{
+	r := &SpeakingQuestionRedis{
 		cache:  cache,
 		logger: logger,
 	}
+	r.details = newDetailCache(cache, logger, "speaking_question", r.SetCacheSpeakingQuestionDetail)
+	return r
 }
 
 func (r *SpeakingQuestionRedis) GetCache() cache.Cache {
 	return r.cache
 }
 
+// GetSpeakingQuestionDetail reads a question through the cache, concurrent misses share one call to
+// load. It returns nil without an error when the question does not exist.
+func (r *SpeakingQuestionRedis) GetSpeakingQuestionDetail(ctx context.Context, id uuid.UUID, load DetailLoader[speakingDTO.SpeakingQuestionDetail]) (*speakingDTO.SpeakingQuestionDetail, error) {
+	return r.details.get(ctx, id, load)
+}
+
 func (r *SpeakingQuestionRedis) SetCacheSpeakingQuestionDetail(ctx context.Context, question *speakingDTO.SpeakingQuestionDetail, isComplete bool) error {
-	if !status.GetRedisStatus() {
+	if !cacheAvailable(r.cache) {
 		return nil
 	}
 
@@ -42,13 +50,15 @@ func (r *SpeakingQuestionRedis) SetCacheSpeakingQuestionDetail(ctx context.Conte
 	}
 
 	if err := r.cache.Set(ctx, cacheKey, string(questionJSON), 24*time.Hour); err != nil {
-		r.logger.Error("speaking_question_redis.cache", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_question_redis.cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to cache question")
 		return err
 	}
 
+	r.details.remember(ctx, question.ID, cacheKey, 24*time.Hour)
+
 	return nil
 }
 
@@ -61,7 +71,7 @@ func (r *SpeakingQuestionRedis) GenerateCacheKeyForSpeakingQuestion(id uuid.UUID
 }
 
 func (r *SpeakingQuestionRedis) RemoveSpeakingQuestionCacheEntries(ctx context.Context, id uuid.UUID) error {
-	if !status.GetRedisStatus() {
+	if !cacheAvailable(r.cache) {
 		return nil
 	}
 
@@ -70,7 +80,7 @@ func (r *SpeakingQuestionRedis) RemoveSpeakingQuestionCacheEntries(ctx context.C
 }
 
 func (r *SpeakingQuestionRedis) UpdateCachedSpeakingQuestion(ctx context.Context, question *speakingDTO.SpeakingQuestionDetail, isComplete bool) error {
-	if !status.GetRedisStatus() {
+	if !cacheAvailable(r.cache) {
 		return nil
 	}
 
@@ -82,7 +92,7 @@ func (r *SpeakingQuestionRedis) UpdateCachedSpeakingQuestion(ctx context.Context
 	}
 
 	if err := r.cache.Set(ctx, newCacheKey, string(questionJSON), 24*time.Hour); err != nil {
-		r.logger.Error("speaking_question_redis.update_cache", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_question_redis.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to set new cache entry")
@@ -98,7 +108,7 @@ func (r *SpeakingQuestionRedis) UpdateCachedSpeakingQuestion(ctx context.Context
 	for _, key := range keys {
 		if key != newCacheKey {
 			if err := r.cache.Delete(ctx, key); err != nil {
-				r.logger.Error("speaking_question_redis.update_cache.delete_old", map[string]interface{}{
+				r.logger.WithContext(ctx).Error("speaking_question_redis.update_cache.delete_old", map[string]interface{}{
 					"error": err.Error(),
 					"key":   key,
 				}, "Failed to delete old cache entry")
@@ -106,5 +116,7 @@ func (r *SpeakingQuestionRedis) UpdateCachedSpeakingQuestion(ctx context.Context
 		}
 	}
 
+	r.details.remember(ctx, question.ID, newCacheKey, 24*time.Hour)
+
 	return nil
 }
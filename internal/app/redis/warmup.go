@@ -0,0 +1,11 @@
+package redis
+
+import "github.com/google/uuid"
+
+// CacheWarmup prebuilds the cached details of the questions most likely to be read. The question
+// services report their reads to it, and call Reset once all questions of a skill are deleted so
+// the reads of the old questions are dropped and a new pass fills the emptied cache.
+type CacheWarmup interface {
+	RecordRead(skill string, id uuid.UUID)
+	Reset(skill string)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fluencybe/internal/app/dto"
-	"fluencybe/internal/core/status"
 	"fluencybe/pkg/cache"
 	"fluencybe/pkg/logger"
 	"fmt"
@@ -14,24 +13,45 @@ import (
 )
 
 type WikiRedis struct {
-	cache  cache.Cache
-	logger *logger.PrettyLogger
+	cache   cache.Cache
+	logger  *logger.PrettyLogger
+	words   *detailCache[dto.WikiWordResponse]
+	phrases *detailCache[dto.WikiPhraseResponse]
 }
 
 func NewWikiRedis(cache cache.Cache, logger *logger.PrettyLogger) *WikiRedis {
-	return &WikiRedis{
+	r := &WikiRedis{
 		cache:  cache,
 		logger: logger,
 	}
+	r.words = newDetailCache(cache, logger, "wiki:word", func(ctx context.Context, word *dto.WikiWordResponse, _ bool) error {
+		return r.SetCacheWord(ctx, word)
+	})
+	r.phrases = newDetailCache(cache, logger, "wiki:phrase", func(ctx context.Context, phrase *dto.WikiPhraseResponse, _ bool) error {
+		return r.SetCachePhrase(ctx, phrase)
+	})
+	return r
 }
 
 func (r *WikiRedis) GetCache() cache.Cache {
 	return r.cache
 }
 
+// GetWord reads a word through the cache, concurrent misses share one call to load. It returns nil
+// without an error when the word does not exist.
+func (r *WikiRedis) GetWord(ctx context.Context, id uuid.UUID, load DetailLoader[dto.WikiWordResponse]) (*dto.WikiWordResponse, error) {
+	return r.words.get(ctx, id, load)
+}
+
+// GetPhrase reads a phrase through the cache, concurrent misses share one call to load. It returns
+// nil without an error when the phrase does not exist.
+func (r *WikiRedis) GetPhrase(ctx context.Context, id uuid.UUID, load DetailLoader[dto.WikiPhraseResponse]) (*dto.WikiPhraseResponse, error) {
+	return r.phrases.get(ctx, id, load)
+}
+
 // Word caching
 func (r *WikiRedis) SetCacheWord(ctx context.Context, word *dto.WikiWordResponse) error {
-	if !status.GetRedisStatus() {
+	if !cacheAvailable(r.cache) {
 		return nil
 	}
 
@@ -42,18 +62,20 @@ func (r *WikiRedis) SetCacheWord(ctx context.Context, word *dto.WikiWordResponse
 	}
 
 	if err := r.cache.Set(ctx, cacheKey, string(wordJSON), 24*time.Hour); err != nil {
-		r.logger.Error("wiki_redis.cache_word", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("wiki_redis.cache_word", map[string]interface{}{
 			"error": err.Error(),
 			"id":    word.ID,
 		}, "Failed to cache word")
 		return err
 	}
 
+	r.words.remember(ctx, word.ID, cacheKey, 24*time.Hour)
+
 	return nil
 }
 
 func (r *WikiRedis) GetCacheWord(ctx context.Context, id uuid.UUID) (*dto.WikiWordResponse, error) {
-	if !status.GetRedisStatus() {
+	if !cacheAvailable(r.cache) {
 		return nil, fmt.Errorf("redis disabled")
 	}
 
@@ -73,7 +95,7 @@ func (r *WikiRedis) GetCacheWord(ctx context.Context, id uuid.UUID) (*dto.WikiWo
 
 // Phrase caching
 func (r *WikiRedis) SetCachePhrase(ctx context.Context, phrase *dto.WikiPhraseResponse) error {
-	if !status.GetRedisStatus() {
+	if !cacheAvailable(r.cache) {
 		return nil
 	}
 
@@ -84,18 +106,20 @@ func (r *WikiRedis) SetCachePhrase(ctx context.Context, phrase *dto.WikiPhraseRe
 	}
 
 	if err := r.cache.Set(ctx, cacheKey, string(phraseJSON), 24*time.Hour); err != nil {
-		r.logger.Error("wiki_redis.cache_phrase", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("wiki_redis.cache_phrase", map[string]interface{}{
 			"error": err.Error(),
 			"id":    phrase.ID,
 		}, "Failed to cache phrase")
 		return err
 	}
 
+	r.phrases.remember(ctx, phrase.ID, cacheKey, 24*time.Hour)
+
 	return nil
 }
 
 func (r *WikiRedis) GetCachePhrase(ctx context.Context, id uuid.UUID) (*dto.WikiPhraseResponse, error) {
-	if !status.GetRedisStatus() {
+	if !cacheAvailable(r.cache) {
 		return nil, fmt.Errorf("redis disabled")
 	}
 
@@ -124,7 +148,7 @@ func (r *WikiRedis) GenerateCacheKeyForPhrase(id uuid.UUID) string {
 
 // Cache removal
 func (r *WikiRedis) RemoveWordCacheEntries(ctx context.Context, id uuid.UUID) error {
-	if !status.GetRedisStatus() {
+	if !cacheAvailable(r.cache) {
 		return nil
 	}
 
@@ -133,7 +157,7 @@ func (r *WikiRedis) RemoveWordCacheEntries(ctx context.Context, id uuid.UUID) er
 }
 
 func (r *WikiRedis) RemovePhraseCacheEntries(ctx context.Context, id uuid.UUID) error {
-	if !status.GetRedisStatus() {
+	if !cacheAvailable(r.cache) {
 		return nil
 	}
 
@@ -143,7 +167,7 @@ func (r *WikiRedis) RemovePhraseCacheEntries(ctx context.Context, id uuid.UUID)
 
 // Cache updates
 func (r *WikiRedis) UpdateCachedWord(ctx context.Context, word *dto.WikiWordResponse) error {
-	if !status.GetRedisStatus() {
+	if !cacheAvailable(r.cache) {
 		return nil
 	}
 
@@ -155,7 +179,7 @@ func (r *WikiRedis) UpdateCachedWord(ctx context.Context, word *dto.WikiWordResp
 	}
 
 	if err := r.cache.Set(ctx, newCacheKey, string(wordJSON), 24*time.Hour); err != nil {
-		r.logger.Error("wiki_redis.update_word_cache", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("wiki_redis.update_word_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    word.ID,
 		}, "Failed to set new cache entry")
@@ -171,7 +195,7 @@ func (r *WikiRedis) UpdateCachedWord(ctx context.Context, word *dto.WikiWordResp
 	for _, key := range keys {
 		if key != newCacheKey {
 			if err := r.cache.Delete(ctx, key); err != nil {
-				r.logger.Error("wiki_redis.update_word_cache.delete_old", map[string]interface{}{
+				r.logger.WithContext(ctx).Error("wiki_redis.update_word_cache.delete_old", map[string]interface{}{
 					"error": err.Error(),
 					"key":   key,
 				}, "Failed to delete old cache entry")
@@ -179,11 +203,13 @@ func (r *WikiRedis) UpdateCachedWord(ctx context.Context, word *dto.WikiWordResp
 		}
 	}
 
+	r.words.remember(ctx, word.ID, newCacheKey, 24*time.Hour)
+
 	return nil
 }
 
 func (r *WikiRedis) UpdateCachedPhrase(ctx context.Context, phrase *dto.WikiPhraseResponse) error {
-	if !status.GetRedisStatus() {
+	if !cacheAvailable(r.cache) {
 		return nil
 	}
 
@@ -195,7 +221,7 @@ func (r *WikiRedis) UpdateCachedPhrase(ctx context.Context, phrase *dto.WikiPhra
 	}
 
 	if err := r.cache.Set(ctx, newCacheKey, string(phraseJSON), 24*time.Hour); err != nil {
-		r.logger.Error("wiki_redis.update_phrase_cache", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("wiki_redis.update_phrase_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    phrase.ID,
 		}, "Failed to set new cache entry")
@@ -211,7 +237,7 @@ func (r *WikiRedis) UpdateCachedPhrase(ctx context.Context, phrase *dto.WikiPhra
 	for _, key := range keys {
 		if key != newCacheKey {
 			if err := r.cache.Delete(ctx, key); err != nil {
-				r.logger.Error("wiki_redis.update_phrase_cache.delete_old", map[string]interface{}{
+				r.logger.WithContext(ctx).Error("wiki_redis.update_phrase_cache.delete_old", map[string]interface{}{
 					"error": err.Error(),
 					"key":   key,
 				}, "Failed to delete old cache entry")
@@ -219,5 +245,7 @@ func (r *WikiRedis) UpdateCachedPhrase(ctx context.Context, phrase *dto.WikiPhra
 		}
 	}
 
+	r.phrases.remember(ctx, phrase.ID, newCacheKey, 24*time.Hour)
+
 	return nil
 }
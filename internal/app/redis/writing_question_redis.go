@@ -3,7 +3,6 @@ package redis
 import (
 	"context"
 	"encoding/json"
-	"fluencybe/internal/core/status"
 	"fluencybe/pkg/cache"
 	"fluencybe/pkg/logger"
 	"fmt"
@@ -15,23 +14,32 @@ import (
 )
 
 type WritingQuestionRedis struct {
-	cache  cache.Cache
-	logger *logger.PrettyLogger
+	cache   cache.Cache
+	logger  *logger.PrettyLogger
+	details *detailCache[writingDTO.WritingQuestionDetail]
 }
 
 func NewWritingQuestionRedis(cache cache.Cache, logger *logger.PrettyLogger) *WritingQuestionRedis {
-	return &WritingQuestionRedis{
+	r := &WritingQuestionRedis{
 		cache:  cache,
 		logger: logger,
 	}
+	r.details = newDetailCache(cache, logger, "writing_question", r.SetCacheWritingQuestionDetail)
+	return r
 }
 
 func (r *WritingQuestionRedis) GetCache() cache.Cache {
 	return r.cache
 }
 
+// GetWritingQuestionDetail reads a question through the cache, concurrent misses share one call to
+// load. It returns nil without an error when the question does not exist.
+func (r *WritingQuestionRedis) GetWritingQuestionDetail(ctx context.Context, id uuid.UUID, load DetailLoader[writingDTO.WritingQuestionDetail]) (*writingDTO.WritingQuestionDetail, error) {
+	return r.details.get(ctx, id, load)
+}
+
 func (r *WritingQuestionRedis) SetCacheWritingQuestionDetail(ctx context.Context, question *writingDTO.WritingQuestionDetail, isComplete bool) error {
-	if !status.GetRedisStatus() {
+	if !cacheAvailable(r.cache) {
 		return nil
 	}
 
@@ -42,13 +50,15 @@ func (r *WritingQuestionRedis) SetCacheWritingQuestionDetail(ctx context.Context
 	}
 
 	if err := r.cache.Set(ctx, cacheKey, string(questionJSON), 24*time.Hour); err != nil {
-		r.logger.Error("writing_question_redis.cache", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("writing_question_redis.cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to cache question")
 		return err
 	}
 
+	r.details.remember(ctx, question.ID, cacheKey, 24*time.Hour)
+
 	return nil
 }
 
@@ -61,7 +71,7 @@ func (r *WritingQuestionRedis) GenerateCacheKeyForWritingQuestion(id uuid.UUID,
 }
 
 func (r *WritingQuestionRedis) RemoveWritingQuestionCacheEntries(ctx context.Context, id uuid.UUID) error {
-	if !status.GetRedisStatus() {
+	if !cacheAvailable(r.cache) {
 		return nil
 	}
 
@@ -70,7 +80,7 @@ func (r *WritingQuestionRedis) RemoveWritingQuestionCacheEntries(ctx context.Con
 }
 
 func (r *WritingQuestionRedis) UpdateCachedWritingQuestion(ctx context.Context, question *writingDTO.WritingQuestionDetail, isComplete bool) error {
-	if !status.GetRedisStatus() {
+	if !cacheAvailable(r.cache) {
 		return nil
 	}
 
@@ -82,7 +92,7 @@ func (r *WritingQuestionRedis) UpdateCachedWritingQuestion(ctx context.Context,
 	}
 
 	if err := r.cache.Set(ctx, newCacheKey, string(questionJSON), 24*time.Hour); err != nil {
-		r.logger.Error("writing_question_redis.update_cache", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("writing_question_redis.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to set new cache entry")
@@ -98,7 +108,7 @@ func (r *WritingQuestionRedis) UpdateCachedWritingQuestion(ctx context.Context,
 	for _, key := range keys {
 		if key != newCacheKey {
 			if err := r.cache.Delete(ctx, key); err != nil {
-				r.logger.Error("writing_question_redis.update_cache.delete_old", map[string]interface{}{
+				r.logger.WithContext(ctx).Error("writing_question_redis.update_cache.delete_old", map[string]interface{}{
 					"error": err.Error(),
 					"key":   key,
 				}, "Failed to delete old cache entry")
@@ -106,5 +116,7 @@ func (r *WritingQuestionRedis) UpdateCachedWritingQuestion(ctx context.Context,
 		}
 	}
 
+	r.details.remember(ctx, question.ID, newCacheKey, 24*time.Hour)
+
 	return nil
 }
@@ -0,0 +1,186 @@
+package account
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"fluencybe/internal/app/model/account"
+	"fluencybe/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+var (
+	ErrAPIKeyNotFound = errors.New("api key not found")
+)
+
+type DeveloperAPIKeyRepository struct {
+	db     *sql.DB
+	logger *logger.PrettyLogger
+}
+
+func NewDeveloperAPIKeyRepository(db *sql.DB, logger *logger.PrettyLogger) *DeveloperAPIKeyRepository {
+	return &DeveloperAPIKeyRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const developerAPIKeyColumns = "id, developer_id, name, key_prefix, key_hash, scopes, last_used_at, expires_at, revoked_at, created_at"
+
+func scanDeveloperAPIKey(scanner interface{ Scan(...interface{}) error }) (*account.DeveloperAPIKey, error) {
+	key := &account.DeveloperAPIKey{}
+	err := scanner.Scan(
+		&key.ID,
+		&key.DeveloperID,
+		&key.Name,
+		&key.KeyPrefix,
+		&key.KeyHash,
+		pq.Array(&key.Scopes),
+		&key.LastUsedAt,
+		&key.ExpiresAt,
+		&key.RevokedAt,
+		&key.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func (r *DeveloperAPIKeyRepository) Create(ctx context.Context, key *account.DeveloperAPIKey) error {
+	query := `
+		INSERT INTO developer_api_keys (id, developer_id, name, key_prefix, key_hash, scopes, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	key.CreatedAt = time.Now().UTC()
+	_, err := r.db.ExecContext(ctx, query,
+		key.ID,
+		key.DeveloperID,
+		key.Name,
+		key.KeyPrefix,
+		key.KeyHash,
+		pq.Array(key.Scopes),
+		key.ExpiresAt,
+		key.CreatedAt,
+	)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("developer_api_key_repository.create", map[string]interface{}{
+			"error":        err.Error(),
+			"developer_id": key.DeveloperID,
+		}, "Failed to create api key")
+		return fmt.Errorf("failed to create api key: %w", err)
+	}
+	return nil
+}
+
+func (r *DeveloperAPIKeyRepository) GetByID(ctx context.Context, developerID, id uuid.UUID) (*account.DeveloperAPIKey, error) {
+	row := r.db.QueryRowContext(ctx,
+		"SELECT "+developerAPIKeyColumns+" FROM developer_api_keys WHERE id = $1 AND developer_id = $2",
+		id,
+		developerID,
+	)
+
+	key, err := scanDeveloperAPIKey(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrAPIKeyNotFound
+		}
+		r.logger.WithContext(ctx).Error("developer_api_key_repository.get_by_id", map[string]interface{}{
+			"error": err.Error(),
+			"id":    id,
+		}, "Failed to get api key")
+		return nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+	return key, nil
+}
+
+func (r *DeveloperAPIKeyRepository) ListByDeveloper(ctx context.Context, developerID uuid.UUID) ([]*account.DeveloperAPIKey, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+developerAPIKeyColumns+" FROM developer_api_keys WHERE developer_id = $1 ORDER BY created_at DESC",
+		developerID,
+	)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("developer_api_key_repository.list", map[string]interface{}{
+			"error":        err.Error(),
+			"developer_id": developerID,
+		}, "Failed to list api keys")
+		return nil, fmt.Errorf("failed to list api keys: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []*account.DeveloperAPIKey
+	for rows.Next() {
+		key, err := scanDeveloperAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan api key: %w", err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+func (r *DeveloperAPIKeyRepository) CountActive(ctx context.Context, developerID uuid.UUID) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM developer_api_keys WHERE developer_id = $1 AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > NOW())",
+		developerID,
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count api keys: %w", err)
+	}
+	return count, nil
+}
+
+// Rotate replaces the secret of a key in place, name, scopes and expiry are kept
+func (r *DeveloperAPIKeyRepository) Rotate(ctx context.Context, developerID, id uuid.UUID, keyPrefix, keyHash string) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE developer_api_keys SET key_prefix = $3, key_hash = $4, last_used_at = NULL
+		WHERE id = $1 AND developer_id = $2 AND revoked_at IS NULL
+	`, id, developerID, keyPrefix, keyHash)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("developer_api_key_repository.rotate", map[string]interface{}{
+			"error": err.Error(),
+			"id":    id,
+		}, "Failed to rotate api key")
+		return fmt.Errorf("failed to rotate api key: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+func (r *DeveloperAPIKeyRepository) Revoke(ctx context.Context, developerID, id uuid.UUID) error {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE developer_api_keys SET revoked_at = NOW() WHERE id = $1 AND developer_id = $2 AND revoked_at IS NULL",
+		id,
+		developerID,
+	)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("developer_api_key_repository.revoke", map[string]interface{}{
+			"error": err.Error(),
+			"id":    id,
+		}, "Failed to revoke api key")
+		return fmt.Errorf("failed to revoke api key: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrAPIKeyNotFound
+	}
+	return nil
+}
@@ -52,7 +52,7 @@ func (r *DeveloperRepository) Create(ctx context.Context, dev *account.Developer
 
 	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
 	if err != nil {
-		r.logger.Error("developer_repository.create.begin_transaction", map[string]interface{}{"error": err.Error()}, "Failed to begin transaction")
+		r.logger.WithContext(ctx).Error("developer_repository.create.begin_transaction", map[string]interface{}{"error": err.Error()}, "Failed to begin transaction")
 		return fmt.Errorf("database error: %w", err)
 	}
 	defer tx.Rollback()
@@ -77,7 +77,7 @@ func (r *DeveloperRepository) Create(ctx context.Context, dev *account.Developer
 			case "23505": // unique_violation
 				return ErrDeveloperDuplicateEmail
 			default:
-				r.logger.Error("developer_repository.create.exec", map[string]interface{}{
+				r.logger.WithContext(ctx).Error("developer_repository.create.exec", map[string]interface{}{
 					"error": err.Error(),
 					"code":  pqErr.Code,
 				}, "Database error")
@@ -87,14 +87,14 @@ func (r *DeveloperRepository) Create(ctx context.Context, dev *account.Developer
 	}
 
 	if err := tx.Commit(); err != nil {
-		r.logger.Error("developer_repository.create.commit", map[string]interface{}{"error": err.Error()}, "Failed to commit transaction")
+		r.logger.WithContext(ctx).Error("developer_repository.create.commit", map[string]interface{}{"error": err.Error()}, "Failed to commit transaction")
 		return fmt.Errorf("database error: %w", err)
 	}
 
 	// Cache the new developer
 	if jsonData, err := json.Marshal(dev); err == nil {
 		if err := r.cache.Set(ctx, r.getCacheKey(dev.ID), string(jsonData), developerCacheDuration); err != nil {
-			r.logger.Warning("developer_repository.create.cache", map[string]interface{}{"error": err.Error()}, "Failed to cache developer")
+			r.logger.WithContext(ctx).Warning("developer_repository.create.cache", map[string]interface{}{"error": err.Error()}, "Failed to cache developer")
 		}
 	}
 
@@ -130,14 +130,14 @@ func (r *DeveloperRepository) GetByID(ctx context.Context, id uuid.UUID) (*accou
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrDeveloperNotFound
 		}
-		r.logger.Error("developer_repository.get_by_id.scan", map[string]interface{}{"error": err.Error()}, "Failed to get developer")
+		r.logger.WithContext(ctx).Error("developer_repository.get_by_id.scan", map[string]interface{}{"error": err.Error()}, "Failed to get developer")
 		return nil, fmt.Errorf("database error: %w", err)
 	}
 
 	// Cache the developer
 	if jsonData, err := json.Marshal(&dev); err == nil {
 		if err := r.cache.Set(ctx, cacheKey, string(jsonData), developerCacheDuration); err != nil {
-			r.logger.Warning("developer_repository.get_by_id.cache", map[string]interface{}{"error": err.Error()}, "Failed to cache developer")
+			r.logger.WithContext(ctx).Warning("developer_repository.get_by_id.cache", map[string]interface{}{"error": err.Error()}, "Failed to cache developer")
 		}
 	}
 
@@ -164,14 +164,14 @@ func (r *DeveloperRepository) GetByEmail(ctx context.Context, email string) (*ac
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrDeveloperNotFound
 		}
-		r.logger.Error("developer_repository.get_by_email.scan", map[string]interface{}{"error": err.Error()}, "Failed to get developer")
+		r.logger.WithContext(ctx).Error("developer_repository.get_by_email.scan", map[string]interface{}{"error": err.Error()}, "Failed to get developer")
 		return nil, fmt.Errorf("database error: %w", err)
 	}
 
 	// Cache the developer
 	if jsonData, err := json.Marshal(&dev); err == nil {
 		if err := r.cache.Set(ctx, r.getCacheKey(dev.ID), string(jsonData), developerCacheDuration); err != nil {
-			r.logger.Warning("developer_repository.get_by_email.cache", map[string]interface{}{"error": err.Error()}, "Failed to cache developer")
+			r.logger.WithContext(ctx).Warning("developer_repository.get_by_email.cache", map[string]interface{}{"error": err.Error()}, "Failed to cache developer")
 		}
 	}
 
@@ -198,7 +198,7 @@ func (r *DeveloperRepository) Update(ctx context.Context, id uuid.UUID, updates
 
 	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
 	if err != nil {
-		r.logger.Error("developer_repository.update.begin_transaction", map[string]interface{}{"error": err.Error()}, "Failed to begin transaction")
+		r.logger.WithContext(ctx).Error("developer_repository.update.begin_transaction", map[string]interface{}{"error": err.Error()}, "Failed to begin transaction")
 		return fmt.Errorf("database error: %w", err)
 	}
 	defer tx.Rollback()
@@ -225,13 +225,13 @@ func (r *DeveloperRepository) Update(ctx context.Context, id uuid.UUID, updates
 		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
 			return ErrDeveloperDuplicateEmail
 		}
-		r.logger.Error("developer_repository.update.exec", map[string]interface{}{"error": err.Error()}, "Failed to update developer")
+		r.logger.WithContext(ctx).Error("developer_repository.update.exec", map[string]interface{}{"error": err.Error()}, "Failed to update developer")
 		return fmt.Errorf("database error: %w", err)
 	}
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		r.logger.Error("developer_repository.update.rows_affected", map[string]interface{}{"error": err.Error()}, "Failed to get rows affected")
+		r.logger.WithContext(ctx).Error("developer_repository.update.rows_affected", map[string]interface{}{"error": err.Error()}, "Failed to get rows affected")
 		return fmt.Errorf("database error: %w", err)
 	}
 
@@ -240,13 +240,13 @@ func (r *DeveloperRepository) Update(ctx context.Context, id uuid.UUID, updates
 	}
 
 	if err := tx.Commit(); err != nil {
-		r.logger.Error("developer_repository.update.commit", map[string]interface{}{"error": err.Error()}, "Failed to commit transaction")
+		r.logger.WithContext(ctx).Error("developer_repository.update.commit", map[string]interface{}{"error": err.Error()}, "Failed to commit transaction")
 		return fmt.Errorf("database error: %w", err)
 	}
 
 	// Invalidate cache
 	if err := r.cache.Delete(ctx, r.getCacheKey(id)); err != nil {
-		r.logger.Warning("developer_repository.update.cache_invalidate", map[string]interface{}{"error": err.Error()}, "Failed to invalidate cache")
+		r.logger.WithContext(ctx).Warning("developer_repository.update.cache_invalidate", map[string]interface{}{"error": err.Error()}, "Failed to invalidate cache")
 	}
 
 	return nil
@@ -255,7 +255,7 @@ func (r *DeveloperRepository) Update(ctx context.Context, id uuid.UUID, updates
 func (r *DeveloperRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
 	if err != nil {
-		r.logger.Error("developer_repository.delete.begin_transaction", map[string]interface{}{"error": err.Error()}, "Failed to begin transaction")
+		r.logger.WithContext(ctx).Error("developer_repository.delete.begin_transaction", map[string]interface{}{"error": err.Error()}, "Failed to begin transaction")
 		return fmt.Errorf("database error: %w", err)
 	}
 	defer tx.Rollback()
@@ -263,13 +263,13 @@ func (r *DeveloperRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	query := "DELETE FROM developers WHERE id = $1"
 	result, err := tx.ExecContext(ctx, query, id)
 	if err != nil {
-		r.logger.Error("developer_repository.delete.exec", map[string]interface{}{"error": err.Error()}, "Failed to delete developer")
+		r.logger.WithContext(ctx).Error("developer_repository.delete.exec", map[string]interface{}{"error": err.Error()}, "Failed to delete developer")
 		return fmt.Errorf("database error: %w", err)
 	}
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		r.logger.Error("developer_repository.delete.rows_affected", map[string]interface{}{"error": err.Error()}, "Failed to get rows affected")
+		r.logger.WithContext(ctx).Error("developer_repository.delete.rows_affected", map[string]interface{}{"error": err.Error()}, "Failed to get rows affected")
 		return fmt.Errorf("database error: %w", err)
 	}
 
@@ -278,13 +278,13 @@ func (r *DeveloperRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	}
 
 	if err := tx.Commit(); err != nil {
-		r.logger.Error("developer_repository.delete.commit", map[string]interface{}{"error": err.Error()}, "Failed to commit transaction")
+		r.logger.WithContext(ctx).Error("developer_repository.delete.commit", map[string]interface{}{"error": err.Error()}, "Failed to commit transaction")
 		return fmt.Errorf("database error: %w", err)
 	}
 
 	// Invalidate cache
 	if err := r.cache.Delete(ctx, r.getCacheKey(id)); err != nil {
-		r.logger.Warning("developer_repository.delete.cache_invalidate", map[string]interface{}{"error": err.Error()}, "Failed to invalidate cache")
+		r.logger.WithContext(ctx).Warning("developer_repository.delete.cache_invalidate", map[string]interface{}{"error": err.Error()}, "Failed to invalidate cache")
 	}
 
 	return nil
@@ -300,7 +300,7 @@ func (r *DeveloperRepository) GetList(ctx context.Context, limit int, offset int
 
 	rows, err := r.db.QueryContext(ctx, query, limit, offset)
 	if err != nil {
-		r.logger.Error("developer_repository.get_list.query", map[string]interface{}{"error": err.Error()}, "Failed to get developer list")
+		r.logger.WithContext(ctx).Error("developer_repository.get_list.query", map[string]interface{}{"error": err.Error()}, "Failed to get developer list")
 		return nil, fmt.Errorf("database error: %w", err)
 	}
 	defer rows.Close()
@@ -317,7 +317,7 @@ func (r *DeveloperRepository) GetList(ctx context.Context, limit int, offset int
 			&dev.UpdatedAt,
 		)
 		if err != nil {
-			r.logger.Error("developer_repository.get_list.scan", map[string]interface{}{"error": err.Error()}, "Failed to scan developer")
+			r.logger.WithContext(ctx).Error("developer_repository.get_list.scan", map[string]interface{}{"error": err.Error()}, "Failed to scan developer")
 			return nil, fmt.Errorf("database error: %w", err)
 		}
 		developers = append(developers, &dev)
@@ -325,13 +325,13 @@ func (r *DeveloperRepository) GetList(ctx context.Context, limit int, offset int
 		// Cache each developer
 		if jsonData, err := json.Marshal(&dev); err == nil {
 			if err := r.cache.Set(ctx, r.getCacheKey(dev.ID), string(jsonData), developerCacheDuration); err != nil {
-				r.logger.Warning("developer_repository.get_list.cache", map[string]interface{}{"error": err.Error()}, "Failed to cache developer")
+				r.logger.WithContext(ctx).Warning("developer_repository.get_list.cache", map[string]interface{}{"error": err.Error()}, "Failed to cache developer")
 			}
 		}
 	}
 
 	if err = rows.Err(); err != nil {
-		r.logger.Error("developer_repository.get_list.rows_err", map[string]interface{}{"error": err.Error()}, "Error iterating over rows")
+		r.logger.WithContext(ctx).Error("developer_repository.get_list.rows_err", map[string]interface{}{"error": err.Error()}, "Error iterating over rows")
 		return nil, fmt.Errorf("database error: %w", err)
 	}
 
@@ -353,14 +353,14 @@ func (r *DeveloperRepository) GetTotalCount(ctx context.Context) (int64, error)
 	var count int64
 	err := r.db.QueryRowContext(ctx, query).Scan(&count)
 	if err != nil {
-		r.logger.Error("developer_repository.get_total_count.query", map[string]interface{}{"error": err.Error()}, "Failed to get total count")
+		r.logger.WithContext(ctx).Error("developer_repository.get_total_count.query", map[string]interface{}{"error": err.Error()}, "Failed to get total count")
 		return 0, fmt.Errorf("database error: %w", err)
 	}
 
 	// Cache the count
 	if jsonData, err := json.Marshal(count); err == nil {
 		if err := r.cache.Set(ctx, cacheKey, string(jsonData), developerCacheDuration); err != nil {
-			r.logger.Warning("developer_repository.get_total_count.cache", map[string]interface{}{"error": err.Error()}, "Failed to cache total count")
+			r.logger.WithContext(ctx).Warning("developer_repository.get_total_count.cache", map[string]interface{}{"error": err.Error()}, "Failed to cache total count")
 		}
 	}
 
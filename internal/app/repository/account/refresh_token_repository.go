@@ -0,0 +1,197 @@
+package account
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"fluencybe/internal/app/model/account"
+	"fluencybe/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrRefreshTokenNotFound = errors.New("refresh token not found")
+)
+
+type RefreshTokenRepository struct {
+	db     *sql.DB
+	logger *logger.PrettyLogger
+}
+
+func NewRefreshTokenRepository(db *sql.DB, logger *logger.PrettyLogger) *RefreshTokenRepository {
+	return &RefreshTokenRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *RefreshTokenRepository) Create(ctx context.Context, token *account.RefreshToken) error {
+	query := `
+		INSERT INTO refresh_tokens (id, session_id, subject_id, role, token_hash, user_agent, ip_address, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	token.CreatedAt = time.Now().UTC()
+	_, err := r.db.ExecContext(ctx, query,
+		token.ID,
+		token.SessionID,
+		token.SubjectID,
+		token.Role,
+		token.TokenHash,
+		token.UserAgent,
+		token.IPAddress,
+		token.ExpiresAt,
+		token.CreatedAt,
+	)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("refresh_token_repository.create", map[string]interface{}{
+			"error":      err.Error(),
+			"session_id": token.SessionID,
+		}, "Failed to create refresh token")
+		return fmt.Errorf("failed to create refresh token: %w", err)
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*account.RefreshToken, error) {
+	query := `
+		SELECT id, session_id, subject_id, role, token_hash, user_agent, ip_address, expires_at, revoked_at, created_at
+		FROM refresh_tokens
+		WHERE token_hash = $1
+	`
+
+	token := &account.RefreshToken{}
+	var revokedAt sql.NullTime
+	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(
+		&token.ID,
+		&token.SessionID,
+		&token.SubjectID,
+		&token.Role,
+		&token.TokenHash,
+		&token.UserAgent,
+		&token.IPAddress,
+		&token.ExpiresAt,
+		&revokedAt,
+		&token.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrRefreshTokenNotFound
+		}
+		r.logger.WithContext(ctx).Error("refresh_token_repository.get_by_hash", map[string]interface{}{
+			"error": err.Error(),
+		}, "Failed to get refresh token")
+		return nil, fmt.Errorf("failed to get refresh token: %w", err)
+	}
+
+	if revokedAt.Valid {
+		token.RevokedAt = &revokedAt.Time
+	}
+
+	return token, nil
+}
+
+// Rotate revokes the presented token and stores its successor atomically,
+// it returns ErrRefreshTokenNotFound when the token was already used concurrently
+func (r *RefreshTokenRepository) Rotate(ctx context.Context, oldID uuid.UUID, next *account.RefreshToken) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx,
+		"UPDATE refresh_tokens SET revoked_at = NOW() WHERE id = $1 AND revoked_at IS NULL",
+		oldID,
+	)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("refresh_token_repository.rotate.revoke", map[string]interface{}{
+			"error": err.Error(),
+			"id":    oldID,
+		}, "Failed to revoke refresh token")
+		return fmt.Errorf("failed to revoke refresh token: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrRefreshTokenNotFound
+	}
+
+	next.CreatedAt = time.Now().UTC()
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO refresh_tokens (id, session_id, subject_id, role, token_hash, user_agent, ip_address, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`,
+		next.ID,
+		next.SessionID,
+		next.SubjectID,
+		next.Role,
+		next.TokenHash,
+		next.UserAgent,
+		next.IPAddress,
+		next.ExpiresAt,
+		next.CreatedAt,
+	)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("refresh_token_repository.rotate.insert", map[string]interface{}{
+			"error":      err.Error(),
+			"session_id": next.SessionID,
+		}, "Failed to store rotated refresh token")
+		return fmt.Errorf("failed to store refresh token: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepository) RevokeSession(ctx context.Context, sessionID uuid.UUID) error {
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE refresh_tokens SET revoked_at = NOW() WHERE session_id = $1 AND revoked_at IS NULL",
+		sessionID,
+	)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("refresh_token_repository.revoke_session", map[string]interface{}{
+			"error":      err.Error(),
+			"session_id": sessionID,
+		}, "Failed to revoke session")
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+	return nil
+}
+
+func (r *RefreshTokenRepository) RevokeAllForSubject(ctx context.Context, subjectID uuid.UUID, role string) error {
+	_, err := r.db.ExecContext(ctx,
+		"UPDATE refresh_tokens SET revoked_at = NOW() WHERE subject_id = $1 AND role = $2 AND revoked_at IS NULL",
+		subjectID,
+		role,
+	)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("refresh_token_repository.revoke_all", map[string]interface{}{
+			"error":      err.Error(),
+			"subject_id": subjectID,
+			"role":       role,
+		}, "Failed to revoke all sessions")
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+	return nil
+}
+
+// DeleteExpired removes tokens that can no longer be used so the table does not grow forever
+func (r *RefreshTokenRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM refresh_tokens WHERE expires_at < NOW()")
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired refresh tokens: %w", err)
+	}
+	return result.RowsAffected()
+}
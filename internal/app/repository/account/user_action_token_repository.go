@@ -0,0 +1,114 @@
+package account
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"fluencybe/internal/app/model/account"
+	"fluencybe/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrActionTokenInvalid = errors.New("token is invalid, expired or already used")
+)
+
+type UserActionTokenRepository struct {
+	db     *sql.DB
+	logger *logger.PrettyLogger
+}
+
+func NewUserActionTokenRepository(db *sql.DB, logger *logger.PrettyLogger) *UserActionTokenRepository {
+	return &UserActionTokenRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Replace invalidates the outstanding tokens of the same purpose and stores the new one,
+// so only the latest emailed link works
+func (r *UserActionTokenRepository) Replace(ctx context.Context, token *account.UserActionToken) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE user_action_tokens SET used_at = NOW() WHERE user_id = $1 AND purpose = $2 AND used_at IS NULL",
+		token.UserID,
+		token.Purpose,
+	)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("user_action_token_repository.replace.invalidate", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": token.UserID,
+			"purpose": token.Purpose,
+		}, "Failed to invalidate previous tokens")
+		return fmt.Errorf("failed to invalidate previous tokens: %w", err)
+	}
+
+	if token.ID == uuid.Nil {
+		token.ID = uuid.New()
+	}
+	token.CreatedAt = time.Now().UTC()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO user_action_tokens (id, user_id, purpose, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`,
+		token.ID,
+		token.UserID,
+		token.Purpose,
+		token.TokenHash,
+		token.ExpiresAt,
+		token.CreatedAt,
+	)
+	if err != nil {
+		r.logger.WithContext(ctx).Error("user_action_token_repository.replace.insert", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": token.UserID,
+			"purpose": token.Purpose,
+		}, "Failed to store token")
+		return fmt.Errorf("failed to store token: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// Consume marks a valid token as used and returns its user, a token can only be consumed once
+func (r *UserActionTokenRepository) Consume(ctx context.Context, tokenHash string, purpose string) (uuid.UUID, error) {
+	var userID uuid.UUID
+	err := r.db.QueryRowContext(ctx, `
+		UPDATE user_action_tokens SET used_at = NOW()
+		WHERE token_hash = $1 AND purpose = $2 AND used_at IS NULL AND expires_at > NOW()
+		RETURNING user_id
+	`, tokenHash, purpose).Scan(&userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return uuid.Nil, ErrActionTokenInvalid
+		}
+		r.logger.WithContext(ctx).Error("user_action_token_repository.consume", map[string]interface{}{
+			"error":   err.Error(),
+			"purpose": purpose,
+		}, "Failed to consume token")
+		return uuid.Nil, fmt.Errorf("failed to consume token: %w", err)
+	}
+	return userID, nil
+}
+
+// DeleteExpired removes used and expired tokens, neither can be consumed anymore
+func (r *UserActionTokenRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM user_action_tokens WHERE used_at IS NOT NULL OR expires_at < NOW()")
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired tokens: %w", err)
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,85 @@
+package account
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"fluencybe/internal/app/model/account"
+	"fluencybe/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+var (
+	ErrIdentityNotFound  = errors.New("identity not found")
+	ErrIdentityDuplicate = errors.New("identity already linked")
+)
+
+type UserIdentityRepository struct {
+	db     *sql.DB
+	logger *logger.PrettyLogger
+}
+
+func NewUserIdentityRepository(db *sql.DB, logger *logger.PrettyLogger) *UserIdentityRepository {
+	return &UserIdentityRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *UserIdentityRepository) GetUserID(ctx context.Context, provider, subject string) (uuid.UUID, error) {
+	var userID uuid.UUID
+	err := r.db.QueryRowContext(ctx,
+		"SELECT user_id FROM user_identities WHERE provider = $1 AND provider_subject = $2",
+		provider,
+		subject,
+	).Scan(&userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return uuid.Nil, ErrIdentityNotFound
+		}
+		r.logger.WithContext(ctx).Error("user_identity_repository.get_user_id", map[string]interface{}{
+			"error":    err.Error(),
+			"provider": provider,
+		}, "Failed to get linked identity")
+		return uuid.Nil, fmt.Errorf("failed to get identity: %w", err)
+	}
+	return userID, nil
+}
+
+func (r *UserIdentityRepository) Link(ctx context.Context, identity *account.UserIdentity) error {
+	query := `
+		INSERT INTO user_identities (id, user_id, provider, provider_subject, email, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	if identity.ID == uuid.Nil {
+		identity.ID = uuid.New()
+	}
+	identity.CreatedAt = time.Now().UTC()
+
+	_, err := r.db.ExecContext(ctx, query,
+		identity.ID,
+		identity.UserID,
+		identity.Provider,
+		identity.ProviderSubject,
+		identity.Email,
+		identity.CreatedAt,
+	)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return ErrIdentityDuplicate
+		}
+		r.logger.WithContext(ctx).Error("user_identity_repository.link", map[string]interface{}{
+			"error":    err.Error(),
+			"user_id":  identity.UserID,
+			"provider": identity.Provider,
+		}, "Failed to link identity")
+		return fmt.Errorf("failed to link identity: %w", err)
+	}
+	return nil
+}
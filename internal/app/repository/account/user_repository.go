@@ -19,22 +19,24 @@ import (
 
 // SafeUser là struct để lưu cache, không chứa password
 type SafeUser struct {
-	ID        uuid.UUID `json:"id"`
-	Email     string    `json:"email"`
-	Username  string    `json:"username"`
-	Type      string    `json:"type"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID              uuid.UUID  `json:"id"`
+	Email           string     `json:"email"`
+	Username        string     `json:"username"`
+	Type            string     `json:"type"`
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
 }
 
 func toSafeUser(user *account.User) *SafeUser {
 	return &SafeUser{
-		ID:        user.ID,
-		Email:     user.Email,
-		Username:  user.Username,
-		Type:      user.Type,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
+		ID:              user.ID,
+		Email:           user.Email,
+		Username:        user.Username,
+		Type:            user.Type,
+		EmailVerifiedAt: user.EmailVerifiedAt,
+		CreatedAt:       user.CreatedAt,
+		UpdatedAt:       user.UpdatedAt,
 	}
 }
 
@@ -79,19 +81,19 @@ func (r *UserRepository) tryCache(operation func() error) {
 }
 
 func (r *UserRepository) Create(ctx context.Context, user *account.User) error {
-	r.logger.Info("create_user_start", map[string]interface{}{
+	r.logger.WithContext(ctx).Info("create_user_start", map[string]interface{}{
 		"email": user.Email,
 	}, "Starting to create user in database")
 
 	if user == nil {
-		r.logger.Error("create_user_invalid_input", nil, "User object is nil")
+		r.logger.WithContext(ctx).Error("create_user_invalid_input", nil, "User object is nil")
 		return ErrUserInvalidInput
 	}
 
 	// Start a transaction
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		r.logger.Error("create_user_tx_begin_failed", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("create_user_tx_begin_failed", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to begin transaction")
 		return fmt.Errorf("failed to begin transaction: %v", err)
@@ -99,15 +101,15 @@ func (r *UserRepository) Create(ctx context.Context, user *account.User) error {
 
 	// Prepare the insert statement
 	query := `
-		INSERT INTO users (id, email, username, password, type, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO users (id, email, username, password, type, email_verified_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 	`
 
 	now := time.Now()
 	user.CreatedAt = now
 	user.UpdatedAt = now
 
-	r.logger.Debug("create_user_execute", map[string]interface{}{
+	r.logger.WithContext(ctx).Debug("create_user_execute", map[string]interface{}{
 		"user_id": user.ID.String(),
 		"email":   user.Email,
 	}, "Executing create user query")
@@ -119,6 +121,7 @@ func (r *UserRepository) Create(ctx context.Context, user *account.User) error {
 		user.Username,
 		user.Password,
 		user.Type,
+		user.EmailVerifiedAt,
 		user.CreatedAt,
 		user.UpdatedAt,
 	)
@@ -126,20 +129,20 @@ func (r *UserRepository) Create(ctx context.Context, user *account.User) error {
 	if err != nil {
 		// Rollback the transaction
 		if rbErr := tx.Rollback(); rbErr != nil {
-			r.logger.Error("create_user_rollback_failed", map[string]interface{}{
+			r.logger.WithContext(ctx).Error("create_user_rollback_failed", map[string]interface{}{
 				"error": rbErr.Error(),
 			}, "Failed to rollback transaction")
 		}
 
 		// Check for duplicate email
 		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
-			r.logger.Error("create_user_duplicate_email", map[string]interface{}{
+			r.logger.WithContext(ctx).Error("create_user_duplicate_email", map[string]interface{}{
 				"email": user.Email,
 			}, "Duplicate email address")
 			return ErrUserDuplicateEmail
 		}
 
-		r.logger.Error("create_user_failed", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("create_user_failed", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create user")
 		return fmt.Errorf("failed to create user: %v", err)
@@ -147,13 +150,13 @@ func (r *UserRepository) Create(ctx context.Context, user *account.User) error {
 
 	// Commit the transaction
 	if err = tx.Commit(); err != nil {
-		r.logger.Error("create_user_commit_failed", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("create_user_commit_failed", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to commit transaction")
 		return fmt.Errorf("failed to commit transaction: %v", err)
 	}
 
-	r.logger.Info("create_user_success", map[string]interface{}{
+	r.logger.WithContext(ctx).Info("create_user_success", map[string]interface{}{
 		"user_id": user.ID.String(),
 		"email":   user.Email,
 	}, "User created successfully")
@@ -172,7 +175,7 @@ func (r *UserRepository) Create(ctx context.Context, user *account.User) error {
 }
 
 func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (*account.User, error) {
-	r.logger.Info("get_user_by_id_start", map[string]interface{}{
+	r.logger.WithContext(ctx).Info("get_user_by_id_start", map[string]interface{}{
 		"user_id": id.String(),
 	}, "Starting to get user by ID")
 
@@ -183,30 +186,31 @@ func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (*account.Us
 		cachedData, err := r.cache.Get(ctx, cacheKey)
 		if err == nil {
 			if err := json.Unmarshal([]byte(cachedData), &safeUser); err == nil {
-				r.logger.Debug("get_user_by_id_cache_hit", map[string]interface{}{
+				r.logger.WithContext(ctx).Debug("get_user_by_id_cache_hit", map[string]interface{}{
 					"user_id": id.String(),
 				}, "User found in cache")
 
 				// Convert SafeUser to User
 				return &account.User{
-					ID:        safeUser.ID,
-					Email:     safeUser.Email,
-					Username:  safeUser.Username,
-					Type:      safeUser.Type,
-					CreatedAt: safeUser.CreatedAt,
-					UpdatedAt: safeUser.UpdatedAt,
+					ID:              safeUser.ID,
+					Email:           safeUser.Email,
+					Username:        safeUser.Username,
+					Type:            safeUser.Type,
+					EmailVerifiedAt: safeUser.EmailVerifiedAt,
+					CreatedAt:       safeUser.CreatedAt,
+					UpdatedAt:       safeUser.UpdatedAt,
 				}, nil
 			}
 		}
 	}
 
-	r.logger.Debug("get_user_by_id_cache_miss", map[string]interface{}{
+	r.logger.WithContext(ctx).Debug("get_user_by_id_cache_miss", map[string]interface{}{
 		"user_id": id.String(),
 	}, "User not found in cache, querying database")
 
 	// Query the database
 	query := `
-		SELECT id, email, username, password, type, created_at, updated_at
+		SELECT id, email, username, password, type, email_verified_at, created_at, updated_at
 		FROM users
 		WHERE id = $1
 	`
@@ -218,25 +222,26 @@ func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (*account.Us
 		&user.Username,
 		&user.Password,
 		&user.Type,
+		&user.EmailVerifiedAt,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
-			r.logger.Error("get_user_by_id_not_found", map[string]interface{}{
+			r.logger.WithContext(ctx).Error("get_user_by_id_not_found", map[string]interface{}{
 				"user_id": id.String(),
 			}, "User not found")
 			return nil, ErrUserNotFound
 		}
-		r.logger.Error("get_user_by_id_failed", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("get_user_by_id_failed", map[string]interface{}{
 			"error":   err.Error(),
 			"user_id": id.String(),
 		}, "Failed to get user")
 		return nil, fmt.Errorf("failed to get user: %v", err)
 	}
 
-	r.logger.Info("get_user_by_id_success", map[string]interface{}{
+	r.logger.WithContext(ctx).Info("get_user_by_id_success", map[string]interface{}{
 		"user_id": id.String(),
 	}, "User retrieved successfully")
 
@@ -254,12 +259,12 @@ func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (*account.Us
 }
 
 func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*account.User, error) {
-	r.logger.Info("get_user_by_email_start", map[string]interface{}{
+	r.logger.WithContext(ctx).Info("get_user_by_email_start", map[string]interface{}{
 		"email": email,
 	}, "Starting to get user by email")
 
 	query := `
-		SELECT id, email, username, password, type, created_at, updated_at
+		SELECT id, email, username, password, type, email_verified_at, created_at, updated_at
 		FROM users
 		WHERE email = $1
 	`
@@ -271,25 +276,26 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*account
 		&user.Username,
 		&user.Password,
 		&user.Type,
+		&user.EmailVerifiedAt,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
-			r.logger.Error("get_user_by_email_not_found", map[string]interface{}{
+			r.logger.WithContext(ctx).Error("get_user_by_email_not_found", map[string]interface{}{
 				"email": email,
 			}, "User not found")
 			return nil, ErrUserNotFound
 		}
-		r.logger.Error("get_user_by_email_failed", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("get_user_by_email_failed", map[string]interface{}{
 			"error": err.Error(),
 			"email": email,
 		}, "Failed to get user by email")
 		return nil, fmt.Errorf("failed to get user: %v", err)
 	}
 
-	r.logger.Info("get_user_by_email_success", map[string]interface{}{
+	r.logger.WithContext(ctx).Info("get_user_by_email_success", map[string]interface{}{
 		"email":   email,
 		"user_id": user.ID.String(),
 	}, "User retrieved successfully")
@@ -298,12 +304,12 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*account
 }
 
 func (r *UserRepository) Update(ctx context.Context, id uuid.UUID, updates map[string]interface{}) error {
-	r.logger.Info("update_user_start", map[string]interface{}{
+	r.logger.WithContext(ctx).Info("update_user_start", map[string]interface{}{
 		"user_id": id.String(),
 	}, "Starting to update user")
 
 	if len(updates) == 0 {
-		r.logger.Warning("update_user_no_updates", map[string]interface{}{
+		r.logger.WithContext(ctx).Warning("update_user_no_updates", map[string]interface{}{
 			"user_id": id.String(),
 		}, "No updates provided")
 		return nil
@@ -312,7 +318,7 @@ func (r *UserRepository) Update(ctx context.Context, id uuid.UUID, updates map[s
 	// Start transaction
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		r.logger.Error("update_user_tx_begin_failed", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("update_user_tx_begin_failed", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to begin transaction")
 		return fmt.Errorf("failed to begin transaction: %v", err)
@@ -330,7 +336,7 @@ func (r *UserRepository) Update(ctx context.Context, id uuid.UUID, updates map[s
 	}
 	query += " WHERE id = $1"
 
-	r.logger.Debug("update_user_execute", map[string]interface{}{
+	r.logger.WithContext(ctx).Debug("update_user_execute", map[string]interface{}{
 		"user_id": id.String(),
 		"updates": updates,
 	}, "Executing update user query")
@@ -339,19 +345,19 @@ func (r *UserRepository) Update(ctx context.Context, id uuid.UUID, updates map[s
 	result, err := tx.ExecContext(ctx, query, values...)
 	if err != nil {
 		if rbErr := tx.Rollback(); rbErr != nil {
-			r.logger.Error("update_user_rollback_failed", map[string]interface{}{
+			r.logger.WithContext(ctx).Error("update_user_rollback_failed", map[string]interface{}{
 				"error": rbErr.Error(),
 			}, "Failed to rollback transaction")
 		}
 
 		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
-			r.logger.Error("update_user_duplicate_email", map[string]interface{}{
+			r.logger.WithContext(ctx).Error("update_user_duplicate_email", map[string]interface{}{
 				"user_id": id.String(),
 			}, "Duplicate email address")
 			return ErrUserDuplicateEmail
 		}
 
-		r.logger.Error("update_user_failed", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("update_user_failed", map[string]interface{}{
 			"error":   err.Error(),
 			"user_id": id.String(),
 		}, "Failed to update user")
@@ -362,11 +368,11 @@ func (r *UserRepository) Update(ctx context.Context, id uuid.UUID, updates map[s
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
 		if rbErr := tx.Rollback(); rbErr != nil {
-			r.logger.Error("update_user_rollback_failed", map[string]interface{}{
+			r.logger.WithContext(ctx).Error("update_user_rollback_failed", map[string]interface{}{
 				"error": rbErr.Error(),
 			}, "Failed to rollback transaction")
 		}
-		r.logger.Error("update_user_rows_affected_failed", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("update_user_rows_affected_failed", map[string]interface{}{
 			"error":   err.Error(),
 			"user_id": id.String(),
 		}, "Failed to get rows affected")
@@ -375,11 +381,11 @@ func (r *UserRepository) Update(ctx context.Context, id uuid.UUID, updates map[s
 
 	if rowsAffected == 0 {
 		if rbErr := tx.Rollback(); rbErr != nil {
-			r.logger.Error("update_user_rollback_failed", map[string]interface{}{
+			r.logger.WithContext(ctx).Error("update_user_rollback_failed", map[string]interface{}{
 				"error": rbErr.Error(),
 			}, "Failed to rollback transaction")
 		}
-		r.logger.Error("update_user_not_found", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("update_user_not_found", map[string]interface{}{
 			"user_id": id.String(),
 		}, "User not found")
 		return ErrUserNotFound
@@ -387,13 +393,13 @@ func (r *UserRepository) Update(ctx context.Context, id uuid.UUID, updates map[s
 
 	// Commit the transaction
 	if err = tx.Commit(); err != nil {
-		r.logger.Error("update_user_commit_failed", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("update_user_commit_failed", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to commit transaction")
 		return fmt.Errorf("failed to commit transaction: %v", err)
 	}
 
-	r.logger.Info("update_user_success", map[string]interface{}{
+	r.logger.WithContext(ctx).Info("update_user_success", map[string]interface{}{
 		"user_id": id.String(),
 	}, "User updated successfully")
 
@@ -406,14 +412,14 @@ func (r *UserRepository) Update(ctx context.Context, id uuid.UUID, updates map[s
 }
 
 func (r *UserRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	r.logger.Info("delete_user_start", map[string]interface{}{
+	r.logger.WithContext(ctx).Info("delete_user_start", map[string]interface{}{
 		"user_id": id.String(),
 	}, "Starting to delete user")
 
 	// Start transaction
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		r.logger.Error("delete_user_tx_begin_failed", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("delete_user_tx_begin_failed", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to begin transaction")
 		return fmt.Errorf("failed to begin transaction: %v", err)
@@ -421,19 +427,19 @@ func (r *UserRepository) Delete(ctx context.Context, id uuid.UUID) error {
 
 	query := "DELETE FROM users WHERE id = $1"
 
-	r.logger.Debug("delete_user_execute", map[string]interface{}{
+	r.logger.WithContext(ctx).Debug("delete_user_execute", map[string]interface{}{
 		"user_id": id.String(),
 	}, "Executing delete user query")
 
 	result, err := tx.ExecContext(ctx, query, id)
 	if err != nil {
 		if rbErr := tx.Rollback(); rbErr != nil {
-			r.logger.Error("delete_user_rollback_failed", map[string]interface{}{
+			r.logger.WithContext(ctx).Error("delete_user_rollback_failed", map[string]interface{}{
 				"error": rbErr.Error(),
 			}, "Failed to rollback transaction")
 		}
 
-		r.logger.Error("delete_user_failed", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("delete_user_failed", map[string]interface{}{
 			"error":   err.Error(),
 			"user_id": id.String(),
 		}, "Failed to delete user")
@@ -443,11 +449,11 @@ func (r *UserRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
 		if rbErr := tx.Rollback(); rbErr != nil {
-			r.logger.Error("delete_user_rollback_failed", map[string]interface{}{
+			r.logger.WithContext(ctx).Error("delete_user_rollback_failed", map[string]interface{}{
 				"error": rbErr.Error(),
 			}, "Failed to rollback transaction")
 		}
-		r.logger.Error("delete_user_rows_affected_failed", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("delete_user_rows_affected_failed", map[string]interface{}{
 			"error":   err.Error(),
 			"user_id": id.String(),
 		}, "Failed to get rows affected")
@@ -456,11 +462,11 @@ func (r *UserRepository) Delete(ctx context.Context, id uuid.UUID) error {
 
 	if rowsAffected == 0 {
 		if rbErr := tx.Rollback(); rbErr != nil {
-			r.logger.Error("delete_user_rollback_failed", map[string]interface{}{
+			r.logger.WithContext(ctx).Error("delete_user_rollback_failed", map[string]interface{}{
 				"error": rbErr.Error(),
 			}, "Failed to rollback transaction")
 		}
-		r.logger.Error("delete_user_not_found", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("delete_user_not_found", map[string]interface{}{
 			"user_id": id.String(),
 		}, "User not found")
 		return ErrUserNotFound
@@ -468,13 +474,13 @@ func (r *UserRepository) Delete(ctx context.Context, id uuid.UUID) error {
 
 	// Commit the transaction
 	if err = tx.Commit(); err != nil {
-		r.logger.Error("delete_user_commit_failed", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("delete_user_commit_failed", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to commit transaction")
 		return fmt.Errorf("failed to commit transaction: %v", err)
 	}
 
-	r.logger.Info("delete_user_success", map[string]interface{}{
+	r.logger.WithContext(ctx).Info("delete_user_success", map[string]interface{}{
 		"user_id": id.String(),
 	}, "User deleted successfully")
 
@@ -487,26 +493,26 @@ func (r *UserRepository) Delete(ctx context.Context, id uuid.UUID) error {
 }
 
 func (r *UserRepository) GetList(ctx context.Context, limit int, offset int) ([]*account.User, error) {
-	r.logger.Info("get_user_list_start", map[string]interface{}{
+	r.logger.WithContext(ctx).Info("get_user_list_start", map[string]interface{}{
 		"limit":  limit,
 		"offset": offset,
 	}, "Starting to get list of users")
 
 	query := `
-		SELECT id, email, username, password, type, created_at, updated_at
+		SELECT id, email, username, password, type, email_verified_at, created_at, updated_at
 		FROM users
 		ORDER BY created_at DESC
 		LIMIT $1 OFFSET $2
 	`
 
-	r.logger.Debug("get_user_list_execute", map[string]interface{}{
+	r.logger.WithContext(ctx).Debug("get_user_list_execute", map[string]interface{}{
 		"limit":  limit,
 		"offset": offset,
 	}, "Executing get user list query")
 
 	rows, err := r.db.QueryContext(ctx, query, limit, offset)
 	if err != nil {
-		r.logger.Error("get_user_list_failed", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("get_user_list_failed", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get list of users")
 		return nil, fmt.Errorf("failed to get users: %v", err)
@@ -522,11 +528,12 @@ func (r *UserRepository) GetList(ctx context.Context, limit int, offset int) ([]
 			&user.Username,
 			&user.Password,
 			&user.Type,
+			&user.EmailVerifiedAt,
 			&user.CreatedAt,
 			&user.UpdatedAt,
 		)
 		if err != nil {
-			r.logger.Error("get_user_list_scan_failed", map[string]interface{}{
+			r.logger.WithContext(ctx).Error("get_user_list_scan_failed", map[string]interface{}{
 				"error": err.Error(),
 			}, "Failed to scan user row")
 			return nil, fmt.Errorf("failed to scan user: %v", err)
@@ -535,13 +542,13 @@ func (r *UserRepository) GetList(ctx context.Context, limit int, offset int) ([]
 	}
 
 	if err = rows.Err(); err != nil {
-		r.logger.Error("get_user_list_rows_failed", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("get_user_list_rows_failed", map[string]interface{}{
 			"error": err.Error(),
 		}, "Error occurred while iterating rows")
 		return nil, fmt.Errorf("error occurred while iterating rows: %v", err)
 	}
 
-	r.logger.Info("get_user_list_success", map[string]interface{}{
+	r.logger.WithContext(ctx).Info("get_user_list_success", map[string]interface{}{
 		"count": len(users),
 	}, "User list retrieved successfully")
 
@@ -549,22 +556,22 @@ func (r *UserRepository) GetList(ctx context.Context, limit int, offset int) ([]
 }
 
 func (r *UserRepository) GetTotalCount(ctx context.Context) (int64, error) {
-	r.logger.Info("get_total_count_start", nil, "Starting to get total user count")
+	r.logger.WithContext(ctx).Info("get_total_count_start", nil, "Starting to get total user count")
 
 	var count int64
 	query := "SELECT COUNT(*) FROM users"
 
-	r.logger.Debug("get_total_count_execute", nil, "Executing get total count query")
+	r.logger.WithContext(ctx).Debug("get_total_count_execute", nil, "Executing get total count query")
 
 	err := r.db.QueryRowContext(ctx, query).Scan(&count)
 	if err != nil {
-		r.logger.Error("get_total_count_failed", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("get_total_count_failed", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get total user count")
 		return 0, fmt.Errorf("failed to get total count: %v", err)
 	}
 
-	r.logger.Info("get_total_count_success", map[string]interface{}{
+	r.logger.WithContext(ctx).Info("get_total_count_success", map[string]interface{}{
 		"count": count,
 	}, "Total user count retrieved successfully")
 
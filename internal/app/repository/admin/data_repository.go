@@ -0,0 +1,227 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"fluencybe/pkg/logger"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+var ErrUnknownTable = errors.New("table is not part of a data set")
+
+// DataSets are the tables of each content area, parents before children so an import never
+// references a row it has not inserted yet
+var DataSets = map[string][]string{
+	"grammar": {
+		"grammar_questions",
+		"grammar_fill_in_the_blank_questions",
+		"grammar_fill_in_the_blank_answers",
+		"grammar_choice_one_questions",
+		"grammar_choice_one_options",
+		"grammar_error_identifications",
+		"grammar_sentence_transformations",
+	},
+	"listening": {
+		"listening_questions",
+		"listening_fill_in_the_blank_questions",
+		"listening_fill_in_the_blank_answers",
+		"listening_choice_one_questions",
+		"listening_choice_one_options",
+		"listening_choice_multi_questions",
+		"listening_choice_multi_options",
+		"listening_map_labellings",
+		"listening_matchings",
+	},
+	"reading": {
+		"reading_questions",
+		"reading_true_falses",
+		"reading_fill_in_the_blank_questions",
+		"reading_fill_in_the_blank_answers",
+		"reading_matchings",
+		"reading_choice_one_questions",
+		"reading_choice_one_options",
+		"reading_choice_multi_questions",
+		"reading_choice_multi_options",
+	},
+	"speaking": {
+		"speaking_questions",
+		"speaking_word_repetitions",
+		"speaking_phrase_repetitions",
+		"speaking_paragraph_repetitions",
+		"speaking_open_paragraphs",
+		"speaking_conversational_repetitions",
+		"speaking_conversational_repetition_qas",
+		"speaking_conversational_opens",
+	},
+	"writing": {
+		"writing_questions",
+		"writing_sentence_completions",
+		"writing_essays",
+	},
+	"course": {
+		"courses",
+		"course_books",
+		"course_others",
+		"lessons",
+		"lesson_questions",
+		"course_bundles",
+	},
+}
+
+// DataSetOrder is the order sets are exported in, courses last since lessons point at questions
+var DataSetOrder = []string{"grammar", "listening", "reading", "speaking", "writing", "course"}
+
+// DataRepository copies table rows as JSON for exports, imports and fixtures. Table and column
+// names only ever come from DataSets and the catalog, never from input.
+type DataRepository struct {
+	db     *gorm.DB
+	logger *logger.PrettyLogger
+}
+
+func NewDataRepository(db *gorm.DB, logger *logger.PrettyLogger) *DataRepository {
+	return &DataRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// ExportTable calls fn with every row of table in ID order
+func (r *DataRepository) ExportTable(ctx context.Context, table string, fn func(row json.RawMessage) error) error {
+	if !knownTable(table) {
+		return fmt.Errorf("%w: %s", ErrUnknownTable, table)
+	}
+
+	query := fmt.Sprintf("SELECT row_to_json(t) FROM %s t ORDER BY t.id", pq.QuoteIdentifier(table))
+	rows, err := r.db.WithContext(ctx).Raw(query).Rows()
+	if err != nil {
+		r.logger.WithContext(ctx).Error("data_repository.export", map[string]interface{}{
+			"error": err.Error(),
+			"table": table,
+		}, "Failed to read table")
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row []byte
+		if err := rows.Scan(&row); err != nil {
+			return err
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// Import inserts rows in one transaction. next returns io.EOF once it has no rows left. A row
+// whose key already exists is skipped, so importing the same file twice is harmless.
+func (r *DataRepository) Import(ctx context.Context, next func() (string, json.RawMessage, error), inserted, skipped map[string]int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		columns := make(map[string]map[string]bool)
+		for {
+			table, row, err := next()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if !knownTable(table) {
+				return fmt.Errorf("%w: %s", ErrUnknownTable, table)
+			}
+
+			known, ok := columns[table]
+			if !ok {
+				if known, err = r.tableColumns(tx, table); err != nil {
+					return err
+				}
+				columns[table] = known
+			}
+
+			query, err := insertQuery(table, known, row)
+			if err != nil {
+				return err
+			}
+			result := tx.Exec(query, string(row))
+			if result.Error != nil {
+				r.logger.WithContext(ctx).Error("data_repository.import", map[string]interface{}{
+					"error": result.Error.Error(),
+					"table": table,
+				}, "Failed to import row")
+				return fmt.Errorf("failed to import into %s: %w", table, result.Error)
+			}
+			if result.RowsAffected > 0 {
+				inserted[table]++
+			} else {
+				skipped[table]++
+			}
+		}
+	})
+}
+
+func (r *DataRepository) tableColumns(tx *gorm.DB, table string) (map[string]bool, error) {
+	var names []string
+	err := tx.Raw(
+		"SELECT column_name FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = ?",
+		table,
+	).Scan(&names).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to read columns of %s: %w", table, err)
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("table %s does not exist, run the migrations first", table)
+	}
+
+	known := make(map[string]bool, len(names))
+	for _, name := range names {
+		known[name] = true
+	}
+	return known, nil
+}
+
+// insertQuery inserts only the columns present in row, the others keep their defaults
+func insertQuery(table string, known map[string]bool, row json.RawMessage) (string, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(row, &fields); err != nil {
+		return "", fmt.Errorf("row of %s is not a JSON object: %w", table, err)
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		if !known[name] {
+			return "", fmt.Errorf("table %s has no column %q", table, name)
+		}
+		names = append(names, pq.QuoteIdentifier(name))
+	}
+	if len(names) == 0 {
+		return "", fmt.Errorf("row of %s has no columns", table)
+	}
+	sort.Strings(names)
+
+	list := strings.Join(names, ", ")
+	quoted := pq.QuoteIdentifier(table)
+	return fmt.Sprintf(
+		"INSERT INTO %s (%s) SELECT %s FROM json_populate_record(NULL::%s, ?::json) ON CONFLICT DO NOTHING",
+		quoted, list, list, quoted,
+	), nil
+}
+
+func knownTable(table string) bool {
+	for _, tables := range DataSets {
+		for _, t := range tables {
+			if t == table {
+				return true
+			}
+		}
+	}
+	return false
+}
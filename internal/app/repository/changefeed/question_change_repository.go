@@ -0,0 +1,51 @@
+package changefeed
+
+import (
+	"context"
+	"fluencybe/internal/app/model/changefeed"
+	"fluencybe/pkg/logger"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type QuestionChangeRepository struct {
+	db     *gorm.DB
+	logger *logger.PrettyLogger
+}
+
+func NewQuestionChangeRepository(db *gorm.DB, logger *logger.PrettyLogger) *QuestionChangeRepository {
+	return &QuestionChangeRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// ListSince returns changes ordered by (txid, seq) after the given position. Rows of transactions
+// still running when the query starts are held back: once every transaction below the snapshot
+// xmin has finished no row can appear before the returned ones, so a cursor never skips a change.
+func (r *QuestionChangeRepository) ListSince(ctx context.Context, skill string, txID uint64, seq int64, limit int) ([]*changefeed.QuestionChange, error) {
+	query := `
+		SELECT seq, skill, question_id, operation, version, txid::text::bigint AS txid, changed_at
+		FROM question_changes
+		WHERE (txid, seq) > (?::text::xid8, ?)
+		AND txid < pg_snapshot_xmin(pg_current_snapshot())
+	`
+	args := []interface{}{fmt.Sprint(txID), seq}
+	if skill != "" {
+		query += " AND skill = ?"
+		args = append(args, skill)
+	}
+	query += " ORDER BY txid, seq LIMIT ?"
+	args = append(args, limit)
+
+	var changes []*changefeed.QuestionChange
+	if err := r.db.WithContext(ctx).Raw(query, args...).Scan(&changes).Error; err != nil {
+		r.logger.WithContext(ctx).Error("question_change_repository.list_since", map[string]interface{}{
+			"error": err.Error(),
+			"skill": skill,
+		}, "Failed to list question changes")
+		return nil, err
+	}
+	return changes, nil
+}
@@ -34,7 +34,7 @@ func (r *CourseBookRepository) Create(ctx context.Context, courseBook *course.Co
 
 	err := r.db.WithContext(ctx).Create(courseBook).Error
 	if err != nil {
-		r.logger.Error("course_book_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("course_book_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create course book")
 		return err
@@ -49,7 +49,7 @@ func (r *CourseBookRepository) GetByID(ctx context.Context, id uuid.UUID) (*cour
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrCourseNotFound
 		}
-		r.logger.Error("course_book_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("course_book_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get course book")
@@ -65,7 +65,7 @@ func (r *CourseBookRepository) GetByCourseID(ctx context.Context, courseID uuid.
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrCourseNotFound
 		}
-		r.logger.Error("course_book_repository.get_by_course_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("course_book_repository.get_by_course_id", map[string]interface{}{
 			"error":    err.Error(),
 			"courseID": courseID,
 		}, "Failed to get course book")
@@ -87,7 +87,7 @@ func (r *CourseBookRepository) Update(ctx context.Context, courseBook *course.Co
 		})
 
 	if result.Error != nil {
-		r.logger.Error("course_book_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("course_book_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    courseBook.ID,
 		}, "Failed to update course book")
@@ -104,7 +104,7 @@ func (r *CourseBookRepository) Update(ctx context.Context, courseBook *course.Co
 func (r *CourseBookRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	result := r.db.WithContext(ctx).Delete(&course.CourseBook{}, "id = ?", id)
 	if result.Error != nil {
-		r.logger.Error("course_book_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("course_book_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete course book")
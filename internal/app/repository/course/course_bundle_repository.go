@@ -0,0 +1,94 @@
+package course
+
+import (
+	"context"
+	"errors"
+	"fluencybe/internal/app/model/course"
+	"fluencybe/pkg/logger"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrBundleNotFound        = errors.New("course bundle not found")
+	ErrBundleVersionConflict = errors.New("course bundle version already exists")
+)
+
+type CourseBundleRepository struct {
+	db     *gorm.DB
+	logger *logger.PrettyLogger
+}
+
+func NewCourseBundleRepository(db *gorm.DB, logger *logger.PrettyLogger) *CourseBundleRepository {
+	return &CourseBundleRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create stores a new bundle version, ErrBundleVersionConflict means another build took the version first
+func (r *CourseBundleRepository) Create(ctx context.Context, bundle *course.CourseBundle) error {
+	bundle.CreatedAt = time.Now()
+	if err := r.db.WithContext(ctx).Create(bundle).Error; err != nil {
+		if strings.Contains(err.Error(), "unique_course_bundle_version") {
+			return ErrBundleVersionConflict
+		}
+		r.logger.WithContext(ctx).Error("course_bundle_repository.create", map[string]interface{}{
+			"error":    err.Error(),
+			"courseID": bundle.CourseID,
+		}, "Failed to create course bundle")
+		return err
+	}
+	return nil
+}
+
+// GetLatest returns the newest bundle of a course, the archive is only loaded when requested
+func (r *CourseBundleRepository) GetLatest(ctx context.Context, courseID uuid.UUID, withArchive bool) (*course.CourseBundle, error) {
+	return r.first(ctx, withArchive, r.db.WithContext(ctx).
+		Where("course_id = ?", courseID).
+		Order("version DESC"))
+}
+
+func (r *CourseBundleRepository) GetByVersion(ctx context.Context, courseID uuid.UUID, version int, withArchive bool) (*course.CourseBundle, error) {
+	return r.first(ctx, withArchive, r.db.WithContext(ctx).
+		Where("course_id = ? AND version = ?", courseID, version))
+}
+
+// ListByCourseID returns bundle metadata newest first, without manifests and archives
+func (r *CourseBundleRepository) ListByCourseID(ctx context.Context, courseID uuid.UUID) ([]*course.CourseBundle, error) {
+	var bundles []*course.CourseBundle
+	err := r.db.WithContext(ctx).
+		Omit("manifest", "archive").
+		Where("course_id = ?", courseID).
+		Order("version DESC").
+		Find(&bundles).Error
+	if err != nil {
+		r.logger.WithContext(ctx).Error("course_bundle_repository.list", map[string]interface{}{
+			"error":    err.Error(),
+			"courseID": courseID,
+		}, "Failed to list course bundles")
+		return nil, err
+	}
+	return bundles, nil
+}
+
+func (r *CourseBundleRepository) first(ctx context.Context, withArchive bool, query *gorm.DB) (*course.CourseBundle, error) {
+	if !withArchive {
+		query = query.Omit("archive")
+	}
+
+	var bundle course.CourseBundle
+	if err := query.First(&bundle).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrBundleNotFound
+		}
+		r.logger.WithContext(ctx).Error("course_bundle_repository.get", map[string]interface{}{
+			"error": err.Error(),
+		}, "Failed to get course bundle")
+		return nil, err
+	}
+	return &bundle, nil
+}
@@ -34,7 +34,7 @@ func (r *CourseOtherRepository) Create(ctx context.Context, courseOther *course.
 
 	err := r.db.WithContext(ctx).Create(courseOther).Error
 	if err != nil {
-		r.logger.Error("course_other_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("course_other_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create course other")
 		return err
@@ -49,7 +49,7 @@ func (r *CourseOtherRepository) GetByID(ctx context.Context, id uuid.UUID) (*cou
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrCourseNotFound
 		}
-		r.logger.Error("course_other_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("course_other_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get course other")
@@ -65,7 +65,7 @@ func (r *CourseOtherRepository) GetByCourseID(ctx context.Context, courseID uuid
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrCourseNotFound
 		}
-		r.logger.Error("course_other_repository.get_by_course_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("course_other_repository.get_by_course_id", map[string]interface{}{
 			"error":    err.Error(),
 			"courseID": courseID,
 		}, "Failed to get course other")
@@ -77,7 +77,7 @@ func (r *CourseOtherRepository) GetByCourseID(ctx context.Context, courseID uuid
 func (r *CourseOtherRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	result := r.db.WithContext(ctx).Delete(&course.CourseOther{}, "id = ?", id)
 	if result.Error != nil {
-		r.logger.Error("course_other_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("course_other_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete course other")
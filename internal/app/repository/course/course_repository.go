@@ -41,7 +41,7 @@ func (r *CourseRepository) Create(ctx context.Context, course *courseModel.Cours
 
 	err := r.db.WithContext(ctx).Create(course).Error
 	if err != nil {
-		r.logger.Error("course_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("course_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create course")
 		return err
@@ -56,7 +56,7 @@ func (r *CourseRepository) GetByID(ctx context.Context, id uuid.UUID) (*courseMo
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrCourseNotFound
 		}
-		r.logger.Error("course_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("course_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get course")
@@ -81,7 +81,7 @@ func (r *CourseRepository) Update(ctx context.Context, course *courseModel.Cours
 		})
 
 	if result.Error != nil {
-		r.logger.Error("course_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("course_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    course.ID,
 		}, "Failed to update course")
@@ -98,7 +98,7 @@ func (r *CourseRepository) Update(ctx context.Context, course *courseModel.Cours
 func (r *CourseRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	result := r.db.WithContext(ctx).Delete(&courseModel.Course{}, "id = ?", id)
 	if result.Error != nil {
-		r.logger.Error("course_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("course_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete course")
@@ -44,7 +44,7 @@ func (r *LessonQuestionRepository) Create(ctx context.Context, question *course.
 			question.LessonID,
 		).Scan(&nextSeq).Error
 		if err != nil {
-			r.logger.Error("lesson_question_repository.get_next_sequence", map[string]interface{}{
+			r.logger.WithContext(ctx).Error("lesson_question_repository.get_next_sequence", map[string]interface{}{
 				"error":    err.Error(),
 				"lessonID": question.LessonID,
 			}, "Failed to get next sequence")
@@ -55,7 +55,7 @@ func (r *LessonQuestionRepository) Create(ctx context.Context, question *course.
 
 	err := r.db.WithContext(ctx).Create(question).Error
 	if err != nil {
-		r.logger.Error("lesson_question_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("lesson_question_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create lesson question")
 		return err
@@ -70,7 +70,7 @@ func (r *LessonQuestionRepository) GetByID(ctx context.Context, id uuid.UUID) (*
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrLessonQuestionNotFound
 		}
-		r.logger.Error("lesson_question_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("lesson_question_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get lesson question")
@@ -87,7 +87,7 @@ func (r *LessonQuestionRepository) GetByLessonID(ctx context.Context, lessonID u
 		Find(&questions).Error
 
 	if err != nil {
-		r.logger.Error("lesson_question_repository.get_by_lesson_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("lesson_question_repository.get_by_lesson_id", map[string]interface{}{
 			"error":    err.Error(),
 			"lessonID": lessonID,
 		}, "Failed to get lesson questions")
@@ -96,6 +96,25 @@ func (r *LessonQuestionRepository) GetByLessonID(ctx context.Context, lessonID u
 	return questions, nil
 }
 
+// ListQuestionIDsByType returns the distinct ids of the questions of questionType used in a lesson
+func (r *LessonQuestionRepository) ListQuestionIDsByType(ctx context.Context, questionType string) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.db.WithContext(ctx).
+		Model(&course.LessonQuestion{}).
+		Where("question_type = ?", questionType).
+		Distinct().
+		Pluck("question_id", &ids).Error
+
+	if err != nil {
+		r.logger.WithContext(ctx).Error("lesson_question_repository.list_question_ids_by_type", map[string]interface{}{
+			"error":        err.Error(),
+			"questionType": questionType,
+		}, "Failed to list lesson question ids")
+		return nil, err
+	}
+	return ids, nil
+}
+
 func (r *LessonQuestionRepository) Update(ctx context.Context, question *course.LessonQuestion) error {
 	question.UpdatedAt = time.Now()
 
@@ -109,7 +128,7 @@ func (r *LessonQuestionRepository) Update(ctx context.Context, question *course.
 		})
 
 	if result.Error != nil {
-		r.logger.Error("lesson_question_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("lesson_question_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    question.ID,
 		}, "Failed to update lesson question")
@@ -126,7 +145,7 @@ func (r *LessonQuestionRepository) Update(ctx context.Context, question *course.
 func (r *LessonQuestionRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	result := r.db.WithContext(ctx).Delete(&course.LessonQuestion{}, "id = ?", id)
 	if result.Error != nil {
-		r.logger.Error("lesson_question_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("lesson_question_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete lesson question")
@@ -148,7 +167,7 @@ func (r *LessonQuestionRepository) SwapSequence(ctx context.Context, q1, q2 *cou
 	)
 
 	if result.Error != nil {
-		r.logger.Error("lesson_question_repository.swap_sequence", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("lesson_question_repository.swap_sequence", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id1":   q1.ID,
 			"id2":   q2.ID,
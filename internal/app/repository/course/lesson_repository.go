@@ -44,7 +44,7 @@ func (r *LessonRepository) Create(ctx context.Context, lesson *course.Lesson) er
 			lesson.CourseID,
 		).Scan(&nextSeq).Error
 		if err != nil {
-			r.logger.Error("lesson_repository.get_next_sequence", map[string]interface{}{
+			r.logger.WithContext(ctx).Error("lesson_repository.get_next_sequence", map[string]interface{}{
 				"error":    err.Error(),
 				"courseID": lesson.CourseID,
 			}, "Failed to get next sequence")
@@ -55,7 +55,7 @@ func (r *LessonRepository) Create(ctx context.Context, lesson *course.Lesson) er
 
 	err := r.db.WithContext(ctx).Create(lesson).Error
 	if err != nil {
-		r.logger.Error("lesson_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("lesson_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create lesson")
 		return err
@@ -70,7 +70,7 @@ func (r *LessonRepository) GetByID(ctx context.Context, id uuid.UUID) (*course.L
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrLessonNotFound
 		}
-		r.logger.Error("lesson_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("lesson_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get lesson")
@@ -87,7 +87,7 @@ func (r *LessonRepository) GetByCourseID(ctx context.Context, courseID uuid.UUID
 		Find(&lessons).Error
 
 	if err != nil {
-		r.logger.Error("lesson_repository.get_by_course_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("lesson_repository.get_by_course_id", map[string]interface{}{
 			"error":    err.Error(),
 			"courseID": courseID,
 		}, "Failed to get lessons")
@@ -109,7 +109,7 @@ func (r *LessonRepository) Update(ctx context.Context, lesson *course.Lesson) er
 		})
 
 	if result.Error != nil {
-		r.logger.Error("lesson_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("lesson_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    lesson.ID,
 		}, "Failed to update lesson")
@@ -126,7 +126,7 @@ func (r *LessonRepository) Update(ctx context.Context, lesson *course.Lesson) er
 func (r *LessonRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	result := r.db.WithContext(ctx).Delete(&course.Lesson{}, "id = ?", id)
 	if result.Error != nil {
-		r.logger.Error("lesson_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("lesson_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete lesson")
@@ -148,7 +148,7 @@ func (r *LessonRepository) SwapSequence(ctx context.Context, lesson1, lesson2 *c
 	)
 
 	if result.Error != nil {
-		r.logger.Error("lesson_repository.swap_sequence", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("lesson_repository.swap_sequence", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id1":   lesson1.ID,
 			"id2":   lesson2.ID,
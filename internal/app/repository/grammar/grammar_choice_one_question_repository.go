@@ -34,7 +34,7 @@ func (r *GrammarChoiceOneQuestionRepository) Create(ctx context.Context, questio
 
 	err := r.db.WithContext(ctx).Create(question).Error
 	if err != nil {
-		r.logger.Error("grammar_choice_one_question_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_choice_one_question_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create question")
 		return err
@@ -49,7 +49,7 @@ func (r *GrammarChoiceOneQuestionRepository) GetByID(ctx context.Context, id uui
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("grammar_choice_one_question_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_choice_one_question_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get question")
 		return nil, err
@@ -69,7 +69,7 @@ func (r *GrammarChoiceOneQuestionRepository) Update(ctx context.Context, questio
 		})
 
 	if result.Error != nil {
-		r.logger.Error("grammar_choice_one_question_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_choice_one_question_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 		}, "Failed to update question")
 		return result.Error
@@ -86,7 +86,7 @@ func (r *GrammarChoiceOneQuestionRepository) Delete(ctx context.Context, id uuid
 	result := r.db.WithContext(ctx).Delete(&grammar.GrammarChoiceOneQuestion{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("grammar_choice_one_question_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_choice_one_question_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete question")
@@ -108,7 +108,7 @@ func (r *GrammarChoiceOneQuestionRepository) GetByGrammarQuestionID(ctx context.
 		Find(&questions).Error
 
 	if err != nil {
-		r.logger.Error("grammar_choice_one_question_repository.get_by_grammar_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_choice_one_question_repository.get_by_grammar_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    grammarQuestionID,
 		}, "Failed to get questions")
@@ -34,7 +34,7 @@ func (r *GrammarErrorIdentificationRepository) Create(ctx context.Context, ident
 
 	err := r.db.WithContext(ctx).Create(identification).Error
 	if err != nil {
-		r.logger.Error("grammar_error_identification_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_error_identification_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create error identification")
 		return err
@@ -49,7 +49,7 @@ func (r *GrammarErrorIdentificationRepository) GetByID(ctx context.Context, id u
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("grammar_error_identification_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_error_identification_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get error identification")
@@ -72,7 +72,7 @@ func (r *GrammarErrorIdentificationRepository) Update(ctx context.Context, ident
 		})
 
 	if result.Error != nil {
-		r.logger.Error("grammar_error_identification_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_error_identification_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    identification.ID,
 		}, "Failed to update error identification")
@@ -90,7 +90,7 @@ func (r *GrammarErrorIdentificationRepository) Delete(ctx context.Context, id uu
 	result := r.db.WithContext(ctx).Delete(&grammar.GrammarErrorIdentification{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("grammar_error_identification_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_error_identification_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete error identification")
@@ -111,7 +111,7 @@ func (r *GrammarErrorIdentificationRepository) GetByGrammarQuestionID(ctx contex
 		Find(&identifications).Error
 
 	if err != nil {
-		r.logger.Error("grammar_error_identification_repository.get_by_grammar_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_error_identification_repository.get_by_grammar_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    grammarQuestionID,
 		}, "Failed to get error identifications")
@@ -30,7 +30,7 @@ func (r *GrammarFillInTheBlankAnswerRepository) Create(ctx context.Context, answ
 
 	err := r.db.WithContext(ctx).Create(answer).Error
 	if err != nil {
-		r.logger.Error("grammar_fill_in_the_blank_answer_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_answer_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create answer")
 		return err
@@ -48,7 +48,7 @@ func (r *GrammarFillInTheBlankAnswerRepository) GetByGrammarFillInTheBlankQuesti
 		Find(&answers).Error
 
 	if err != nil {
-		r.logger.Error("grammar_fill_in_the_blank_answer_repository.get_by_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_answer_repository.get_by_question_id", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get answers")
 		return nil, err
@@ -65,7 +65,7 @@ func (r *GrammarFillInTheBlankAnswerRepository) GetByID(ctx context.Context, id
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("grammar_fill_in_the_blank_answer_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_answer_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get fill in the blank answer")
 		return nil, err
@@ -86,7 +86,7 @@ func (r *GrammarFillInTheBlankAnswerRepository) Update(ctx context.Context, answ
 		})
 
 	if result.Error != nil {
-		r.logger.Error("grammar_fill_in_the_blank_answer_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_answer_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 		}, "Failed to update answer")
 		return result.Error
@@ -103,7 +103,7 @@ func (r *GrammarFillInTheBlankAnswerRepository) Delete(ctx context.Context, id u
 	result := r.db.WithContext(ctx).Delete(&grammar.GrammarFillInTheBlankAnswer{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("grammar_fill_in_the_blank_answer_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_answer_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete answer")
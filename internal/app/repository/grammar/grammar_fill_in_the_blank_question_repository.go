@@ -34,7 +34,7 @@ func (r *GrammarFillInTheBlankQuestionRepository) Create(ctx context.Context, qu
 		if strings.Contains(err.Error(), "unique_grammar_fill_in_the_blank_question") {
 			return ErrDuplicateQuestion
 		}
-		r.logger.Error("grammar_fill_in_the_blank_question_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_question_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create question")
 		return err
@@ -51,7 +51,7 @@ func (r *GrammarFillInTheBlankQuestionRepository) GetByID(ctx context.Context, i
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("grammar_fill_in_the_blank_question_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_question_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get question")
 		return nil, err
@@ -71,7 +71,7 @@ func (r *GrammarFillInTheBlankQuestionRepository) Update(ctx context.Context, qu
 		})
 
 	if result.Error != nil {
-		r.logger.Error("grammar_fill_in_the_blank_question_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_question_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 		}, "Failed to update question")
 		return result.Error
@@ -87,7 +87,7 @@ func (r *GrammarFillInTheBlankQuestionRepository) Update(ctx context.Context, qu
 func (r *GrammarFillInTheBlankQuestionRepository) GetByGrammarQuestionID(ctx context.Context, grammarQuestionID uuid.UUID) ([]*grammar.GrammarFillInTheBlankQuestion, error) {
 	var questions []*grammar.GrammarFillInTheBlankQuestion
 
-	r.logger.Debug("GetByGrammarQuestionID", map[string]interface{}{
+	r.logger.WithContext(ctx).Debug("GetByGrammarQuestionID", map[string]interface{}{
 		"grammarQuestionID": grammarQuestionID,
 	}, "Getting fill in blank questions")
 
@@ -97,14 +97,14 @@ func (r *GrammarFillInTheBlankQuestionRepository) GetByGrammarQuestionID(ctx con
 		Find(&questions).Error
 
 	if err != nil {
-		r.logger.Error("GetByGrammarQuestionID", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("GetByGrammarQuestionID", map[string]interface{}{
 			"error":             err.Error(),
 			"grammarQuestionID": grammarQuestionID,
 		}, "Failed to get questions")
 		return nil, err
 	}
 
-	r.logger.Debug("GetByGrammarQuestionID", map[string]interface{}{
+	r.logger.WithContext(ctx).Debug("GetByGrammarQuestionID", map[string]interface{}{
 		"count": len(questions),
 	}, "Got questions")
 
@@ -115,7 +115,7 @@ func (r *GrammarFillInTheBlankQuestionRepository) Delete(ctx context.Context, id
 	result := r.db.WithContext(ctx).Delete(&grammar.GrammarFillInTheBlankQuestion{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("grammar_fill_in_the_blank_question_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_question_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 		}, "Failed to delete question")
 		return result.Error
@@ -41,7 +41,7 @@ func (r *GrammarQuestionRepository) CreateGrammarQuestion(ctx context.Context, q
 
 	result := r.db.WithContext(ctx).Create(question)
 	if result.Error != nil {
-		r.logger.Error("grammar_question_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_question_repository.create", map[string]interface{}{
 			"error": result.Error.Error(),
 		}, "Failed to create grammar question")
 		return result.Error
@@ -57,7 +57,7 @@ func (r *GrammarQuestionRepository) GetGrammarQuestionByID(ctx context.Context,
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrQuestionNotFound
 		}
-		r.logger.Error("grammar_question_repository.get_by_id", map[string]interface{}{"error": err.Error()}, "Failed to get grammar question")
+		r.logger.WithContext(ctx).Error("grammar_question_repository.get_by_id", map[string]interface{}{"error": err.Error()}, "Failed to get grammar question")
 		return nil, err
 	}
 
@@ -91,7 +91,7 @@ func (r *GrammarQuestionRepository) UpdateGrammarQuestion(ctx context.Context, q
 
 	if result.Error != nil {
 		tx.Rollback()
-		r.logger.Error("grammar_question_repository.update", map[string]interface{}{"error": result.Error.Error()}, "Failed to update grammar question")
+		r.logger.WithContext(ctx).Error("grammar_question_repository.update", map[string]interface{}{"error": result.Error.Error()}, "Failed to update grammar question")
 		return result.Error
 	}
 
@@ -102,7 +102,7 @@ func (r *GrammarQuestionRepository) UpdateGrammarQuestion(ctx context.Context, q
 
 	if err := tx.First(question, "id = ?", question.ID).Error; err != nil {
 		tx.Rollback()
-		r.logger.Error("grammar_question_repository.update.get_updated", map[string]interface{}{"error": err.Error()}, "Failed to get updated question")
+		r.logger.WithContext(ctx).Error("grammar_question_repository.update.get_updated", map[string]interface{}{"error": err.Error()}, "Failed to get updated question")
 		return err
 	}
 
@@ -112,7 +112,7 @@ func (r *GrammarQuestionRepository) UpdateGrammarQuestion(ctx context.Context, q
 func (r *GrammarQuestionRepository) DeleteGrammarQuestion(ctx context.Context, id uuid.UUID) error {
 	result := r.db.WithContext(ctx).Delete(&grammar.GrammarQuestion{}, "id = ?", id)
 	if result.Error != nil {
-		r.logger.Error("grammar_question_repository.delete", map[string]interface{}{"error": result.Error.Error()}, "Failed to delete grammar question")
+		r.logger.WithContext(ctx).Error("grammar_question_repository.delete", map[string]interface{}{"error": result.Error.Error()}, "Failed to delete grammar question")
 		return result.Error
 	}
 
@@ -218,7 +218,7 @@ func (r *GrammarQuestionRepository) GetNewUpdatedQuestions(ctx context.Context,
 
 	err := query.Find(&questions).Error
 	if err != nil {
-		r.logger.Error("grammar_question_repository.get_new_updated", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_question_repository.get_new_updated", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get updated questions")
 		return nil, err
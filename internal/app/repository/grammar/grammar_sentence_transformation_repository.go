@@ -34,7 +34,7 @@ func (r *GrammarSentenceTransformationRepository) Create(ctx context.Context, tr
 
 	err := r.db.WithContext(ctx).Create(transformation).Error
 	if err != nil {
-		r.logger.Error("grammar_sentence_transformation_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_sentence_transformation_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create sentence transformation")
 		return err
@@ -49,7 +49,7 @@ func (r *GrammarSentenceTransformationRepository) GetByID(ctx context.Context, i
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("grammar_sentence_transformation_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_sentence_transformation_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get sentence transformation")
@@ -72,7 +72,7 @@ func (r *GrammarSentenceTransformationRepository) Update(ctx context.Context, tr
 		})
 
 	if result.Error != nil {
-		r.logger.Error("grammar_sentence_transformation_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_sentence_transformation_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    transformation.ID,
 		}, "Failed to update sentence transformation")
@@ -90,7 +90,7 @@ func (r *GrammarSentenceTransformationRepository) Delete(ctx context.Context, id
 	result := r.db.WithContext(ctx).Delete(&grammar.GrammarSentenceTransformation{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("grammar_sentence_transformation_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_sentence_transformation_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete sentence transformation")
@@ -111,7 +111,7 @@ func (r *GrammarSentenceTransformationRepository) GetByGrammarQuestionID(ctx con
 		Find(&transformations).Error
 
 	if err != nil {
-		r.logger.Error("grammar_sentence_transformation_repository.get_by_grammar_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("grammar_sentence_transformation_repository.get_by_grammar_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    grammarQuestionID,
 		}, "Failed to get sentence transformations")
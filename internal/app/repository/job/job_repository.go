@@ -0,0 +1,196 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fluencybe/internal/app/model/job"
+	"fluencybe/pkg/logger"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrJobNotFound = errors.New("job not found")
+	// ErrLeaseLost is returned to a runner whose job was claimed again after its lease ran out
+	ErrLeaseLost = errors.New("job lease lost")
+)
+
+type JobRepository struct {
+	db     *gorm.DB
+	logger *logger.PrettyLogger
+}
+
+func NewJobRepository(db *gorm.DB, logger *logger.PrettyLogger) *JobRepository {
+	return &JobRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+func (r *JobRepository) Create(ctx context.Context, j *job.Job) error {
+	now := time.Now().UTC()
+	j.Status = job.StatusQueued
+	j.CreatedAt = now
+	j.RunAt = now
+
+	if err := r.db.WithContext(ctx).Create(j).Error; err != nil {
+		r.logger.WithContext(ctx).Error("job_repository.create", map[string]interface{}{
+			"error": err.Error(),
+			"type":  j.Type,
+		}, "Failed to create job")
+		return err
+	}
+	return nil
+}
+
+func (r *JobRepository) GetByID(ctx context.Context, id uuid.UUID) (*job.Job, error) {
+	var result job.Job
+	err := r.db.WithContext(ctx).First(&result, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrJobNotFound
+		}
+		r.logger.WithContext(ctx).Error("job_repository.get_by_id", map[string]interface{}{
+			"error": err.Error(),
+			"id":    id,
+		}, "Failed to get job")
+		return nil, err
+	}
+	return &result, nil
+}
+
+// List returns the jobs newest first, jobType and status are ignored when empty
+func (r *JobRepository) List(ctx context.Context, jobType string, status job.JobStatus, page, pageSize int) ([]*job.Job, int64, error) {
+	query := r.db.WithContext(ctx).Model(&job.Job{})
+	if jobType != "" {
+		query = query.Where("type = ?", jobType)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		r.logger.WithContext(ctx).Error("job_repository.list.count", map[string]interface{}{
+			"error": err.Error(),
+		}, "Failed to count jobs")
+		return nil, 0, err
+	}
+
+	var jobs []*job.Job
+	if err := query.Order("created_at DESC").Offset((page - 1) * pageSize).Limit(pageSize).Find(&jobs).Error; err != nil {
+		r.logger.WithContext(ctx).Error("job_repository.list", map[string]interface{}{
+			"error": err.Error(),
+		}, "Failed to list jobs")
+		return nil, 0, err
+	}
+	return jobs, total, nil
+}
+
+// Claim leases the oldest due job of one of types to the caller, or returns nil when there is
+// none. A running job whose lease ran out is due again, so the jobs of a runner that died are
+// picked up on their own, and SKIP LOCKED lets several runners claim at once.
+func (r *JobRepository) Claim(ctx context.Context, types []string, lease time.Duration) (*job.Job, error) {
+	if len(types) == 0 {
+		return nil, nil
+	}
+
+	query := `
+		UPDATE jobs
+		SET status = ?, attempts = attempts + 1, run_at = ?, started_at = ?, finished_at = NULL,
+			progress = 0, progress_message = NULL
+		WHERE id = (
+			SELECT id FROM jobs
+			WHERE status IN ? AND run_at <= ? AND type IN ?
+			ORDER BY run_at, created_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *
+	`
+	now := time.Now().UTC()
+	due := []job.JobStatus{job.StatusQueued, job.StatusRunning}
+
+	var jobs []*job.Job
+	if err := r.db.WithContext(ctx).Raw(query, job.StatusRunning, now.Add(lease), now, due, now, types).Scan(&jobs).Error; err != nil {
+		r.logger.WithContext(ctx).Error("job_repository.claim", map[string]interface{}{
+			"error": err.Error(),
+		}, "Failed to claim job")
+		return nil, err
+	}
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+	return jobs[0], nil
+}
+
+// Heartbeat extends the lease of a running job and records its progress. The attempt number
+// identifies the claim, ErrLeaseLost means another runner holds the job now.
+func (r *JobRepository) Heartbeat(ctx context.Context, id uuid.UUID, attempt int, lease time.Duration, progress int, message string) error {
+	updates := map[string]interface{}{
+		"run_at":   time.Now().UTC().Add(lease),
+		"progress": progress,
+	}
+	if message != "" {
+		updates["progress_message"] = message
+	}
+	return r.updateClaimed(ctx, id, attempt, updates)
+}
+
+// Complete marks a job as succeeded with its result, nil when the job returns nothing
+func (r *JobRepository) Complete(ctx context.Context, id uuid.UUID, attempt int, result *string) error {
+	return r.updateClaimed(ctx, id, attempt, map[string]interface{}{
+		"status":      job.StatusSucceeded,
+		"progress":    100,
+		"result":      result,
+		"last_error":  nil,
+		"finished_at": time.Now().UTC(),
+	})
+}
+
+// Fail schedules a job for another attempt at retryAt, or marks it as failed when dead is set
+func (r *JobRepository) Fail(ctx context.Context, id uuid.UUID, attempt int, lastError string, retryAt time.Time, dead bool) error {
+	updates := map[string]interface{}{
+		"last_error": lastError,
+	}
+	if dead {
+		updates["status"] = job.StatusFailed
+		updates["finished_at"] = time.Now().UTC()
+	} else {
+		updates["status"] = job.StatusQueued
+		updates["run_at"] = retryAt
+	}
+	return r.updateClaimed(ctx, id, attempt, updates)
+}
+
+// Release puts a job interrupted by a shutdown back in the queue, the attempt does not count
+func (r *JobRepository) Release(ctx context.Context, id uuid.UUID, attempt int) error {
+	return r.updateClaimed(ctx, id, attempt, map[string]interface{}{
+		"status":   job.StatusQueued,
+		"attempts": gorm.Expr("attempts - 1"),
+		"run_at":   time.Now().UTC(),
+	})
+}
+
+func (r *JobRepository) updateClaimed(ctx context.Context, id uuid.UUID, attempt int, updates map[string]interface{}) error {
+	result := r.db.WithContext(ctx).Model(&job.Job{}).
+		Where("id = ? AND status = ? AND attempts = ?", id, job.StatusRunning, attempt).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrLeaseLost
+	}
+	return nil
+}
+
+// PurgeFinished deletes succeeded and failed jobs finished before the given time
+func (r *JobRepository) PurgeFinished(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("status IN ? AND finished_at < ?", []job.JobStatus{job.StatusSucceeded, job.StatusFailed}, before).
+		Delete(&job.Job{})
+	return result.RowsAffected, result.Error
+}
@@ -34,7 +34,7 @@ func (r *ListeningChoiceMultiOptionRepository) Create(ctx context.Context, optio
 
 	err := r.db.WithContext(ctx).Create(option).Error
 	if err != nil {
-		r.logger.Error("listening_choice_multi_option_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_choice_multi_option_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create option")
 		return err
@@ -49,7 +49,7 @@ func (r *ListeningChoiceMultiOptionRepository) GetByID(ctx context.Context, id u
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("listening_choice_multi_option_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_choice_multi_option_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get option")
@@ -65,7 +65,7 @@ func (r *ListeningChoiceMultiOptionRepository) GetByListeningChoiceMultiQuestion
 		Find(&options).Error
 
 	if err != nil {
-		r.logger.Error("listening_choice_multi_option_repository.get_by_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_choice_multi_option_repository.get_by_question_id", map[string]interface{}{
 			"error":       err.Error(),
 			"question_id": questionID,
 		}, "Failed to get options by question ID")
@@ -87,7 +87,7 @@ func (r *ListeningChoiceMultiOptionRepository) Update(ctx context.Context, optio
 		})
 
 	if result.Error != nil {
-		r.logger.Error("listening_choice_multi_option_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_choice_multi_option_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    option.ID,
 		}, "Failed to update option")
@@ -105,7 +105,7 @@ func (r *ListeningChoiceMultiOptionRepository) Delete(ctx context.Context, id uu
 	result := r.db.WithContext(ctx).Delete(&listening.ListeningChoiceMultiOption{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("listening_choice_multi_option_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_choice_multi_option_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete option")
@@ -34,7 +34,7 @@ func (r *ListeningChoiceMultiQuestionRepository) Create(ctx context.Context, que
 
 	err := r.db.WithContext(ctx).Create(question).Error
 	if err != nil {
-		r.logger.Error("listening_choice_multi_question_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_choice_multi_question_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create question")
 		return err
@@ -50,7 +50,7 @@ func (r *ListeningChoiceMultiQuestionRepository) GetByListeningQuestionID(ctx co
 		Find(&questions).Error
 
 	if err != nil {
-		r.logger.Error("listening_choice_multi_question_repository.get_by_listening_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_choice_multi_question_repository.get_by_listening_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    listeningQuestionID,
 		}, "Failed to get questions")
@@ -67,7 +67,7 @@ func (r *ListeningChoiceMultiQuestionRepository) GetByID(ctx context.Context, id
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("listening_choice_multi_question_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_choice_multi_question_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get question")
 		return nil, err
@@ -87,7 +87,7 @@ func (r *ListeningChoiceMultiQuestionRepository) Update(ctx context.Context, que
 		})
 
 	if result.Error != nil {
-		r.logger.Error("listening_choice_multi_question_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_choice_multi_question_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 		}, "Failed to update question")
 		return result.Error
@@ -104,7 +104,7 @@ func (r *ListeningChoiceMultiQuestionRepository) Delete(ctx context.Context, id
 	result := r.db.WithContext(ctx).Delete(&listening.ListeningChoiceMultiQuestion{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("listening_choice_multi_question_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_choice_multi_question_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete question")
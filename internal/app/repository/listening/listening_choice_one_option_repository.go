@@ -34,7 +34,7 @@ func (r *ListeningChoiceOneOptionRepository) Create(ctx context.Context, option
 
 	err := r.db.WithContext(ctx).Create(option).Error
 	if err != nil {
-		r.logger.Error("listening_choice_one_option_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_choice_one_option_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create option")
 		return err
@@ -49,7 +49,7 @@ func (r *ListeningChoiceOneOptionRepository) GetByID(ctx context.Context, id uui
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("listening_choice_one_option_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_choice_one_option_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get option")
@@ -70,7 +70,7 @@ func (r *ListeningChoiceOneOptionRepository) Update(ctx context.Context, option
 		})
 
 	if result.Error != nil {
-		r.logger.Error("listening_choice_one_option_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_choice_one_option_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    option.ID,
 		}, "Failed to update option")
@@ -88,7 +88,7 @@ func (r *ListeningChoiceOneOptionRepository) Delete(ctx context.Context, id uuid
 	result := r.db.WithContext(ctx).Delete(&listening.ListeningChoiceOneOption{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("listening_choice_one_option_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_choice_one_option_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete option")
@@ -109,7 +109,7 @@ func (r *ListeningChoiceOneOptionRepository) GetByQuestionID(ctx context.Context
 		Find(&options).Error
 
 	if err != nil {
-		r.logger.Error("listening_choice_one_option_repository.get_by_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_choice_one_option_repository.get_by_question_id", map[string]interface{}{
 			"error":       err.Error(),
 			"question_id": questionID,
 		}, "Failed to get options by question ID")
@@ -129,7 +129,7 @@ func (r *ListeningChoiceOneOptionRepository) GetCorrectOption(ctx context.Contex
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
 		}
-		r.logger.Error("listening_choice_one_option_repository.get_correct_option", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_choice_one_option_repository.get_correct_option", map[string]interface{}{
 			"error":       err.Error(),
 			"question_id": questionID,
 		}, "Failed to get correct option")
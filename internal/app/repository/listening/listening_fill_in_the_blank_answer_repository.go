@@ -30,7 +30,7 @@ func (r *ListeningFillInTheBlankAnswerRepository) Create(ctx context.Context, an
 
 	err := r.db.WithContext(ctx).Create(answer).Error
 	if err != nil {
-		r.logger.Error("listening_fill_in_the_blank_answer_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_fill_in_the_blank_answer_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create answer")
 		return err
@@ -48,7 +48,7 @@ func (r *ListeningFillInTheBlankAnswerRepository) GetByListeningFillInTheBlankQu
 		Find(&answers).Error
 
 	if err != nil {
-		r.logger.Error("listening_fill_in_the_blank_answer_repository.get_by_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_fill_in_the_blank_answer_repository.get_by_question_id", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get answers")
 		return nil, err
@@ -65,7 +65,7 @@ func (r *ListeningFillInTheBlankAnswerRepository) GetByID(ctx context.Context, i
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("listening_fill_in_the_blank_answer_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_fill_in_the_blank_answer_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get fill in the blank answer")
 		return nil, err
@@ -86,7 +86,7 @@ func (r *ListeningFillInTheBlankAnswerRepository) Update(ctx context.Context, an
 		})
 
 	if result.Error != nil {
-		r.logger.Error("listening_fill_in_the_blank_answer_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_fill_in_the_blank_answer_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 		}, "Failed to update answer")
 		return result.Error
@@ -103,7 +103,7 @@ func (r *ListeningFillInTheBlankAnswerRepository) Delete(ctx context.Context, id
 	result := r.db.WithContext(ctx).Delete(&listening.ListeningFillInTheBlankAnswer{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("listening_fill_in_the_blank_answer_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_fill_in_the_blank_answer_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete answer")
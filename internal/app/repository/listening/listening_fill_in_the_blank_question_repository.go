@@ -34,7 +34,7 @@ func (r *ListeningFillInTheBlankQuestionRepository) Create(ctx context.Context,
 		if strings.Contains(err.Error(), "unique_listening_fill_in_the_blank_question") {
 			return ErrDuplicateQuestion
 		}
-		r.logger.Error("listening_fill_in_the_blank_question_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_fill_in_the_blank_question_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create question")
 		return err
@@ -51,7 +51,7 @@ func (r *ListeningFillInTheBlankQuestionRepository) GetByID(ctx context.Context,
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("listening_fill_in_the_blank_question_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_fill_in_the_blank_question_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get question")
 		return nil, err
@@ -71,7 +71,7 @@ func (r *ListeningFillInTheBlankQuestionRepository) Update(ctx context.Context,
 		})
 
 	if result.Error != nil {
-		r.logger.Error("listening_fill_in_the_blank_question_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_fill_in_the_blank_question_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 		}, "Failed to update question")
 		return result.Error
@@ -88,7 +88,7 @@ func (r *ListeningFillInTheBlankQuestionRepository) GetByListeningQuestionID(ctx
 	var questions []*listening.ListeningFillInTheBlankQuestion
 
 	// Add debug logging
-	r.logger.Debug("GetByListeningQuestionID", map[string]interface{}{
+	r.logger.WithContext(ctx).Debug("GetByListeningQuestionID", map[string]interface{}{
 		"listeningQuestionID": listeningQuestionID,
 	}, "Getting fill in blank questions")
 
@@ -98,14 +98,14 @@ func (r *ListeningFillInTheBlankQuestionRepository) GetByListeningQuestionID(ctx
 		Find(&questions).Error
 
 	if err != nil {
-		r.logger.Error("GetByListeningQuestionID", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("GetByListeningQuestionID", map[string]interface{}{
 			"error":               err.Error(),
 			"listeningQuestionID": listeningQuestionID,
 		}, "Failed to get questions")
 		return nil, err
 	}
 
-	r.logger.Debug("GetByListeningQuestionID", map[string]interface{}{
+	r.logger.WithContext(ctx).Debug("GetByListeningQuestionID", map[string]interface{}{
 		"count": len(questions),
 	}, "Got questions")
 
@@ -116,7 +116,7 @@ func (r *ListeningFillInTheBlankQuestionRepository) Delete(ctx context.Context,
 	result := r.db.WithContext(ctx).Delete(&listening.ListeningFillInTheBlankQuestion{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("listening_fill_in_the_blank_question_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_fill_in_the_blank_question_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 		}, "Failed to delete question")
 		return result.Error
@@ -29,7 +29,7 @@ func (r *ListeningMapLabellingRepository) Create(ctx context.Context, qa *listen
 	qa.UpdatedAt = now
 
 	if err := r.db.WithContext(ctx).Create(qa).Error; err != nil {
-		r.logger.Error("listening_map_labelling_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_map_labelling_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create map labelling")
 		return err
@@ -40,7 +40,7 @@ func (r *ListeningMapLabellingRepository) Create(ctx context.Context, qa *listen
 func (r *ListeningMapLabellingRepository) GetByID(ctx context.Context, id uuid.UUID) (*listening.ListeningMapLabelling, error) {
 	var qa listening.ListeningMapLabelling
 	if err := r.db.WithContext(ctx).First(&qa, "id = ?", id).Error; err != nil {
-		r.logger.Error("listening_map_labelling_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_map_labelling_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get map labelling")
@@ -50,7 +50,7 @@ func (r *ListeningMapLabellingRepository) GetByID(ctx context.Context, id uuid.U
 }
 
 func (r *ListeningMapLabellingRepository) GetByListeningQuestionID(ctx context.Context, listeningQuestionID uuid.UUID) ([]*listening.ListeningMapLabelling, error) {
-	r.logger.Debug("GetByListeningQuestionID.start", map[string]interface{}{
+	r.logger.WithContext(ctx).Debug("GetByListeningQuestionID.start", map[string]interface{}{
 		"listeningQuestionID": listeningQuestionID,
 	}, "Starting to get map labellings")
 
@@ -60,7 +60,7 @@ func (r *ListeningMapLabellingRepository) GetByListeningQuestionID(ctx context.C
 		Find(&labellings)
 
 	if result.Error != nil {
-		r.logger.Error("listening_map_labelling_repository.get_by_listening_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_map_labelling_repository.get_by_listening_question_id", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    listeningQuestionID,
 		}, "Failed to get map labellings")
@@ -83,7 +83,7 @@ func (r *ListeningMapLabellingRepository) Update(ctx context.Context, qa *listen
 		})
 
 	if result.Error != nil {
-		r.logger.Error("listening_map_labelling_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_map_labelling_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    qa.ID,
 		}, "Failed to update map labelling")
@@ -100,7 +100,7 @@ func (r *ListeningMapLabellingRepository) Update(ctx context.Context, qa *listen
 func (r *ListeningMapLabellingRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	result := r.db.WithContext(ctx).Delete(&listening.ListeningMapLabelling{}, "id = ?", id)
 	if result.Error != nil {
-		r.logger.Error("listening_map_labelling_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_map_labelling_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete map labelling")
@@ -29,7 +29,7 @@ func (r *ListeningMatchingRepository) Create(ctx context.Context, matching *list
 	matching.UpdatedAt = now
 
 	if err := r.db.WithContext(ctx).Create(matching).Error; err != nil {
-		r.logger.Error("listening_matching_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_matching_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create matching")
 		return err
@@ -40,7 +40,7 @@ func (r *ListeningMatchingRepository) Create(ctx context.Context, matching *list
 func (r *ListeningMatchingRepository) GetByID(ctx context.Context, id uuid.UUID) (*listening.ListeningMatching, error) {
 	var matching listening.ListeningMatching
 	if err := r.db.WithContext(ctx).First(&matching, "id = ?", id).Error; err != nil {
-		r.logger.Error("listening_matching_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_matching_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get matching")
@@ -54,7 +54,7 @@ func (r *ListeningMatchingRepository) GetByListeningQuestionID(ctx context.Conte
 	if err := r.db.WithContext(ctx).
 		Where("listening_question_id = ?", listeningQuestionID).
 		Find(&matchings).Error; err != nil {
-		r.logger.Error("listening_matching_repository.get_by_listening_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_matching_repository.get_by_listening_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    listeningQuestionID,
 		}, "Failed to get matchings")
@@ -76,7 +76,7 @@ func (r *ListeningMatchingRepository) Update(ctx context.Context, matching *list
 		})
 
 	if result.Error != nil {
-		r.logger.Error("listening_matching_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_matching_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    matching.ID,
 		}, "Failed to update matching")
@@ -93,7 +93,7 @@ func (r *ListeningMatchingRepository) Update(ctx context.Context, matching *list
 func (r *ListeningMatchingRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	result := r.db.WithContext(ctx).Delete(&listening.ListeningMatching{}, "id = ?", id)
 	if result.Error != nil {
-		r.logger.Error("listening_matching_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_matching_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete matching")
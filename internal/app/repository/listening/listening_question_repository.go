@@ -42,7 +42,7 @@ func (r *ListeningQuestionRepository) CreateListeningQuestion(ctx context.Contex
 	// Create record in database
 	result := r.db.WithContext(ctx).Create(question)
 	if result.Error != nil {
-		r.logger.Error("listening_question_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_question_repository.create", map[string]interface{}{
 			"error": result.Error.Error(),
 		}, "Failed to create listening question")
 		return result.Error
@@ -58,7 +58,7 @@ func (r *ListeningQuestionRepository) GetListeningQuestionByID(ctx context.Conte
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrQuestionNotFound
 		}
-		r.logger.Error("listening_question_repository.get_by_id", map[string]interface{}{"error": err.Error()}, "Failed to get listening question")
+		r.logger.WithContext(ctx).Error("listening_question_repository.get_by_id", map[string]interface{}{"error": err.Error()}, "Failed to get listening question")
 		return nil, err
 	}
 
@@ -96,7 +96,7 @@ func (r *ListeningQuestionRepository) UpdateListeningQuestion(ctx context.Contex
 
 	if result.Error != nil {
 		tx.Rollback()
-		r.logger.Error("listening_question_repository.update", map[string]interface{}{"error": result.Error.Error()}, "Failed to update listening question")
+		r.logger.WithContext(ctx).Error("listening_question_repository.update", map[string]interface{}{"error": result.Error.Error()}, "Failed to update listening question")
 		return result.Error
 	}
 
@@ -108,7 +108,7 @@ func (r *ListeningQuestionRepository) UpdateListeningQuestion(ctx context.Contex
 	// Get the updated question with the new version
 	if err := tx.First(question, "id = ?", question.ID).Error; err != nil {
 		tx.Rollback()
-		r.logger.Error("listening_question_repository.update.get_updated", map[string]interface{}{"error": err.Error()}, "Failed to get updated question")
+		r.logger.WithContext(ctx).Error("listening_question_repository.update.get_updated", map[string]interface{}{"error": err.Error()}, "Failed to get updated question")
 		return err
 	}
 
@@ -118,7 +118,7 @@ func (r *ListeningQuestionRepository) UpdateListeningQuestion(ctx context.Contex
 func (r *ListeningQuestionRepository) DeleteListeningQuestion(ctx context.Context, id uuid.UUID) error {
 	result := r.db.WithContext(ctx).Delete(&listening.ListeningQuestion{}, "id = ?", id)
 	if result.Error != nil {
-		r.logger.Error("listening_question_repository.delete", map[string]interface{}{"error": result.Error.Error()}, "Failed to delete listening question")
+		r.logger.WithContext(ctx).Error("listening_question_repository.delete", map[string]interface{}{"error": result.Error.Error()}, "Failed to delete listening question")
 		return result.Error
 	}
 
@@ -229,7 +229,7 @@ func (r *ListeningQuestionRepository) GetNewUpdatedQuestions(ctx context.Context
 
 	err := query.Find(&questions).Error
 	if err != nil {
-		r.logger.Error("listening_question_repository.get_new_updated", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("listening_question_repository.get_new_updated", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get updated questions")
 		return nil, err
@@ -0,0 +1,177 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fluencybe/internal/app/model/outbox"
+	"fluencybe/pkg/logger"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrEventNotFound = errors.New("dead outbox event not found")
+)
+
+type OutboxRepository struct {
+	db     *gorm.DB
+	logger *logger.PrettyLogger
+}
+
+func NewOutboxRepository(db *gorm.DB, logger *logger.PrettyLogger) *OutboxRepository {
+	return &OutboxRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// ClaimBatch leases up to limit due events to the caller. The lease is the pushed back
+// next_attempt_at, so events of a relay that dies mid-batch become due again on their own,
+// and SKIP LOCKED lets several instances claim disjoint batches.
+func (r *OutboxRepository) ClaimBatch(ctx context.Context, limit int, lease time.Duration) ([]*outbox.OutboxEvent, error) {
+	query := `
+		UPDATE outbox_events
+		SET attempts = attempts + 1, next_attempt_at = ?
+		WHERE id IN (
+			SELECT id FROM outbox_events
+			WHERE status = ? AND next_attempt_at <= ?
+			ORDER BY id
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *
+	`
+	now := time.Now().UTC()
+
+	var events []*outbox.OutboxEvent
+	if err := r.db.WithContext(ctx).Raw(query, now.Add(lease), outbox.StatusPending, now, limit).Scan(&events).Error; err != nil {
+		r.logger.WithContext(ctx).Error("outbox_repository.claim_batch", map[string]interface{}{
+			"error": err.Error(),
+		}, "Failed to claim outbox events")
+		return nil, err
+	}
+	return events, nil
+}
+
+func (r *OutboxRepository) MarkDone(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Model(&outbox.OutboxEvent{}).
+		Where("id IN ?", ids).
+		Updates(map[string]interface{}{
+			"status":       outbox.StatusDone,
+			"processed_at": time.Now().UTC(),
+			"last_error":   nil,
+		}).Error
+}
+
+// MarkFailed schedules the events for another attempt at retryAt, or parks them in the
+// dead-letter queue when dead is set
+func (r *OutboxRepository) MarkFailed(ctx context.Context, ids []int64, lastError string, retryAt time.Time, dead bool) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	updates := map[string]interface{}{
+		"last_error":      lastError,
+		"next_attempt_at": retryAt,
+	}
+	if dead {
+		updates["status"] = outbox.StatusDead
+		updates["processed_at"] = time.Now().UTC()
+	}
+	return r.db.WithContext(ctx).Model(&outbox.OutboxEvent{}).
+		Where("id IN ?", ids).
+		Updates(updates).Error
+}
+
+func (r *OutboxRepository) ListDead(ctx context.Context, aggregateType string, page, pageSize int) ([]*outbox.OutboxEvent, int64, error) {
+	query := r.db.WithContext(ctx).Model(&outbox.OutboxEvent{}).Where("status = ?", outbox.StatusDead)
+	if aggregateType != "" {
+		query = query.Where("aggregate_type = ?", aggregateType)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		r.logger.WithContext(ctx).Error("outbox_repository.list_dead.count", map[string]interface{}{
+			"error": err.Error(),
+		}, "Failed to count dead outbox events")
+		return nil, 0, err
+	}
+
+	var events []*outbox.OutboxEvent
+	if err := query.Order("id DESC").Offset((page - 1) * pageSize).Limit(pageSize).Find(&events).Error; err != nil {
+		r.logger.WithContext(ctx).Error("outbox_repository.list_dead", map[string]interface{}{
+			"error": err.Error(),
+		}, "Failed to list dead outbox events")
+		return nil, 0, err
+	}
+	return events, total, nil
+}
+
+// Requeue moves a dead event back to pending with a fresh attempt budget
+func (r *OutboxRepository) Requeue(ctx context.Context, id int64) error {
+	result := r.db.WithContext(ctx).Model(&outbox.OutboxEvent{}).
+		Where("id = ? AND status = ?", id, outbox.StatusDead).
+		Updates(map[string]interface{}{
+			"status":          outbox.StatusPending,
+			"attempts":        0,
+			"next_attempt_at": time.Now().UTC(),
+			"processed_at":    nil,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrEventNotFound
+	}
+	return nil
+}
+
+// RequeueAll moves every dead event, optionally of one aggregate type, back to pending
+func (r *OutboxRepository) RequeueAll(ctx context.Context, aggregateType string) (int64, error) {
+	query := r.db.WithContext(ctx).Model(&outbox.OutboxEvent{}).Where("status = ?", outbox.StatusDead)
+	if aggregateType != "" {
+		query = query.Where("aggregate_type = ?", aggregateType)
+	}
+	result := query.Updates(map[string]interface{}{
+		"status":          outbox.StatusPending,
+		"attempts":        0,
+		"next_attempt_at": time.Now().UTC(),
+		"processed_at":    nil,
+	})
+	return result.RowsAffected, result.Error
+}
+
+// CountByStatus returns the number of events per status
+func (r *OutboxRepository) CountByStatus(ctx context.Context) (map[outbox.EventStatus]int64, error) {
+	var rows []struct {
+		Status outbox.EventStatus
+		Count  int64
+	}
+	if err := r.db.WithContext(ctx).Model(&outbox.OutboxEvent{}).
+		Select("status, COUNT(*) AS count").
+		Group("status").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	counts := map[outbox.EventStatus]int64{
+		outbox.StatusPending: 0,
+		outbox.StatusDone:    0,
+		outbox.StatusDead:    0,
+	}
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
+
+// PurgeProcessed deletes done events processed before the given time
+func (r *OutboxRepository) PurgeProcessed(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("status = ? AND processed_at < ?", outbox.StatusDone, before).
+		Delete(&outbox.OutboxEvent{})
+	return result.RowsAffected, result.Error
+}
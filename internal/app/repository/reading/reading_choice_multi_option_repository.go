@@ -34,7 +34,7 @@ func (r *ReadingChoiceMultiOptionRepository) Create(ctx context.Context, option
 
 	err := r.db.WithContext(ctx).Create(option).Error
 	if err != nil {
-		r.logger.Error("reading_choice_multi_option_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_choice_multi_option_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create option")
 		return err
@@ -49,7 +49,7 @@ func (r *ReadingChoiceMultiOptionRepository) GetByID(ctx context.Context, id uui
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("reading_choice_multi_option_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_choice_multi_option_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get option")
 		return nil, err
@@ -68,7 +68,7 @@ func (r *ReadingChoiceMultiOptionRepository) Update(ctx context.Context, option
 		})
 
 	if result.Error != nil {
-		r.logger.Error("reading_choice_multi_option_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_choice_multi_option_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 		}, "Failed to update option")
 		return result.Error
@@ -85,7 +85,7 @@ func (r *ReadingChoiceMultiOptionRepository) Delete(ctx context.Context, id uuid
 	result := r.db.WithContext(ctx).Delete(&reading.ReadingChoiceMultiOption{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("reading_choice_multi_option_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_choice_multi_option_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete option")
@@ -106,7 +106,7 @@ func (r *ReadingChoiceMultiOptionRepository) GetByReadingChoiceMultiQuestionID(c
 		Find(&options).Error
 
 	if err != nil {
-		r.logger.Error("reading_choice_multi_option_repository.get_by_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_choice_multi_option_repository.get_by_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    questionID,
 		}, "Failed to get options by question ID")
@@ -34,7 +34,7 @@ func (r *ReadingChoiceMultiQuestionRepository) Create(ctx context.Context, quest
 
 	err := r.db.WithContext(ctx).Create(question).Error
 	if err != nil {
-		r.logger.Error("reading_choice_multi_question_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_choice_multi_question_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create question")
 		return err
@@ -49,7 +49,7 @@ func (r *ReadingChoiceMultiQuestionRepository) GetByID(ctx context.Context, id u
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("reading_choice_multi_question_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_choice_multi_question_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get question")
 		return nil, err
@@ -69,7 +69,7 @@ func (r *ReadingChoiceMultiQuestionRepository) Update(ctx context.Context, quest
 		})
 
 	if result.Error != nil {
-		r.logger.Error("reading_choice_multi_question_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_choice_multi_question_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 		}, "Failed to update question")
 		return result.Error
@@ -86,7 +86,7 @@ func (r *ReadingChoiceMultiQuestionRepository) Delete(ctx context.Context, id uu
 	result := r.db.WithContext(ctx).Delete(&reading.ReadingChoiceMultiQuestion{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("reading_choice_multi_question_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_choice_multi_question_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete question")
@@ -107,7 +107,7 @@ func (r *ReadingChoiceMultiQuestionRepository) GetByReadingQuestionID(ctx contex
 		Find(&questions).Error
 
 	if err != nil {
-		r.logger.Error("reading_choice_multi_question_repository.get_by_reading_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_choice_multi_question_repository.get_by_reading_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    readingQuestionID,
 		}, "Failed to get questions by reading question ID")
@@ -34,7 +34,7 @@ func (r *ReadingChoiceOneOptionRepository) Create(ctx context.Context, option *r
 
 	err := r.db.WithContext(ctx).Create(option).Error
 	if err != nil {
-		r.logger.Error("reading_choice_one_option_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_choice_one_option_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create option")
 		return err
@@ -49,7 +49,7 @@ func (r *ReadingChoiceOneOptionRepository) GetByID(ctx context.Context, id uuid.
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("reading_choice_one_option_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_choice_one_option_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get option")
 		return nil, err
@@ -83,7 +83,7 @@ func (r *ReadingChoiceOneOptionRepository) Update(ctx context.Context, option *r
 		Updates(updates)
 
 	if result.Error != nil {
-		r.logger.Error("reading_choice_one_option_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_choice_one_option_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 		}, "Failed to update option")
 		return result.Error
@@ -100,7 +100,7 @@ func (r *ReadingChoiceOneOptionRepository) Delete(ctx context.Context, id uuid.U
 	result := r.db.WithContext(ctx).Delete(&reading.ReadingChoiceOneOption{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("reading_choice_one_option_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_choice_one_option_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete option")
@@ -121,7 +121,7 @@ func (r *ReadingChoiceOneOptionRepository) GetByReadingChoiceOneQuestionID(ctx c
 		Find(&options).Error
 
 	if err != nil {
-		r.logger.Error("reading_choice_one_option_repository.get_by_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_choice_one_option_repository.get_by_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    questionID,
 		}, "Failed to get options by question ID")
@@ -141,7 +141,7 @@ func (r *ReadingChoiceOneOptionRepository) GetCorrectOption(ctx context.Context,
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
 		}
-		r.logger.Error("reading_choice_one_option_repository.get_correct_option", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_choice_one_option_repository.get_correct_option", map[string]interface{}{
 			"error":       err.Error(),
 			"question_id": questionID,
 		}, "Failed to get correct option")
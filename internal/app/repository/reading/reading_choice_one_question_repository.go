@@ -34,7 +34,7 @@ func (r *ReadingChoiceOneQuestionRepository) Create(ctx context.Context, questio
 
 	err := r.db.WithContext(ctx).Create(question).Error
 	if err != nil {
-		r.logger.Error("reading_choice_one_question_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_choice_one_question_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create question")
 		return err
@@ -49,7 +49,7 @@ func (r *ReadingChoiceOneQuestionRepository) GetByID(ctx context.Context, id uui
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("reading_choice_one_question_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_choice_one_question_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get question")
 		return nil, err
@@ -69,7 +69,7 @@ func (r *ReadingChoiceOneQuestionRepository) Update(ctx context.Context, questio
 		})
 
 	if result.Error != nil {
-		r.logger.Error("reading_choice_one_question_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_choice_one_question_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 		}, "Failed to update question")
 		return result.Error
@@ -86,7 +86,7 @@ func (r *ReadingChoiceOneQuestionRepository) Delete(ctx context.Context, id uuid
 	result := r.db.WithContext(ctx).Delete(&reading.ReadingChoiceOneQuestion{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("reading_choice_one_question_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_choice_one_question_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete question")
@@ -107,7 +107,7 @@ func (r *ReadingChoiceOneQuestionRepository) GetByReadingQuestionID(ctx context.
 		Find(&questions).Error
 
 	if err != nil {
-		r.logger.Error("reading_choice_one_question_repository.get_by_reading_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_choice_one_question_repository.get_by_reading_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    readingQuestionID,
 		}, "Failed to get questions by reading question ID")
@@ -34,7 +34,7 @@ func (r *ReadingFillInTheBlankAnswerRepository) Create(ctx context.Context, answ
 
 	err := r.db.WithContext(ctx).Create(answer).Error
 	if err != nil {
-		r.logger.Error("reading_fill_in_the_blank_answer_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_fill_in_the_blank_answer_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create answer")
 		return err
@@ -49,7 +49,7 @@ func (r *ReadingFillInTheBlankAnswerRepository) GetByID(ctx context.Context, id
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("reading_fill_in_the_blank_answer_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_fill_in_the_blank_answer_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get answer")
 		return nil, err
@@ -69,7 +69,7 @@ func (r *ReadingFillInTheBlankAnswerRepository) Update(ctx context.Context, answ
 		})
 
 	if result.Error != nil {
-		r.logger.Error("reading_fill_in_the_blank_answer_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_fill_in_the_blank_answer_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 		}, "Failed to update answer")
 		return result.Error
@@ -86,7 +86,7 @@ func (r *ReadingFillInTheBlankAnswerRepository) Delete(ctx context.Context, id u
 	result := r.db.WithContext(ctx).Delete(&reading.ReadingFillInTheBlankAnswer{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("reading_fill_in_the_blank_answer_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_fill_in_the_blank_answer_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete answer")
@@ -107,7 +107,7 @@ func (r *ReadingFillInTheBlankAnswerRepository) GetByReadingFillInTheBlankQuesti
 		Find(&answers).Error
 
 	if err != nil {
-		r.logger.Error("reading_fill_in_the_blank_answer_repository.get_by_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_fill_in_the_blank_answer_repository.get_by_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    questionID,
 		}, "Failed to get answers by question ID")
@@ -34,7 +34,7 @@ func (r *ReadingFillInTheBlankQuestionRepository) Create(ctx context.Context, qu
 
 	err := r.db.WithContext(ctx).Create(question).Error
 	if err != nil {
-		r.logger.Error("reading_fill_in_the_blank_question_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_fill_in_the_blank_question_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create question")
 		return err
@@ -49,7 +49,7 @@ func (r *ReadingFillInTheBlankQuestionRepository) GetByID(ctx context.Context, i
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("reading_fill_in_the_blank_question_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_fill_in_the_blank_question_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get question")
 		return nil, err
@@ -68,7 +68,7 @@ func (r *ReadingFillInTheBlankQuestionRepository) Update(ctx context.Context, qu
 		})
 
 	if result.Error != nil {
-		r.logger.Error("reading_fill_in_the_blank_question_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_fill_in_the_blank_question_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 		}, "Failed to update question")
 		return result.Error
@@ -85,7 +85,7 @@ func (r *ReadingFillInTheBlankQuestionRepository) Delete(ctx context.Context, id
 	result := r.db.WithContext(ctx).Delete(&reading.ReadingFillInTheBlankQuestion{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("reading_fill_in_the_blank_question_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_fill_in_the_blank_question_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete question")
@@ -106,7 +106,7 @@ func (r *ReadingFillInTheBlankQuestionRepository) GetByReadingQuestionID(ctx con
 		Find(&questions).Error
 
 	if err != nil {
-		r.logger.Error("reading_fill_in_the_blank_question_repository.get_by_reading_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_fill_in_the_blank_question_repository.get_by_reading_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    readingQuestionID,
 		}, "Failed to get questions by reading question ID")
@@ -34,7 +34,7 @@ func (r *ReadingMatchingRepository) Create(ctx context.Context, matching *readin
 
 	err := r.db.WithContext(ctx).Create(matching).Error
 	if err != nil {
-		r.logger.Error("reading_matching_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_matching_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create matching")
 		return err
@@ -49,7 +49,7 @@ func (r *ReadingMatchingRepository) GetByID(ctx context.Context, id uuid.UUID) (
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("reading_matching_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_matching_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get matching")
 		return nil, err
@@ -69,7 +69,7 @@ func (r *ReadingMatchingRepository) Update(ctx context.Context, matching *readin
 		})
 
 	if result.Error != nil {
-		r.logger.Error("reading_matching_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_matching_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 		}, "Failed to update matching")
 		return result.Error
@@ -86,7 +86,7 @@ func (r *ReadingMatchingRepository) Delete(ctx context.Context, id uuid.UUID) er
 	result := r.db.WithContext(ctx).Delete(&reading.ReadingMatching{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("reading_matching_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_matching_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete matching")
@@ -107,7 +107,7 @@ func (r *ReadingMatchingRepository) GetByReadingQuestionID(ctx context.Context,
 		Find(&matchings).Error
 
 	if err != nil {
-		r.logger.Error("reading_matching_repository.get_by_reading_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_matching_repository.get_by_reading_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    readingQuestionID,
 		}, "Failed to get matchings by reading question ID")
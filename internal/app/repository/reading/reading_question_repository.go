@@ -42,7 +42,7 @@ func (r *ReadingQuestionRepository) CreateReadingQuestion(ctx context.Context, q
 	// Create record in database
 	result := r.db.WithContext(ctx).Create(question)
 	if result.Error != nil {
-		r.logger.Error("reading_question_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_question_repository.create", map[string]interface{}{
 			"error": result.Error.Error(),
 		}, "Failed to create reading question")
 		return result.Error
@@ -58,7 +58,7 @@ func (r *ReadingQuestionRepository) GetReadingQuestionByID(ctx context.Context,
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrQuestionNotFound
 		}
-		r.logger.Error("reading_question_repository.get_by_id", map[string]interface{}{"error": err.Error()}, "Failed to get reading question")
+		r.logger.WithContext(ctx).Error("reading_question_repository.get_by_id", map[string]interface{}{"error": err.Error()}, "Failed to get reading question")
 		return nil, err
 	}
 
@@ -94,7 +94,7 @@ func (r *ReadingQuestionRepository) UpdateReadingQuestion(ctx context.Context, q
 
 	if result.Error != nil {
 		tx.Rollback()
-		r.logger.Error("reading_question_repository.update", map[string]interface{}{"error": result.Error.Error()}, "Failed to update reading question")
+		r.logger.WithContext(ctx).Error("reading_question_repository.update", map[string]interface{}{"error": result.Error.Error()}, "Failed to update reading question")
 		return result.Error
 	}
 
@@ -109,7 +109,7 @@ func (r *ReadingQuestionRepository) UpdateReadingQuestion(ctx context.Context, q
 func (r *ReadingQuestionRepository) DeleteReadingQuestion(ctx context.Context, id uuid.UUID) error {
 	result := r.db.WithContext(ctx).Delete(&reading.ReadingQuestion{}, "id = ?", id)
 	if result.Error != nil {
-		r.logger.Error("reading_question_repository.delete", map[string]interface{}{"error": result.Error.Error()}, "Failed to delete reading question")
+		r.logger.WithContext(ctx).Error("reading_question_repository.delete", map[string]interface{}{"error": result.Error.Error()}, "Failed to delete reading question")
 		return result.Error
 	}
 	if result.RowsAffected == 0 {
@@ -122,7 +122,7 @@ func (r *ReadingQuestionRepository) GetAllCompleteQuestions(ctx context.Context)
 	var questions []*reading.ReadingQuestion
 	err := r.db.WithContext(ctx).Find(&questions).Error
 	if err != nil {
-		r.logger.Error("reading_question_repository.get_all", map[string]interface{}{"error": err.Error()}, "Failed to get all reading questions")
+		r.logger.WithContext(ctx).Error("reading_question_repository.get_all", map[string]interface{}{"error": err.Error()}, "Failed to get all reading questions")
 		return nil, err
 	}
 	return questions, nil
@@ -141,13 +141,13 @@ func (r *ReadingQuestionRepository) SearchQuestions(ctx context.Context, searchP
 
 	err := query.Count(&total).Error
 	if err != nil {
-		r.logger.Error("reading_question_repository.search_count", map[string]interface{}{"error": err.Error()}, "Failed to count reading questions")
+		r.logger.WithContext(ctx).Error("reading_question_repository.search_count", map[string]interface{}{"error": err.Error()}, "Failed to count reading questions")
 		return 0, nil, err
 	}
 
 	err = query.Limit(pageSize).Offset(offset).Find(&questions).Error
 	if err != nil {
-		r.logger.Error("reading_question_repository.search", map[string]interface{}{"error": err.Error()}, "Failed to search reading questions")
+		r.logger.WithContext(ctx).Error("reading_question_repository.search", map[string]interface{}{"error": err.Error()}, "Failed to search reading questions")
 		return 0, nil, err
 	}
 
@@ -206,7 +206,7 @@ func (r *ReadingQuestionRepository) GetNewUpdatedQuestions(ctx context.Context,
 		Find(&questions)
 
 	if query.Error != nil {
-		r.logger.Error("reading_question_repository.get_new_updated", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_question_repository.get_new_updated", map[string]interface{}{
 			"error": query.Error.Error(),
 		}, "Failed to get new/updated questions")
 		return nil, query.Error
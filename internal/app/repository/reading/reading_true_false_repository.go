@@ -34,7 +34,7 @@ func (r *ReadingTrueFalseRepository) Create(ctx context.Context, trueFalse *read
 
 	err := r.db.WithContext(ctx).Create(trueFalse).Error
 	if err != nil {
-		r.logger.Error("reading_true_false_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_true_false_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create true/false question")
 		return err
@@ -49,7 +49,7 @@ func (r *ReadingTrueFalseRepository) GetByID(ctx context.Context, id uuid.UUID)
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("reading_true_false_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_true_false_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get true/false question")
 		return nil, err
@@ -69,7 +69,7 @@ func (r *ReadingTrueFalseRepository) Update(ctx context.Context, trueFalse *read
 		})
 
 	if result.Error != nil {
-		r.logger.Error("reading_true_false_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_true_false_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 		}, "Failed to update true/false question")
 		return result.Error
@@ -86,7 +86,7 @@ func (r *ReadingTrueFalseRepository) Delete(ctx context.Context, id uuid.UUID) e
 	result := r.db.WithContext(ctx).Delete(&reading.ReadingTrueFalse{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("reading_true_false_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_true_false_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete true/false question")
@@ -107,7 +107,7 @@ func (r *ReadingTrueFalseRepository) GetByReadingQuestionID(ctx context.Context,
 		Find(&trueFalses).Error
 
 	if err != nil {
-		r.logger.Error("reading_true_false_repository.get_by_reading_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("reading_true_false_repository.get_by_reading_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    readingQuestionID,
 		}, "Failed to get true/false questions by reading question ID")
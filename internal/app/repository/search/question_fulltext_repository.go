@@ -0,0 +1,404 @@
+package search
+
+import (
+	"context"
+	"fluencybe/pkg/logger"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// TextMatch is a full-text condition on one or more columns, it holds when any of the columns
+// matches, like an OpenSearch match or multi_match query
+type TextMatch struct {
+	Columns []string
+	Text    string
+	// AllTerms requires every term in the same column, like the "and" operator
+	AllTerms bool
+}
+
+// QuestionSearchQuery is a question search filter translated for Postgres, every set condition must hold
+type QuestionSearchQuery struct {
+	Type string
+	// Topic is a comma separated list, a question matches when it has any of the topics
+	Topic   string
+	Matches []TextMatch
+	// ArrayTerms maps an array column to a value it must contain
+	ArrayTerms map[string]string
+	// MaxTime is a "min-max" range, ignored when malformed
+	MaxTime string
+	// Metadata is matched against the child rows of Type, it is ignored when Type is empty
+	Metadata string
+	// ChildText is matched against every child row, whatever the question type
+	ChildText string
+	// HasChild is a comma separated list of child kinds, a question matches when it has any of them
+	HasChild   string
+	MinOptions int
+	Page       int
+	PageSize   int
+}
+
+type questionTable struct {
+	// textColumns maps a searchable column to its expression, they must match the indexes in migrations/0012_search.up.sql
+	textColumns  map[string]string
+	arrayColumns []string
+	// metadata maps a question type to the queries selecting its child rows as jsonb
+	metadata map[string][]string
+	// children maps a child kind of the search documents to the query selecting its rows as jsonb
+	children map[string]string
+}
+
+// optionKinds are the child kinds counted by the min_options filter
+var optionKinds = []string{"choice_one_option", "choice_multi_option"}
+
+var questionTables = map[string]questionTable{
+	"grammar": {
+		textColumns: map[string]string{
+			"instruction": "q.instruction",
+		},
+		metadata: map[string][]string{
+			"FILL_IN_THE_BLANK": {
+				childRows("grammar_fill_in_the_blank_questions", "grammar_question_id"),
+				grandchildRows("grammar_fill_in_the_blank_answers", "grammar_fill_in_the_blank_question_id", "grammar_fill_in_the_blank_questions", "grammar_question_id"),
+			},
+			"CHOICE_ONE": {
+				childRows("grammar_choice_one_questions", "grammar_question_id"),
+				grandchildRows("grammar_choice_one_options", "grammar_choice_one_question_id", "grammar_choice_one_questions", "grammar_question_id"),
+			},
+			"ERROR_IDENTIFICATION": {
+				childRows("grammar_error_identifications", "grammar_question_id"),
+			},
+			"SENTENCE_TRANSFORMATION": {
+				childRows("grammar_sentence_transformations", "grammar_question_id"),
+			},
+		},
+		children: map[string]string{
+			"fill_in_the_blank_question": childRows("grammar_fill_in_the_blank_questions", "grammar_question_id"),
+			"fill_in_the_blank_answer":   grandchildRows("grammar_fill_in_the_blank_answers", "grammar_fill_in_the_blank_question_id", "grammar_fill_in_the_blank_questions", "grammar_question_id"),
+			"choice_one_question":        childRows("grammar_choice_one_questions", "grammar_question_id"),
+			"choice_one_option":          grandchildRows("grammar_choice_one_options", "grammar_choice_one_question_id", "grammar_choice_one_questions", "grammar_question_id"),
+			"error_identification":       childRows("grammar_error_identifications", "grammar_question_id"),
+			"sentence_transformation":    childRows("grammar_sentence_transformations", "grammar_question_id"),
+		},
+	},
+	"listening": {
+		textColumns: map[string]string{
+			"instruction": "q.instruction",
+			"transcript":  "q.transcript",
+		},
+		arrayColumns: []string{"audio_urls", "image_urls"},
+		metadata: map[string][]string{
+			"FILL_IN_THE_BLANK": {
+				childRows("listening_fill_in_the_blank_questions", "listening_question_id"),
+				grandchildRows("listening_fill_in_the_blank_answers", "listening_fill_in_the_blank_question_id", "listening_fill_in_the_blank_questions", "listening_question_id"),
+			},
+			"CHOICE_ONE": {
+				childRows("listening_choice_one_questions", "listening_question_id"),
+				grandchildRows("listening_choice_one_options", "listening_choice_one_question_id", "listening_choice_one_questions", "listening_question_id"),
+			},
+			"CHOICE_MULTI": {
+				childRows("listening_choice_multi_questions", "listening_question_id"),
+				grandchildRows("listening_choice_multi_options", "listening_choice_multi_question_id", "listening_choice_multi_questions", "listening_question_id"),
+			},
+			"MAP_LABELLING": {
+				childRows("listening_map_labellings", "listening_question_id"),
+			},
+			"MATCHING": {
+				childRows("listening_matchings", "listening_question_id"),
+			},
+		},
+		children: map[string]string{
+			"fill_in_the_blank_question": childRows("listening_fill_in_the_blank_questions", "listening_question_id"),
+			"fill_in_the_blank_answer":   grandchildRows("listening_fill_in_the_blank_answers", "listening_fill_in_the_blank_question_id", "listening_fill_in_the_blank_questions", "listening_question_id"),
+			"choice_one_question":        childRows("listening_choice_one_questions", "listening_question_id"),
+			"choice_one_option":          grandchildRows("listening_choice_one_options", "listening_choice_one_question_id", "listening_choice_one_questions", "listening_question_id"),
+			"choice_multi_question":      childRows("listening_choice_multi_questions", "listening_question_id"),
+			"choice_multi_option":        grandchildRows("listening_choice_multi_options", "listening_choice_multi_question_id", "listening_choice_multi_questions", "listening_question_id"),
+			"map_labelling":              childRows("listening_map_labellings", "listening_question_id"),
+			"matching":                   childRows("listening_matchings", "listening_question_id"),
+		},
+	},
+	"reading": {
+		textColumns: map[string]string{
+			"instruction": "q.instruction",
+			"title":       "q.title",
+			"passages":    "search_text_array(q.passages)",
+			"topic":       "search_text_array(q.topic::TEXT[])",
+		},
+		arrayColumns: []string{"image_urls"},
+		metadata: map[string][]string{
+			"FILL_IN_THE_BLANK": {
+				childRows("reading_fill_in_the_blank_questions", "reading_question_id"),
+				grandchildRows("reading_fill_in_the_blank_answers", "reading_fill_in_the_blank_question_id", "reading_fill_in_the_blank_questions", "reading_question_id"),
+			},
+			"CHOICE_ONE": {
+				childRows("reading_choice_one_questions", "reading_question_id"),
+				grandchildRows("reading_choice_one_options", "reading_choice_one_question_id", "reading_choice_one_questions", "reading_question_id"),
+			},
+			"CHOICE_MULTI": {
+				childRows("reading_choice_multi_questions", "reading_question_id"),
+				grandchildRows("reading_choice_multi_options", "reading_choice_multi_question_id", "reading_choice_multi_questions", "reading_question_id"),
+			},
+			"TRUE_FALSE": {
+				childRows("reading_true_falses", "reading_question_id"),
+			},
+			"MATCHING": {
+				childRows("reading_matchings", "reading_question_id"),
+			},
+		},
+		children: map[string]string{
+			"true_false":                 childRows("reading_true_falses", "reading_question_id"),
+			"fill_in_the_blank_question": childRows("reading_fill_in_the_blank_questions", "reading_question_id"),
+			"fill_in_the_blank_answer":   grandchildRows("reading_fill_in_the_blank_answers", "reading_fill_in_the_blank_question_id", "reading_fill_in_the_blank_questions", "reading_question_id"),
+			"choice_one_question":        childRows("reading_choice_one_questions", "reading_question_id"),
+			"choice_one_option":          grandchildRows("reading_choice_one_options", "reading_choice_one_question_id", "reading_choice_one_questions", "reading_question_id"),
+			"choice_multi_question":      childRows("reading_choice_multi_questions", "reading_question_id"),
+			"choice_multi_option":        grandchildRows("reading_choice_multi_options", "reading_choice_multi_question_id", "reading_choice_multi_questions", "reading_question_id"),
+			"matching":                   childRows("reading_matchings", "reading_question_id"),
+		},
+	},
+	"speaking": {
+		children: map[string]string{
+			"word_repetition":              childRows("speaking_word_repetitions", "speaking_question_id"),
+			"phrase_repetition":            childRows("speaking_phrase_repetitions", "speaking_question_id"),
+			"paragraph_repetition":         childRows("speaking_paragraph_repetitions", "speaking_question_id"),
+			"open_paragraph":               childRows("speaking_open_paragraphs", "speaking_question_id"),
+			"conversational_repetition":    childRows("speaking_conversational_repetitions", "speaking_question_id"),
+			"conversational_repetition_qa": grandchildRows("speaking_conversational_repetition_qas", "speaking_conversational_repetition_id", "speaking_conversational_repetitions", "speaking_question_id"),
+			"conversational_open":          childRows("speaking_conversational_opens", "speaking_question_id"),
+		},
+	},
+	"writing": {
+		children: map[string]string{
+			"sentence_completion": childRows("writing_sentence_completions", "writing_question_id"),
+			"essay":               childRows("writing_essays", "writing_question_id"),
+		},
+	},
+}
+
+// childRows selects the rows of a child table that belong to question q
+func childRows(table, questionFK string) string {
+	return fmt.Sprintf("SELECT to_jsonb(c) FROM %s c WHERE c.%s = q.id", table, questionFK)
+}
+
+// grandchildRows selects the rows of a table hanging off a child table of question q
+func grandchildRows(table, parentFK, parent, questionFK string) string {
+	return fmt.Sprintf("SELECT to_jsonb(c) FROM %s c JOIN %s p ON p.id = c.%s WHERE p.%s = q.id", table, parent, parentFK, questionFK)
+}
+
+// QuestionFullTextRepository searches the skill question tables with tsvector and trigram matching,
+// it answers searches while OpenSearch is unavailable
+type QuestionFullTextRepository struct {
+	db     *gorm.DB
+	logger *logger.PrettyLogger
+}
+
+func NewQuestionFullTextRepository(db *gorm.DB, logger *logger.PrettyLogger) *QuestionFullTextRepository {
+	return &QuestionFullTextRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Search returns the IDs of one page of matching questions of a skill, best matches first,
+// together with the total number of matches
+func (r *QuestionFullTextRepository) Search(ctx context.Context, skill string, query QuestionSearchQuery) ([]uuid.UUID, int64, error) {
+	table, ok := questionTables[skill]
+	if !ok {
+		return nil, 0, fmt.Errorf("unknown skill %q", skill)
+	}
+
+	where, whereArgs, rank, rankArgs, err := table.build(query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// The table name comes from the fixed skill list above, never from input
+	countQuery := fmt.Sprintf("SELECT count(*) FROM %s_questions q WHERE %s", skill, where)
+
+	var total int64
+	if err := r.db.WithContext(ctx).Raw(countQuery, whereArgs...).Scan(&total).Error; err != nil {
+		r.logger.WithContext(ctx).Error("question_fulltext_repository.count", map[string]interface{}{
+			"error": err.Error(),
+			"skill": skill,
+		}, "Failed to count matching questions")
+		return nil, 0, err
+	}
+	if total == 0 {
+		return []uuid.UUID{}, 0, nil
+	}
+
+	pageQuery := fmt.Sprintf(
+		"SELECT q.id FROM %s_questions q WHERE %s ORDER BY %s DESC, q.created_at DESC, q.id LIMIT ? OFFSET ?",
+		skill, where, rank,
+	)
+	args := append(append(whereArgs, rankArgs...), query.PageSize, (query.Page-1)*query.PageSize)
+
+	var rows []struct {
+		ID uuid.UUID
+	}
+	if err := r.db.WithContext(ctx).Raw(pageQuery, args...).Scan(&rows).Error; err != nil {
+		r.logger.WithContext(ctx).Error("question_fulltext_repository.search", map[string]interface{}{
+			"error": err.Error(),
+			"skill": skill,
+		}, "Failed to search questions")
+		return nil, 0, err
+	}
+
+	ids := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+	return ids, total, nil
+}
+
+// build turns the query into a WHERE clause and a rank expression with their positional arguments
+func (t questionTable) build(query QuestionSearchQuery) (string, []interface{}, string, []interface{}, error) {
+	conditions := []string{"TRUE"}
+	var whereArgs []interface{}
+	ranks := []string{"0"}
+	var rankArgs []interface{}
+
+	if query.Type != "" {
+		conditions = append(conditions, "q.type::TEXT = ?")
+		whereArgs = append(whereArgs, query.Type)
+	}
+
+	if query.Topic != "" {
+		topics := strings.Split(query.Topic, ",")
+		for i, topic := range topics {
+			topics[i] = strings.TrimSpace(topic)
+		}
+		conditions = append(conditions, "q.topic && CAST(? AS VARCHAR[])")
+		whereArgs = append(whereArgs, pq.StringArray(topics))
+	}
+
+	for _, match := range query.Matches {
+		if match.Text == "" {
+			continue
+		}
+
+		tsQuery, tsArg := "plainto_tsquery('simple', ?)", match.Text
+		if !match.AllTerms {
+			tsQuery, tsArg = "websearch_to_tsquery('simple', ?)", anyTerm(match.Text)
+		}
+
+		var columnConditions, columnRanks []string
+		for _, column := range match.Columns {
+			expr, ok := t.textColumns[column]
+			if !ok {
+				return "", nil, "", nil, fmt.Errorf("column %q is not searchable", column)
+			}
+			columnConditions = append(columnConditions, fmt.Sprintf("to_tsvector('simple', %s) @@ %s OR ? <%% %s", expr, tsQuery, expr))
+			whereArgs = append(whereArgs, tsArg, match.Text)
+			columnRanks = append(columnRanks, fmt.Sprintf("ts_rank(to_tsvector('simple', %s), %s) + word_similarity(?, %s)", expr, tsQuery, expr))
+			rankArgs = append(rankArgs, tsArg, match.Text)
+		}
+		conditions = append(conditions, "("+strings.Join(columnConditions, " OR ")+")")
+		ranks = append(ranks, "GREATEST("+strings.Join(columnRanks, ", ")+")")
+	}
+
+	for column, value := range query.ArrayTerms {
+		if value == "" {
+			continue
+		}
+		if !slices.Contains(t.arrayColumns, column) {
+			return "", nil, "", nil, fmt.Errorf("column %q is not searchable", column)
+		}
+		conditions = append(conditions, fmt.Sprintf("? = ANY(q.%s)", column))
+		whereArgs = append(whereArgs, value)
+	}
+
+	if query.MaxTime != "" {
+		parts := strings.Split(query.MaxTime, "-")
+		if len(parts) == 2 {
+			minTime, err1 := strconv.Atoi(strings.TrimSpace(parts[0]))
+			maxTime, err2 := strconv.Atoi(strings.TrimSpace(parts[1]))
+			if err1 == nil && err2 == nil {
+				conditions = append(conditions, "q.max_time BETWEEN ? AND ?")
+				whereArgs = append(whereArgs, minTime, maxTime)
+			}
+		}
+	}
+
+	if query.Metadata != "" && query.Type != "" {
+		if rows, ok := t.metadata[query.Type]; ok {
+			conditions = append(conditions, fmt.Sprintf(
+				"EXISTS (SELECT 1 FROM (%s) m(doc) WHERE jsonb_to_tsvector('simple', m.doc, '[\"string\"]') @@ websearch_to_tsquery('simple', ?))",
+				strings.Join(rows, " UNION ALL "),
+			))
+			whereArgs = append(whereArgs, anyTerm(query.Metadata))
+		}
+	}
+
+	if query.ChildText != "" {
+		rows := make([]string, 0, len(t.children))
+		for _, row := range t.children {
+			rows = append(rows, row)
+		}
+		slices.Sort(rows)
+		if len(rows) == 0 {
+			conditions = append(conditions, "FALSE")
+		} else {
+			conditions = append(conditions, fmt.Sprintf(
+				"EXISTS (SELECT 1 FROM (%s) c(doc) WHERE jsonb_to_tsvector('simple', c.doc, '[\"string\"]') @@ websearch_to_tsquery('simple', ?))",
+				strings.Join(rows, " UNION ALL "),
+			))
+			whereArgs = append(whereArgs, anyTerm(query.ChildText))
+		}
+	}
+
+	if query.HasChild != "" {
+		var rows []string
+		for _, kind := range strings.Split(query.HasChild, ",") {
+			if row, ok := t.children[strings.TrimSpace(kind)]; ok {
+				rows = append(rows, row)
+			}
+		}
+		// A kind the skill does not have matches nothing, like a term OpenSearch has not indexed
+		if len(rows) == 0 {
+			conditions = append(conditions, "FALSE")
+		} else {
+			conditions = append(conditions, fmt.Sprintf("EXISTS (%s)", strings.Join(rows, " UNION ALL ")))
+		}
+	}
+
+	if query.MinOptions > 0 {
+		var rows []string
+		for _, kind := range optionKinds {
+			if row, ok := t.children[kind]; ok {
+				rows = append(rows, row)
+			}
+		}
+		if len(rows) == 0 {
+			conditions = append(conditions, "FALSE")
+		} else {
+			conditions = append(conditions, fmt.Sprintf("(SELECT count(*) FROM (%s) o) >= ?", strings.Join(rows, " UNION ALL ")))
+			whereArgs = append(whereArgs, query.MinOptions)
+		}
+	}
+
+	return strings.Join(conditions, " AND "), whereArgs, "(" + strings.Join(ranks, " + ") + ")", rankArgs, nil
+}
+
+// anyTerm rewrites text as a websearch_to_tsquery input matching any of its words, the default
+// operator of OpenSearch match queries. Punctuation is dropped so it cannot be read as syntax.
+func anyTerm(text string) string {
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		if !strings.EqualFold(word, "or") {
+			terms = append(terms, word)
+		}
+	}
+	return strings.Join(terms, " or ")
+}
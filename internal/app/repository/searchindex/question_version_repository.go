@@ -0,0 +1,70 @@
+package searchindex
+
+import (
+	"context"
+	"fluencybe/internal/core/constants"
+	"fluencybe/pkg/logger"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// QuestionVersion is the ID and version of a question row
+type QuestionVersion struct {
+	ID      uuid.UUID
+	Version int
+}
+
+// QuestionVersionRepository reads question IDs and versions across the skill tables
+type QuestionVersionRepository struct {
+	db     *gorm.DB
+	logger *logger.PrettyLogger
+}
+
+func NewQuestionVersionRepository(db *gorm.DB, logger *logger.PrettyLogger) *QuestionVersionRepository {
+	return &QuestionVersionRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// ListVersions returns up to limit questions of a skill with an ID greater than after, in ID order
+func (r *QuestionVersionRepository) ListVersions(ctx context.Context, skill string, after uuid.UUID, limit int) ([]QuestionVersion, error) {
+	if !slices.Contains(constants.ChangeFeedSkills, skill) {
+		return nil, fmt.Errorf("unknown skill %q", skill)
+	}
+
+	// The table name comes from the fixed skill list above, never from input
+	query := fmt.Sprintf("SELECT id, version FROM %s_questions WHERE id > ? ORDER BY id LIMIT ?", skill)
+
+	var versions []QuestionVersion
+	if err := r.db.WithContext(ctx).Raw(query, after, limit).Scan(&versions).Error; err != nil {
+		r.logger.WithContext(ctx).Error("question_version_repository.list_versions", map[string]interface{}{
+			"error": err.Error(),
+			"skill": skill,
+		}, "Failed to list question versions")
+		return nil, err
+	}
+	return versions, nil
+}
+
+// ListChangedSince returns the questions of a skill with an outbox event written at or after since
+func (r *QuestionVersionRepository) ListChangedSince(ctx context.Context, skill string, since time.Time) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.db.WithContext(ctx).
+		Table("outbox_events").
+		Distinct("aggregate_id").
+		Where("aggregate_type = ? AND created_at >= ?", skill, since).
+		Pluck("aggregate_id", &ids).Error
+	if err != nil {
+		r.logger.WithContext(ctx).Error("question_version_repository.list_changed_since", map[string]interface{}{
+			"error": err.Error(),
+			"skill": skill,
+		}, "Failed to list changed questions")
+		return nil, err
+	}
+	return ids, nil
+}
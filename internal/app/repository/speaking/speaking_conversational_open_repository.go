@@ -34,7 +34,7 @@ func (r *SpeakingConversationalOpenRepository) Create(ctx context.Context, open
 
 	err := r.db.WithContext(ctx).Create(open).Error
 	if err != nil {
-		r.logger.Error("speaking_conversational_open_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_conversational_open_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create conversational open")
 		return err
@@ -49,7 +49,7 @@ func (r *SpeakingConversationalOpenRepository) GetByID(ctx context.Context, id u
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("speaking_conversational_open_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_conversational_open_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get conversational open")
@@ -65,7 +65,7 @@ func (r *SpeakingConversationalOpenRepository) GetBySpeakingQuestionID(ctx conte
 		Find(&opens).Error
 
 	if err != nil {
-		r.logger.Error("speaking_conversational_open_repository.get_by_speaking_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_conversational_open_repository.get_by_speaking_question_id", map[string]interface{}{
 			"error":       err.Error(),
 			"question_id": speakingQuestionID,
 		}, "Failed to get conversational opens")
@@ -88,7 +88,7 @@ func (r *SpeakingConversationalOpenRepository) Update(ctx context.Context, open
 		})
 
 	if result.Error != nil {
-		r.logger.Error("speaking_conversational_open_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_conversational_open_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    open.ID,
 		}, "Failed to update conversational open")
@@ -106,7 +106,7 @@ func (r *SpeakingConversationalOpenRepository) Delete(ctx context.Context, id uu
 	result := r.db.WithContext(ctx).Delete(&speaking.SpeakingConversationalOpen{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("speaking_conversational_open_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_conversational_open_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete conversational open")
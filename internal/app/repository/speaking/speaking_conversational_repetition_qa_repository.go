@@ -34,7 +34,7 @@ func (r *SpeakingConversationalRepetitionQARepository) Create(ctx context.Contex
 
 	err := r.db.WithContext(ctx).Create(qa).Error
 	if err != nil {
-		r.logger.Error("speaking_conversational_repetition_qa_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_conversational_repetition_qa_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create QA")
 		return err
@@ -49,7 +49,7 @@ func (r *SpeakingConversationalRepetitionQARepository) GetByID(ctx context.Conte
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("speaking_conversational_repetition_qa_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_conversational_repetition_qa_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get QA")
@@ -65,7 +65,7 @@ func (r *SpeakingConversationalRepetitionQARepository) GetBySpeakingConversation
 		Find(&qas).Error
 
 	if err != nil {
-		r.logger.Error("speaking_conversational_repetition_qa_repository.get_by_repetition_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_conversational_repetition_qa_repository.get_by_repetition_id", map[string]interface{}{
 			"error":        err.Error(),
 			"repetitionID": repetitionID,
 		}, "Failed to get QAs")
@@ -90,7 +90,7 @@ func (r *SpeakingConversationalRepetitionQARepository) Update(ctx context.Contex
 		})
 
 	if result.Error != nil {
-		r.logger.Error("speaking_conversational_repetition_qa_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_conversational_repetition_qa_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    qa.ID,
 		}, "Failed to update QA")
@@ -108,7 +108,7 @@ func (r *SpeakingConversationalRepetitionQARepository) Delete(ctx context.Contex
 	result := r.db.WithContext(ctx).Delete(&speaking.SpeakingConversationalRepetitionQA{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("speaking_conversational_repetition_qa_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_conversational_repetition_qa_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete QA")
@@ -34,7 +34,7 @@ func (r *SpeakingConversationalRepetitionRepository) Create(ctx context.Context,
 
 	err := r.db.WithContext(ctx).Create(repetition).Error
 	if err != nil {
-		r.logger.Error("speaking_conversational_repetition_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_conversational_repetition_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create conversational repetition")
 		return err
@@ -49,7 +49,7 @@ func (r *SpeakingConversationalRepetitionRepository) GetByID(ctx context.Context
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("speaking_conversational_repetition_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_conversational_repetition_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get conversational repetition")
@@ -65,7 +65,7 @@ func (r *SpeakingConversationalRepetitionRepository) GetBySpeakingQuestionID(ctx
 		Find(&repetitions).Error
 
 	if err != nil {
-		r.logger.Error("speaking_conversational_repetition_repository.get_by_speaking_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_conversational_repetition_repository.get_by_speaking_question_id", map[string]interface{}{
 			"error":       err.Error(),
 			"question_id": speakingQuestionID,
 		}, "Failed to get conversational repetitions")
@@ -87,7 +87,7 @@ func (r *SpeakingConversationalRepetitionRepository) Update(ctx context.Context,
 		})
 
 	if result.Error != nil {
-		r.logger.Error("speaking_conversational_repetition_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_conversational_repetition_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    repetition.ID,
 		}, "Failed to update conversational repetition")
@@ -105,7 +105,7 @@ func (r *SpeakingConversationalRepetitionRepository) Delete(ctx context.Context,
 	result := r.db.WithContext(ctx).Delete(&speaking.SpeakingConversationalRepetition{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("speaking_conversational_repetition_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_conversational_repetition_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete conversational repetition")
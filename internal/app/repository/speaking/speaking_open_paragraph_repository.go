@@ -34,7 +34,7 @@ func (r *SpeakingOpenParagraphRepository) Create(ctx context.Context, paragraph
 
 	err := r.db.WithContext(ctx).Create(paragraph).Error
 	if err != nil {
-		r.logger.Error("speaking_open_paragraph_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_open_paragraph_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create open paragraph")
 		return err
@@ -49,7 +49,7 @@ func (r *SpeakingOpenParagraphRepository) GetByID(ctx context.Context, id uuid.U
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("speaking_open_paragraph_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_open_paragraph_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get open paragraph")
 		return nil, err
@@ -70,7 +70,7 @@ func (r *SpeakingOpenParagraphRepository) Update(ctx context.Context, paragraph
 		})
 
 	if result.Error != nil {
-		r.logger.Error("speaking_open_paragraph_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_open_paragraph_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 		}, "Failed to update open paragraph")
 		return result.Error
@@ -87,7 +87,7 @@ func (r *SpeakingOpenParagraphRepository) Delete(ctx context.Context, id uuid.UU
 	result := r.db.WithContext(ctx).Delete(&speaking.SpeakingOpenParagraph{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("speaking_open_paragraph_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_open_paragraph_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete open paragraph")
@@ -108,7 +108,7 @@ func (r *SpeakingOpenParagraphRepository) GetBySpeakingQuestionID(ctx context.Co
 		Find(&paragraphs).Error
 
 	if err != nil {
-		r.logger.Error("speaking_open_paragraph_repository.get_by_speaking_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_open_paragraph_repository.get_by_speaking_question_id", map[string]interface{}{
 			"error":       err.Error(),
 			"question_id": speakingQuestionID,
 		}, "Failed to get open paragraphs")
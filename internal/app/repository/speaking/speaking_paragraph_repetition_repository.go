@@ -34,7 +34,7 @@ func (r *SpeakingParagraphRepetitionRepository) Create(ctx context.Context, para
 
 	err := r.db.WithContext(ctx).Create(paragraph).Error
 	if err != nil {
-		r.logger.Error("speaking_paragraph_repetition_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_paragraph_repetition_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create paragraph repetition")
 		return err
@@ -49,7 +49,7 @@ func (r *SpeakingParagraphRepetitionRepository) GetByID(ctx context.Context, id
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("speaking_paragraph_repetition_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_paragraph_repetition_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get paragraph repetition")
@@ -65,7 +65,7 @@ func (r *SpeakingParagraphRepetitionRepository) GetBySpeakingQuestionID(ctx cont
 		Find(&paragraphs).Error
 
 	if err != nil {
-		r.logger.Error("speaking_paragraph_repetition_repository.get_by_speaking_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_paragraph_repetition_repository.get_by_speaking_question_id", map[string]interface{}{
 			"error":       err.Error(),
 			"question_id": speakingQuestionID,
 		}, "Failed to get paragraph repetitions")
@@ -87,7 +87,7 @@ func (r *SpeakingParagraphRepetitionRepository) Update(ctx context.Context, para
 		})
 
 	if result.Error != nil {
-		r.logger.Error("speaking_paragraph_repetition_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_paragraph_repetition_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    paragraph.ID,
 		}, "Failed to update paragraph repetition")
@@ -105,7 +105,7 @@ func (r *SpeakingParagraphRepetitionRepository) Delete(ctx context.Context, id u
 	result := r.db.WithContext(ctx).Delete(&speaking.SpeakingParagraphRepetition{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("speaking_paragraph_repetition_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_paragraph_repetition_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete paragraph repetition")
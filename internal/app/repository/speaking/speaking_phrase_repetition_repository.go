@@ -34,7 +34,7 @@ func (r *SpeakingPhraseRepetitionRepository) Create(ctx context.Context, phrase
 
 	err := r.db.WithContext(ctx).Create(phrase).Error
 	if err != nil {
-		r.logger.Error("speaking_phrase_repetition_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_phrase_repetition_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create phrase repetition")
 		return err
@@ -49,7 +49,7 @@ func (r *SpeakingPhraseRepetitionRepository) GetByID(ctx context.Context, id uui
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("speaking_phrase_repetition_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_phrase_repetition_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get phrase repetition")
 		return nil, err
@@ -69,7 +69,7 @@ func (r *SpeakingPhraseRepetitionRepository) Update(ctx context.Context, phrase
 		})
 
 	if result.Error != nil {
-		r.logger.Error("speaking_phrase_repetition_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_phrase_repetition_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 		}, "Failed to update phrase repetition")
 		return result.Error
@@ -86,7 +86,7 @@ func (r *SpeakingPhraseRepetitionRepository) Delete(ctx context.Context, id uuid
 	result := r.db.WithContext(ctx).Delete(&speaking.SpeakingPhraseRepetition{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("speaking_phrase_repetition_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_phrase_repetition_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete phrase repetition")
@@ -107,7 +107,7 @@ func (r *SpeakingPhraseRepetitionRepository) GetBySpeakingQuestionID(ctx context
 		Find(&phrases).Error
 
 	if err != nil {
-		r.logger.Error("speaking_phrase_repetition_repository.get_by_speaking_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_phrase_repetition_repository.get_by_speaking_question_id", map[string]interface{}{
 			"error":       err.Error(),
 			"question_id": speakingQuestionID,
 		}, "Failed to get phrase repetitions")
@@ -34,7 +34,7 @@ func (r *SpeakingWordRepetitionRepository) Create(ctx context.Context, word *spe
 
 	err := r.db.WithContext(ctx).Create(word).Error
 	if err != nil {
-		r.logger.Error("speaking_word_repetition_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_word_repetition_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create word repetition")
 		return err
@@ -49,7 +49,7 @@ func (r *SpeakingWordRepetitionRepository) GetByID(ctx context.Context, id uuid.
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("speaking_word_repetition_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_word_repetition_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get word repetition")
 		return nil, err
@@ -69,7 +69,7 @@ func (r *SpeakingWordRepetitionRepository) Update(ctx context.Context, word *spe
 		})
 
 	if result.Error != nil {
-		r.logger.Error("speaking_word_repetition_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_word_repetition_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 		}, "Failed to update word repetition")
 		return result.Error
@@ -86,7 +86,7 @@ func (r *SpeakingWordRepetitionRepository) Delete(ctx context.Context, id uuid.U
 	result := r.db.WithContext(ctx).Delete(&speaking.SpeakingWordRepetition{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("speaking_word_repetition_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_word_repetition_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete word repetition")
@@ -107,7 +107,7 @@ func (r *SpeakingWordRepetitionRepository) GetBySpeakingQuestionID(ctx context.C
 		Find(&words).Error
 
 	if err != nil {
-		r.logger.Error("speaking_word_repetition_repository.get_by_speaking_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("speaking_word_repetition_repository.get_by_speaking_question_id", map[string]interface{}{
 			"error":       err.Error(),
 			"question_id": speakingQuestionID,
 		}, "Failed to get word repetitions")
@@ -34,7 +34,7 @@ func (r *WritingEssayRepository) Create(ctx context.Context, essay *writing.Writ
 
 	err := r.db.WithContext(ctx).Create(essay).Error
 	if err != nil {
-		r.logger.Error("writing_essay_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("writing_essay_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create essay")
 		return err
@@ -49,7 +49,7 @@ func (r *WritingEssayRepository) GetByID(ctx context.Context, id uuid.UUID) (*wr
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("writing_essay_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("writing_essay_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get essay")
@@ -65,7 +65,7 @@ func (r *WritingEssayRepository) GetByWritingQuestionID(ctx context.Context, wri
 		Find(&essays).Error
 
 	if err != nil {
-		r.logger.Error("writing_essay_repository.get_by_writing_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("writing_essay_repository.get_by_writing_question_id", map[string]interface{}{
 			"error":       err.Error(),
 			"question_id": writingQuestionID,
 		}, "Failed to get essays")
@@ -91,7 +91,7 @@ func (r *WritingEssayRepository) Update(ctx context.Context, essay *writing.Writ
 		})
 
 	if result.Error != nil {
-		r.logger.Error("writing_essay_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("writing_essay_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    essay.ID,
 		}, "Failed to update essay")
@@ -109,7 +109,7 @@ func (r *WritingEssayRepository) Delete(ctx context.Context, id uuid.UUID) error
 	result := r.db.WithContext(ctx).Delete(&writing.WritingEssay{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("writing_essay_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("writing_essay_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete essay")
@@ -45,7 +45,7 @@ func (r *WritingQuestionRepository) CreateWritingQuestion(ctx context.Context, q
 
 	result := r.db.WithContext(ctx).Create(question)
 	if result.Error != nil {
-		r.logger.Error("writing_question_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("writing_question_repository.create", map[string]interface{}{
 			"error": result.Error.Error(),
 		}, "Failed to create writing question")
 		return result.Error
@@ -61,7 +61,7 @@ func (r *WritingQuestionRepository) GetWritingQuestionByID(ctx context.Context,
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrQuestionNotFound
 		}
-		r.logger.Error("writing_question_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("writing_question_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get writing question")
@@ -98,7 +98,7 @@ func (r *WritingQuestionRepository) UpdateWritingQuestion(ctx context.Context, q
 
 	if result.Error != nil {
 		tx.Rollback()
-		r.logger.Error("writing_question_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("writing_question_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 		}, "Failed to update writing question")
 		return result.Error
@@ -111,7 +111,7 @@ func (r *WritingQuestionRepository) UpdateWritingQuestion(ctx context.Context, q
 
 	if err := tx.First(question, "id = ?", question.ID).Error; err != nil {
 		tx.Rollback()
-		r.logger.Error("writing_question_repository.update.get_updated", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("writing_question_repository.update.get_updated", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get updated question")
 		return err
@@ -123,7 +123,7 @@ func (r *WritingQuestionRepository) UpdateWritingQuestion(ctx context.Context, q
 func (r *WritingQuestionRepository) DeleteWritingQuestion(ctx context.Context, id uuid.UUID) error {
 	result := r.db.WithContext(ctx).Delete(&writing.WritingQuestion{}, "id = ?", id)
 	if result.Error != nil {
-		r.logger.Error("writing_question_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("writing_question_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete writing question")
@@ -160,7 +160,7 @@ func (r *WritingQuestionRepository) GetNewUpdatedQuestions(ctx context.Context,
 
 	err := query.Find(&questions).Error
 	if err != nil {
-		r.logger.Error("writing_question_repository.get_new_updated", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("writing_question_repository.get_new_updated", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get updated questions")
 		return nil, err
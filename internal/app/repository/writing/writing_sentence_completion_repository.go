@@ -34,7 +34,7 @@ func (r *WritingSentenceCompletionRepository) Create(ctx context.Context, senten
 
 	err := r.db.WithContext(ctx).Create(sentence).Error
 	if err != nil {
-		r.logger.Error("writing_sentence_completion_repository.create", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("writing_sentence_completion_repository.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create sentence completion")
 		return err
@@ -49,7 +49,7 @@ func (r *WritingSentenceCompletionRepository) GetByID(ctx context.Context, id uu
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
 		}
-		r.logger.Error("writing_sentence_completion_repository.get_by_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("writing_sentence_completion_repository.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get sentence completion")
@@ -65,7 +65,7 @@ func (r *WritingSentenceCompletionRepository) GetByWritingQuestionID(ctx context
 		Find(&sentences).Error
 
 	if err != nil {
-		r.logger.Error("writing_sentence_completion_repository.get_by_writing_question_id", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("writing_sentence_completion_repository.get_by_writing_question_id", map[string]interface{}{
 			"error":       err.Error(),
 			"question_id": writingQuestionID,
 		}, "Failed to get sentence completions")
@@ -92,7 +92,7 @@ func (r *WritingSentenceCompletionRepository) Update(ctx context.Context, senten
 		})
 
 	if result.Error != nil {
-		r.logger.Error("writing_sentence_completion_repository.update", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("writing_sentence_completion_repository.update", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    sentence.ID,
 		}, "Failed to update sentence completion")
@@ -110,7 +110,7 @@ func (r *WritingSentenceCompletionRepository) Delete(ctx context.Context, id uui
 	result := r.db.WithContext(ctx).Delete(&writing.WritingSentenceCompletion{}, "id = ?", id)
 
 	if result.Error != nil {
-		r.logger.Error("writing_sentence_completion_repository.delete", map[string]interface{}{
+		r.logger.WithContext(ctx).Error("writing_sentence_completion_repository.delete", map[string]interface{}{
 			"error": result.Error.Error(),
 			"id":    id,
 		}, "Failed to delete sentence completion")
@@ -0,0 +1,215 @@
+package account
+
+import (
+	"context"
+	"errors"
+	accountModel "fluencybe/internal/app/model/account"
+	accountRepository "fluencybe/internal/app/repository/account"
+	"fluencybe/internal/core/constants"
+	"fluencybe/internal/core/session"
+	"fluencybe/pkg/logger"
+	"fluencybe/pkg/utils"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrAPIKeyNotFound     = errors.New("api key not found")
+	ErrInvalidScope       = errors.New("invalid api key scope")
+	ErrAPIKeyNameRequired = errors.New("api key name is required")
+	ErrTooManyAPIKeys     = errors.New("maximum number of active api keys reached")
+)
+
+type APIKeyService struct {
+	repo   *accountRepository.DeveloperAPIKeyRepository
+	logger *logger.PrettyLogger
+}
+
+func NewAPIKeyService(repo *accountRepository.DeveloperAPIKeyRepository) *APIKeyService {
+	return &APIKeyService{
+		repo:   repo,
+		logger: logger.GetGlobalLogger(),
+	}
+}
+
+// Create mints a key, the plaintext is only returned here and cannot be recovered later
+func (s *APIKeyService) Create(ctx context.Context, developerID string, name string, scopes []string, expiresAt *time.Time) (*accountModel.DeveloperAPIKey, string, error) {
+	devID, err := uuid.Parse(developerID)
+	if err != nil {
+		return nil, "", ErrInvalidInput
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", ErrAPIKeyNameRequired
+	}
+
+	scopes, err = normalizeScopes(scopes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if expiresAt != nil && expiresAt.Before(time.Now()) {
+		return nil, "", ErrInvalidInput
+	}
+
+	active, err := s.repo.CountActive(ctx, devID)
+	if err != nil {
+		return nil, "", err
+	}
+	if active >= constants.APIKeyMaxPerDeveloper {
+		return nil, "", ErrTooManyAPIKeys
+	}
+
+	plaintext, prefix, err := generateAPIKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	key := &accountModel.DeveloperAPIKey{
+		ID:          uuid.New(),
+		DeveloperID: devID,
+		Name:        name,
+		KeyPrefix:   prefix,
+		KeyHash:     utils.HashToken(plaintext),
+		Scopes:      scopes,
+		ExpiresAt:   expiresAt,
+	}
+	if err := s.repo.Create(ctx, key); err != nil {
+		return nil, "", err
+	}
+
+	s.logger.WithContext(ctx).Info("api_key_service.create", map[string]interface{}{
+		"developer_id": developerID,
+		"key_id":       key.ID.String(),
+		"scopes":       scopes,
+	}, "API key created")
+	return key, plaintext, nil
+}
+
+func (s *APIKeyService) List(ctx context.Context, developerID string) ([]*accountModel.DeveloperAPIKey, error) {
+	devID, err := uuid.Parse(developerID)
+	if err != nil {
+		return nil, ErrInvalidInput
+	}
+	return s.repo.ListByDeveloper(ctx, devID)
+}
+
+// Rotate issues a new secret for an existing key, the previous secret stops working immediately
+func (s *APIKeyService) Rotate(ctx context.Context, developerID string, keyID string) (*accountModel.DeveloperAPIKey, string, error) {
+	devID, id, err := parseKeyIDs(developerID, keyID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	key, err := s.repo.GetByID(ctx, devID, id)
+	if err != nil {
+		return nil, "", mapAPIKeyError(err)
+	}
+
+	plaintext, prefix, err := generateAPIKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := s.repo.Rotate(ctx, devID, id, prefix, utils.HashToken(plaintext)); err != nil {
+		return nil, "", mapAPIKeyError(err)
+	}
+	session.InvalidateAPIKey(key.KeyHash)
+
+	key.KeyPrefix = prefix
+	key.LastUsedAt = nil
+	s.logger.WithContext(ctx).Info("api_key_service.rotate", map[string]interface{}{
+		"developer_id": developerID,
+		"key_id":       keyID,
+	}, "API key rotated")
+	return key, plaintext, nil
+}
+
+func (s *APIKeyService) Revoke(ctx context.Context, developerID string, keyID string) error {
+	devID, id, err := parseKeyIDs(developerID, keyID)
+	if err != nil {
+		return err
+	}
+
+	key, err := s.repo.GetByID(ctx, devID, id)
+	if err != nil {
+		return mapAPIKeyError(err)
+	}
+
+	if err := s.repo.Revoke(ctx, devID, id); err != nil {
+		return mapAPIKeyError(err)
+	}
+	session.InvalidateAPIKey(key.KeyHash)
+
+	s.logger.WithContext(ctx).Info("api_key_service.revoke", map[string]interface{}{
+		"developer_id": developerID,
+		"key_id":       keyID,
+	}, "API key revoked")
+	return nil
+}
+
+func generateAPIKey() (plaintext string, prefix string, err error) {
+	secret, err := utils.GenerateOpaqueToken()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+	return constants.APIKeyPrefix + secret, secret[:constants.APIKeyDisplayPrefixLength], nil
+}
+
+// normalizeScopes validates and deduplicates requested scopes
+func normalizeScopes(scopes []string) ([]string, error) {
+	if len(scopes) == 0 {
+		return nil, ErrInvalidScope
+	}
+
+	seen := make(map[string]bool)
+	var result []string
+	for _, scope := range scopes {
+		scope = strings.ToLower(strings.TrimSpace(scope))
+		if !isValidScope(scope) {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidScope, scope)
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			result = append(result, scope)
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+func isValidScope(scope string) bool {
+	if scope == constants.ScopeRead {
+		return true
+	}
+	for _, area := range constants.APIKeyWriteAreas {
+		if scope == constants.WriteScope(area) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseKeyIDs(developerID, keyID string) (uuid.UUID, uuid.UUID, error) {
+	devID, err := uuid.Parse(developerID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, ErrInvalidInput
+	}
+	id, err := uuid.Parse(keyID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, ErrInvalidInput
+	}
+	return devID, id, nil
+}
+
+func mapAPIKeyError(err error) error {
+	if errors.Is(err, accountRepository.ErrAPIKeyNotFound) {
+		return ErrAPIKeyNotFound
+	}
+	return err
+}
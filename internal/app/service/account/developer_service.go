@@ -5,8 +5,8 @@ import (
 	"errors"
 	accountModel "fluencybe/internal/app/model/account"
 	accountRepository "fluencybe/internal/app/repository/account"
+	"fluencybe/internal/core/constants"
 	"fluencybe/pkg/logger"
-	"fluencybe/pkg/utils"
 	"fmt"
 	"strconv"
 	"time"
@@ -25,12 +25,14 @@ var (
 
 type DeveloperService struct {
 	repo   *accountRepository.DeveloperRepository
+	tokens *TokenService
 	logger *logger.PrettyLogger
 }
 
-func NewDeveloperService(repo *accountRepository.DeveloperRepository) *DeveloperService {
+func NewDeveloperService(repo *accountRepository.DeveloperRepository, tokens *TokenService) *DeveloperService {
 	return &DeveloperService{
 		repo:   repo,
+		tokens: tokens,
 		logger: logger.GetGlobalLogger(),
 	}
 }
@@ -53,7 +55,7 @@ func (s *DeveloperService) Register(ctx context.Context, dev *accountModel.Devel
 
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(dev.Password), bcrypt.DefaultCost)
 	if err != nil {
-		s.logger.Error("developer_service.register.hash_password", map[string]interface{}{"error": err.Error()}, "Failed to hash password")
+		s.logger.WithContext(ctx).Error("developer_service.register.hash_password", map[string]interface{}{"error": err.Error()}, "Failed to hash password")
 		return fmt.Errorf("failed to hash password: %w", err)
 	}
 	dev.Password = string(hashedPassword)
@@ -70,16 +72,16 @@ func (s *DeveloperService) Register(ctx context.Context, dev *accountModel.Devel
 	})
 
 	if err != nil {
-		s.logger.Error("developer_service.register", map[string]interface{}{"error": err.Error()}, "Failed to register developer")
+		s.logger.WithContext(ctx).Error("developer_service.register", map[string]interface{}{"error": err.Error()}, "Failed to register developer")
 		return err
 	}
 
 	return nil
 }
 
-func (s *DeveloperService) Login(ctx context.Context, email, password string) (*accountModel.Developer, string, error) {
+func (s *DeveloperService) Login(ctx context.Context, email, password string, client ClientInfo) (*accountModel.Developer, *TokenPair, error) {
 	if email == "" || password == "" {
-		return nil, "", ErrInvalidInput
+		return nil, nil, ErrInvalidInput
 	}
 
 	var dev *accountModel.Developer
@@ -98,24 +100,49 @@ func (s *DeveloperService) Login(ctx context.Context, email, password string) (*
 
 	if err != nil {
 		if errors.Is(err, ErrNotFound) {
-			return nil, "", ErrInvalidCredentials
+			return nil, nil, ErrInvalidCredentials
 		}
-		s.logger.Error("developer_service.login.get_developer", map[string]interface{}{"error": err.Error()}, "Failed to get developer")
-		return nil, "", fmt.Errorf("failed to retrieve developer: %w", err)
+		s.logger.WithContext(ctx).Error("developer_service.login.get_developer", map[string]interface{}{"error": err.Error()}, "Failed to get developer")
+		return nil, nil, fmt.Errorf("failed to retrieve developer: %w", err)
 	}
 
 	if err := bcrypt.CompareHashAndPassword([]byte(dev.Password), []byte(password)); err != nil {
-		return nil, "", ErrInvalidCredentials
+		return nil, nil, ErrInvalidCredentials
 	}
 
-	// Generate JWT token with expiration
-	token, err := utils.GenerateJWT(dev.ID.String(), "developer")
+	// Open a new session with a short-lived access token and a refresh token
+	tokens, err := s.tokens.IssueTokens(ctx, dev.ID, constants.RoleDeveloper, client)
 	if err != nil {
-		s.logger.Error("developer_service.login.generate_token", map[string]interface{}{"error": err.Error()}, "Failed to generate token")
-		return nil, "", fmt.Errorf("failed to generate token: %w", err)
+		s.logger.WithContext(ctx).Error("developer_service.login.generate_token", map[string]interface{}{"error": err.Error()}, "Failed to generate token")
+		return nil, nil, fmt.Errorf("failed to generate token: %w", err)
 	}
 
-	return dev, token, nil
+	return dev, tokens, nil
+}
+
+func (s *DeveloperService) Refresh(ctx context.Context, refreshToken string, client ClientInfo) (*TokenPair, error) {
+	tokens, err := s.tokens.Refresh(ctx, refreshToken, constants.RoleDeveloper, client)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("developer_service.refresh", map[string]interface{}{"error": err.Error()}, "Failed to refresh developer token")
+		return nil, err
+	}
+	return tokens, nil
+}
+
+func (s *DeveloperService) Logout(ctx context.Context, sessionID string) error {
+	if err := s.tokens.Logout(ctx, sessionID); err != nil {
+		s.logger.WithContext(ctx).Error("developer_service.logout", map[string]interface{}{"error": err.Error()}, "Failed to revoke developer session")
+		return err
+	}
+	return nil
+}
+
+func (s *DeveloperService) LogoutAll(ctx context.Context, devID string) error {
+	if err := s.tokens.LogoutAll(ctx, devID, constants.RoleDeveloper); err != nil {
+		s.logger.WithContext(ctx).Error("developer_service.logout_all", map[string]interface{}{"error": err.Error()}, "Failed to revoke developer sessions")
+		return err
+	}
+	return nil
 }
 
 func (s *DeveloperService) GetDeveloper(ctx context.Context, id string) (*accountModel.Developer, error) {
@@ -139,7 +166,7 @@ func (s *DeveloperService) GetDeveloper(ctx context.Context, id string) (*accoun
 	})
 
 	if err != nil {
-		s.logger.Error("developer_service.get_developer", map[string]interface{}{"error": err.Error()}, "Failed to get developer")
+		s.logger.WithContext(ctx).Error("developer_service.get_developer", map[string]interface{}{"error": err.Error()}, "Failed to get developer")
 		return nil, err
 	}
 
@@ -159,7 +186,7 @@ func (s *DeveloperService) UpdateDeveloper(ctx context.Context, id string, updat
 	if password, ok := updates["password"].(string); ok {
 		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 		if err != nil {
-			s.logger.Error("developer_service.update_developer.hash_password", map[string]interface{}{"error": err.Error()}, "Failed to hash password")
+			s.logger.WithContext(ctx).Error("developer_service.update_developer.hash_password", map[string]interface{}{"error": err.Error()}, "Failed to hash password")
 			return fmt.Errorf("failed to hash password: %w", err)
 		}
 		updates["password"] = string(hashedPassword)
@@ -179,7 +206,7 @@ func (s *DeveloperService) UpdateDeveloper(ctx context.Context, id string, updat
 	})
 
 	if err != nil {
-		s.logger.Error("developer_service.update_developer", map[string]interface{}{"error": err.Error()}, "Failed to update developer")
+		s.logger.WithContext(ctx).Error("developer_service.update_developer", map[string]interface{}{"error": err.Error()}, "Failed to update developer")
 		return err
 	}
 
@@ -204,10 +231,14 @@ func (s *DeveloperService) DeleteDeveloper(ctx context.Context, id string) error
 	})
 
 	if err != nil {
-		s.logger.Error("developer_service.delete_developer", map[string]interface{}{"error": err.Error()}, "Failed to delete developer")
+		s.logger.WithContext(ctx).Error("developer_service.delete_developer", map[string]interface{}{"error": err.Error()}, "Failed to delete developer")
 		return err
 	}
 
+	if err := s.tokens.LogoutAll(ctx, id, constants.RoleDeveloper); err != nil {
+		s.logger.WithContext(ctx).Warning("developer_service.delete_developer.revoke_sessions", map[string]interface{}{"error": err.Error()}, "Failed to revoke sessions of deleted developer")
+	}
+
 	return nil
 }
 
@@ -240,7 +271,7 @@ func (s *DeveloperService) GetDeveloperList(ctx context.Context, page string, li
 	})
 
 	if err != nil {
-		s.logger.Error("developer_service.get_developer_list", map[string]interface{}{"error": err.Error()}, "Failed to get developer list")
+		s.logger.WithContext(ctx).Error("developer_service.get_developer_list", map[string]interface{}{"error": err.Error()}, "Failed to get developer list")
 		return nil, 0, fmt.Errorf("failed to get developer list: %w", err)
 	}
 
@@ -255,7 +286,7 @@ func (s *DeveloperService) GetDeveloperList(ctx context.Context, page string, li
 	})
 
 	if err != nil {
-		s.logger.Error("developer_service.get_total_count", map[string]interface{}{"error": err.Error()}, "Failed to get total count")
+		s.logger.WithContext(ctx).Error("developer_service.get_total_count", map[string]interface{}{"error": err.Error()}, "Failed to get total count")
 		return nil, 0, fmt.Errorf("failed to get total count: %w", err)
 	}
 
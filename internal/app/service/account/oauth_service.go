@@ -0,0 +1,264 @@
+package account
+
+import (
+	"context"
+	"errors"
+	accountModel "fluencybe/internal/app/model/account"
+	accountRepository "fluencybe/internal/app/repository/account"
+	"fluencybe/internal/core/constants"
+	"fluencybe/pkg/logger"
+	"fluencybe/pkg/oauth"
+	"fluencybe/pkg/utils"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrOAuthStateInvalid   = errors.New("invalid oauth state")
+	ErrOAuthIdentityLinked = errors.New("identity is linked to another account")
+)
+
+var usernameInvalidChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// oauthUsers, oauthIdentities and oauthTokens are what the oauth login uses of the user and
+// identity repositories and of the token service
+type oauthUsers interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*accountModel.User, error)
+	GetByEmail(ctx context.Context, email string) (*accountModel.User, error)
+	Create(ctx context.Context, user *accountModel.User) error
+	Update(ctx context.Context, id uuid.UUID, updates map[string]interface{}) error
+}
+
+type oauthIdentities interface {
+	GetUserID(ctx context.Context, provider, subject string) (uuid.UUID, error)
+	Link(ctx context.Context, identity *accountModel.UserIdentity) error
+}
+
+type oauthTokens interface {
+	IssueTokens(ctx context.Context, subjectID uuid.UUID, role string, client ClientInfo) (*TokenPair, error)
+	LogoutAll(ctx context.Context, subjectID string, role string) error
+}
+
+type OAuthService struct {
+	providers  *oauth.Registry
+	users      oauthUsers
+	identities oauthIdentities
+	tokens     oauthTokens
+	logger     *logger.PrettyLogger
+}
+
+func NewOAuthService(
+	providers *oauth.Registry,
+	users oauthUsers,
+	identities oauthIdentities,
+	tokens oauthTokens,
+) *OAuthService {
+	return &OAuthService{
+		providers:  providers,
+		users:      users,
+		identities: identities,
+		tokens:     tokens,
+		logger:     logger.GetGlobalLogger(),
+	}
+}
+
+// AuthorizationURL returns the provider consent page and the PKCE verifier the browser has to
+// bring back to the callback. The signed state is bound to the provider and to the hash of the
+// verifier, so it only completes a login in the browser that started it.
+func (s *OAuthService) AuthorizationURL(providerName string) (string, string, error) {
+	provider, err := s.providers.Get(providerName)
+	if err != nil {
+		return "", "", err
+	}
+
+	verifier := oauth.NewVerifier()
+	state, err := utils.GenerateStateToken(statePurpose(providerName), utils.HashToken(verifier), constants.OAuthStateTTL)
+	if err != nil {
+		s.logger.Error("oauth_service.authorization_url", map[string]interface{}{"error": err.Error()}, "Failed to generate oauth state")
+		return "", "", fmt.Errorf("failed to generate state: %w", err)
+	}
+
+	return provider.AuthCodeURL(state, verifier), verifier, nil
+}
+
+// Login completes the callback: the identity is resolved to an existing link, then to a user
+// with the same verified email, otherwise a new user is created. The same JWTs as the password login are issued.
+// verifier is the one AuthorizationURL returned to the browser the state was issued to.
+func (s *OAuthService) Login(ctx context.Context, providerName, code, state, verifier string, client ClientInfo) (*accountModel.User, *TokenPair, error) {
+	provider, err := s.providers.Get(providerName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if verifier == "" || utils.ValidateStateToken(state, statePurpose(providerName), utils.HashToken(verifier)) != nil {
+		return nil, nil, ErrOAuthStateInvalid
+	}
+
+	identity, err := provider.Exchange(ctx, code, verifier)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("oauth_service.login.exchange", map[string]interface{}{
+			"error":    err.Error(),
+			"provider": providerName,
+		}, "Failed to exchange oauth code")
+		return nil, nil, err
+	}
+
+	user, err := s.resolveUser(ctx, identity)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tokens, err := s.tokens.IssueTokens(ctx, user.ID, constants.RoleUser, client)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	s.logger.WithContext(ctx).Info("oauth_service.login", map[string]interface{}{
+		"user_id":  user.ID.String(),
+		"provider": providerName,
+	}, "User logged in with oauth provider")
+	return user, tokens, nil
+}
+
+func (s *OAuthService) resolveUser(ctx context.Context, identity *oauth.Identity) (*accountModel.User, error) {
+	userID, err := s.identities.GetUserID(ctx, identity.Provider, identity.Subject)
+	if err == nil {
+		return s.users.GetByID(ctx, userID)
+	}
+	if !errors.Is(err, accountRepository.ErrIdentityNotFound) {
+		return nil, err
+	}
+
+	// Linking by email is only safe when the provider vouches for it
+	if identity.Email == "" || !identity.EmailVerified {
+		return nil, oauth.ErrEmailNotVerified
+	}
+
+	user, err := s.users.GetByEmail(ctx, identity.Email)
+	if err != nil {
+		if !errors.Is(err, accountRepository.ErrUserNotFound) {
+			return nil, err
+		}
+		if user, err = s.createUser(ctx, identity); err != nil {
+			return nil, err
+		}
+	} else if !user.IsEmailVerified() {
+		if err := s.claimUnverifiedUser(ctx, user); err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.identities.Link(ctx, &accountModel.UserIdentity{
+		UserID:          user.ID,
+		Provider:        identity.Provider,
+		ProviderSubject: identity.Subject,
+		Email:           identity.Email,
+	})
+	if errors.Is(err, accountRepository.ErrIdentityDuplicate) {
+		// A concurrent login linked the identity first, it must have linked it to this user
+		linkedID, err := s.identities.GetUserID(ctx, identity.Provider, identity.Subject)
+		if err != nil {
+			return nil, err
+		}
+		if linkedID != user.ID {
+			return nil, ErrOAuthIdentityLinked
+		}
+		return user, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// claimUnverifiedUser hands an unverified account over to the verified owner of the email.
+// Whoever registered it without proving the address loses the password and sessions.
+func (s *OAuthService) claimUnverifiedUser(ctx context.Context, user *accountModel.User) error {
+	hashedPassword, err := randomPasswordHash()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	if err := s.users.Update(ctx, user.ID, map[string]interface{}{
+		"password":          hashedPassword,
+		"email_verified_at": now,
+	}); err != nil {
+		return err
+	}
+	user.EmailVerifiedAt = &now
+
+	if err := s.tokens.LogoutAll(ctx, user.ID.String(), constants.RoleUser); err != nil {
+		s.logger.WithContext(ctx).Warning("oauth_service.claim_user.revoke_sessions", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": user.ID.String(),
+		}, "Failed to revoke sessions of claimed user")
+	}
+	return nil
+}
+
+func (s *OAuthService) createUser(ctx context.Context, identity *oauth.Identity) (*accountModel.User, error) {
+	// OAuth users never log in with a password, store the hash of a random one
+	hashedPassword, err := randomPasswordHash()
+	if err != nil {
+		return nil, err
+	}
+
+	userType := identity.Provider
+	if userType != oauth.ProviderGoogle && userType != oauth.ProviderFacebook && userType != oauth.ProviderGithub {
+		userType = "other"
+	}
+
+	verifiedAt := time.Now().UTC()
+	user := &accountModel.User{
+		ID:              uuid.New(),
+		Email:           identity.Email,
+		Username:        usernameFromEmail(identity.Email),
+		Password:        hashedPassword,
+		Type:            userType,
+		EmailVerifiedAt: &verifiedAt,
+	}
+
+	if err := s.users.Create(ctx, user); err != nil {
+		s.logger.WithContext(ctx).Error("oauth_service.create_user", map[string]interface{}{
+			"error":    err.Error(),
+			"provider": identity.Provider,
+		}, "Failed to create user from oauth identity")
+		return nil, err
+	}
+	return user, nil
+}
+
+func randomPasswordHash() (string, error) {
+	secret, err := utils.GenerateOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(secret), bcrypt.DefaultCost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+	return string(hashedPassword), nil
+}
+
+func statePurpose(provider string) string {
+	return "oauth:" + provider
+}
+
+// usernameFromEmail derives a unique-enough username matching is_valid_username
+func usernameFromEmail(email string) string {
+	base := usernameInvalidChars.ReplaceAllString(strings.SplitN(email, "@", 2)[0], "")
+	if len(base) > 20 {
+		base = base[:20]
+	}
+	for len(base) < 3 {
+		base += "_"
+	}
+	return base + "_" + strings.ReplaceAll(uuid.NewString(), "-", "")[:6]
+}
@@ -0,0 +1,440 @@
+package account
+
+import (
+	"context"
+	"errors"
+	accountModel "fluencybe/internal/app/model/account"
+	accountRepository "fluencybe/internal/app/repository/account"
+	"fluencybe/internal/core/constants"
+	"fluencybe/pkg/oauth"
+	"fluencybe/pkg/oauth/mockidp"
+	"fluencybe/pkg/utils"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const testCallbackURL = "http://localhost:8080/v1/user/oauth/mock/callback"
+
+type fakeUsers struct {
+	mu    sync.Mutex
+	users map[uuid.UUID]*accountModel.User
+}
+
+func newFakeUsers() *fakeUsers {
+	return &fakeUsers{users: make(map[uuid.UUID]*accountModel.User)}
+}
+
+func (f *fakeUsers) GetByID(ctx context.Context, id uuid.UUID) (*accountModel.User, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	user, ok := f.users[id]
+	if !ok {
+		return nil, accountRepository.ErrUserNotFound
+	}
+	copied := *user
+	return &copied, nil
+}
+
+func (f *fakeUsers) GetByEmail(ctx context.Context, email string) (*accountModel.User, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, user := range f.users {
+		if user.Email == email {
+			copied := *user
+			return &copied, nil
+		}
+	}
+	return nil, accountRepository.ErrUserNotFound
+}
+
+func (f *fakeUsers) Create(ctx context.Context, user *accountModel.User) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	copied := *user
+	f.users[user.ID] = &copied
+	return nil
+}
+
+func (f *fakeUsers) Update(ctx context.Context, id uuid.UUID, updates map[string]interface{}) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	user, ok := f.users[id]
+	if !ok {
+		return accountRepository.ErrUserNotFound
+	}
+	if password, ok := updates["password"].(string); ok {
+		user.Password = password
+	}
+	if verifiedAt, ok := updates["email_verified_at"].(time.Time); ok {
+		user.EmailVerifiedAt = &verifiedAt
+	}
+	return nil
+}
+
+// fakeIdentities keeps links by provider and subject. A concurrent link only shows up once Link
+// runs into it, like a row committed between the lookup and the insert.
+type fakeIdentities struct {
+	mu         sync.Mutex
+	links      map[string]uuid.UUID
+	concurrent map[string]uuid.UUID
+}
+
+func newFakeIdentities() *fakeIdentities {
+	return &fakeIdentities{
+		links:      make(map[string]uuid.UUID),
+		concurrent: make(map[string]uuid.UUID),
+	}
+}
+
+func (f *fakeIdentities) GetUserID(ctx context.Context, provider, subject string) (uuid.UUID, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	userID, ok := f.links[provider+":"+subject]
+	if !ok {
+		return uuid.Nil, accountRepository.ErrIdentityNotFound
+	}
+	return userID, nil
+}
+
+func (f *fakeIdentities) Link(ctx context.Context, identity *accountModel.UserIdentity) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	key := identity.Provider + ":" + identity.ProviderSubject
+	if userID, ok := f.concurrent[key]; ok {
+		delete(f.concurrent, key)
+		f.links[key] = userID
+	}
+	if _, ok := f.links[key]; ok {
+		return accountRepository.ErrIdentityDuplicate
+	}
+	f.links[key] = identity.UserID
+	return nil
+}
+
+type fakeTokens struct {
+	mu        sync.Mutex
+	issued    []uuid.UUID
+	loggedOut []string
+}
+
+func (f *fakeTokens) IssueTokens(ctx context.Context, subjectID uuid.UUID, role string, client ClientInfo) (*TokenPair, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.issued = append(f.issued, subjectID)
+	return &TokenPair{
+		SessionID:    uuid.New(),
+		AccessToken:  "access-" + subjectID.String(),
+		RefreshToken: "refresh-" + subjectID.String(),
+	}, nil
+}
+
+func (f *fakeTokens) LogoutAll(ctx context.Context, subjectID string, role string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.loggedOut = append(f.loggedOut, subjectID)
+	return nil
+}
+
+type oauthFixture struct {
+	idp        *mockidp.Server
+	service    *OAuthService
+	users      *fakeUsers
+	identities *fakeIdentities
+	tokens     *fakeTokens
+}
+
+func newOAuthFixture(t *testing.T, identity oauth.Identity) *oauthFixture {
+	t.Helper()
+	t.Setenv(constants.EnvJWTSecret, "oauth-test-secret")
+
+	idp, err := mockidp.Start(identity)
+	if err != nil {
+		t.Fatalf("failed to start mock idp: %v", err)
+	}
+	t.Cleanup(func() { idp.Close() })
+
+	providers, err := oauth.NewRegistry([]oauth.ProviderConfig{idp.ProviderConfig(testCallbackURL)})
+	if err != nil {
+		t.Fatalf("failed to build providers: %v", err)
+	}
+
+	f := &oauthFixture{
+		idp:        idp,
+		users:      newFakeUsers(),
+		identities: newFakeIdentities(),
+		tokens:     &fakeTokens{},
+	}
+	f.service = NewOAuthService(providers, f.users, f.identities, f.tokens)
+	return f
+}
+
+// authorize follows the authorization URL to the mock idp and returns the code and state it
+// redirects back to the callback with, and the verifier kept in the browser cookie
+func (f *oauthFixture) authorize(t *testing.T) (code, state, verifier string) {
+	t.Helper()
+
+	authorizationURL, verifier, err := f.service.AuthorizationURL(oauth.ProviderMock)
+	if err != nil {
+		t.Fatalf("failed to get authorization url: %v", err)
+	}
+
+	client := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Get(authorizationURL)
+	if err != nil {
+		t.Fatalf("failed to authorize: %v", err)
+	}
+	resp.Body.Close()
+
+	callback, err := resp.Location()
+	if err != nil {
+		t.Fatalf("mock idp did not redirect: %v", err)
+	}
+	if got := callback.Scheme + "://" + callback.Host + callback.Path; got != testCallbackURL {
+		t.Fatalf("redirected to %s, want %s", got, testCallbackURL)
+	}
+	return callback.Query().Get("code"), callback.Query().Get("state"), verifier
+}
+
+func (f *oauthFixture) login(t *testing.T) (*accountModel.User, *TokenPair, error) {
+	t.Helper()
+	code, state, verifier := f.authorize(t)
+	return f.service.Login(context.Background(), oauth.ProviderMock, code, state, verifier, ClientInfo{})
+}
+
+func TestOAuthLoginCreatesUser(t *testing.T) {
+	f := newOAuthFixture(t, oauth.Identity{
+		Subject:       "subject-1",
+		Email:         "New.Learner@Example.com",
+		EmailVerified: true,
+		Name:          "New Learner",
+	})
+
+	user, tokens, err := f.login(t)
+	if err != nil {
+		t.Fatalf("login failed: %v", err)
+	}
+
+	if user.Email != "new.learner@example.com" {
+		t.Errorf("email = %q, want the normalized provider email", user.Email)
+	}
+	if !user.IsEmailVerified() {
+		t.Error("user created from a verified identity is not verified")
+	}
+	if user.Type != "other" {
+		t.Errorf("type = %q, want other for the mock provider", user.Type)
+	}
+	if tokens == nil || tokens.AccessToken == "" {
+		t.Fatal("no tokens issued")
+	}
+
+	linkedID, err := f.identities.GetUserID(context.Background(), oauth.ProviderMock, "subject-1")
+	if err != nil || linkedID != user.ID {
+		t.Fatalf("identity linked to %s (%v), want %s", linkedID, err, user.ID)
+	}
+}
+
+func TestOAuthLoginReusesLinkedIdentity(t *testing.T) {
+	f := newOAuthFixture(t, oauth.Identity{
+		Subject:       "subject-1",
+		Email:         "learner@example.com",
+		EmailVerified: true,
+	})
+
+	first, _, err := f.login(t)
+	if err != nil {
+		t.Fatalf("first login failed: %v", err)
+	}
+
+	// The link wins over the email, which the provider account may have changed since
+	f.idp.SetIdentity(oauth.Identity{
+		Subject:       "subject-1",
+		Email:         "renamed@example.com",
+		EmailVerified: true,
+	})
+	second, _, err := f.login(t)
+	if err != nil {
+		t.Fatalf("second login failed: %v", err)
+	}
+
+	if second.ID != first.ID {
+		t.Errorf("second login resolved %s, want the linked user %s", second.ID, first.ID)
+	}
+	if len(f.users.users) != 1 {
+		t.Errorf("%d users exist, want 1", len(f.users.users))
+	}
+}
+
+func TestOAuthLoginLinksExistingUser(t *testing.T) {
+	f := newOAuthFixture(t, oauth.Identity{
+		Subject:       "subject-1",
+		Email:         "learner@example.com",
+		EmailVerified: true,
+	})
+
+	existing := &accountModel.User{
+		ID:       uuid.New(),
+		Email:    "learner@example.com",
+		Username: "learner",
+		Password: "registered-password-hash",
+		Type:     "other",
+	}
+	f.users.Create(context.Background(), existing)
+
+	user, _, err := f.login(t)
+	if err != nil {
+		t.Fatalf("login failed: %v", err)
+	}
+
+	if user.ID != existing.ID {
+		t.Fatalf("login resolved %s, want the user with the same email %s", user.ID, existing.ID)
+	}
+	// Whoever registered the email without verifying it loses the account
+	stored, _ := f.users.GetByID(context.Background(), existing.ID)
+	if !stored.IsEmailVerified() || stored.Password == existing.Password {
+		t.Error("unverified account was not claimed")
+	}
+	if len(f.tokens.loggedOut) != 1 || f.tokens.loggedOut[0] != existing.ID.String() {
+		t.Errorf("sessions revoked for %v, want %s", f.tokens.loggedOut, existing.ID)
+	}
+
+	linkedID, err := f.identities.GetUserID(context.Background(), oauth.ProviderMock, "subject-1")
+	if err != nil || linkedID != existing.ID {
+		t.Fatalf("identity linked to %s (%v), want %s", linkedID, err, existing.ID)
+	}
+}
+
+func TestOAuthLoginRejectsStateMismatch(t *testing.T) {
+	f := newOAuthFixture(t, oauth.Identity{
+		Subject:       "subject-1",
+		Email:         "learner@example.com",
+		EmailVerified: true,
+	})
+
+	for name, forge := range map[string]func(verifier string) (string, error){
+		"forged": func(string) (string, error) {
+			return "not-a-state", nil
+		},
+		"other provider": func(verifier string) (string, error) {
+			return utils.GenerateStateToken(statePurpose(oauth.ProviderGoogle), utils.HashToken(verifier), constants.OAuthStateTTL)
+		},
+		"expired": func(verifier string) (string, error) {
+			return utils.GenerateStateToken(statePurpose(oauth.ProviderMock), utils.HashToken(verifier), -time.Minute)
+		},
+		// The attacker's own state, started in another browser, completing a login in the victim's
+		"other browser": func(string) (string, error) {
+			_, state, _ := f.authorize(t)
+			return state, nil
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			code, _, verifier := f.authorize(t)
+			state, err := forge(verifier)
+			if err != nil {
+				t.Fatalf("failed to generate state: %v", err)
+			}
+			_, _, err = f.service.Login(context.Background(), oauth.ProviderMock, code, state, verifier, ClientInfo{})
+			if !errors.Is(err, ErrOAuthStateInvalid) {
+				t.Fatalf("err = %v, want %v", err, ErrOAuthStateInvalid)
+			}
+		})
+	}
+
+	t.Run("missing cookie", func(t *testing.T) {
+		code, state, _ := f.authorize(t)
+		_, _, err := f.service.Login(context.Background(), oauth.ProviderMock, code, state, "", ClientInfo{})
+		if !errors.Is(err, ErrOAuthStateInvalid) {
+			t.Fatalf("err = %v, want %v", err, ErrOAuthStateInvalid)
+		}
+	})
+
+	if len(f.users.users) != 0 || len(f.tokens.issued) != 0 {
+		t.Error("a login with an invalid state created a user or issued tokens")
+	}
+}
+
+func TestOAuthLoginRejectsIdentityLinkedElsewhere(t *testing.T) {
+	f := newOAuthFixture(t, oauth.Identity{
+		Subject:       "subject-1",
+		Email:         "learner@example.com",
+		EmailVerified: true,
+	})
+
+	// Another login links the identity to a different account between the lookup and the link
+	otherUser := uuid.New()
+	f.identities.concurrent[oauth.ProviderMock+":subject-1"] = otherUser
+
+	_, _, err := f.login(t)
+	if !errors.Is(err, ErrOAuthIdentityLinked) {
+		t.Fatalf("err = %v, want %v", err, ErrOAuthIdentityLinked)
+	}
+	if len(f.tokens.issued) != 0 {
+		t.Error("tokens issued for an identity linked to another account")
+	}
+}
+
+func TestOAuthLoginRejectsUnverifiedEmail(t *testing.T) {
+	f := newOAuthFixture(t, oauth.Identity{
+		Subject: "subject-1",
+		Email:   "learner@example.com",
+	})
+
+	_, _, err := f.login(t)
+	if !errors.Is(err, oauth.ErrEmailNotVerified) {
+		t.Fatalf("err = %v, want %v", err, oauth.ErrEmailNotVerified)
+	}
+}
+
+func TestOAuthLoginRejectsReusedCode(t *testing.T) {
+	f := newOAuthFixture(t, oauth.Identity{
+		Subject:       "subject-1",
+		Email:         "learner@example.com",
+		EmailVerified: true,
+	})
+
+	code, state, verifier := f.authorize(t)
+	if _, _, err := f.service.Login(context.Background(), oauth.ProviderMock, code, state, verifier, ClientInfo{}); err != nil {
+		t.Fatalf("login failed: %v", err)
+	}
+	if _, _, err := f.service.Login(context.Background(), oauth.ProviderMock, code, state, verifier, ClientInfo{}); !errors.Is(err, oauth.ErrCodeRejected) {
+		t.Fatalf("err = %v, want %v", err, oauth.ErrCodeRejected)
+	}
+}
+
+func TestOAuthLoginRequiresCodeVerifier(t *testing.T) {
+	f := newOAuthFixture(t, oauth.Identity{
+		Subject:       "subject-1",
+		Email:         "learner@example.com",
+		EmailVerified: true,
+	})
+
+	authorizationURL, _, err := f.service.AuthorizationURL(oauth.ProviderMock)
+	if err != nil {
+		t.Fatalf("failed to get authorization url: %v", err)
+	}
+	parsed, err := url.Parse(authorizationURL)
+	if err != nil {
+		t.Fatalf("invalid authorization url: %v", err)
+	}
+	if parsed.Query().Get("code_challenge_method") != "S256" || parsed.Query().Get("code_challenge") == "" {
+		t.Fatalf("authorization url %s carries no S256 code challenge", authorizationURL)
+	}
+
+	// An intercepted code is useless without the verifier its challenge was made from
+	code, _, _ := f.authorize(t)
+	provider, err := f.service.providers.Get(oauth.ProviderMock)
+	if err != nil {
+		t.Fatalf("failed to get provider: %v", err)
+	}
+	if _, err := provider.Exchange(context.Background(), code, oauth.NewVerifier()); !errors.Is(err, oauth.ErrCodeRejected) {
+		t.Fatalf("err = %v, want %v", err, oauth.ErrCodeRejected)
+	}
+}
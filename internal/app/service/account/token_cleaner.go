@@ -0,0 +1,115 @@
+package account
+
+import (
+	"context"
+	"fluencybe/internal/core/constants"
+	"fluencybe/pkg/logger"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ExpiredTokens deletes the tokens of one table that can no longer be used
+type ExpiredTokens interface {
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// TokenCleaner deletes the expired tokens of every table on start and then every
+// TokenPurgeInterval. The deletes are idempotent, every replica runs one.
+type TokenCleaner struct {
+	tables map[string]ExpiredTokens
+	logger *logger.PrettyLogger
+
+	cancel context.CancelFunc
+	done   chan struct{}
+	mu     sync.Mutex
+}
+
+func NewTokenCleaner(tables map[string]ExpiredTokens, logger *logger.PrettyLogger) *TokenCleaner {
+	return &TokenCleaner{
+		tables: tables,
+		logger: logger,
+	}
+}
+
+// Start launches the purge loop, calling it again while running is a no-op
+func (c *TokenCleaner) Start() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+	c.done = make(chan struct{})
+
+	c.logger.Info("TOKEN_CLEANER_START", map[string]interface{}{
+		"tables":   c.names(),
+		"interval": constants.TokenPurgeInterval.String(),
+	}, "Starting expired token purge")
+
+	go c.run(ctx)
+}
+
+// Stop ends the purge loop, abandoning the purge in flight
+func (c *TokenCleaner) Stop() {
+	c.mu.Lock()
+	cancel, done := c.cancel, c.done
+	c.cancel = nil
+	c.mu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+}
+
+func (c *TokenCleaner) run(ctx context.Context) {
+	defer close(c.done)
+
+	ticker := time.NewTicker(constants.TokenPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		c.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *TokenCleaner) purge(ctx context.Context) {
+	for _, name := range c.names() {
+		deleted, err := c.tables[name].DeleteExpired(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			c.logger.Error("token_cleaner.purge", map[string]interface{}{
+				"error": err.Error(),
+				"table": name,
+			}, "Failed to purge expired tokens")
+			continue
+		}
+		if deleted > 0 {
+			c.logger.Debug("token_cleaner.purge", map[string]interface{}{
+				"deleted": deleted,
+				"table":   name,
+			}, "Purged expired tokens")
+		}
+	}
+}
+
+func (c *TokenCleaner) names() []string {
+	names := make([]string, 0, len(c.tables))
+	for name := range c.tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,179 @@
+package account
+
+import (
+	"context"
+	"errors"
+	accountModel "fluencybe/internal/app/model/account"
+	accountRepository "fluencybe/internal/app/repository/account"
+	"fluencybe/internal/core/constants"
+	"fluencybe/internal/core/session"
+	"fluencybe/pkg/logger"
+	"fluencybe/pkg/utils"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	ErrRefreshTokenReused  = errors.New("refresh token reuse detected")
+)
+
+// ClientInfo describes the device a session was opened from
+type ClientInfo struct {
+	UserAgent string
+	IPAddress string
+}
+
+type TokenPair struct {
+	SessionID        uuid.UUID
+	AccessToken      string
+	AccessExpiresAt  time.Time
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+}
+
+type TokenService struct {
+	repo   *accountRepository.RefreshTokenRepository
+	logger *logger.PrettyLogger
+}
+
+func NewTokenService(repo *accountRepository.RefreshTokenRepository) *TokenService {
+	return &TokenService{
+		repo:   repo,
+		logger: logger.GetGlobalLogger(),
+	}
+}
+
+// IssueTokens opens a new session for the subject and returns its first token pair
+func (s *TokenService) IssueTokens(ctx context.Context, subjectID uuid.UUID, role string, client ClientInfo) (*TokenPair, error) {
+	refreshToken, record, err := s.newRefreshToken(uuid.New(), subjectID, role, client)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Create(ctx, record); err != nil {
+		return nil, err
+	}
+
+	return s.buildPair(record, refreshToken)
+}
+
+// Refresh exchanges a refresh token for a new pair, the presented token is revoked.
+// Presenting an already revoked token revokes the whole session since it was most likely stolen.
+func (s *TokenService) Refresh(ctx context.Context, refreshToken string, role string, client ClientInfo) (*TokenPair, error) {
+	if refreshToken == "" {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	current, err := s.repo.GetByHash(ctx, utils.HashToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, accountRepository.ErrRefreshTokenNotFound) {
+			return nil, ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+
+	if current.Role != role {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	if current.RevokedAt != nil {
+		s.logger.WithContext(ctx).Warning("token_service.refresh.reuse", map[string]interface{}{
+			"session_id": current.SessionID.String(),
+			"subject_id": current.SubjectID.String(),
+		}, "Revoked refresh token presented, revoking session")
+		if err := s.revokeSession(ctx, current.SessionID); err != nil {
+			return nil, err
+		}
+		return nil, ErrRefreshTokenReused
+	}
+
+	if time.Now().After(current.ExpiresAt) {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	nextToken, next, err := s.newRefreshToken(current.SessionID, current.SubjectID, role, client)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Rotate(ctx, current.ID, next); err != nil {
+		if errors.Is(err, accountRepository.ErrRefreshTokenNotFound) {
+			// Lost a race against another refresh with the same token
+			if err := s.revokeSession(ctx, current.SessionID); err != nil {
+				return nil, err
+			}
+			return nil, ErrRefreshTokenReused
+		}
+		return nil, err
+	}
+
+	return s.buildPair(next, nextToken)
+}
+
+func (s *TokenService) Logout(ctx context.Context, sessionID string) error {
+	id, err := uuid.Parse(sessionID)
+	if err != nil {
+		return ErrInvalidInput
+	}
+	return s.revokeSession(ctx, id)
+}
+
+// LogoutAll revokes every session of the subject, on every device
+func (s *TokenService) LogoutAll(ctx context.Context, subjectID string, role string) error {
+	id, err := uuid.Parse(subjectID)
+	if err != nil {
+		return ErrInvalidInput
+	}
+
+	if err := s.repo.RevokeAllForSubject(ctx, id, role); err != nil {
+		return err
+	}
+	session.InvalidateSubject(subjectID)
+	return nil
+}
+
+func (s *TokenService) revokeSession(ctx context.Context, sessionID uuid.UUID) error {
+	if err := s.repo.RevokeSession(ctx, sessionID); err != nil {
+		return err
+	}
+	session.InvalidateSession(sessionID.String())
+	return nil
+}
+
+func (s *TokenService) newRefreshToken(sessionID, subjectID uuid.UUID, role string, client ClientInfo) (string, *accountModel.RefreshToken, error) {
+	token, err := utils.GenerateOpaqueToken()
+	if err != nil {
+		s.logger.Error("token_service.generate_refresh_token", map[string]interface{}{"error": err.Error()}, "Failed to generate refresh token")
+		return "", nil, fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	return token, &accountModel.RefreshToken{
+		ID:        uuid.New(),
+		SessionID: sessionID,
+		SubjectID: subjectID,
+		Role:      role,
+		TokenHash: utils.HashToken(token),
+		UserAgent: client.UserAgent,
+		IPAddress: client.IPAddress,
+		ExpiresAt: time.Now().UTC().Add(constants.JWTRefreshTokenTTL),
+	}, nil
+}
+
+func (s *TokenService) buildPair(record *accountModel.RefreshToken, refreshToken string) (*TokenPair, error) {
+	accessToken, accessExpiresAt, err := utils.GenerateJWT(record.SubjectID.String(), record.Role, record.SessionID.String())
+	if err != nil {
+		s.logger.Error("token_service.generate_access_token", map[string]interface{}{"error": err.Error()}, "Failed to generate access token")
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	return &TokenPair{
+		SessionID:        record.SessionID,
+		AccessToken:      accessToken,
+		AccessExpiresAt:  accessExpiresAt,
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: record.ExpiresAt,
+	}, nil
+}
@@ -5,8 +5,8 @@ import (
 	"errors"
 	accountModel "fluencybe/internal/app/model/account"
 	accountRepository "fluencybe/internal/app/repository/account"
+	"fluencybe/internal/core/constants"
 	"fluencybe/pkg/logger"
-	"fluencybe/pkg/utils"
 	"fmt"
 	"strconv"
 
@@ -16,14 +16,18 @@ import (
 )
 
 type UserService struct {
-	repo   *accountRepository.UserRepository
-	logger *logger.PrettyLogger
+	repo         *accountRepository.UserRepository
+	tokens       *TokenService
+	verification *VerificationService
+	logger       *logger.PrettyLogger
 }
 
-func NewUserService(repo *accountRepository.UserRepository) *UserService {
+func NewUserService(repo *accountRepository.UserRepository, tokens *TokenService, verification *VerificationService) *UserService {
 	return &UserService{
-		repo:   repo,
-		logger: logger.GetGlobalLogger(),
+		repo:         repo,
+		tokens:       tokens,
+		verification: verification,
+		logger:       logger.GetGlobalLogger(),
 	}
 }
 
@@ -51,18 +55,18 @@ func (s *UserService) verifyPassword(hashedPassword, password string) error {
 }
 
 func (s *UserService) Register(ctx context.Context, user *accountModel.User) error {
-	s.logger.Info("register_start", map[string]interface{}{
+	s.logger.WithContext(ctx).Info("register_start", map[string]interface{}{
 		"email": user.Email,
 	}, "Starting user registration")
 
 	if user.Email == "" || user.Password == "" {
-		s.logger.Error("register_validation_failed", nil, "Email and password are required")
+		s.logger.WithContext(ctx).Error("register_validation_failed", nil, "Email and password are required")
 		return errors.New("email and password are required")
 	}
 
 	hashedPassword, err := s.hashPassword(user.Password)
 	if err != nil {
-		s.logger.Error("register_hash_failed", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("register_hash_failed", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to hash password during registration")
 		return err
@@ -71,68 +75,132 @@ func (s *UserService) Register(ctx context.Context, user *accountModel.User) err
 	user.Password = hashedPassword
 	if err := s.repo.Create(ctx, user); err != nil {
 		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
-			s.logger.Error("register_duplicate", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("register_duplicate", map[string]interface{}{
 				"email": user.Email,
 			}, "Email already exists")
 			return errors.New("email already exists")
 		}
-		s.logger.Error("register_failed", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("register_failed", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create user in database")
 		return err
 	}
 
-	s.logger.Info("register_success", map[string]interface{}{
+	s.logger.WithContext(ctx).Info("register_success", map[string]interface{}{
 		"user_id": user.ID.String(),
 		"email":   user.Email,
 	}, "User registered successfully")
+
+	// The account exists even if the email could not be sent, the user can ask for a new link
+	if err := s.verification.SendEmailVerification(ctx, user); err != nil {
+		s.logger.WithContext(ctx).Warning("register_verification_email_failed", map[string]interface{}{
+			"user_id": user.ID.String(),
+			"error":   err.Error(),
+		}, "Failed to send verification email")
+	}
 	return nil
 }
 
-func (s *UserService) Login(ctx context.Context, email, password string) (*accountModel.User, string, error) {
-	s.logger.Info("login_start", map[string]interface{}{
+func (s *UserService) Login(ctx context.Context, email, password string, client ClientInfo) (*accountModel.User, *TokenPair, error) {
+	s.logger.WithContext(ctx).Info("login_start", map[string]interface{}{
 		"email": email,
 	}, "Starting user login")
 
 	user, err := s.repo.GetByEmail(ctx, email)
 	if err != nil {
-		s.logger.Error("login_user_not_found", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("login_user_not_found", map[string]interface{}{
 			"email": email,
 			"error": err.Error(),
 		}, "User not found during login")
-		return nil, "", errors.New("invalid credentials")
+		return nil, nil, errors.New("invalid credentials")
 	}
 
 	if err := s.verifyPassword(user.Password, password); err != nil {
-		s.logger.Error("login_invalid_password", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("login_invalid_password", map[string]interface{}{
 			"email": email,
 		}, "Invalid password during login")
-		return nil, "", err
+		return nil, nil, err
+	}
+
+	if s.verification.Required() && !user.IsEmailVerified() {
+		s.logger.WithContext(ctx).Warning("login_email_not_verified", map[string]interface{}{
+			"user_id": user.ID.String(),
+		}, "Login rejected, email not verified")
+		return nil, nil, ErrEmailNotVerified
 	}
 
-	token, err := utils.GenerateJWT(user.ID.String(), "user")
+	tokens, err := s.tokens.IssueTokens(ctx, user.ID, constants.RoleUser, client)
 	if err != nil {
-		s.logger.Error("login_token_generation_failed", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("login_token_generation_failed", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to generate JWT token")
-		return nil, "", fmt.Errorf("failed to generate token: %v", err)
+		return nil, nil, fmt.Errorf("failed to generate token: %v", err)
 	}
 
-	s.logger.Info("login_success", map[string]interface{}{
+	s.logger.WithContext(ctx).Info("login_success", map[string]interface{}{
 		"user_id": user.ID.String(),
 		"email":   user.Email,
 	}, "User logged in successfully")
-	return user, token, nil
+	return user, tokens, nil
+}
+
+func (s *UserService) Refresh(ctx context.Context, refreshToken string, client ClientInfo) (*TokenPair, error) {
+	tokens, err := s.tokens.Refresh(ctx, refreshToken, constants.RoleUser, client)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("refresh_failed", map[string]interface{}{
+			"error": err.Error(),
+		}, "Failed to refresh user token")
+		return nil, err
+	}
+	return tokens, nil
+}
+
+func (s *UserService) Logout(ctx context.Context, sessionID string) error {
+	if err := s.tokens.Logout(ctx, sessionID); err != nil {
+		s.logger.WithContext(ctx).Error("logout_failed", map[string]interface{}{
+			"session_id": sessionID,
+			"error":      err.Error(),
+		}, "Failed to revoke user session")
+		return err
+	}
+	return nil
+}
+
+func (s *UserService) LogoutAll(ctx context.Context, userID string) error {
+	if err := s.tokens.LogoutAll(ctx, userID, constants.RoleUser); err != nil {
+		s.logger.WithContext(ctx).Error("logout_all_failed", map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		}, "Failed to revoke user sessions")
+		return err
+	}
+	return nil
+}
+
+func (s *UserService) VerifyEmail(ctx context.Context, token string) error {
+	return s.verification.VerifyEmail(ctx, token)
+}
+
+func (s *UserService) ResendEmailVerification(ctx context.Context, userID string) error {
+	return s.verification.ResendEmailVerification(ctx, userID)
+}
+
+func (s *UserService) RequestPasswordReset(ctx context.Context, email string) error {
+	return s.verification.RequestPasswordReset(ctx, email)
+}
+
+func (s *UserService) ResetPassword(ctx context.Context, token string, newPassword string) error {
+	return s.verification.ResetPassword(ctx, token, newPassword)
 }
 
 func (s *UserService) GetUser(ctx context.Context, id string) (*accountModel.User, error) {
-	s.logger.Info("get_user_start", map[string]interface{}{
+	s.logger.WithContext(ctx).Info("get_user_start", map[string]interface{}{
 		"user_id": id,
 	}, "Starting to get user")
 
 	userID, err := uuid.Parse(id)
 	if err != nil {
-		s.logger.Error("get_user_invalid_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("get_user_invalid_id", map[string]interface{}{
 			"user_id": id,
 			"error":   err.Error(),
 		}, "Invalid user ID format")
@@ -141,28 +209,28 @@ func (s *UserService) GetUser(ctx context.Context, id string) (*accountModel.Use
 
 	user, err := s.repo.GetByID(ctx, userID)
 	if err != nil {
-		s.logger.Error("get_user_failed", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("get_user_failed", map[string]interface{}{
 			"user_id": id,
 			"error":   err.Error(),
 		}, "Failed to get user")
 		return nil, err
 	}
 
-	s.logger.Info("get_user_success", map[string]interface{}{
+	s.logger.WithContext(ctx).Info("get_user_success", map[string]interface{}{
 		"user_id": id,
 	}, "User retrieved successfully")
 	return user, nil
 }
 
 func (s *UserService) UpdateUser(ctx context.Context, id string, updates map[string]interface{}) error {
-	s.logger.Info("update_user_start", map[string]interface{}{
+	s.logger.WithContext(ctx).Info("update_user_start", map[string]interface{}{
 		"user_id": id,
 		"updates": updates,
 	}, "Starting to update user")
 
 	userID, err := uuid.Parse(id)
 	if err != nil {
-		s.logger.Error("update_user_invalid_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("update_user_invalid_id", map[string]interface{}{
 			"user_id": id,
 			"error":   err.Error(),
 		}, "Invalid user ID format")
@@ -173,7 +241,7 @@ func (s *UserService) UpdateUser(ctx context.Context, id string, updates map[str
 	if password, ok := updates["password"].(string); ok {
 		hashedPassword, err := s.hashPassword(password)
 		if err != nil {
-			s.logger.Error("update_user_password_hash_failed", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("update_user_password_hash_failed", map[string]interface{}{
 				"error": err.Error(),
 			}, "Failed to hash new password")
 			return err
@@ -181,28 +249,43 @@ func (s *UserService) UpdateUser(ctx context.Context, id string, updates map[str
 		updates["password"] = hashedPassword
 	}
 
+	// A new address has to be verified again
+	_, emailChanged := updates["email"]
+	if emailChanged {
+		updates["email_verified_at"] = nil
+	}
+
 	if err := s.repo.Update(ctx, userID, updates); err != nil {
-		s.logger.Error("update_user_failed", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("update_user_failed", map[string]interface{}{
 			"user_id": id,
 			"error":   err.Error(),
 		}, "Failed to update user")
 		return err
 	}
 
-	s.logger.Info("update_user_success", map[string]interface{}{
+	if emailChanged {
+		if err := s.verification.ResendEmailVerification(ctx, id); err != nil {
+			s.logger.WithContext(ctx).Warning("update_user_verification_email_failed", map[string]interface{}{
+				"user_id": id,
+				"error":   err.Error(),
+			}, "Failed to send verification email")
+		}
+	}
+
+	s.logger.WithContext(ctx).Info("update_user_success", map[string]interface{}{
 		"user_id": id,
 	}, "User updated successfully")
 	return nil
 }
 
 func (s *UserService) DeleteUser(ctx context.Context, id string) error {
-	s.logger.Info("delete_user_start", map[string]interface{}{
+	s.logger.WithContext(ctx).Info("delete_user_start", map[string]interface{}{
 		"user_id": id,
 	}, "Starting to delete user")
 
 	userID, err := uuid.Parse(id)
 	if err != nil {
-		s.logger.Error("delete_user_invalid_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("delete_user_invalid_id", map[string]interface{}{
 			"user_id": id,
 			"error":   err.Error(),
 		}, "Invalid user ID format")
@@ -210,28 +293,35 @@ func (s *UserService) DeleteUser(ctx context.Context, id string) error {
 	}
 
 	if err := s.repo.Delete(ctx, userID); err != nil {
-		s.logger.Error("delete_user_failed", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("delete_user_failed", map[string]interface{}{
 			"user_id": id,
 			"error":   err.Error(),
 		}, "Failed to delete user")
 		return err
 	}
 
-	s.logger.Info("delete_user_success", map[string]interface{}{
+	if err := s.tokens.LogoutAll(ctx, id, constants.RoleUser); err != nil {
+		s.logger.WithContext(ctx).Warning("delete_user_revoke_sessions_failed", map[string]interface{}{
+			"user_id": id,
+			"error":   err.Error(),
+		}, "Failed to revoke sessions of deleted user")
+	}
+
+	s.logger.WithContext(ctx).Info("delete_user_success", map[string]interface{}{
 		"user_id": id,
 	}, "User deleted successfully")
 	return nil
 }
 
 func (s *UserService) GetUserList(ctx context.Context, page string, limit string) ([]*accountModel.User, int64, error) {
-	s.logger.Info("get_user_list_start", map[string]interface{}{
+	s.logger.WithContext(ctx).Info("get_user_list_start", map[string]interface{}{
 		"page":  page,
 		"limit": limit,
 	}, "Starting to get list of users")
 
 	pageNum, err := strconv.Atoi(page)
 	if err != nil {
-		s.logger.Error("get_user_list_invalid_page", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("get_user_list_invalid_page", map[string]interface{}{
 			"page":  page,
 			"error": err.Error(),
 		}, "Invalid page number")
@@ -240,7 +330,7 @@ func (s *UserService) GetUserList(ctx context.Context, page string, limit string
 
 	limitNum, err := strconv.Atoi(limit)
 	if err != nil {
-		s.logger.Error("get_user_list_invalid_limit", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("get_user_list_invalid_limit", map[string]interface{}{
 			"limit": limit,
 			"error": err.Error(),
 		}, "Invalid limit number")
@@ -250,7 +340,7 @@ func (s *UserService) GetUserList(ctx context.Context, page string, limit string
 	offset := (pageNum - 1) * limitNum
 	users, err := s.repo.GetList(ctx, limitNum, offset)
 	if err != nil {
-		s.logger.Error("get_user_list_failed", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("get_user_list_failed", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get list of users")
 		return nil, 0, err
@@ -258,13 +348,13 @@ func (s *UserService) GetUserList(ctx context.Context, page string, limit string
 
 	total, err := s.repo.GetTotalCount(ctx)
 	if err != nil {
-		s.logger.Error("get_user_list_count_failed", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("get_user_list_count_failed", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get total count")
 		return nil, 0, err
 	}
 
-	s.logger.Info("get_user_list_success", map[string]interface{}{
+	s.logger.WithContext(ctx).Info("get_user_list_success", map[string]interface{}{
 		"total": total,
 		"count": len(users),
 	}, "User list retrieved successfully")
@@ -0,0 +1,243 @@
+package account
+
+import (
+	"context"
+	"errors"
+	accountModel "fluencybe/internal/app/model/account"
+	accountRepository "fluencybe/internal/app/repository/account"
+	"fluencybe/internal/core/constants"
+	"fluencybe/pkg/logger"
+	"fluencybe/pkg/mailer"
+	"fluencybe/pkg/utils"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrEmailNotVerified     = errors.New("email address has not been verified")
+	ErrEmailAlreadyVerified = errors.New("email address is already verified")
+	ErrInvalidActionToken   = errors.New("token is invalid or has expired")
+	ErrPasswordTooShort     = errors.New("password must be at least 8 characters")
+)
+
+// VerificationService sends the emailed links for email verification and password reset
+type VerificationService struct {
+	users           *accountRepository.UserRepository
+	actionTokens    *accountRepository.UserActionTokenRepository
+	tokens          *TokenService
+	mailer          mailer.Mailer
+	appBaseURL      string
+	requireVerified bool
+	logger          *logger.PrettyLogger
+}
+
+func NewVerificationService(
+	users *accountRepository.UserRepository,
+	actionTokens *accountRepository.UserActionTokenRepository,
+	tokens *TokenService,
+	mailer mailer.Mailer,
+	appBaseURL string,
+	requireVerified bool,
+) *VerificationService {
+	return &VerificationService{
+		users:           users,
+		actionTokens:    actionTokens,
+		tokens:          tokens,
+		mailer:          mailer,
+		appBaseURL:      strings.TrimSuffix(appBaseURL, "/"),
+		requireVerified: requireVerified,
+		logger:          logger.GetGlobalLogger(),
+	}
+}
+
+// Required reports whether password logins need a verified email
+func (s *VerificationService) Required() bool {
+	return s.requireVerified
+}
+
+func (s *VerificationService) SendEmailVerification(ctx context.Context, user *accountModel.User) error {
+	if user.IsEmailVerified() {
+		return ErrEmailAlreadyVerified
+	}
+
+	token, err := s.issueToken(ctx, user.ID, constants.TokenPurposeVerifyEmail, constants.EmailVerificationTokenTTL)
+	if err != nil {
+		return err
+	}
+
+	return s.send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nPlease confirm your email address by opening the link below:\n\n%s\n\nThe link expires in %s.\n",
+			user.Username,
+			s.link("/verify-email", token),
+			constants.EmailVerificationTokenTTL,
+		),
+	})
+}
+
+func (s *VerificationService) ResendEmailVerification(ctx context.Context, userID string) error {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	user, err := s.users.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	return s.SendEmailVerification(ctx, user)
+}
+
+func (s *VerificationService) VerifyEmail(ctx context.Context, token string) error {
+	userID, err := s.consume(ctx, token, constants.TokenPurposeVerifyEmail)
+	if err != nil {
+		return err
+	}
+
+	if err := s.users.Update(ctx, userID, map[string]interface{}{
+		"email_verified_at": time.Now().UTC(),
+	}); err != nil {
+		s.logger.WithContext(ctx).Error("verification_service.verify_email", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID.String(),
+		}, "Failed to mark email as verified")
+		return err
+	}
+
+	s.logger.WithContext(ctx).Info("verification_service.verify_email", map[string]interface{}{
+		"user_id": userID.String(),
+	}, "Email verified")
+	return nil
+}
+
+// RequestPasswordReset emails a reset link. Unknown emails are not reported to the caller
+// so the endpoint cannot be used to discover registered addresses.
+func (s *VerificationService) RequestPasswordReset(ctx context.Context, email string) error {
+	user, err := s.users.GetByEmail(ctx, strings.ToLower(strings.TrimSpace(email)))
+	if err != nil {
+		if errors.Is(err, accountRepository.ErrUserNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	token, err := s.issueToken(ctx, user.ID, constants.TokenPurposeResetPassword, constants.PasswordResetTokenTTL)
+	if err != nil {
+		return err
+	}
+
+	return s.send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nSomeone asked to reset the password of your account. If it was you, open the link below:\n\n%s\n\nThe link expires in %s. If you did not ask for it you can ignore this email.\n",
+			user.Username,
+			s.link("/reset-password", token),
+			constants.PasswordResetTokenTTL,
+		),
+	})
+}
+
+// ResetPassword sets a new password and logs the user out of every device
+func (s *VerificationService) ResetPassword(ctx context.Context, token string, newPassword string) error {
+	if len(newPassword) < 8 {
+		return ErrPasswordTooShort
+	}
+
+	userID, err := s.consume(ctx, token, constants.TokenPurposeResetPassword)
+	if err != nil {
+		return err
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	// Receiving the link proves ownership of the address as well
+	updates := map[string]interface{}{
+		"password": string(hashedPassword),
+	}
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !user.IsEmailVerified() {
+		updates["email_verified_at"] = time.Now().UTC()
+	}
+
+	if err := s.users.Update(ctx, userID, updates); err != nil {
+		s.logger.WithContext(ctx).Error("verification_service.reset_password", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID.String(),
+		}, "Failed to update password")
+		return err
+	}
+
+	if err := s.tokens.LogoutAll(ctx, userID.String(), constants.RoleUser); err != nil {
+		s.logger.WithContext(ctx).Warning("verification_service.reset_password.revoke_sessions", map[string]interface{}{
+			"error":   err.Error(),
+			"user_id": userID.String(),
+		}, "Failed to revoke sessions after password reset")
+	}
+
+	s.logger.WithContext(ctx).Info("verification_service.reset_password", map[string]interface{}{
+		"user_id": userID.String(),
+	}, "Password reset")
+	return nil
+}
+
+func (s *VerificationService) issueToken(ctx context.Context, userID uuid.UUID, purpose string, ttl time.Duration) (string, error) {
+	token, err := utils.GenerateOpaqueToken()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	err = s.actionTokens.Replace(ctx, &accountModel.UserActionToken{
+		UserID:    userID,
+		Purpose:   purpose,
+		TokenHash: utils.HashToken(token),
+		ExpiresAt: time.Now().UTC().Add(ttl),
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+func (s *VerificationService) consume(ctx context.Context, token string, purpose string) (uuid.UUID, error) {
+	if token == "" {
+		return uuid.Nil, ErrInvalidActionToken
+	}
+
+	userID, err := s.actionTokens.Consume(ctx, utils.HashToken(token), purpose)
+	if err != nil {
+		if errors.Is(err, accountRepository.ErrActionTokenInvalid) {
+			return uuid.Nil, ErrInvalidActionToken
+		}
+		return uuid.Nil, err
+	}
+	return userID, nil
+}
+
+func (s *VerificationService) send(ctx context.Context, msg mailer.Message) error {
+	if err := s.mailer.Send(ctx, msg); err != nil {
+		s.logger.WithContext(ctx).Error("verification_service.send", map[string]interface{}{
+			"error":   err.Error(),
+			"subject": msg.Subject,
+		}, "Failed to send email")
+		return err
+	}
+	return nil
+}
+
+func (s *VerificationService) link(path string, token string) string {
+	return s.appBaseURL + path + "?token=" + url.QueryEscape(token)
+}
@@ -0,0 +1,437 @@
+package admin
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fluencybe/fixtures"
+	"fluencybe/internal/app/dto"
+	redisClient "fluencybe/internal/app/redis"
+	adminRepo "fluencybe/internal/app/repository/admin"
+	searchindexRepo "fluencybe/internal/app/repository/searchindex"
+	"fluencybe/internal/core/constants"
+	"fluencybe/pkg/cache"
+	"fluencybe/pkg/logger"
+	"fmt"
+	"io"
+	"io/fs"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrUnknownSet     = errors.New("unknown data set")
+	ErrUnknownFixture = errors.New("unknown fixture")
+)
+
+// QuestionSource is implemented by the skill question services
+type QuestionSource interface {
+	WarmQuestion(ctx context.Context, id uuid.UUID) error
+	SyncQuestionByID(ctx context.Context, id uuid.UUID) error
+}
+
+// AdminService runs the operational tasks of the admin CLI: cache maintenance, data export and
+// import, and fixtures
+type AdminService struct {
+	data     *adminRepo.DataRepository
+	versions *searchindexRepo.QuestionVersionRepository
+	cache    cache.Cache
+	sources  map[string]QuestionSource
+	logger   *logger.PrettyLogger
+}
+
+func NewAdminService(
+	data *adminRepo.DataRepository,
+	versions *searchindexRepo.QuestionVersionRepository,
+	cache cache.Cache,
+	sources map[string]QuestionSource,
+	logger *logger.PrettyLogger,
+) *AdminService {
+	return &AdminService{
+		data:     data,
+		versions: versions,
+		cache:    cache,
+		sources:  sources,
+		logger:   logger,
+	}
+}
+
+// Skills returns the question skills, sorted
+func (s *AdminService) Skills() []string {
+	skills := make([]string, 0, len(s.sources))
+	for skill := range s.sources {
+		skills = append(skills, skill)
+	}
+	sort.Strings(skills)
+	return skills
+}
+
+// DataSets returns the sets that can be exported and flushed from the cache, in export order
+func (s *AdminService) DataSets() []string {
+	return slices.Clone(adminRepo.DataSetOrder)
+}
+
+// FlushCache drops the cache entries of a set on every replica
+func (s *AdminService) FlushCache(ctx context.Context, set string) (*dto.CacheFlushResult, error) {
+	pattern, err := cachePattern(set)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.cache.DeletePattern(ctx, pattern); err != nil {
+		s.logger.WithContext(ctx).Error("admin_service.flush_cache", map[string]interface{}{
+			"error":   err.Error(),
+			"pattern": pattern,
+		}, "Failed to flush cache")
+		return nil, err
+	}
+
+	s.logger.WithContext(ctx).Info("admin_service.flush_cache.done", map[string]interface{}{
+		"set":     set,
+		"pattern": pattern,
+	}, "Cache flushed")
+
+	return &dto.CacheFlushResult{Set: set, Pattern: pattern}, nil
+}
+
+// WarmCache loads the detail of every question of a skill into the cache
+func (s *AdminService) WarmCache(ctx context.Context, skill string) (*dto.CacheWarmResult, error) {
+	source, ok := s.sources[skill]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSet, skill)
+	}
+
+	result := &dto.CacheWarmResult{
+		Skill:     skill,
+		StartedAt: time.Now().UTC(),
+	}
+
+	after := uuid.Nil
+	for {
+		batch, err := s.versions.ListVersions(ctx, skill, after, constants.OpenSearchBulkSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, question := range batch {
+			if err := source.WarmQuestion(ctx, question.ID); err != nil {
+				if ctx.Err() != nil {
+					return nil, ctx.Err()
+				}
+				result.Failed++
+				if len(result.FailedIDs) < constants.AdminMaxListed {
+					result.FailedIDs = append(result.FailedIDs, question.ID)
+				}
+				s.logger.WithContext(ctx).Warning("admin_service.warm_cache.question", map[string]interface{}{
+					"error": err.Error(),
+					"skill": skill,
+					"id":    question.ID,
+				}, "Failed to warm question")
+				continue
+			}
+			result.Warmed++
+		}
+		if len(batch) < constants.OpenSearchBulkSize {
+			break
+		}
+		after = batch[len(batch)-1].ID
+	}
+
+	result.FinishedAt = time.Now().UTC()
+
+	s.logger.WithContext(ctx).Info("admin_service.warm_cache.done", map[string]interface{}{
+		"skill":    skill,
+		"warmed":   result.Warmed,
+		"failed":   result.Failed,
+		"duration": result.FinishedAt.Sub(result.StartedAt).String(),
+	}, "Cache warmed")
+
+	return result, nil
+}
+
+// VerifyCache compares the cached details of a skill with Postgres. With repair, stale and
+// orphaned entries are rebuilt or removed from the database state.
+func (s *AdminService) VerifyCache(ctx context.Context, skill string, repair bool) (*dto.CacheConsistencyReport, error) {
+	source, ok := s.sources[skill]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSet, skill)
+	}
+
+	stored, err := s.loadVersions(ctx, skill)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := skill + "_question:"
+	keys, err := s.cache.Keys(ctx, prefix+"*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cache keys: %w", err)
+	}
+	sort.Strings(keys)
+
+	report := &dto.CacheConsistencyReport{
+		Skill:         skill,
+		DatabaseCount: len(stored),
+		Stale:         []dto.StaleCacheEntry{},
+		Orphaned:      []string{},
+		Repair:        repair,
+	}
+
+	toSync := make(map[uuid.UUID]bool)
+	var toDelete []string
+	for _, key := range keys {
+		// Detail entries are <prefix><id>:<status>:<version>, current entries <prefix>current:<id>.
+		// Only current entries recording a missing id are checked, the others point at a detail.
+		parts := strings.Split(strings.TrimPrefix(key, prefix), ":")
+		var id uuid.UUID
+		var version int
+		switch {
+		case len(parts) == 2 && parts[0] == "current":
+			if id, err = uuid.Parse(parts[1]); err != nil {
+				continue
+			}
+			value, err := s.cache.Get(ctx, key)
+			if err != nil || !redisClient.IsMissingDetail(value) {
+				continue
+			}
+		case len(parts) == 3:
+			if id, err = uuid.Parse(parts[0]); err != nil {
+				continue
+			}
+			if version, err = strconv.Atoi(parts[2]); err != nil {
+				continue
+			}
+		default:
+			continue
+		}
+		report.CachedCount++
+
+		databaseVersion, exists := stored[id]
+		switch {
+		case parts[0] == "current":
+			// A missing id is only wrong once the question exists
+			if !exists {
+				continue
+			}
+			toDelete = append(toDelete, key)
+		case !exists:
+			report.OrphanedCount++
+			if len(report.Orphaned) < constants.AdminMaxListed {
+				report.Orphaned = append(report.Orphaned, key)
+			}
+			toSync[id] = true
+			continue
+		case version == databaseVersion:
+			continue
+		default:
+			toSync[id] = true
+		}
+
+		report.StaleCount++
+		if len(report.Stale) < constants.AdminMaxListed {
+			report.Stale = append(report.Stale, dto.StaleCacheEntry{
+				Key:             key,
+				ID:              id,
+				DatabaseVersion: databaseVersion,
+				CacheVersion:    version,
+			})
+		}
+	}
+
+	if repair {
+		for _, key := range toDelete {
+			if err := s.cache.Delete(ctx, key); err != nil {
+				report.RepairFailed++
+				continue
+			}
+			report.Repaired++
+		}
+		// SyncQuestionByID rewrites the entries of questions that exist and removes the others
+		for id := range toSync {
+			if err := source.SyncQuestionByID(ctx, id); err != nil {
+				report.RepairFailed++
+				s.logger.WithContext(ctx).Warning("admin_service.verify_cache.repair", map[string]interface{}{
+					"error": err.Error(),
+					"skill": skill,
+					"id":    id,
+				}, "Failed to repair cache entry")
+				continue
+			}
+			report.Repaired++
+		}
+	}
+
+	report.CheckedAt = time.Now().UTC()
+
+	s.logger.WithContext(ctx).Info("admin_service.verify_cache.done", map[string]interface{}{
+		"skill":    skill,
+		"cached":   report.CachedCount,
+		"stale":    report.StaleCount,
+		"orphaned": report.OrphanedCount,
+		"repaired": report.Repaired,
+	}, "Cache verified")
+
+	return report, nil
+}
+
+// Export writes the rows of the given sets to w as JSON lines, one dto.DataRow per line
+func (s *AdminService) Export(ctx context.Context, sets []string, w io.Writer) (*dto.ExportResult, error) {
+	for _, set := range sets {
+		if _, ok := adminRepo.DataSets[set]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownSet, set)
+		}
+	}
+
+	result := &dto.ExportResult{
+		Sets:      sets,
+		Rows:      make(map[string]int),
+		StartedAt: time.Now().UTC(),
+	}
+
+	buffered := bufio.NewWriter(w)
+	encoder := json.NewEncoder(buffered)
+	for _, set := range adminRepo.DataSetOrder {
+		if !slices.Contains(sets, set) {
+			continue
+		}
+		for _, table := range adminRepo.DataSets[set] {
+			err := s.data.ExportTable(ctx, table, func(row json.RawMessage) error {
+				result.Rows[table]++
+				return encoder.Encode(dto.DataRow{Table: table, Row: row})
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to export %s: %w", table, err)
+			}
+		}
+	}
+	if err := buffered.Flush(); err != nil {
+		return nil, err
+	}
+
+	result.FinishedAt = time.Now().UTC()
+
+	s.logger.WithContext(ctx).Info("admin_service.export.done", map[string]interface{}{
+		"sets":     sets,
+		"duration": result.FinishedAt.Sub(result.StartedAt).String(),
+	}, "Data exported")
+
+	return result, nil
+}
+
+// Import loads a file written by Export in one transaction. The outbox triggers queue a sync
+// event for every question row, so caches and indexes follow once the relay runs.
+func (s *AdminService) Import(ctx context.Context, r io.Reader) (*dto.ImportResult, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), constants.AdminImportMaxLineSize)
+	line := 0
+	next := func() (string, json.RawMessage, error) {
+		for scanner.Scan() {
+			line++
+			text := strings.TrimSpace(scanner.Text())
+			if text == "" {
+				continue
+			}
+			var row dto.DataRow
+			if err := json.Unmarshal([]byte(text), &row); err != nil {
+				return "", nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			return row.Table, row.Row, nil
+		}
+		if err := scanner.Err(); err != nil {
+			return "", nil, fmt.Errorf("line %d: %w", line+1, err)
+		}
+		return "", nil, io.EOF
+	}
+	return s.importRows(ctx, next)
+}
+
+// ImportRows loads rows in the format of an export file in one transaction, like Import
+func (s *AdminService) ImportRows(ctx context.Context, rows []dto.DataRow) (*dto.ImportResult, error) {
+	i := 0
+	next := func() (string, json.RawMessage, error) {
+		if i == len(rows) {
+			return "", nil, io.EOF
+		}
+		row := rows[i]
+		i++
+		return row.Table, row.Row, nil
+	}
+	return s.importRows(ctx, next)
+}
+
+func (s *AdminService) importRows(ctx context.Context, next func() (string, json.RawMessage, error)) (*dto.ImportResult, error) {
+	result := &dto.ImportResult{
+		Inserted:  make(map[string]int),
+		Skipped:   make(map[string]int),
+		StartedAt: time.Now().UTC(),
+	}
+
+	if err := s.data.Import(ctx, next, result.Inserted, result.Skipped); err != nil {
+		return nil, err
+	}
+
+	result.FinishedAt = time.Now().UTC()
+
+	s.logger.WithContext(ctx).Info("admin_service.import.done", map[string]interface{}{
+		"inserted": result.Inserted,
+		"skipped":  result.Skipped,
+		"duration": result.FinishedAt.Sub(result.StartedAt).String(),
+	}, "Data imported")
+
+	return result, nil
+}
+
+// Fixtures returns the names of the embedded fixtures
+func (s *AdminService) Fixtures() ([]string, error) {
+	matches, err := fs.Glob(fixtures.FS, "*.jsonl")
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(matches))
+	for i, match := range matches {
+		names[i] = strings.TrimSuffix(match, ".jsonl")
+	}
+	return names, nil
+}
+
+// Seed imports an embedded fixture
+func (s *AdminService) Seed(ctx context.Context, name string) (*dto.ImportResult, error) {
+	file, err := fixtures.FS.Open(name + ".jsonl")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownFixture, name)
+	}
+	defer file.Close()
+	return s.Import(ctx, file)
+}
+
+func (s *AdminService) loadVersions(ctx context.Context, skill string) (map[uuid.UUID]int, error) {
+	stored := make(map[uuid.UUID]int)
+	after := uuid.Nil
+	for {
+		batch, err := s.versions.ListVersions(ctx, skill, after, constants.OpenSearchBulkSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, question := range batch {
+			stored[question.ID] = question.Version
+		}
+		if len(batch) < constants.OpenSearchBulkSize {
+			return stored, nil
+		}
+		after = batch[len(batch)-1].ID
+	}
+}
+
+func cachePattern(set string) (string, error) {
+	if _, ok := adminRepo.DataSets[set]; !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownSet, set)
+	}
+	if set == "course" {
+		return "course:*", nil
+	}
+	return set + "_question:*", nil
+}
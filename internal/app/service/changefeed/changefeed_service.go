@@ -0,0 +1,144 @@
+package changefeed
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fluencybe/internal/app/dto"
+	"fluencybe/internal/app/model/changefeed"
+	changefeedRepository "fluencybe/internal/app/repository/changefeed"
+	"fluencybe/internal/core/constants"
+	"fluencybe/pkg/logger"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrUnknownSkill  = errors.New("unknown skill")
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+type ChangeFeedService struct {
+	repo   *changefeedRepository.QuestionChangeRepository
+	logger *logger.PrettyLogger
+}
+
+func NewChangeFeedService(repo *changefeedRepository.QuestionChangeRepository, logger *logger.PrettyLogger) *ChangeFeedService {
+	return &ChangeFeedService{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// GetChanges returns the questions created, updated and deleted after cursor, an empty skill
+// reads every skill. Several changes of the same question in one page are folded into one entry.
+func (s *ChangeFeedService) GetChanges(ctx context.Context, skill string, cursor string, limit int) (*dto.ChangeFeedResponse, error) {
+	if skill != "" && !slices.Contains(constants.ChangeFeedSkills, skill) {
+		return nil, ErrUnknownSkill
+	}
+
+	txID, seq, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = constants.ChangeFeedDefaultLimit
+	}
+	limit = min(limit, constants.ChangeFeedMaxLimit)
+
+	changes, err := s.repo.ListSince(ctx, skill, txID, seq, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	hasMore := len(changes) > limit
+	if hasMore {
+		changes = changes[:limit]
+	}
+
+	result := &dto.ChangeFeedResponse{
+		Created:    []dto.QuestionChangeRef{},
+		Updated:    []dto.QuestionChangeRef{},
+		Deleted:    []dto.QuestionChangeRef{},
+		NextCursor: cursor,
+		HasMore:    hasMore,
+	}
+	if len(changes) == 0 {
+		return result, nil
+	}
+
+	last := changes[len(changes)-1]
+	result.NextCursor = encodeCursor(last.TxID, last.Seq)
+
+	for _, change := range foldChanges(changes) {
+		ref := dto.QuestionChangeRef{
+			ID:        change.QuestionID,
+			Skill:     change.Skill,
+			Version:   change.Version,
+			ChangedAt: change.ChangedAt,
+		}
+		switch change.Operation {
+		case changefeed.OperationCreated:
+			result.Created = append(result.Created, ref)
+		case changefeed.OperationUpdated:
+			result.Updated = append(result.Updated, ref)
+		case changefeed.OperationDeleted:
+			result.Deleted = append(result.Deleted, ref)
+		}
+	}
+	return result, nil
+}
+
+// foldChanges keeps the last change of every question in log order. A question created and then
+// updated in the same page is still reported as created, since the client has never seen it.
+func foldChanges(changes []*changefeed.QuestionChange) []*changefeed.QuestionChange {
+	latest := make(map[uuid.UUID]*changefeed.QuestionChange, len(changes))
+	created := make(map[uuid.UUID]bool)
+	var order []uuid.UUID
+
+	for _, change := range changes {
+		if _, seen := latest[change.QuestionID]; !seen {
+			order = append(order, change.QuestionID)
+		}
+		if change.Operation == changefeed.OperationCreated {
+			created[change.QuestionID] = true
+		}
+		latest[change.QuestionID] = change
+	}
+
+	folded := make([]*changefeed.QuestionChange, 0, len(order))
+	for _, id := range order {
+		change := *latest[id]
+		if change.Operation == changefeed.OperationUpdated && created[id] {
+			change.Operation = changefeed.OperationCreated
+		}
+		folded = append(folded, &change)
+	}
+	return folded
+}
+
+// Cursors are opaque to clients, they encode the (txid, seq) position of the last change returned
+func encodeCursor(txID uint64, seq int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d", txID, seq)))
+}
+
+func decodeCursor(cursor string) (uint64, int64, error) {
+	if cursor == "" {
+		return 0, 0, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, 0, ErrInvalidCursor
+	}
+
+	var txID uint64
+	var seq int64
+	if _, err := fmt.Sscanf(string(raw), "%d:%d", &txID, &seq); err != nil {
+		return 0, 0, ErrInvalidCursor
+	}
+	return txID, seq, nil
+}
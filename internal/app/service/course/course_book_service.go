@@ -78,7 +78,7 @@ func (s *CourseBookService) Create(ctx context.Context, courseBook *course.Cours
 
 	if err := s.repo.Create(ctx, courseBook); err != nil {
 		tx.Rollback()
-		s.logger.Error("course_book_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_book_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create course book")
 		return err
@@ -94,7 +94,7 @@ func (s *CourseBookService) Create(ctx context.Context, courseBook *course.Cours
 	// Update cache and search
 	if err := s.courseUpdator.UpdateCacheAndSearch(ctx, parentCourse); err != nil {
 		tx.Rollback()
-		s.logger.Error("course_book_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_book_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentCourse.ID,
 		}, "Failed to update cache and search")
@@ -112,7 +112,7 @@ func (s *CourseBookService) Create(ctx context.Context, courseBook *course.Cours
 func (s *CourseBookService) GetByID(ctx context.Context, id uuid.UUID) (*course.CourseBook, error) {
 	courseBook, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		s.logger.Error("course_book_service.get_by_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_book_service.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get course book by ID")
@@ -124,7 +124,7 @@ func (s *CourseBookService) GetByID(ctx context.Context, id uuid.UUID) (*course.
 func (s *CourseBookService) GetByCourseID(ctx context.Context, courseID uuid.UUID) (*course.CourseBook, error) {
 	courseBook, err := s.repo.GetByCourseID(ctx, courseID)
 	if err != nil {
-		s.logger.Error("course_book_service.get_by_course_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_book_service.get_by_course_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    courseID,
 		}, "Failed to get course book by course ID")
@@ -151,7 +151,7 @@ func (s *CourseBookService) Update(ctx context.Context, courseBook *course.Cours
 
 	if err := s.repo.Update(ctx, courseBook); err != nil {
 		tx.Rollback()
-		s.logger.Error("course_book_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_book_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    courseBook.ID,
 		}, "Failed to update course book")
@@ -168,7 +168,7 @@ func (s *CourseBookService) Update(ctx context.Context, courseBook *course.Cours
 	// Update cache and search
 	if err := s.courseUpdator.UpdateCacheAndSearch(ctx, parentCourse); err != nil {
 		tx.Rollback()
-		s.logger.Error("course_book_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_book_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentCourse.ID,
 		}, "Failed to update cache and search")
@@ -206,7 +206,7 @@ func (s *CourseBookService) Delete(ctx context.Context, id uuid.UUID) error {
 
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("course_book_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_book_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete course book")
@@ -223,7 +223,7 @@ func (s *CourseBookService) Delete(ctx context.Context, id uuid.UUID) error {
 	// Update cache and search
 	if err := s.courseUpdator.UpdateCacheAndSearch(ctx, parentCourse); err != nil {
 		tx.Rollback()
-		s.logger.Error("course_book_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_book_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentCourse.ID,
 		}, "Failed to update cache and search")
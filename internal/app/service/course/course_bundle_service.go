@@ -0,0 +1,516 @@
+package course
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	courseDTO "fluencybe/internal/app/dto"
+	"fluencybe/internal/app/model/course"
+	courseRepo "fluencybe/internal/app/repository/course"
+	"fluencybe/pkg/archive"
+	"fluencybe/pkg/logger"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const bundleFormatVersion = 1
+
+var (
+	ErrBundleNotFound      = errors.New("course bundle not found")
+	ErrInvalidBundleRange  = errors.New("from version must be lower than to version")
+	ErrBundleBuildConflict = errors.New("another bundle build for this course is in progress")
+)
+
+// BundleQuestionSource returns the full details of the given questions of one skill
+type BundleQuestionSource func(ctx context.Context, ids []uuid.UUID) (interface{}, error)
+
+// BundleQuestionSources are the skill services lesson questions are resolved with, keyed by skill
+type BundleQuestionSources map[string]BundleQuestionSource
+
+func (s BundleQuestionSources) fetch(ctx context.Context, skill string, ids []uuid.UUID) (interface{}, error) {
+	source, ok := s[skill]
+	if !ok {
+		return nil, fmt.Errorf("unsupported question type: %s", skill)
+	}
+	return source(ctx, ids)
+}
+
+// CourseBundleService builds offline archives of a course. A bundle is a tar.gz with
+// manifest.json, course.json and questions/{skill}.json holding the full question details.
+type CourseBundleService struct {
+	repo          *courseRepo.CourseBundleRepository
+	courseRepo    *courseRepo.CourseRepository
+	courseService *CourseService
+	questions     BundleQuestionSources
+	logger        *logger.PrettyLogger
+}
+
+func NewCourseBundleService(
+	repo *courseRepo.CourseBundleRepository,
+	courseRepository *courseRepo.CourseRepository,
+	courseService *CourseService,
+	questions BundleQuestionSources,
+	logger *logger.PrettyLogger,
+) *CourseBundleService {
+	return &CourseBundleService{
+		repo:          repo,
+		courseRepo:    courseRepository,
+		courseService: courseService,
+		questions:     questions,
+		logger:        logger,
+	}
+}
+
+// skillQuestions holds the raw question details of one skill, keyed by question ID
+type skillQuestions map[uuid.UUID]json.RawMessage
+
+// Build creates the next bundle version of a course. When nothing changed since the latest
+// bundle, the latest bundle is returned and created is false.
+func (s *CourseBundleService) Build(ctx context.Context, courseID uuid.UUID, createdBy *uuid.UUID) (*course.CourseBundle, bool, error) {
+	courseModel, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil {
+		if errors.Is(err, courseRepo.ErrCourseNotFound) {
+			return nil, false, ErrCourseNotFound
+		}
+		return nil, false, err
+	}
+
+	// Build from the database rather than the cache so the bundle reflects committed content
+	detail, err := s.courseService.BuildCourseDetail(ctx, courseModel)
+	if err != nil {
+		return nil, false, err
+	}
+
+	courseJSON, err := json.Marshal(detail)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to encode course: %w", err)
+	}
+
+	manifest := &courseDTO.CourseBundleManifest{
+		FormatVersion:  bundleFormatVersion,
+		CourseID:       courseID,
+		CourseChecksum: checksum(courseJSON),
+		Questions:      []courseDTO.CourseBundleQuestion{},
+	}
+
+	bySkill, err := s.loadQuestions(ctx, detail, manifest)
+	if err != nil {
+		return nil, false, err
+	}
+	manifest.Media = collectMedia(courseJSON, bySkill)
+
+	contentChecksum := contentChecksum(manifest)
+	latest, err := s.repo.GetLatest(ctx, courseID, false)
+	if err != nil && !errors.Is(err, courseRepo.ErrBundleNotFound) {
+		return nil, false, err
+	}
+	if latest != nil && latest.ContentChecksum == contentChecksum {
+		return latest, false, nil
+	}
+
+	manifest.Version = 1
+	if latest != nil {
+		manifest.Version = latest.Version + 1
+	}
+	manifest.CreatedAt = time.Now().UTC()
+
+	files, err := bundleFiles(manifest, courseJSON, bySkill)
+	if err != nil {
+		return nil, false, err
+	}
+	data, err := archive.WriteTarGz(files, manifest.CreatedAt)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to write bundle archive: %w", err)
+	}
+
+	manifestJSON, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, false, err
+	}
+
+	bundle := &course.CourseBundle{
+		ID:              uuid.New(),
+		CourseID:        courseID,
+		Version:         manifest.Version,
+		ContentChecksum: contentChecksum,
+		ArchiveChecksum: checksum(data),
+		SizeBytes:       int64(len(data)),
+		QuestionCount:   len(manifest.Questions),
+		MediaCount:      len(manifest.Media),
+		Manifest:        string(manifestJSON),
+		Archive:         data,
+		CreatedBy:       createdBy,
+	}
+	if err := s.repo.Create(ctx, bundle); err != nil {
+		if errors.Is(err, courseRepo.ErrBundleVersionConflict) {
+			return nil, false, ErrBundleBuildConflict
+		}
+		return nil, false, err
+	}
+
+	s.logger.WithContext(ctx).Info("course_bundle_service.build", map[string]interface{}{
+		"courseID":  courseID,
+		"version":   bundle.Version,
+		"questions": bundle.QuestionCount,
+		"media":     bundle.MediaCount,
+		"sizeBytes": bundle.SizeBytes,
+	}, "Course bundle built")
+	return bundle, true, nil
+}
+
+func (s *CourseBundleService) List(ctx context.Context, courseID uuid.UUID) ([]*course.CourseBundle, error) {
+	return s.repo.ListByCourseID(ctx, courseID)
+}
+
+// Get returns a bundle with its archive, version 0 means the latest one
+func (s *CourseBundleService) Get(ctx context.Context, courseID uuid.UUID, version int) (*course.CourseBundle, error) {
+	var bundle *course.CourseBundle
+	var err error
+	if version == 0 {
+		bundle, err = s.repo.GetLatest(ctx, courseID, true)
+	} else {
+		bundle, err = s.repo.GetByVersion(ctx, courseID, version, true)
+	}
+	if errors.Is(err, courseRepo.ErrBundleNotFound) {
+		return nil, ErrBundleNotFound
+	}
+	return bundle, err
+}
+
+// Diff builds an archive with diff.json and the details of the questions added or updated
+// between two versions, toVersion 0 means the latest one
+func (s *CourseBundleService) Diff(ctx context.Context, courseID uuid.UUID, fromVersion, toVersion int) ([]byte, *courseDTO.CourseBundleDiff, error) {
+	from, err := s.repo.GetByVersion(ctx, courseID, fromVersion, false)
+	if err != nil {
+		if errors.Is(err, courseRepo.ErrBundleNotFound) {
+			return nil, nil, ErrBundleNotFound
+		}
+		return nil, nil, err
+	}
+
+	to, err := s.Get(ctx, courseID, toVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+	if from.Version >= to.Version {
+		return nil, nil, ErrInvalidBundleRange
+	}
+
+	var fromManifest, toManifest courseDTO.CourseBundleManifest
+	if err := json.Unmarshal([]byte(from.Manifest), &fromManifest); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode manifest of version %d: %w", from.Version, err)
+	}
+	if err := json.Unmarshal([]byte(to.Manifest), &toManifest); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode manifest of version %d: %w", to.Version, err)
+	}
+
+	diff := diffManifests(&fromManifest, &toManifest)
+
+	toFiles, err := archive.ReadTarGz(to.Archive)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read bundle archive: %w", err)
+	}
+
+	changed := make(map[string]map[uuid.UUID]bool)
+	for _, question := range append(append([]courseDTO.CourseBundleQuestion{}, diff.Added...), diff.Updated...) {
+		if changed[question.Skill] == nil {
+			changed[question.Skill] = make(map[uuid.UUID]bool)
+		}
+		changed[question.Skill][question.ID] = true
+	}
+
+	diffJSON, err := json.Marshal(diff)
+	if err != nil {
+		return nil, nil, err
+	}
+	files := []archive.File{{Name: "diff.json", Data: diffJSON}}
+	if diff.CourseChanged {
+		files = append(files, archive.File{Name: "course.json", Data: toFiles["course.json"]})
+	}
+
+	for _, skill := range sortedKeys(changed) {
+		var all []json.RawMessage
+		if err := json.Unmarshal(toFiles[questionsFile(skill)], &all); err != nil {
+			return nil, nil, fmt.Errorf("failed to decode %s questions: %w", skill, err)
+		}
+
+		selected := make([]json.RawMessage, 0, len(changed[skill]))
+		for _, raw := range all {
+			if id, _, err := questionIdentity(raw); err == nil && changed[skill][id] {
+				selected = append(selected, raw)
+			}
+		}
+
+		data, err := json.Marshal(selected)
+		if err != nil {
+			return nil, nil, err
+		}
+		files = append(files, archive.File{Name: questionsFile(skill), Data: data})
+	}
+
+	data, err := archive.WriteTarGz(files, to.CreatedAt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to write diff archive: %w", err)
+	}
+	return data, diff, nil
+}
+
+// loadQuestions resolves every lesson question of the course into its skill details
+func (s *CourseBundleService) loadQuestions(ctx context.Context, detail *courseDTO.CourseDetail, manifest *courseDTO.CourseBundleManifest) (map[string]skillQuestions, error) {
+	idsBySkill := make(map[string][]uuid.UUID)
+	seen := make(map[uuid.UUID]bool)
+	for _, lesson := range detail.Lessons {
+		for _, question := range lesson.Questions {
+			if seen[question.QuestionID] {
+				continue
+			}
+			seen[question.QuestionID] = true
+			skill := strings.ToLower(question.QuestionType)
+			idsBySkill[skill] = append(idsBySkill[skill], question.QuestionID)
+		}
+	}
+
+	bySkill := make(map[string]skillQuestions)
+	for _, skill := range sortedKeys(idsBySkill) {
+		ids := idsBySkill[skill]
+		details, err := s.questions.fetch(ctx, skill, ids)
+		if err != nil {
+			s.logger.WithContext(ctx).Error("course_bundle_service.load_questions", map[string]interface{}{
+				"error": err.Error(),
+				"skill": skill,
+			}, "Failed to load lesson questions")
+			return nil, err
+		}
+
+		encoded, err := json.Marshal(details)
+		if err != nil {
+			return nil, err
+		}
+		var raws []json.RawMessage
+		if err := json.Unmarshal(encoded, &raws); err != nil {
+			return nil, err
+		}
+
+		questions := make(skillQuestions, len(raws))
+		for _, raw := range raws {
+			id, version, err := questionIdentity(raw)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read %s question: %w", skill, err)
+			}
+			questions[id] = raw
+			manifest.Questions = append(manifest.Questions, courseDTO.CourseBundleQuestion{
+				ID:       id,
+				Skill:    skill,
+				Version:  version,
+				Checksum: checksum(raw),
+			})
+		}
+
+		for _, id := range ids {
+			if _, ok := questions[id]; !ok {
+				manifest.MissingQuestions = append(manifest.MissingQuestions, courseDTO.CourseBundleQuestionRef{
+					ID:    id,
+					Skill: skill,
+				})
+			}
+		}
+		bySkill[skill] = questions
+	}
+
+	sort.Slice(manifest.Questions, func(i, j int) bool {
+		if manifest.Questions[i].Skill != manifest.Questions[j].Skill {
+			return manifest.Questions[i].Skill < manifest.Questions[j].Skill
+		}
+		return manifest.Questions[i].ID.String() < manifest.Questions[j].ID.String()
+	})
+	return bySkill, nil
+}
+
+func bundleFiles(manifest *courseDTO.CourseBundleManifest, courseJSON []byte, bySkill map[string]skillQuestions) ([]archive.File, error) {
+	manifestJSON, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	files := []archive.File{
+		{Name: "manifest.json", Data: manifestJSON},
+		{Name: "course.json", Data: courseJSON},
+	}
+
+	for _, skill := range sortedKeys(bySkill) {
+		list := make([]json.RawMessage, 0, len(bySkill[skill]))
+		for _, question := range manifest.Questions {
+			if question.Skill == skill {
+				list = append(list, bySkill[skill][question.ID])
+			}
+		}
+
+		data, err := json.Marshal(list)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, archive.File{Name: questionsFile(skill), Data: data})
+	}
+	return files, nil
+}
+
+func diffManifests(from, to *courseDTO.CourseBundleManifest) *courseDTO.CourseBundleDiff {
+	diff := &courseDTO.CourseBundleDiff{
+		CourseID:      to.CourseID,
+		FromVersion:   from.Version,
+		ToVersion:     to.Version,
+		CourseChanged: from.CourseChecksum != to.CourseChecksum,
+		Added:         []courseDTO.CourseBundleQuestion{},
+		Updated:       []courseDTO.CourseBundleQuestion{},
+		Removed:       []courseDTO.CourseBundleQuestionRef{},
+		MediaAdded:    []courseDTO.CourseBundleMedia{},
+		MediaRemoved:  []courseDTO.CourseBundleMedia{},
+	}
+
+	previous := make(map[uuid.UUID]courseDTO.CourseBundleQuestion, len(from.Questions))
+	for _, question := range from.Questions {
+		previous[question.ID] = question
+	}
+
+	for _, question := range to.Questions {
+		old, ok := previous[question.ID]
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, question)
+		case old.Checksum != question.Checksum:
+			diff.Updated = append(diff.Updated, question)
+		}
+		delete(previous, question.ID)
+	}
+	for _, question := range from.Questions {
+		if _, ok := previous[question.ID]; ok {
+			diff.Removed = append(diff.Removed, courseDTO.CourseBundleQuestionRef{ID: question.ID, Skill: question.Skill})
+		}
+	}
+
+	previousMedia := make(map[string]bool, len(from.Media))
+	for _, media := range from.Media {
+		previousMedia[media.URL] = true
+	}
+	currentMedia := make(map[string]bool, len(to.Media))
+	for _, media := range to.Media {
+		currentMedia[media.URL] = true
+		if !previousMedia[media.URL] {
+			diff.MediaAdded = append(diff.MediaAdded, media)
+		}
+	}
+	for _, media := range from.Media {
+		if !currentMedia[media.URL] {
+			diff.MediaRemoved = append(diff.MediaRemoved, media)
+		}
+	}
+	return diff
+}
+
+// collectMedia lists every URL found under *_url or *_urls keys of the course and its questions
+func collectMedia(courseJSON []byte, bySkill map[string]skillQuestions) []courseDTO.CourseBundleMedia {
+	found := make(map[string]string)
+	walkMedia(courseJSON, found)
+	for _, questions := range bySkill {
+		for _, raw := range questions {
+			walkMedia(raw, found)
+		}
+	}
+
+	media := make([]courseDTO.CourseBundleMedia, 0, len(found))
+	for url, mediaType := range found {
+		media = append(media, courseDTO.CourseBundleMedia{URL: url, Type: mediaType})
+	}
+	sort.Slice(media, func(i, j int) bool { return media[i].URL < media[j].URL })
+	return media
+}
+
+func walkMedia(raw []byte, found map[string]string) {
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return
+	}
+
+	var walk func(key string, value interface{})
+	walk = func(key string, value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			for childKey, child := range v {
+				walk(strings.ToLower(childKey), child)
+			}
+		case []interface{}:
+			for _, child := range v {
+				walk(key, child)
+			}
+		case string:
+			if v != "" && (strings.HasSuffix(key, "_url") || strings.HasSuffix(key, "_urls")) {
+				found[v] = mediaType(key)
+			}
+		}
+	}
+	walk("", value)
+}
+
+func mediaType(key string) string {
+	switch {
+	case strings.Contains(key, "audio"):
+		return "audio"
+	case strings.Contains(key, "image"):
+		return "image"
+	case strings.Contains(key, "video"):
+		return "video"
+	default:
+		return "other"
+	}
+}
+
+func questionIdentity(raw json.RawMessage) (uuid.UUID, int, error) {
+	var identity struct {
+		ID      uuid.UUID `json:"id"`
+		Version int       `json:"version"`
+	}
+	if err := json.Unmarshal(raw, &identity); err != nil {
+		return uuid.Nil, 0, err
+	}
+	if identity.ID == uuid.Nil {
+		return uuid.Nil, 0, errors.New("question has no id")
+	}
+	return identity.ID, identity.Version, nil
+}
+
+// contentChecksum ignores the version and timestamps so rebuilding unchanged content is detected
+func contentChecksum(manifest *courseDTO.CourseBundleManifest) string {
+	hash := sha256.New()
+	hash.Write([]byte(manifest.CourseChecksum))
+	for _, question := range manifest.Questions {
+		hash.Write([]byte(question.Checksum))
+	}
+	for _, missing := range manifest.MissingQuestions {
+		hash.Write([]byte(missing.ID.String()))
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func questionsFile(skill string) string {
+	return "questions/" + skill + ".json"
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -69,7 +69,7 @@ func (s *CourseOtherService) Create(ctx context.Context, courseOther *course.Cou
 
 	if err := s.repo.Create(ctx, courseOther); err != nil {
 		tx.Rollback()
-		s.logger.Error("course_other_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_other_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create course other")
 		return err
@@ -85,7 +85,7 @@ func (s *CourseOtherService) Create(ctx context.Context, courseOther *course.Cou
 	// Update cache and search
 	if err := s.courseUpdator.UpdateCacheAndSearch(ctx, parentCourse); err != nil {
 		tx.Rollback()
-		s.logger.Error("course_other_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_other_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentCourse.ID,
 		}, "Failed to update cache and search")
@@ -103,7 +103,7 @@ func (s *CourseOtherService) Create(ctx context.Context, courseOther *course.Cou
 func (s *CourseOtherService) GetByID(ctx context.Context, id uuid.UUID) (*course.CourseOther, error) {
 	courseOther, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		s.logger.Error("course_other_service.get_by_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_other_service.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get course other by ID")
@@ -115,7 +115,7 @@ func (s *CourseOtherService) GetByID(ctx context.Context, id uuid.UUID) (*course
 func (s *CourseOtherService) GetByCourseID(ctx context.Context, courseID uuid.UUID) (*course.CourseOther, error) {
 	courseOther, err := s.repo.GetByCourseID(ctx, courseID)
 	if err != nil {
-		s.logger.Error("course_other_service.get_by_course_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_other_service.get_by_course_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    courseID,
 		}, "Failed to get course other by course ID")
@@ -144,7 +144,7 @@ func (s *CourseOtherService) Delete(ctx context.Context, id uuid.UUID) error {
 
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("course_other_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_other_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete course other")
@@ -161,7 +161,7 @@ func (s *CourseOtherService) Delete(ctx context.Context, id uuid.UUID) error {
 	// Update cache and search
 	if err := s.courseUpdator.UpdateCacheAndSearch(ctx, parentCourse); err != nil {
 		tx.Rollback()
-		s.logger.Error("course_other_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_other_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentCourse.ID,
 		}, "Failed to update cache and search")
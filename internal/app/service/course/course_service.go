@@ -69,7 +69,7 @@ func (s *CourseService) Create(ctx context.Context, course *course.Course) error
 	}
 
 	if err := s.repo.Create(ctx, course); err != nil {
-		s.logger.Error("course_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_service.create", map[string]interface{}{
 			"error": err.Error(),
 			"type":  course.Type,
 		}, "Failed to create course")
@@ -95,7 +95,7 @@ func (s *CourseService) Create(ctx context.Context, course *course.Course) error
 
 	// Index to OpenSearch
 	if err := s.search.UpsertCourse(ctx, &courseDetail); err != nil {
-		s.logger.Error("course_service.create.opensearch", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_service.create.opensearch", map[string]interface{}{
 			"error": err.Error(),
 			"id":    course.ID,
 		}, "Failed to index course in OpenSearch")
@@ -105,38 +105,35 @@ func (s *CourseService) Create(ctx context.Context, course *course.Course) error
 }
 
 func (s *CourseService) GetByID(ctx context.Context, id uuid.UUID) (*courseDTO.CourseDetail, error) {
-	// Try to get from Redis first
-	cachedCourse, err := s.redis.GetCacheCourseDetail(ctx, id)
-	if err == nil && cachedCourse != nil {
-		return cachedCourse, nil
-	}
+	response, err := s.redis.GetCourseDetail(ctx, id, func(ctx context.Context) (*courseDTO.CourseDetail, bool, error) {
+		course, err := s.repo.GetByID(ctx, id)
+		if errors.Is(err, courseRepo.ErrCourseNotFound) {
+			return nil, false, nil
+		}
+		if err != nil {
+			s.logger.WithContext(ctx).Error("course_service.get", map[string]interface{}{
+				"error": err.Error(),
+				"id":    id,
+			}, "Failed to get course")
+			return nil, false, err
+		}
 
-	// If not in cache or error getting from cache, get from database
-	course, err := s.repo.GetByID(ctx, id)
-	if err != nil {
-		s.logger.Error("course_service.get", map[string]interface{}{
-			"error": err.Error(),
-			"id":    id,
-		}, "Failed to get course")
-		return nil, err
-	}
+		response, err := s.BuildCourseDetail(ctx, course)
+		if err != nil {
+			s.logger.WithContext(ctx).Error("course_service.get.detail", map[string]interface{}{
+				"error": err.Error(),
+				"id":    id,
+			}, "Failed to get course details")
+			return nil, false, err
+		}
 
-	response, err := s.BuildCourseDetail(ctx, course)
+		return response, false, nil
+	})
 	if err != nil {
-		s.logger.Error("course_service.get.detail", map[string]interface{}{
-			"error": err.Error(),
-			"id":    id,
-		}, "Failed to get course details")
 		return nil, err
 	}
-
-	// Cache the course for future requests
-	if err := s.redis.SetCacheCourseDetail(ctx, response); err != nil {
-		s.logger.Error("course_service.get.cache", map[string]interface{}{
-			"error": err.Error(),
-			"id":    id,
-		}, "Failed to cache course detail")
-		// Continue even if caching fails
+	if response == nil {
+		return nil, ErrCourseNotFound
 	}
 
 	return response, nil
@@ -175,7 +172,7 @@ func (s *CourseService) Update(ctx context.Context, id uuid.UUID, update courseD
 	// Update in database
 	if err := s.repo.Update(ctx, baseCourse); err != nil {
 		tx.Rollback()
-		s.logger.Error("course_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    baseCourse.ID,
 		}, "Failed to update course in database")
@@ -184,7 +181,7 @@ func (s *CourseService) Update(ctx context.Context, id uuid.UUID, update courseD
 
 	// First invalidate existing cache
 	if err := s.redis.RemoveCourseCacheEntries(ctx, baseCourse.ID); err != nil {
-		s.logger.Error("course_service.update.remove_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_service.update.remove_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    baseCourse.ID,
 		}, "Failed to remove old cache entries")
@@ -259,7 +256,7 @@ func (s *CourseService) Update(ctx context.Context, id uuid.UUID, update courseD
 
 	// Update cache with new data
 	if err := s.redis.SetCacheCourseDetail(ctx, courseDetail); err != nil {
-		s.logger.Error("course_service.update.cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_service.update.cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    baseCourse.ID,
 		}, "Failed to update cache")
@@ -267,7 +264,7 @@ func (s *CourseService) Update(ctx context.Context, id uuid.UUID, update courseD
 
 	// Update search index
 	if err := s.search.UpsertCourse(ctx, courseDetail); err != nil {
-		s.logger.Error("course_service.update.search", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_service.update.search", map[string]interface{}{
 			"error": err.Error(),
 			"id":    baseCourse.ID,
 		}, "Failed to update search index")
@@ -295,7 +292,7 @@ func (s *CourseService) Delete(ctx context.Context, id uuid.UUID) error {
 
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("course_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete course")
@@ -305,7 +302,7 @@ func (s *CourseService) Delete(ctx context.Context, id uuid.UUID) error {
 	// Invalidate cache
 	if err := s.redis.RemoveCourseCacheEntries(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("course_service.delete.cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_service.delete.cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to remove cache entries")
@@ -315,7 +312,7 @@ func (s *CourseService) Delete(ctx context.Context, id uuid.UUID) error {
 	// Delete from OpenSearch
 	if err := s.search.DeleteCourseFromIndex(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("course_service.delete.opensearch", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_service.delete.opensearch", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete from OpenSearch")
@@ -332,13 +329,13 @@ func (s *CourseService) Delete(ctx context.Context, id uuid.UUID) error {
 
 func (s *CourseService) SearchWithFilter(ctx context.Context, filter courseDTO.CourseSearchRequest) (*courseDTO.ListCoursePagination, error) {
 	// Add debug logging
-	s.logger.Debug("course_service.search.start", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("course_service.search.start", map[string]interface{}{
 		"filter": filter,
 	}, "Starting course search")
 
 	result, err := s.search.SearchCourses(ctx, filter)
 	if err != nil {
-		s.logger.Error("course_service.search", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_service.search", map[string]interface{}{
 			"error":  err.Error(),
 			"filter": filter,
 		}, "Failed to search courses")
@@ -346,7 +343,7 @@ func (s *CourseService) SearchWithFilter(ctx context.Context, filter courseDTO.C
 	}
 
 	// Add debug logging for results
-	s.logger.Debug("course_service.search.complete", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("course_service.search.complete", map[string]interface{}{
 		"total_results": result.Total,
 		"page":          result.Page,
 		"page_size":     result.PageSize,
@@ -377,7 +374,7 @@ func (s *CourseService) BuildCourseDetail(ctx context.Context, course *course.Co
 	case "OTHER":
 		err = s.loadCourseOtherData(ctx, course.ID, response)
 	default:
-		s.logger.Error("buildCourseDetail.unknown_type", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("buildCourseDetail.unknown_type", map[string]interface{}{
 			"type": course.Type,
 		}, "Unknown course type")
 		return nil, fmt.Errorf("unknown course type: %s", course.Type)
@@ -489,7 +486,7 @@ func (s *CourseService) DeleteAllCourseData(ctx context.Context) error {
 	// Delete all records from lesson_questions
 	if err := tx.Exec("DELETE FROM lesson_questions").Error; err != nil {
 		tx.Rollback()
-		s.logger.Error("course_service.delete_all.lesson_questions", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_service.delete_all.lesson_questions", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to delete lesson questions")
 		return err
@@ -498,7 +495,7 @@ func (s *CourseService) DeleteAllCourseData(ctx context.Context) error {
 	// Delete all records from lessons
 	if err := tx.Exec("DELETE FROM lessons").Error; err != nil {
 		tx.Rollback()
-		s.logger.Error("course_service.delete_all.lessons", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_service.delete_all.lessons", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to delete lessons")
 		return err
@@ -507,7 +504,7 @@ func (s *CourseService) DeleteAllCourseData(ctx context.Context) error {
 	// Delete all records from course_books
 	if err := tx.Exec("DELETE FROM course_books").Error; err != nil {
 		tx.Rollback()
-		s.logger.Error("course_service.delete_all.course_books", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_service.delete_all.course_books", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to delete course books")
 		return err
@@ -516,7 +513,7 @@ func (s *CourseService) DeleteAllCourseData(ctx context.Context) error {
 	// Delete all records from course_others
 	if err := tx.Exec("DELETE FROM course_others").Error; err != nil {
 		tx.Rollback()
-		s.logger.Error("course_service.delete_all.course_others", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_service.delete_all.course_others", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to delete course others")
 		return err
@@ -525,7 +522,7 @@ func (s *CourseService) DeleteAllCourseData(ctx context.Context) error {
 	// Delete all records from courses
 	if err := tx.Exec("DELETE FROM courses").Error; err != nil {
 		tx.Rollback()
-		s.logger.Error("course_service.delete_all.courses", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_service.delete_all.courses", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to delete courses")
 		return err
@@ -534,7 +531,7 @@ func (s *CourseService) DeleteAllCourseData(ctx context.Context) error {
 	// Delete all Redis cache with pattern course:*
 	if err := s.redis.GetCache().DeletePattern(ctx, "course:*"); err != nil {
 		tx.Rollback()
-		s.logger.Error("course_service.delete_all.cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_service.delete_all.cache", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to delete Redis cache")
 		return err
@@ -543,7 +540,7 @@ func (s *CourseService) DeleteAllCourseData(ctx context.Context) error {
 	// Delete OpenSearch index
 	if err := s.search.RemoveCoursesIndex(ctx); err != nil {
 		tx.Rollback()
-		s.logger.Error("course_service.delete_all.search", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("course_service.delete_all.search", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to delete OpenSearch index")
 		return err
@@ -78,7 +78,7 @@ func (s *LessonQuestionService) Create(ctx context.Context, question *course.Les
 
 	if err := s.repo.Create(ctx, question); err != nil {
 		tx.Rollback()
-		s.logger.Error("lesson_question_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("lesson_question_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create lesson question")
 		return err
@@ -101,7 +101,7 @@ func (s *LessonQuestionService) Create(ctx context.Context, question *course.Les
 	// Update cache and search
 	if err := s.courseUpdator.UpdateCacheAndSearch(ctx, course); err != nil {
 		tx.Rollback()
-		s.logger.Error("lesson_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("lesson_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    course.ID,
 		}, "Failed to update cache and search")
@@ -119,7 +119,7 @@ func (s *LessonQuestionService) Create(ctx context.Context, question *course.Les
 func (s *LessonQuestionService) GetByID(ctx context.Context, id uuid.UUID) (*course.LessonQuestion, error) {
 	question, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		s.logger.Error("lesson_question_service.get_by_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("lesson_question_service.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get lesson question by ID")
@@ -131,7 +131,7 @@ func (s *LessonQuestionService) GetByID(ctx context.Context, id uuid.UUID) (*cou
 func (s *LessonQuestionService) GetByLessonID(ctx context.Context, lessonID uuid.UUID) ([]*course.LessonQuestion, error) {
 	questions, err := s.repo.GetByLessonID(ctx, lessonID)
 	if err != nil {
-		s.logger.Error("lesson_question_service.get_by_lesson_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("lesson_question_service.get_by_lesson_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    lessonID,
 		}, "Failed to get lesson questions")
@@ -158,7 +158,7 @@ func (s *LessonQuestionService) Update(ctx context.Context, question *course.Les
 
 	if err := s.repo.Update(ctx, question); err != nil {
 		tx.Rollback()
-		s.logger.Error("lesson_question_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("lesson_question_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to update lesson question")
@@ -182,7 +182,7 @@ func (s *LessonQuestionService) Update(ctx context.Context, question *course.Les
 	// Update cache and search
 	if err := s.courseUpdator.UpdateCacheAndSearch(ctx, course); err != nil {
 		tx.Rollback()
-		s.logger.Error("lesson_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("lesson_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    course.ID,
 		}, "Failed to update cache and search")
@@ -75,7 +75,7 @@ func (s *LessonService) Create(ctx context.Context, lesson *course.Lesson) error
 
 	if err := s.repo.Create(ctx, lesson); err != nil {
 		tx.Rollback()
-		s.logger.Error("lesson_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("lesson_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create lesson")
 		return err
@@ -91,7 +91,7 @@ func (s *LessonService) Create(ctx context.Context, lesson *course.Lesson) error
 	// Update cache and search
 	if err := s.courseUpdator.UpdateCacheAndSearch(ctx, parentCourse); err != nil {
 		tx.Rollback()
-		s.logger.Error("lesson_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("lesson_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentCourse.ID,
 		}, "Failed to update cache and search")
@@ -109,7 +109,7 @@ func (s *LessonService) Create(ctx context.Context, lesson *course.Lesson) error
 func (s *LessonService) GetByID(ctx context.Context, id uuid.UUID) (*course.Lesson, error) {
 	lesson, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		s.logger.Error("lesson_service.get_by_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("lesson_service.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get lesson by ID")
@@ -121,7 +121,7 @@ func (s *LessonService) GetByID(ctx context.Context, id uuid.UUID) (*course.Less
 func (s *LessonService) GetByCourseID(ctx context.Context, courseID uuid.UUID) ([]*course.Lesson, error) {
 	lessons, err := s.repo.GetByCourseID(ctx, courseID)
 	if err != nil {
-		s.logger.Error("lesson_service.get_by_course_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("lesson_service.get_by_course_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    courseID,
 		}, "Failed to get lessons")
@@ -148,7 +148,7 @@ func (s *LessonService) Update(ctx context.Context, lesson *course.Lesson) error
 
 	if err := s.repo.Update(ctx, lesson); err != nil {
 		tx.Rollback()
-		s.logger.Error("lesson_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("lesson_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    lesson.ID,
 		}, "Failed to update lesson")
@@ -165,7 +165,7 @@ func (s *LessonService) Update(ctx context.Context, lesson *course.Lesson) error
 	// Update cache and search
 	if err := s.courseUpdator.UpdateCacheAndSearch(ctx, parentCourse); err != nil {
 		tx.Rollback()
-		s.logger.Error("lesson_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("lesson_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentCourse.ID,
 		}, "Failed to update cache and search")
@@ -80,7 +80,7 @@ func (s *GrammarChoiceOneOptionService) CreateOption(ctx context.Context, option
 		existingCorrectOption, err := s.repo.GetCorrectOption(ctx, option.GrammarChoiceOneQuestionID)
 		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 			tx.Rollback()
-			s.logger.Error("grammar_choice_one_option_service.create", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("grammar_choice_one_option_service.create", map[string]interface{}{
 				"error": err.Error(),
 			}, "Failed to check existing correct option")
 			return err
@@ -91,7 +91,7 @@ func (s *GrammarChoiceOneOptionService) CreateOption(ctx context.Context, option
 			existingCorrectOption.IsCorrect = false
 			if err := s.repo.Update(ctx, existingCorrectOption); err != nil {
 				tx.Rollback()
-				s.logger.Error("grammar_choice_one_option_service.create", map[string]interface{}{
+				s.logger.WithContext(ctx).Error("grammar_choice_one_option_service.create", map[string]interface{}{
 					"error": err.Error(),
 				}, "Failed to update existing correct option")
 				return err
@@ -101,7 +101,7 @@ func (s *GrammarChoiceOneOptionService) CreateOption(ctx context.Context, option
 
 	if err := s.repo.Create(ctx, option); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_choice_one_option_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_choice_one_option_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create option")
 		return err
@@ -124,7 +124,7 @@ func (s *GrammarChoiceOneOptionService) CreateOption(ctx context.Context, option
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, grammarQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_choice_one_option_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_choice_one_option_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    grammarQuestion.ID,
 		}, "Failed to update cache and search")
@@ -145,7 +145,7 @@ func (s *GrammarChoiceOneOptionService) GetOption(ctx context.Context, id uuid.U
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, sql.ErrNoRows
 		}
-		s.logger.Error("grammar_choice_one_option_service.get", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_choice_one_option_service.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get option")
@@ -176,7 +176,7 @@ func (s *GrammarChoiceOneOptionService) UpdateOption(ctx context.Context, option
 		existingCorrectOption, err := s.repo.GetCorrectOption(ctx, option.GrammarChoiceOneQuestionID)
 		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 			tx.Rollback()
-			s.logger.Error("grammar_choice_one_option_service.update", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("grammar_choice_one_option_service.update", map[string]interface{}{
 				"error": err.Error(),
 			}, "Failed to check existing correct option")
 			return err
@@ -187,7 +187,7 @@ func (s *GrammarChoiceOneOptionService) UpdateOption(ctx context.Context, option
 			existingCorrectOption.IsCorrect = false
 			if err := s.repo.Update(ctx, existingCorrectOption); err != nil {
 				tx.Rollback()
-				s.logger.Error("grammar_choice_one_option_service.update", map[string]interface{}{
+				s.logger.WithContext(ctx).Error("grammar_choice_one_option_service.update", map[string]interface{}{
 					"error": err.Error(),
 				}, "Failed to update existing correct option")
 				return err
@@ -197,7 +197,7 @@ func (s *GrammarChoiceOneOptionService) UpdateOption(ctx context.Context, option
 
 	if err := s.repo.Update(ctx, option); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_choice_one_option_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_choice_one_option_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    option.ID,
 		}, "Failed to update option")
@@ -221,7 +221,7 @@ func (s *GrammarChoiceOneOptionService) UpdateOption(ctx context.Context, option
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, grammarQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_choice_one_option_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_choice_one_option_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    grammarQuestion.ID,
 		}, "Failed to update cache and search")
@@ -259,7 +259,7 @@ func (s *GrammarChoiceOneOptionService) DeleteOption(ctx context.Context, id uui
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return sql.ErrNoRows
 		}
-		s.logger.Error("grammar_choice_one_option_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_choice_one_option_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete option")
@@ -283,7 +283,7 @@ func (s *GrammarChoiceOneOptionService) DeleteOption(ctx context.Context, id uui
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, grammarQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_choice_one_option_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_choice_one_option_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    grammarQuestion.ID,
 		}, "Failed to update cache and search")
@@ -302,7 +302,7 @@ func (s *GrammarChoiceOneOptionService) GetOptionsByQuestionID(ctx context.Conte
 	// Get directly from DB
 	options, err := s.repo.GetByQuestionID(ctx, questionID)
 	if err != nil {
-		s.logger.Error("grammar_choice_one_option_service.get_by_question_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_choice_one_option_service.get_by_question_id", map[string]interface{}{
 			"error":       err.Error(),
 			"question_id": questionID,
 		}, "Failed to get options by question ID")
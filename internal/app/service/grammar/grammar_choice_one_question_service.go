@@ -73,7 +73,7 @@ func (s *GrammarChoiceOneQuestionService) CreateQuestion(ctx context.Context, qu
 
 	if err := s.repo.Create(ctx, question); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_choice_one_question_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_choice_one_question_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create question")
 		return err
@@ -83,7 +83,7 @@ func (s *GrammarChoiceOneQuestionService) CreateQuestion(ctx context.Context, qu
 	parentQuestion, err := s.grammarQuestionRepo.GetGrammarQuestionByID(ctx, question.GrammarQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_choice_one_question_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_choice_one_question_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.GrammarQuestionID,
 		}, "Failed to get parent question")
@@ -93,7 +93,7 @@ func (s *GrammarChoiceOneQuestionService) CreateQuestion(ctx context.Context, qu
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_choice_one_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_choice_one_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -114,7 +114,7 @@ func (s *GrammarChoiceOneQuestionService) GetQuestion(ctx context.Context, id uu
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, sql.ErrNoRows
 		}
-		s.logger.Error("grammar_choice_one_question_service.get", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_choice_one_question_service.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get question")
@@ -141,7 +141,7 @@ func (s *GrammarChoiceOneQuestionService) UpdateQuestion(ctx context.Context, qu
 
 	if err := s.repo.Update(ctx, question); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_choice_one_question_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_choice_one_question_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to update question")
@@ -152,7 +152,7 @@ func (s *GrammarChoiceOneQuestionService) UpdateQuestion(ctx context.Context, qu
 	parentQuestion, err := s.grammarQuestionRepo.GetGrammarQuestionByID(ctx, question.GrammarQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_choice_one_question_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_choice_one_question_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.GrammarQuestionID,
 		}, "Failed to get parent question")
@@ -162,7 +162,7 @@ func (s *GrammarChoiceOneQuestionService) UpdateQuestion(ctx context.Context, qu
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_choice_one_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_choice_one_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -197,7 +197,7 @@ func (s *GrammarChoiceOneQuestionService) DeleteQuestion(ctx context.Context, id
 
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_choice_one_question_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_choice_one_question_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete question")
@@ -208,7 +208,7 @@ func (s *GrammarChoiceOneQuestionService) DeleteQuestion(ctx context.Context, id
 	parentQuestion, err := s.grammarQuestionRepo.GetGrammarQuestionByID(ctx, question.GrammarQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_choice_one_question_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_choice_one_question_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.GrammarQuestionID,
 		}, "Failed to get parent question")
@@ -218,7 +218,7 @@ func (s *GrammarChoiceOneQuestionService) DeleteQuestion(ctx context.Context, id
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_choice_one_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_choice_one_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -236,7 +236,7 @@ func (s *GrammarChoiceOneQuestionService) DeleteQuestion(ctx context.Context, id
 func (s *GrammarChoiceOneQuestionService) GetQuestionsByGrammarQuestionID(ctx context.Context, grammarQuestionID uuid.UUID) ([]*grammar.GrammarChoiceOneQuestion, error) {
 	questions, err := s.repo.GetByGrammarQuestionID(ctx, grammarQuestionID)
 	if err != nil {
-		s.logger.Error("grammar_choice_one_question_service.get_by_grammar_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_choice_one_question_service.get_by_grammar_id", map[string]interface{}{
 			"error":             err.Error(),
 			"grammarQuestionID": grammarQuestionID,
 		}, "Failed to get questions by grammar question ID")
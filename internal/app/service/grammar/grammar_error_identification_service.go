@@ -81,7 +81,7 @@ func (s *GrammarErrorIdentificationService) Create(ctx context.Context, identifi
 
 	if err := s.repo.Create(ctx, identification); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_error_identification_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_error_identification_service.create", map[string]interface{}{
 			"error": err.Error(),
 			"id":    identification.ID,
 		}, "Failed to create error identification")
@@ -92,7 +92,7 @@ func (s *GrammarErrorIdentificationService) Create(ctx context.Context, identifi
 	parentQuestion, err := s.grammarQuestionRepo.GetGrammarQuestionByID(ctx, identification.GrammarQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_error_identification_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_error_identification_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    identification.GrammarQuestionID,
 		}, "Failed to get parent question")
@@ -102,7 +102,7 @@ func (s *GrammarErrorIdentificationService) Create(ctx context.Context, identifi
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_error_identification_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_error_identification_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -120,7 +120,7 @@ func (s *GrammarErrorIdentificationService) Create(ctx context.Context, identifi
 func (s *GrammarErrorIdentificationService) GetByID(ctx context.Context, id uuid.UUID) (*grammar.GrammarErrorIdentification, error) {
 	identification, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		s.logger.Error("grammar_error_identification_service.get_by_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_error_identification_service.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get error identification")
@@ -147,7 +147,7 @@ func (s *GrammarErrorIdentificationService) Update(ctx context.Context, identifi
 
 	if err := s.repo.Update(ctx, identification); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_error_identification_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_error_identification_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    identification.ID,
 		}, "Failed to update error identification")
@@ -158,7 +158,7 @@ func (s *GrammarErrorIdentificationService) Update(ctx context.Context, identifi
 	parentQuestion, err := s.grammarQuestionRepo.GetGrammarQuestionByID(ctx, identification.GrammarQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_error_identification_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_error_identification_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    identification.GrammarQuestionID,
 		}, "Failed to get parent question")
@@ -168,7 +168,7 @@ func (s *GrammarErrorIdentificationService) Update(ctx context.Context, identifi
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_error_identification_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_error_identification_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -203,7 +203,7 @@ func (s *GrammarErrorIdentificationService) Delete(ctx context.Context, id uuid.
 
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_error_identification_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_error_identification_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete error identification")
@@ -214,7 +214,7 @@ func (s *GrammarErrorIdentificationService) Delete(ctx context.Context, id uuid.
 	parentQuestion, err := s.grammarQuestionRepo.GetGrammarQuestionByID(ctx, identification.GrammarQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_error_identification_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_error_identification_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    identification.GrammarQuestionID,
 		}, "Failed to get parent question")
@@ -224,7 +224,7 @@ func (s *GrammarErrorIdentificationService) Delete(ctx context.Context, id uuid.
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_error_identification_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_error_identification_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -242,7 +242,7 @@ func (s *GrammarErrorIdentificationService) Delete(ctx context.Context, id uuid.
 func (s *GrammarErrorIdentificationService) GetByGrammarQuestionID(ctx context.Context, grammarQuestionID uuid.UUID) ([]*grammar.GrammarErrorIdentification, error) {
 	identifications, err := s.repo.GetByGrammarQuestionID(ctx, grammarQuestionID)
 	if err != nil {
-		s.logger.Error("grammar_error_identification_service.get_by_grammar_question_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_error_identification_service.get_by_grammar_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    grammarQuestionID,
 		}, "Failed to get error identifications")
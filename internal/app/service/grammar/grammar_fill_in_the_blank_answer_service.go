@@ -87,7 +87,7 @@ func (s *GrammarFillInTheBlankAnswerService) CreateAnswer(ctx context.Context, a
 	// Create answer in database
 	if err := s.repo.Create(ctx, answer); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_fill_in_the_blank_answer_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_answer_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create answer")
 		return err
@@ -97,7 +97,7 @@ func (s *GrammarFillInTheBlankAnswerService) CreateAnswer(ctx context.Context, a
 	parentQuestion, err := s.grammarQuestionRepo.GetGrammarQuestionByID(ctx, fillInBlankQuestion.GrammarQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_fill_in_the_blank_answer_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_answer_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    fillInBlankQuestion.GrammarQuestionID,
 		}, "Failed to get parent grammar question")
@@ -107,7 +107,7 @@ func (s *GrammarFillInTheBlankAnswerService) CreateAnswer(ctx context.Context, a
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_fill_in_the_blank_answer_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_answer_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -128,7 +128,7 @@ func (s *GrammarFillInTheBlankAnswerService) GetAnswer(ctx context.Context, id u
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, sql.ErrNoRows
 		}
-		s.logger.Error("grammar_fill_in_the_blank_answer_service.get", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_answer_service.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get answer")
@@ -162,7 +162,7 @@ func (s *GrammarFillInTheBlankAnswerService) UpdateAnswer(ctx context.Context, a
 	// Update answer in database
 	if err := s.repo.Update(ctx, answer); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_fill_in_the_blank_answer_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_answer_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    answer.ID,
 		}, "Failed to update answer")
@@ -173,7 +173,7 @@ func (s *GrammarFillInTheBlankAnswerService) UpdateAnswer(ctx context.Context, a
 	parentQuestion, err := s.grammarQuestionRepo.GetGrammarQuestionByID(ctx, fillInBlankQuestion.GrammarQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_fill_in_the_blank_answer_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_answer_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    fillInBlankQuestion.GrammarQuestionID,
 		}, "Failed to get parent grammar question")
@@ -183,7 +183,7 @@ func (s *GrammarFillInTheBlankAnswerService) UpdateAnswer(ctx context.Context, a
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_fill_in_the_blank_answer_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_answer_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -225,7 +225,7 @@ func (s *GrammarFillInTheBlankAnswerService) DeleteAnswer(ctx context.Context, i
 	// Delete from database
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_fill_in_the_blank_answer_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_answer_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete answer")
@@ -236,7 +236,7 @@ func (s *GrammarFillInTheBlankAnswerService) DeleteAnswer(ctx context.Context, i
 	parentQuestion, err := s.grammarQuestionRepo.GetGrammarQuestionByID(ctx, fillInBlankQuestion.GrammarQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_fill_in_the_blank_answer_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_answer_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    fillInBlankQuestion.GrammarQuestionID,
 		}, "Failed to get parent grammar question")
@@ -246,7 +246,7 @@ func (s *GrammarFillInTheBlankAnswerService) DeleteAnswer(ctx context.Context, i
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_fill_in_the_blank_answer_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_answer_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -264,7 +264,7 @@ func (s *GrammarFillInTheBlankAnswerService) DeleteAnswer(ctx context.Context, i
 func (s *GrammarFillInTheBlankAnswerService) GetAnswersByGrammarFillInTheBlankQuestionID(ctx context.Context, questionID uuid.UUID) ([]*grammar.GrammarFillInTheBlankAnswer, error) {
 	answers, err := s.repo.GetByGrammarFillInTheBlankQuestionID(ctx, questionID)
 	if err != nil {
-		s.logger.Error("grammar_fill_in_the_blank_answer_service.get_by_question", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_answer_service.get_by_question", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get answers")
@@ -86,7 +86,7 @@ func (s *GrammarFillInTheBlankQuestionService) CreateQuestion(ctx context.Contex
 		if errors.Is(err, ErrDuplicateQuestion) {
 			return ErrDuplicateQuestion
 		}
-		s.logger.Error("grammar_fill_in_the_blank_question_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_question_service.create", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to create question")
@@ -97,7 +97,7 @@ func (s *GrammarFillInTheBlankQuestionService) CreateQuestion(ctx context.Contex
 	parentQuestion, err := s.grammarQuestionRepo.GetGrammarQuestionByID(ctx, question.GrammarQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_fill_in_the_blank_question_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_question_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.GrammarQuestionID,
 		}, "Failed to get parent question")
@@ -107,7 +107,7 @@ func (s *GrammarFillInTheBlankQuestionService) CreateQuestion(ctx context.Contex
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_fill_in_the_blank_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -127,7 +127,7 @@ func (s *GrammarFillInTheBlankQuestionService) GetQuestion(ctx context.Context,
 	// Get directly from database without caching
 	question, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		s.logger.Error("grammar_fill_in_the_blank_question_service.get", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_question_service.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get question")
@@ -156,7 +156,7 @@ func (s *GrammarFillInTheBlankQuestionService) UpdateQuestion(ctx context.Contex
 	// Update in database
 	if err := s.repo.Update(ctx, question); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_fill_in_the_blank_question_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_question_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to update question")
@@ -167,7 +167,7 @@ func (s *GrammarFillInTheBlankQuestionService) UpdateQuestion(ctx context.Contex
 	parentQuestion, err := s.grammarQuestionRepo.GetGrammarQuestionByID(ctx, question.GrammarQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_fill_in_the_blank_question_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_question_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.GrammarQuestionID,
 		}, "Failed to get parent question")
@@ -177,7 +177,7 @@ func (s *GrammarFillInTheBlankQuestionService) UpdateQuestion(ctx context.Contex
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_fill_in_the_blank_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -204,7 +204,7 @@ func (s *GrammarFillInTheBlankQuestionService) GetQuestionsByGrammarQuestionID(c
 	if err == nil {
 		var questions []*grammar.GrammarFillInTheBlankQuestion
 		if err := json.Unmarshal([]byte(cachedData), &questions); err == nil {
-			s.logger.Info("get_by_grammar_question.cache", map[string]interface{}{
+			s.logger.WithContext(ctx).Info("get_by_grammar_question.cache", map[string]interface{}{
 				"id":           grammarQuestionID,
 				"cache_status": "hit",
 			}, "Cache hit for fill in blank questions")
@@ -215,14 +215,14 @@ func (s *GrammarFillInTheBlankQuestionService) GetQuestionsByGrammarQuestionID(c
 	// If not in cache or error, get from DB
 	questions, err := s.repo.GetByGrammarQuestionID(ctx, grammarQuestionID)
 	if err != nil {
-		s.logger.Error("get_by_grammar_question", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("get_by_grammar_question", map[string]interface{}{
 			"error": err.Error(),
 			"id":    grammarQuestionID,
 		}, "Failed to get questions")
 		return nil, err
 	}
 
-	s.logger.Debug("get_by_grammar_question.db", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("get_by_grammar_question.db", map[string]interface{}{
 		"id":    grammarQuestionID,
 		"count": len(questions),
 	}, "Retrieved questions from database")
@@ -251,7 +251,7 @@ func (s *GrammarFillInTheBlankQuestionService) DeleteQuestion(ctx context.Contex
 	// Delete from database
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_fill_in_the_blank_question_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_question_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete question")
@@ -262,7 +262,7 @@ func (s *GrammarFillInTheBlankQuestionService) DeleteQuestion(ctx context.Contex
 	parentQuestion, err := s.grammarQuestionRepo.GetGrammarQuestionByID(ctx, question.GrammarQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_fill_in_the_blank_question_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_question_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.GrammarQuestionID,
 		}, "Failed to get parent question")
@@ -272,7 +272,7 @@ func (s *GrammarFillInTheBlankQuestionService) DeleteQuestion(ctx context.Contex
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_fill_in_the_blank_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_fill_in_the_blank_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -0,0 +1,61 @@
+package grammar
+
+import (
+	"context"
+	grammarDTO "fluencybe/internal/app/dto"
+	searchRepo "fluencybe/internal/app/repository/search"
+	searchService "fluencybe/internal/app/service/search"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// GrammarQuestionDatabaseSearch answers search filters from Postgres with the same clauses
+// GrammarQuestionSearch sends to OpenSearch, it is used while OpenSearch is unavailable
+type GrammarQuestionDatabaseSearch struct {
+	repo *searchRepo.QuestionFullTextRepository
+	load func(ctx context.Context, ids []uuid.UUID) ([]*grammarDTO.GrammarQuestionDetail, error)
+}
+
+func NewGrammarQuestionDatabaseSearch(
+	repo *searchRepo.QuestionFullTextRepository,
+	load func(ctx context.Context, ids []uuid.UUID) ([]*grammarDTO.GrammarQuestionDetail, error),
+) *GrammarQuestionDatabaseSearch {
+	return &GrammarQuestionDatabaseSearch{
+		repo: repo,
+		load: load,
+	}
+}
+
+func (s *GrammarQuestionDatabaseSearch) SearchQuestions(ctx context.Context, filter grammarDTO.GrammarQuestionSearchFilter) (*grammarDTO.ListGrammarQuestionsPagination, error) {
+	ids, total, err := s.repo.Search(ctx, "grammar", searchRepo.QuestionSearchQuery{
+		Type:  filter.Type,
+		Topic: filter.Topic,
+		Matches: []searchRepo.TextMatch{
+			{Columns: []string{"instruction"}, Text: filter.Instruction},
+		},
+		Metadata:   filter.Metadata,
+		ChildText:  filter.ChildText,
+		HasChild:   filter.HasChild,
+		MinOptions: filter.MinOptions,
+		Page:       filter.Page,
+		PageSize:   filter.PageSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute database search: %w", err)
+	}
+
+	questions, err := s.load(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load questions: %w", err)
+	}
+
+	return &grammarDTO.ListGrammarQuestionsPagination{
+		Questions: searchService.InIDOrder(ids, questions, func(q *grammarDTO.GrammarQuestionDetail) uuid.UUID {
+			return q.ID
+		}),
+		Total:    total,
+		Page:     filter.Page,
+		PageSize: filter.PageSize,
+	}, nil
+}
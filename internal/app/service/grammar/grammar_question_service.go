@@ -10,6 +10,8 @@ import (
 	searchClient "fluencybe/internal/app/opensearch"
 	redisClient "fluencybe/internal/app/redis"
 	GrammarRepository "fluencybe/internal/app/repository/grammar"
+	searchRepo "fluencybe/internal/app/repository/search"
+	searchService "fluencybe/internal/app/service/search"
 	grammarValidator "fluencybe/internal/app/validator"
 	"fluencybe/pkg/cache"
 	"fluencybe/pkg/logger"
@@ -31,9 +33,12 @@ type GrammarQuestionService struct {
 	logger                        *logger.PrettyLogger
 	redis                         *redisClient.GrammarQuestionRedis
 	search                        *searchClient.GrammarQuestionSearch
+	searcher                      searchService.QuestionSearcher[grammarDTO.GrammarQuestionSearchFilter, *grammarDTO.ListGrammarQuestionsPagination]
+	similarity                    *searchService.QuestionSimilarityService
 	completion                    *grammarHelper.GrammarQuestionCompletionHelper
 	updater                       *grammarHelper.GrammarQuestionFieldUpdater
 	questionUpdator               *grammarHelper.GrammarQuestionUpdator
+	warmup                        redisClient.CacheWarmup
 	fillInBlankQuestionService    *GrammarFillInTheBlankQuestionService
 	fillInBlankAnswerService      *GrammarFillInTheBlankAnswerService
 	choiceOneQuestionService      *GrammarChoiceOneQuestionService
@@ -55,11 +60,12 @@ func NewGrammarQuestionService(
 	sentenceTransformationService *GrammarSentenceTransformationService,
 	questionUpdator *grammarHelper.GrammarQuestionUpdator,
 ) *GrammarQuestionService {
-	return &GrammarQuestionService{
+	s := &GrammarQuestionService{
 		repo:                          repo,
 		logger:                        logger,
 		redis:                         redisClient.NewGrammarQuestionRedis(cache, logger),
 		search:                        searchClient.NewGrammarQuestionSearch(openSearch, logger),
+		similarity:                    searchService.NewQuestionSimilarityService(searchClient.NewQuestionSimilarity(openSearch, logger), logger),
 		completion:                    grammarHelper.NewGrammarQuestionCompletionHelper(logger),
 		updater:                       grammarHelper.NewGrammarQuestionFieldUpdater(logger),
 		fillInBlankQuestionService:    fillInBlankQuestionService,
@@ -70,20 +76,28 @@ func NewGrammarQuestionService(
 		sentenceTransformationService: sentenceTransformationService,
 		questionUpdator:               questionUpdator,
 	}
+	s.searcher = searchService.NewFallbackSearcher[grammarDTO.GrammarQuestionSearchFilter, *grammarDTO.ListGrammarQuestionsPagination](
+		"grammar",
+		s.search,
+		NewGrammarQuestionDatabaseSearch(searchRepo.NewQuestionFullTextRepository(repo.GetDB(), logger), s.GetGrammarByListID),
+		logger,
+	)
+	return s
 }
 
-func (s *GrammarQuestionService) CreateQuestion(ctx context.Context, question *grammar.GrammarQuestion) error {
+// CreateQuestion stores a new question and returns the existing questions it nearly duplicates
+func (s *GrammarQuestionService) CreateQuestion(ctx context.Context, question *grammar.GrammarQuestion) ([]grammarDTO.SimilarQuestion, error) {
 	if err := grammarValidator.ValidateGrammarQuestion(question); err != nil {
-		return fmt.Errorf("validation error: %w", err)
+		return nil, fmt.Errorf("validation error: %w", err)
 	}
 
 	// Create in database
 	if err := s.repo.CreateGrammarQuestion(ctx, question); err != nil {
-		s.logger.Error("grammar_question_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_question_service.create", map[string]interface{}{
 			"error":         err.Error(),
 			"question_type": question.Type,
 		}, "Failed to create grammar question")
-		return err
+		return nil, err
 	}
 
 	questionDetail := grammarDTO.GrammarQuestionDetail{
@@ -106,67 +120,59 @@ func (s *GrammarQuestionService) CreateQuestion(ctx context.Context, question *g
 	// Index to OpenSearch with correct status
 	status := map[bool]string{true: "complete", false: "uncomplete"}[false]
 	if err := s.search.UpsertGrammarQuestion(ctx, &questionDetail, status); err != nil {
-		s.logger.Error("grammar_question_service.create.opensearch", map[string]interface{}{
+		// The question is committed, the outbox relay indexes it once OpenSearch is back
+		s.logger.WithContext(ctx).Warning("grammar_question_service.create.opensearch", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
-		}, "Failed to index question in OpenSearch")
-		return err
+		}, "Failed to index question in OpenSearch, leaving it to the outbox relay")
 	}
 
-	return nil
+	// Near-duplicates only warn the author, the question is created either way
+	return s.similarity.NearDuplicates(ctx, "grammar", question.ID, question.Instruction), nil
 }
 
 func (s *GrammarQuestionService) GetGrammarQuestionDetail(ctx context.Context, id uuid.UUID) (*grammarDTO.GrammarQuestionDetail, error) {
 	start := time.Now()
 	defer func() {
-		s.logger.Info("grammar_question_service.get.timing", map[string]interface{}{
+		s.logger.WithContext(ctx).Info("grammar_question_service.get.timing", map[string]interface{}{
 			"id":          id,
 			"duration_ms": time.Since(start).Milliseconds(),
 		}, "Question retrieval timing")
 	}()
 
-	// Try to get from cache with both complete and uncomplete status
-	pattern := fmt.Sprintf("grammar_question:%s:*", id)
-	keys, err := s.redis.GetCache().Keys(ctx, pattern)
-	if err == nil && len(keys) > 0 {
-		// Found in cache, try to get the data
-		cachedData, err := s.redis.GetCache().Get(ctx, keys[0])
-		if err == nil {
-			var response grammarDTO.GrammarQuestionDetail
-			if err := json.Unmarshal([]byte(cachedData), &response); err == nil {
-				return &response, nil
-			}
+	response, err := s.redis.GetGrammarQuestionDetail(ctx, id, func(ctx context.Context) (*grammarDTO.GrammarQuestionDetail, bool, error) {
+		question, err := s.repo.GetGrammarQuestionByID(ctx, id)
+		if errors.Is(err, GrammarRepository.ErrQuestionNotFound) {
+			return nil, false, nil
+		}
+		if err != nil {
+			s.logger.WithContext(ctx).Error("grammar_question_service.get", map[string]interface{}{
+				"error": err.Error(),
+				"id":    id,
+			}, "Failed to get grammar question")
+			return nil, false, err
 		}
-	}
 
-	// If not in cache or error, get from database
-	question, err := s.repo.GetGrammarQuestionByID(ctx, id)
-	if err != nil {
-		s.logger.Error("grammar_question_service.get", map[string]interface{}{
-			"error": err.Error(),
-			"id":    id,
-		}, "Failed to get grammar question")
-		return nil, err
-	}
+		response, err := s.getGrammarQuestionDetail(ctx, question)
+		if err != nil {
+			s.logger.WithContext(ctx).Error("grammar_question_service.get.detail", map[string]interface{}{
+				"error": err.Error(),
+				"id":    id,
+			}, "Failed to get question details")
+			return nil, false, err
+		}
 
-	response, err := s.getGrammarQuestionDetail(ctx, question)
+		return response, s.completion.IsQuestionComplete(response), nil
+	})
 	if err != nil {
-		s.logger.Error("grammar_question_service.get.detail", map[string]interface{}{
-			"error": err.Error(),
-			"id":    id,
-		}, "Failed to get question details")
 		return nil, err
 	}
+	if response == nil {
+		return nil, ErrQuestionNotFound
+	}
 
-	// Check completion status
-	isComplete := s.completion.IsQuestionComplete(response)
-
-	// Cache with status and version
-	if err := s.redis.SetCacheGrammarQuestionDetail(ctx, response, isComplete); err != nil {
-		s.logger.Error("grammar_question_service.get.cache", map[string]interface{}{
-			"error": err.Error(),
-			"id":    id,
-		}, "Failed to cache question detail")
+	if s.warmup != nil {
+		s.warmup.RecordRead("grammar", id)
 	}
 
 	return response, nil
@@ -193,7 +199,7 @@ func (s *GrammarQuestionService) UpdateQuestion(ctx context.Context, id uuid.UUI
 
 	// Update in database
 	if err := s.repo.UpdateGrammarQuestion(ctx, baseQuestion); err != nil {
-		s.logger.Error("grammar_question_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_question_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    baseQuestion.ID,
 		}, "Failed to update question in database")
@@ -202,7 +208,7 @@ func (s *GrammarQuestionService) UpdateQuestion(ctx context.Context, id uuid.UUI
 
 	// Use the updator to update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, baseQuestion); err != nil {
-		s.logger.Error("grammar_question_service.update.cache_and_search", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_question_service.update.cache_and_search", map[string]interface{}{
 			"error": err.Error(),
 			"id":    baseQuestion.ID,
 		}, "Failed to update cache and search")
@@ -215,7 +221,7 @@ func (s *GrammarQuestionService) UpdateQuestion(ctx context.Context, id uuid.UUI
 func (s *GrammarQuestionService) DeleteQuestion(ctx context.Context, id uuid.UUID) error {
 	// Delete from database
 	if err := s.repo.DeleteGrammarQuestion(ctx, id); err != nil {
-		s.logger.Error("grammar_question_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_question_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete question")
@@ -227,7 +233,7 @@ func (s *GrammarQuestionService) DeleteQuestion(ctx context.Context, id uuid.UUI
 
 	// Delete from OpenSearch
 	if err := s.search.DeleteGrammarQuestionFromIndex(ctx, id); err != nil {
-		s.logger.Error("grammar_question_service.delete.opensearch", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_question_service.delete.opensearch", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete question from OpenSearch")
@@ -277,7 +283,7 @@ func (s *GrammarQuestionService) GetNewUpdatedQuestions(ctx context.Context, ver
 		Order("created_at DESC")
 
 	if err := query.Find(&questions).Error; err != nil {
-		s.logger.Error("grammar_question_service.get_new_grammar_questions", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_question_service.get_new_grammar_questions", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get updated questions")
 		return nil, fmt.Errorf("failed to get updated questions: %w", err)
@@ -323,7 +329,7 @@ func (s *GrammarQuestionService) getGrammarQuestionDetail(ctx context.Context, q
 func (s *GrammarQuestionService) loadFillInTheBlankData(ctx context.Context, questionID uuid.UUID, response *grammarDTO.GrammarQuestionDetail) error {
 	questions, err := s.fillInBlankQuestionService.GetQuestionsByGrammarQuestionID(ctx, questionID)
 	if err != nil {
-		s.logger.Error("loadFillInTheBlankData.get_questions", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("loadFillInTheBlankData.get_questions", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get fill in blank questions")
@@ -342,7 +348,7 @@ func (s *GrammarQuestionService) loadFillInTheBlankData(ctx context.Context, que
 
 	answers, err := s.fillInBlankAnswerService.GetAnswersByGrammarFillInTheBlankQuestionID(ctx, question.ID)
 	if err != nil {
-		s.logger.Error("loadFillInTheBlankData.get_answers", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("loadFillInTheBlankData.get_answers", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": question.ID,
 		}, "Failed to get fill in blank answers")
@@ -364,7 +370,7 @@ func (s *GrammarQuestionService) loadFillInTheBlankData(ctx context.Context, que
 func (s *GrammarQuestionService) loadChoiceOneData(ctx context.Context, questionID uuid.UUID, response *grammarDTO.GrammarQuestionDetail) error {
 	questions, err := s.choiceOneQuestionService.GetQuestionsByGrammarQuestionID(ctx, questionID)
 	if err != nil {
-		s.logger.Error("loadChoiceOneData.get_questions", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("loadChoiceOneData.get_questions", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get choice one questions")
@@ -381,7 +387,7 @@ func (s *GrammarQuestionService) loadChoiceOneData(ctx context.Context, question
 
 		options, err := s.choiceOneOptionService.GetOptionsByQuestionID(ctx, question.ID)
 		if err != nil {
-			s.logger.Error("loadChoiceOneData.get_options", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("loadChoiceOneData.get_options", map[string]interface{}{
 				"error":      err.Error(),
 				"questionID": question.ID,
 			}, "Failed to get choice one options")
@@ -404,7 +410,7 @@ func (s *GrammarQuestionService) loadChoiceOneData(ctx context.Context, question
 func (s *GrammarQuestionService) loadErrorIdentificationData(ctx context.Context, questionID uuid.UUID, response *grammarDTO.GrammarQuestionDetail) error {
 	identifications, err := s.errorIdentificationService.GetByGrammarQuestionID(ctx, questionID)
 	if err != nil {
-		s.logger.Error("loadErrorIdentificationData.get_identifications", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("loadErrorIdentificationData.get_identifications", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get error identifications")
@@ -428,7 +434,7 @@ func (s *GrammarQuestionService) loadErrorIdentificationData(ctx context.Context
 func (s *GrammarQuestionService) loadSentenceTransformationData(ctx context.Context, questionID uuid.UUID, response *grammarDTO.GrammarQuestionDetail) error {
 	transformations, err := s.sentenceTransformationService.GetByGrammarQuestionID(ctx, questionID)
 	if err != nil {
-		s.logger.Error("loadSentenceTransformationData.get_transformations", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("loadSentenceTransformationData.get_transformations", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get sentence transformations")
@@ -489,7 +495,7 @@ func (s *GrammarQuestionService) GetGrammarByListID(ctx context.Context, ids []u
 			Find(&dbQuestions)
 
 		if query.Error != nil {
-			s.logger.Error("grammar_question_service.get_by_list_id", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("grammar_question_service.get_by_list_id", map[string]interface{}{
 				"error": query.Error.Error(),
 			}, "Failed to get questions from database")
 			return nil, query.Error
@@ -500,7 +506,7 @@ func (s *GrammarQuestionService) GetGrammarByListID(ctx context.Context, ids []u
 			// Build complete response with details
 			questionDetail, err := s.getGrammarQuestionDetail(ctx, q)
 			if err != nil {
-				s.logger.Error("grammar_question_service.get_by_list_id.build_response", map[string]interface{}{
+				s.logger.WithContext(ctx).Error("grammar_question_service.get_by_list_id.build_response", map[string]interface{}{
 					"error": err.Error(),
 					"id":    q.ID,
 				}, "Failed to build complete response")
@@ -518,7 +524,7 @@ func (s *GrammarQuestionService) GetGrammarByListID(ctx context.Context, ids []u
 			cacheKey := fmt.Sprintf("grammar_question:%s:%s:%d", q.ID, status, q.Version)
 			if questionJSON, err := json.Marshal(questionDetail); err == nil {
 				if err := s.redis.GetCache().Set(ctx, cacheKey, string(questionJSON), 24*time.Hour); err != nil {
-					s.logger.Error("grammar_question_service.get_by_list_id.cache", map[string]interface{}{
+					s.logger.WithContext(ctx).Error("grammar_question_service.get_by_list_id.cache", map[string]interface{}{
 						"error": err.Error(),
 						"id":    q.ID,
 					}, "Failed to cache question")
@@ -534,13 +540,13 @@ func (s *GrammarQuestionService) GetGrammarByListID(ctx context.Context, ids []u
 
 func (s *GrammarQuestionService) SearchQuestionsWithFilter(ctx context.Context, filter grammarDTO.GrammarQuestionSearchFilter) (*grammarDTO.ListGrammarQuestionsPagination, error) {
 	// Add debug logging
-	s.logger.Debug("grammar_question_service.search.start", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("grammar_question_service.search.start", map[string]interface{}{
 		"filter": filter,
 	}, "Starting question search")
 
-	result, err := s.search.SearchQuestions(ctx, filter)
+	result, err := s.searcher.SearchQuestions(ctx, filter)
 	if err != nil {
-		s.logger.Error("grammar_question_service.search", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_question_service.search", map[string]interface{}{
 			"error":  err.Error(),
 			"filter": filter,
 		}, "Failed to search questions")
@@ -548,7 +554,7 @@ func (s *GrammarQuestionService) SearchQuestionsWithFilter(ctx context.Context,
 	}
 
 	// Add debug logging for results
-	s.logger.Debug("grammar_question_service.search.complete", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("grammar_question_service.search.complete", map[string]interface{}{
 		"total_results": result.Total,
 		"page":          result.Page,
 		"page_size":     result.PageSize,
@@ -565,21 +571,10 @@ func (s *GrammarQuestionService) SearchQuestionsWithFilter(ctx context.Context,
 }
 
 func (s *GrammarQuestionService) DeleteAllQuestions(ctx context.Context) error {
-	// Start transaction
-	tx := s.repo.GetDB().WithContext(ctx).Begin()
-	if tx.Error != nil {
-		return fmt.Errorf("failed to start transaction: %w", tx.Error)
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
-		}
-	}()
-
-	// Delete all records from grammar_questions
-	if err := tx.Exec("DELETE FROM grammar_questions").Error; err != nil {
-		tx.Rollback()
-		s.logger.Error("grammar_question_service.delete_all", map[string]interface{}{
+	// The outbox trigger queues a delete event per row in this same statement, so cache and
+	// search are cleared by the relay even when the bulk cleanup below fails
+	if err := s.repo.GetDB().WithContext(ctx).Exec("DELETE FROM grammar_questions").Error; err != nil {
+		s.logger.WithContext(ctx).Error("grammar_question_service.delete_all", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to delete all grammar questions")
 		return err
@@ -587,26 +582,71 @@ func (s *GrammarQuestionService) DeleteAllQuestions(ctx context.Context) error {
 
 	// Delete all Redis cache with pattern grammar_question:*
 	if err := s.redis.GetCache().DeletePattern(ctx, "grammar_question:*"); err != nil {
-		tx.Rollback()
-		s.logger.Error("grammar_question_service.delete_all.cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Warning("grammar_question_service.delete_all.cache", map[string]interface{}{
 			"error": err.Error(),
-		}, "Failed to delete Redis cache")
-		return err
+		}, "Failed to delete Redis cache, leaving it to the outbox relay")
 	}
 
 	// Delete OpenSearch index
 	if err := s.search.RemoveGrammarQuestionsIndex(ctx); err != nil {
-		tx.Rollback()
-		s.logger.Error("grammar_question_service.delete_all.search", map[string]interface{}{
+		s.logger.WithContext(ctx).Warning("grammar_question_service.delete_all.search", map[string]interface{}{
 			"error": err.Error(),
-		}, "Failed to delete OpenSearch index")
-		return err
+		}, "Failed to delete OpenSearch index, leaving it to the outbox relay")
 	}
 
-	if err := tx.Commit().Error; err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to commit transaction: %w", err)
+	// The cache is empty now, warm it again for the questions that come back
+	if s.warmup != nil {
+		s.warmup.Reset("grammar")
 	}
 
 	return nil
 }
+
+// SetCacheWarmup reports reads to warmup and resets it once all questions are deleted
+func (s *GrammarQuestionService) SetCacheWarmup(warmup redisClient.CacheWarmup) {
+	s.warmup = warmup
+}
+
+// WarmQuestion writes the detail of a question to the cache, a deleted question is not an error.
+// It does not count as a read.
+func (s *GrammarQuestionService) WarmQuestion(ctx context.Context, id uuid.UUID) error {
+	question, err := s.repo.GetGrammarQuestionByID(ctx, id)
+	if errors.Is(err, GrammarRepository.ErrQuestionNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	detail, err := s.getGrammarQuestionDetail(ctx, question)
+	if err != nil {
+		return err
+	}
+	return s.redis.SetCacheGrammarQuestionDetail(ctx, detail, s.completion.IsQuestionComplete(detail))
+}
+
+// SyncQuestionByID refreshes the cache entries and search document of a question from the
+// database, removing them if the question is gone. The outbox relay calls it for every event.
+func (s *GrammarQuestionService) SyncQuestionByID(ctx context.Context, id uuid.UUID) error {
+	question, err := s.repo.GetGrammarQuestionByID(ctx, id)
+	if errors.Is(err, GrammarRepository.ErrQuestionNotFound) {
+		return s.questionUpdator.RemoveQuestion(ctx, id)
+	}
+	if err != nil {
+		return err
+	}
+	return s.questionUpdator.SyncQuestion(ctx, question)
+}
+
+// BuildSearchDocument returns the search document of a question for index rebuilds, or nil
+// when the question has been deleted in the meantime
+func (s *GrammarQuestionService) BuildSearchDocument(ctx context.Context, id uuid.UUID) (map[string]interface{}, error) {
+	question, err := s.repo.GetGrammarQuestionByID(ctx, id)
+	if errors.Is(err, GrammarRepository.ErrQuestionNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.questionUpdator.BuildSearchDocument(ctx, question)
+}
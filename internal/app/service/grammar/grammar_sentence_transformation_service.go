@@ -78,7 +78,7 @@ func (s *GrammarSentenceTransformationService) Create(ctx context.Context, trans
 
 	if err := s.repo.Create(ctx, transformation); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_sentence_transformation_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_sentence_transformation_service.create", map[string]interface{}{
 			"error": err.Error(),
 			"id":    transformation.ID,
 		}, "Failed to create sentence transformation")
@@ -89,7 +89,7 @@ func (s *GrammarSentenceTransformationService) Create(ctx context.Context, trans
 	parentQuestion, err := s.grammarQuestionRepo.GetGrammarQuestionByID(ctx, transformation.GrammarQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_sentence_transformation_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_sentence_transformation_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    transformation.GrammarQuestionID,
 		}, "Failed to get parent question")
@@ -99,7 +99,7 @@ func (s *GrammarSentenceTransformationService) Create(ctx context.Context, trans
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_sentence_transformation_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_sentence_transformation_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -117,7 +117,7 @@ func (s *GrammarSentenceTransformationService) Create(ctx context.Context, trans
 func (s *GrammarSentenceTransformationService) GetByID(ctx context.Context, id uuid.UUID) (*grammar.GrammarSentenceTransformation, error) {
 	transformation, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		s.logger.Error("grammar_sentence_transformation_service.get_by_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_sentence_transformation_service.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get sentence transformation")
@@ -144,7 +144,7 @@ func (s *GrammarSentenceTransformationService) Update(ctx context.Context, trans
 
 	if err := s.repo.Update(ctx, transformation); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_sentence_transformation_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_sentence_transformation_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    transformation.ID,
 		}, "Failed to update sentence transformation")
@@ -155,7 +155,7 @@ func (s *GrammarSentenceTransformationService) Update(ctx context.Context, trans
 	parentQuestion, err := s.grammarQuestionRepo.GetGrammarQuestionByID(ctx, transformation.GrammarQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_sentence_transformation_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_sentence_transformation_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    transformation.GrammarQuestionID,
 		}, "Failed to get parent question")
@@ -165,7 +165,7 @@ func (s *GrammarSentenceTransformationService) Update(ctx context.Context, trans
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_sentence_transformation_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_sentence_transformation_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -200,7 +200,7 @@ func (s *GrammarSentenceTransformationService) Delete(ctx context.Context, id uu
 
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_sentence_transformation_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_sentence_transformation_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete sentence transformation")
@@ -211,7 +211,7 @@ func (s *GrammarSentenceTransformationService) Delete(ctx context.Context, id uu
 	parentQuestion, err := s.grammarQuestionRepo.GetGrammarQuestionByID(ctx, transformation.GrammarQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_sentence_transformation_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_sentence_transformation_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    transformation.GrammarQuestionID,
 		}, "Failed to get parent question")
@@ -221,7 +221,7 @@ func (s *GrammarSentenceTransformationService) Delete(ctx context.Context, id uu
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("grammar_sentence_transformation_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_sentence_transformation_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -239,7 +239,7 @@ func (s *GrammarSentenceTransformationService) Delete(ctx context.Context, id uu
 func (s *GrammarSentenceTransformationService) GetByGrammarQuestionID(ctx context.Context, grammarQuestionID uuid.UUID) ([]*grammar.GrammarSentenceTransformation, error) {
 	transformations, err := s.repo.GetByGrammarQuestionID(ctx, grammarQuestionID)
 	if err != nil {
-		s.logger.Error("grammar_sentence_transformation_service.get_by_grammar_question_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("grammar_sentence_transformation_service.get_by_grammar_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    grammarQuestionID,
 		}, "Failed to get sentence transformations")
@@ -0,0 +1,399 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fluencybe/internal/app/model/job"
+	jobRepo "fluencybe/internal/app/repository/job"
+	"fluencybe/internal/core/constants"
+	"fluencybe/pkg/logger"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// errShutdown cancels the jobs still running when a drain runs out of time
+var errShutdown = errors.New("job runner is shutting down")
+
+// JobRunner claims queued jobs and runs them on at most JobWorkers goroutines, each type also
+// limited to its own concurrency. The limits hold per runner, every replica runs one.
+type JobRunner struct {
+	repo        *jobRepo.JobRepository
+	definitions map[string]Definition
+	logger      *logger.PrettyLogger
+
+	// Jobs in flight, in total and per type
+	slotsMu sync.Mutex
+	total   int
+	running map[string]int
+	jobs    sync.WaitGroup
+	wake    chan struct{}
+
+	// jobCtx outlives the claim loop so jobs can finish while draining
+	jobCtx    context.Context
+	jobCancel context.CancelCauseFunc
+
+	cancel context.CancelFunc
+	done   chan struct{}
+	mu     sync.Mutex
+}
+
+func NewJobRunner(repo *jobRepo.JobRepository, definitions map[string]Definition, logger *logger.PrettyLogger) *JobRunner {
+	return &JobRunner{
+		repo:        repo,
+		definitions: definitions,
+		logger:      logger,
+		running:     make(map[string]int),
+		wake:        make(chan struct{}, 1),
+	}
+}
+
+// Start launches the claim loop, calling it again while running is a no-op
+func (r *JobRunner) Start() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.done = make(chan struct{})
+	r.jobCtx, r.jobCancel = context.WithCancelCause(context.Background())
+
+	types := make([]string, 0, len(r.definitions))
+	for jobType := range r.definitions {
+		types = append(types, jobType)
+	}
+	r.logger.Info("JOB_RUNNER_START", map[string]interface{}{
+		"workers":  constants.JobWorkers,
+		"interval": constants.JobPollInterval.String(),
+		"types":    types,
+	}, "Starting job runner")
+
+	go r.run(ctx)
+}
+
+// Stop stops claiming jobs and waits for the jobs in flight until ctx is done. The jobs still
+// running then are cancelled and put back in the queue, ctx.Err() is returned.
+func (r *JobRunner) Stop(ctx context.Context) error {
+	r.mu.Lock()
+	cancel, done, jobCancel := r.cancel, r.done, r.jobCancel
+	r.cancel = nil
+	r.mu.Unlock()
+
+	if cancel == nil {
+		return nil
+	}
+	cancel()
+	<-done
+
+	drained := make(chan struct{})
+	go func() {
+		r.jobs.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		jobCancel(nil)
+		return nil
+	case <-ctx.Done():
+		r.slotsMu.Lock()
+		running := r.total
+		r.slotsMu.Unlock()
+		r.logger.Warning("JOB_RUNNER_DRAIN_TIMEOUT", map[string]interface{}{
+			"running": running,
+		}, "Jobs still running at shutdown, putting them back in the queue")
+
+		jobCancel(errShutdown)
+		<-drained
+		return ctx.Err()
+	}
+}
+
+func (r *JobRunner) run(ctx context.Context) {
+	defer close(r.done)
+
+	ticker := time.NewTicker(constants.JobPollInterval)
+	defer ticker.Stop()
+
+	lastPurge := time.Time{}
+	for {
+		r.claimAvailable(ctx)
+
+		if time.Since(lastPurge) >= constants.JobPurgeInterval {
+			r.purge(ctx)
+			lastPurge = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-r.wake:
+		}
+	}
+}
+
+// claimAvailable claims jobs until every slot is taken or nothing is due
+func (r *JobRunner) claimAvailable(ctx context.Context) {
+	for ctx.Err() == nil {
+		types := r.freeTypes()
+		if len(types) == 0 {
+			return
+		}
+
+		j, err := r.repo.Claim(ctx, types, constants.JobLeaseDuration)
+		if err != nil || j == nil {
+			return
+		}
+		r.launch(j)
+	}
+}
+
+// freeTypes returns the types with a free slot, none when all workers are busy
+func (r *JobRunner) freeTypes() []string {
+	r.slotsMu.Lock()
+	defer r.slotsMu.Unlock()
+
+	if r.total >= constants.JobWorkers {
+		return nil
+	}
+	types := make([]string, 0, len(r.definitions))
+	for jobType, definition := range r.definitions {
+		if r.running[jobType] < definition.concurrency() {
+			types = append(types, jobType)
+		}
+	}
+	return types
+}
+
+func (r *JobRunner) launch(j *job.Job) {
+	r.slotsMu.Lock()
+	r.total++
+	r.running[j.Type]++
+	r.slotsMu.Unlock()
+
+	r.jobs.Add(1)
+	go func() {
+		defer func() {
+			r.slotsMu.Lock()
+			r.total--
+			r.running[j.Type]--
+			r.slotsMu.Unlock()
+			r.jobs.Done()
+
+			// A slot is free, claim the next job without waiting for the tick
+			select {
+			case r.wake <- struct{}{}:
+			default:
+			}
+		}()
+		r.execute(j)
+	}()
+}
+
+func (r *JobRunner) execute(j *job.Job) {
+	store := context.WithoutCancel(r.jobCtx)
+
+	if j.Attempts > j.MaxAttempts {
+		// Claimed again after the lease of its last attempt ran out, the runner died mid-job
+		r.fail(store, j, fmt.Errorf("%w: lease expired on the last attempt", ErrPermanent))
+		return
+	}
+
+	definition := r.definitions[j.Type]
+	ctx, cancel := context.WithCancelCause(r.jobCtx)
+	defer cancel(nil)
+	ctx, cancelTimeout := context.WithTimeout(ctx, definition.timeout())
+	defer cancelTimeout()
+
+	r.logger.Info("job_runner.start", map[string]interface{}{
+		"id":      j.ID,
+		"type":    j.Type,
+		"attempt": j.Attempts,
+	}, "Running job")
+
+	tracker := &progressTracker{}
+	stopHeartbeat := r.heartbeat(ctx, cancel, j, tracker)
+	result, err := call(ctx, definition.Handler, j, tracker.report)
+	stopHeartbeat()
+
+	cause := context.Cause(ctx)
+	switch {
+	case errors.Is(cause, jobRepo.ErrLeaseLost):
+		r.logger.Warning("job_runner.lease_lost", map[string]interface{}{
+			"id":      j.ID,
+			"type":    j.Type,
+			"attempt": j.Attempts,
+		}, "Job was claimed by another runner, dropping this attempt")
+		return
+	case err != nil && errors.Is(cause, errShutdown):
+		if err := r.repo.Release(store, j.ID, j.Attempts); err != nil {
+			r.logger.Error("job_runner.release", map[string]interface{}{
+				"error": err.Error(),
+				"id":    j.ID,
+			}, "Failed to put interrupted job back in the queue, it is picked up once its lease runs out")
+		}
+		return
+	case err != nil:
+		r.fail(store, j, err)
+		return
+	}
+
+	r.complete(store, j, result)
+}
+
+// heartbeat extends the lease of j and writes its progress until the returned func is called.
+// Losing the lease cancels ctx with ErrLeaseLost.
+func (r *JobRunner) heartbeat(ctx context.Context, cancel context.CancelCauseFunc, j *job.Job, tracker *progressTracker) func() {
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(constants.JobHeartbeatInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			percent, message := tracker.snapshot()
+			err := r.repo.Heartbeat(ctx, j.ID, j.Attempts, constants.JobLeaseDuration, percent, message)
+			if errors.Is(err, jobRepo.ErrLeaseLost) {
+				cancel(err)
+				return
+			}
+			if err != nil && ctx.Err() == nil {
+				r.logger.Warning("job_runner.heartbeat", map[string]interface{}{
+					"error": err.Error(),
+					"id":    j.ID,
+				}, "Failed to extend job lease")
+			}
+		}
+	}()
+
+	return func() {
+		close(stop)
+		<-stopped
+	}
+}
+
+func (r *JobRunner) complete(ctx context.Context, j *job.Job, result interface{}) {
+	var stored *string
+	if result != nil {
+		data, err := json.Marshal(result)
+		if err != nil {
+			r.fail(ctx, j, fmt.Errorf("%w: failed to encode result: %v", ErrPermanent, err))
+			return
+		}
+		encoded := string(data)
+		stored = &encoded
+	}
+
+	if err := r.repo.Complete(ctx, j.ID, j.Attempts, stored); err != nil {
+		r.logger.Error("job_runner.complete", map[string]interface{}{
+			"error": err.Error(),
+			"id":    j.ID,
+		}, "Failed to record job result")
+		return
+	}
+
+	r.logger.Info("job_runner.done", map[string]interface{}{
+		"id":      j.ID,
+		"type":    j.Type,
+		"attempt": j.Attempts,
+	}, "Job succeeded")
+}
+
+func (r *JobRunner) fail(ctx context.Context, j *job.Job, cause error) {
+	dead := errors.Is(cause, ErrPermanent) || j.Attempts >= j.MaxAttempts
+	retryAt := time.Now().UTC().Add(backoff(j.Attempts))
+
+	if dead {
+		r.logger.Error("job_runner.failed", map[string]interface{}{
+			"error":    cause.Error(),
+			"id":       j.ID,
+			"type":     j.Type,
+			"attempts": j.Attempts,
+		}, "Job failed")
+	} else {
+		r.logger.Warning("job_runner.retry", map[string]interface{}{
+			"error":    cause.Error(),
+			"id":       j.ID,
+			"type":     j.Type,
+			"attempts": j.Attempts,
+			"retry_at": retryAt,
+		}, "Job attempt failed, will retry")
+	}
+
+	if err := r.repo.Fail(ctx, j.ID, j.Attempts, cause.Error(), retryAt, dead); err != nil {
+		r.logger.Error("job_runner.mark_failed", map[string]interface{}{
+			"error": err.Error(),
+			"id":    j.ID,
+		}, "Failed to record job failure")
+	}
+}
+
+func (r *JobRunner) purge(ctx context.Context) {
+	deleted, err := r.repo.PurgeFinished(ctx, time.Now().UTC().Add(-constants.JobRetention))
+	if err != nil {
+		r.logger.Error("job_runner.purge", map[string]interface{}{
+			"error": err.Error(),
+		}, "Failed to purge finished jobs")
+		return
+	}
+	if deleted > 0 {
+		r.logger.Debug("job_runner.purge", map[string]interface{}{
+			"deleted": deleted,
+		}, "Purged finished jobs")
+	}
+}
+
+// call runs handler, turning a panic into a failed attempt instead of a crashed process
+func call(ctx context.Context, handler Handler, j *job.Job, progress Progress) (result interface{}, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("job panicked: %v", recovered)
+		}
+	}()
+	return handler(ctx, j, progress)
+}
+
+// progressTracker holds the latest progress reported by a job for the next heartbeat
+type progressTracker struct {
+	mu      sync.Mutex
+	percent int
+	message string
+}
+
+func (t *progressTracker) report(percent int, message string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.percent = min(max(percent, 0), 100)
+	t.message = message
+}
+
+func (t *progressTracker) snapshot() (int, string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.percent, t.message
+}
+
+// backoff doubles the delay with each attempt, capped at JobMaxBackoff
+func backoff(attempts int) time.Duration {
+	delay := constants.JobBaseBackoff
+	for i := 1; i < attempts && delay < constants.JobMaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, constants.JobMaxBackoff)
+}
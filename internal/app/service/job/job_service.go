@@ -0,0 +1,202 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fluencybe/internal/app/dto"
+	"fluencybe/internal/app/model/job"
+	jobRepo "fluencybe/internal/app/repository/job"
+	"fluencybe/internal/core/constants"
+	"fluencybe/pkg/logger"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrJobNotFound     = jobRepo.ErrJobNotFound
+	ErrInvalidStatus   = errors.New("invalid job status")
+	ErrUnknownJobType  = errors.New("unknown job type")
+	ErrInvalidPayload  = errors.New("invalid job payload")
+	ErrPayloadTooLarge = errors.New("job payload too large")
+	// ErrPermanent marks a failure that another attempt cannot fix, handlers wrap it to skip
+	// the remaining retries
+	ErrPermanent = errors.New("permanent job failure")
+)
+
+// Progress records how far a job got, percent is clamped to 0..100. It is cheap to call, the
+// runner writes the latest value with the next heartbeat.
+type Progress func(percent int, message string)
+
+// Handler runs one attempt of a job. The result is stored as JSON and returned by the status
+// endpoint, ctx is cancelled on timeout, shutdown or when the lease is lost.
+type Handler func(ctx context.Context, j *job.Job, progress Progress) (interface{}, error)
+
+// Definition describes a job type. Zero values fall back to JobWorkers, JobMaxAttempts and
+// JobDefaultTimeout.
+type Definition struct {
+	Handler Handler
+	// Validate rejects a payload when the job is created, nil accepts any JSON object
+	Validate    func(payload json.RawMessage) error
+	Concurrency int
+	MaxAttempts int
+	Timeout     time.Duration
+}
+
+func (d Definition) concurrency() int {
+	if d.Concurrency <= 0 {
+		return constants.JobWorkers
+	}
+	return d.Concurrency
+}
+
+func (d Definition) maxAttempts() int {
+	if d.MaxAttempts <= 0 {
+		return constants.JobMaxAttempts
+	}
+	return d.MaxAttempts
+}
+
+func (d Definition) timeout() time.Duration {
+	if d.Timeout <= 0 {
+		return constants.JobDefaultTimeout
+	}
+	return d.Timeout
+}
+
+type JobService struct {
+	repo        *jobRepo.JobRepository
+	definitions map[string]Definition
+	logger      *logger.PrettyLogger
+}
+
+func NewJobService(repo *jobRepo.JobRepository, definitions map[string]Definition, logger *logger.PrettyLogger) *JobService {
+	return &JobService{
+		repo:        repo,
+		definitions: definitions,
+		logger:      logger,
+	}
+}
+
+// Types returns the registered job types, sorted
+func (s *JobService) Types() []string {
+	types := make([]string, 0, len(s.definitions))
+	for jobType := range s.definitions {
+		types = append(types, jobType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Enqueue queues a job of a registered type, a missing payload is stored as an empty object
+func (s *JobService) Enqueue(ctx context.Context, jobType string, payload json.RawMessage, createdBy *uuid.UUID) (*dto.JobResponse, error) {
+	definition, ok := s.definitions[jobType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownJobType, jobType)
+	}
+
+	if len(payload) == 0 {
+		payload = json.RawMessage("{}")
+	}
+	if len(payload) > constants.JobMaxPayloadSize {
+		return nil, ErrPayloadTooLarge
+	}
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &object); err != nil || object == nil {
+		return nil, fmt.Errorf("%w: payload must be a JSON object", ErrInvalidPayload)
+	}
+	if definition.Validate != nil {
+		if err := definition.Validate(payload); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+		}
+	}
+
+	j := &job.Job{
+		ID:          uuid.New(),
+		Type:        jobType,
+		Payload:     string(payload),
+		MaxAttempts: definition.maxAttempts(),
+		CreatedBy:   createdBy,
+	}
+	if err := s.repo.Create(ctx, j); err != nil {
+		return nil, err
+	}
+
+	s.logger.WithContext(ctx).Info("job_service.enqueue", map[string]interface{}{
+		"id":   j.ID,
+		"type": j.Type,
+	}, "Job queued")
+
+	response := toJobResponse(j)
+	return &response, nil
+}
+
+func (s *JobService) Get(ctx context.Context, id uuid.UUID) (*dto.JobResponse, error) {
+	j, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	response := toJobResponse(j)
+	return &response, nil
+}
+
+// List returns the jobs newest first, jobType and status filter when set
+func (s *JobService) List(ctx context.Context, jobType string, status job.JobStatus, page, pageSize int) (*dto.JobPagination, error) {
+	switch status {
+	case "", job.StatusQueued, job.StatusRunning, job.StatusSucceeded, job.StatusFailed:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidStatus, status)
+	}
+	page = max(page, 1)
+	if pageSize <= 0 {
+		pageSize = constants.JobListDefaultSize
+	}
+	pageSize = min(pageSize, constants.JobListMaxSize)
+
+	jobs, total, err := s.repo.List(ctx, jobType, status, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.JobPagination{
+		Jobs:     make([]dto.JobResponse, 0, len(jobs)),
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	for _, j := range jobs {
+		result.Jobs = append(result.Jobs, toJobResponse(j))
+	}
+	return result, nil
+}
+
+func toJobResponse(j *job.Job) dto.JobResponse {
+	response := dto.JobResponse{
+		ID:          j.ID,
+		Type:        j.Type,
+		Status:      string(j.Status),
+		Payload:     json.RawMessage(j.Payload),
+		Attempts:    j.Attempts,
+		MaxAttempts: j.MaxAttempts,
+		Progress:    j.Progress,
+		CreatedBy:   j.CreatedBy,
+		CreatedAt:   j.CreatedAt,
+		RunAt:       j.RunAt,
+		StartedAt:   j.StartedAt,
+		FinishedAt:  j.FinishedAt,
+	}
+	if j.ProgressMessage != nil {
+		response.ProgressMessage = *j.ProgressMessage
+	}
+	if j.Result != nil {
+		response.Result = json.RawMessage(*j.Result)
+	}
+	if j.LastError != nil {
+		response.LastError = *j.LastError
+	}
+	return response
+}
@@ -0,0 +1,174 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fluencybe/internal/app/dto"
+	"fluencybe/internal/app/model/job"
+	adminRepo "fluencybe/internal/app/repository/admin"
+	adminSer "fluencybe/internal/app/service/admin"
+	courseSer "fluencybe/internal/app/service/course"
+	searchindexSer "fluencybe/internal/app/service/searchindex"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// SearchReindexJob rebuilds the search index of the payload skills one after the other. A skill
+// busy with a reindex started from the API is retried later.
+func SearchReindexJob(service *searchindexSer.SearchIndexService) Definition {
+	return Definition{
+		Validate: func(payload json.RawMessage) error {
+			_, err := decodeSkills(payload, service.Skills())
+			return err
+		},
+		Handler: func(ctx context.Context, j *job.Job, progress Progress) (interface{}, error) {
+			skills, err := decodeSkills(json.RawMessage(j.Payload), service.Skills())
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrPermanent, err)
+			}
+			return eachSkill(skills, progress, "Reindexing", func(skill string) (interface{}, error) {
+				return service.Reindex(ctx, skill)
+			})
+		},
+		Concurrency: 1,
+	}
+}
+
+// CacheWarmJob loads every question of the payload skills into the cache
+func CacheWarmJob(service *adminSer.AdminService) Definition {
+	return Definition{
+		Validate: func(payload json.RawMessage) error {
+			_, err := decodeSkills(payload, service.Skills())
+			return err
+		},
+		Handler: func(ctx context.Context, j *job.Job, progress Progress) (interface{}, error) {
+			skills, err := decodeSkills(json.RawMessage(j.Payload), service.Skills())
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrPermanent, err)
+			}
+			return eachSkill(skills, progress, "Warming", func(skill string) (interface{}, error) {
+				return service.WarmCache(ctx, skill)
+			})
+		},
+		Concurrency: 1,
+	}
+}
+
+// CourseBundleJob builds a new bundle of a course, the creator of the job is recorded as the
+// creator of the bundle. The result tells whether a new version was created.
+func CourseBundleJob(service *courseSer.CourseBundleService) Definition {
+	decode := func(payload json.RawMessage) (dto.CourseBundleJobPayload, error) {
+		var decoded dto.CourseBundleJobPayload
+		if err := json.Unmarshal(payload, &decoded); err != nil {
+			return decoded, err
+		}
+		if decoded.CourseID == uuid.Nil {
+			return decoded, errors.New("course_id is required")
+		}
+		return decoded, nil
+	}
+
+	return Definition{
+		Validate: func(payload json.RawMessage) error {
+			_, err := decode(payload)
+			return err
+		},
+		Handler: func(ctx context.Context, j *job.Job, progress Progress) (interface{}, error) {
+			payload, err := decode(json.RawMessage(j.Payload))
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrPermanent, err)
+			}
+
+			bundle, created, err := service.Build(ctx, payload.CourseID, j.CreatedBy)
+			if errors.Is(err, courseSer.ErrCourseNotFound) {
+				return nil, fmt.Errorf("%w: %v", ErrPermanent, err)
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			return map[string]interface{}{
+				"created": created,
+				"bundle": dto.CourseBundleResponse{
+					ID:            bundle.ID,
+					CourseID:      bundle.CourseID,
+					Version:       bundle.Version,
+					Checksum:      bundle.ArchiveChecksum,
+					SizeBytes:     bundle.SizeBytes,
+					QuestionCount: bundle.QuestionCount,
+					MediaCount:    bundle.MediaCount,
+					CreatedBy:     bundle.CreatedBy,
+					CreatedAt:     bundle.CreatedAt,
+				},
+			}, nil
+		},
+	}
+}
+
+// DataImportJob loads the payload rows in one transaction, nothing is written when a row fails
+func DataImportJob(service *adminSer.AdminService) Definition {
+	decode := func(payload json.RawMessage) (dto.DataImportJobPayload, error) {
+		var decoded dto.DataImportJobPayload
+		if err := json.Unmarshal(payload, &decoded); err != nil {
+			return decoded, err
+		}
+		if len(decoded.Rows) == 0 {
+			return decoded, errors.New("rows are required")
+		}
+		return decoded, nil
+	}
+
+	return Definition{
+		Validate: func(payload json.RawMessage) error {
+			_, err := decode(payload)
+			return err
+		},
+		Handler: func(ctx context.Context, j *job.Job, progress Progress) (interface{}, error) {
+			payload, err := decode(json.RawMessage(j.Payload))
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrPermanent, err)
+			}
+
+			result, err := service.ImportRows(ctx, payload.Rows)
+			if errors.Is(err, adminRepo.ErrUnknownTable) {
+				return nil, fmt.Errorf("%w: %v", ErrPermanent, err)
+			}
+			return result, err
+		},
+		Concurrency: 1,
+	}
+}
+
+// decodeSkills returns the skills of a SkillsJobPayload, all available skills when none is given
+func decodeSkills(payload json.RawMessage, available []string) ([]string, error) {
+	var decoded dto.SkillsJobPayload
+	if err := json.Unmarshal(payload, &decoded); err != nil {
+		return nil, err
+	}
+	if len(decoded.Skills) == 0 {
+		return available, nil
+	}
+	for _, skill := range decoded.Skills {
+		if !slices.Contains(available, skill) {
+			return nil, fmt.Errorf("unknown skill %q", skill)
+		}
+	}
+	return decoded.Skills, nil
+}
+
+// eachSkill runs fn for every skill, reporting progress as the share of skills done
+func eachSkill(skills []string, progress Progress, action string, fn func(skill string) (interface{}, error)) (interface{}, error) {
+	results := make([]interface{}, 0, len(skills))
+	for i, skill := range skills {
+		progress(i*100/len(skills), fmt.Sprintf("%s %s", action, skill))
+		result, err := fn(skill)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", skill, err)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
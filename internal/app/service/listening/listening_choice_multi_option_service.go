@@ -77,7 +77,7 @@ func (s *ListeningChoiceMultiOptionService) CreateOption(ctx context.Context, op
 
 	if err := s.repo.Create(ctx, option); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_multi_option_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_multi_option_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create option")
 		return err
@@ -100,7 +100,7 @@ func (s *ListeningChoiceMultiOptionService) CreateOption(ctx context.Context, op
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, listeningQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_multi_option_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_multi_option_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    listeningQuestion.ID,
 		}, "Failed to update cache and search")
@@ -121,7 +121,7 @@ func (s *ListeningChoiceMultiOptionService) GetOption(ctx context.Context, id uu
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, sql.ErrNoRows
 		}
-		s.logger.Error("listening_choice_multi_option_service.get", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_multi_option_service.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get option")
@@ -149,7 +149,7 @@ func (s *ListeningChoiceMultiOptionService) UpdateOption(ctx context.Context, op
 
 	if err := s.repo.Update(ctx, option); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_multi_option_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_multi_option_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    option.ID,
 		}, "Failed to update option")
@@ -173,7 +173,7 @@ func (s *ListeningChoiceMultiOptionService) UpdateOption(ctx context.Context, op
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, listeningQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_multi_option_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_multi_option_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    listeningQuestion.ID,
 		}, "Failed to update cache and search")
@@ -211,7 +211,7 @@ func (s *ListeningChoiceMultiOptionService) DeleteOption(ctx context.Context, id
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return sql.ErrNoRows
 		}
-		s.logger.Error("listening_choice_multi_option_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_multi_option_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete option")
@@ -235,7 +235,7 @@ func (s *ListeningChoiceMultiOptionService) DeleteOption(ctx context.Context, id
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, listeningQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_multi_option_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_multi_option_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    listeningQuestion.ID,
 		}, "Failed to update cache and search")
@@ -253,7 +253,7 @@ func (s *ListeningChoiceMultiOptionService) DeleteOption(ctx context.Context, id
 func (s *ListeningChoiceMultiOptionService) GetOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]*listening.ListeningChoiceMultiOption, error) {
 	options, err := s.repo.GetByListeningChoiceMultiQuestionID(ctx, questionID)
 	if err != nil {
-		s.logger.Error("listening_choice_multi_option_service.get_by_question_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_multi_option_service.get_by_question_id", map[string]interface{}{
 			"error":       err.Error(),
 			"question_id": questionID,
 		}, "Failed to get options by question ID")
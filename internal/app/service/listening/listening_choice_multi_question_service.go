@@ -75,7 +75,7 @@ func (s *ListeningChoiceMultiQuestionService) CreateQuestion(ctx context.Context
 
 	if err := s.repo.Create(ctx, question); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_multi_question_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_multi_question_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create question")
 		return err
@@ -85,7 +85,7 @@ func (s *ListeningChoiceMultiQuestionService) CreateQuestion(ctx context.Context
 	parentQuestion, err := s.listeningQuestionRepo.GetListeningQuestionByID(ctx, question.ListeningQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_multi_question_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_multi_question_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ListeningQuestionID,
 		}, "Failed to get parent question")
@@ -95,7 +95,7 @@ func (s *ListeningChoiceMultiQuestionService) CreateQuestion(ctx context.Context
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_multi_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_multi_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -116,7 +116,7 @@ func (s *ListeningChoiceMultiQuestionService) GetQuestion(ctx context.Context, i
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, sql.ErrNoRows
 		}
-		s.logger.Error("listening_choice_multi_question_service.get", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_multi_question_service.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get question")
@@ -143,7 +143,7 @@ func (s *ListeningChoiceMultiQuestionService) UpdateQuestion(ctx context.Context
 
 	if err := s.repo.Update(ctx, question); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_multi_question_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_multi_question_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to update question")
@@ -154,7 +154,7 @@ func (s *ListeningChoiceMultiQuestionService) UpdateQuestion(ctx context.Context
 	parentQuestion, err := s.listeningQuestionRepo.GetListeningQuestionByID(ctx, question.ListeningQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_multi_question_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_multi_question_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ListeningQuestionID,
 		}, "Failed to get parent question")
@@ -164,7 +164,7 @@ func (s *ListeningChoiceMultiQuestionService) UpdateQuestion(ctx context.Context
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_multi_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_multi_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -199,7 +199,7 @@ func (s *ListeningChoiceMultiQuestionService) DeleteQuestion(ctx context.Context
 
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_multi_question_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_multi_question_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete question")
@@ -210,7 +210,7 @@ func (s *ListeningChoiceMultiQuestionService) DeleteQuestion(ctx context.Context
 	parentQuestion, err := s.listeningQuestionRepo.GetListeningQuestionByID(ctx, question.ListeningQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_multi_question_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_multi_question_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ListeningQuestionID,
 		}, "Failed to get parent question")
@@ -220,7 +220,7 @@ func (s *ListeningChoiceMultiQuestionService) DeleteQuestion(ctx context.Context
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_multi_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_multi_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -238,7 +238,7 @@ func (s *ListeningChoiceMultiQuestionService) DeleteQuestion(ctx context.Context
 func (s *ListeningChoiceMultiQuestionService) GetQuestionsByListeningQuestionID(ctx context.Context, listeningQuestionID uuid.UUID) ([]*listening.ListeningChoiceMultiQuestion, error) {
 	questions, err := s.repo.GetByListeningQuestionID(ctx, listeningQuestionID)
 	if err != nil {
-		s.logger.Error("listening_choice_multi_question_service.get_by_listening_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_multi_question_service.get_by_listening_id", map[string]interface{}{
 			"error":                 err.Error(),
 			"listening_question_id": listeningQuestionID,
 		}, "Failed to get questions by listening question ID")
@@ -63,7 +63,7 @@ func (s *ListeningChoiceOneOptionService) CreateOption(ctx context.Context, opti
 		existingCorrectOption, err := s.repo.GetCorrectOption(ctx, option.ListeningChoiceOneQuestionID)
 		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 			tx.Rollback()
-			s.logger.Error("listening_choice_one_option_service.create", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("listening_choice_one_option_service.create", map[string]interface{}{
 				"error": err.Error(),
 			}, "Failed to check existing correct option")
 			return err
@@ -74,7 +74,7 @@ func (s *ListeningChoiceOneOptionService) CreateOption(ctx context.Context, opti
 			existingCorrectOption.IsCorrect = false
 			if err := s.repo.Update(ctx, existingCorrectOption); err != nil {
 				tx.Rollback()
-				s.logger.Error("listening_choice_one_option_service.create", map[string]interface{}{
+				s.logger.WithContext(ctx).Error("listening_choice_one_option_service.create", map[string]interface{}{
 					"error": err.Error(),
 				}, "Failed to update existing correct option")
 				return err
@@ -84,7 +84,7 @@ func (s *ListeningChoiceOneOptionService) CreateOption(ctx context.Context, opti
 
 	if err := s.repo.Create(ctx, option); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_one_option_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_one_option_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create option")
 		return err
@@ -107,7 +107,7 @@ func (s *ListeningChoiceOneOptionService) CreateOption(ctx context.Context, opti
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, listeningQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_one_option_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_one_option_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    listeningQuestion.ID,
 		}, "Failed to update cache and search")
@@ -128,7 +128,7 @@ func (s *ListeningChoiceOneOptionService) GetOption(ctx context.Context, id uuid
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, sql.ErrNoRows
 		}
-		s.logger.Error("listening_choice_one_option_service.get", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_one_option_service.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get option")
@@ -155,7 +155,7 @@ func (s *ListeningChoiceOneOptionService) UpdateOption(ctx context.Context, opti
 		existingCorrectOption, err := s.repo.GetCorrectOption(ctx, option.ListeningChoiceOneQuestionID)
 		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 			tx.Rollback()
-			s.logger.Error("listening_choice_one_option_service.update", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("listening_choice_one_option_service.update", map[string]interface{}{
 				"error": err.Error(),
 			}, "Failed to check existing correct option")
 			return err
@@ -166,7 +166,7 @@ func (s *ListeningChoiceOneOptionService) UpdateOption(ctx context.Context, opti
 			existingCorrectOption.IsCorrect = false
 			if err := s.repo.Update(ctx, existingCorrectOption); err != nil {
 				tx.Rollback()
-				s.logger.Error("listening_choice_one_option_service.update", map[string]interface{}{
+				s.logger.WithContext(ctx).Error("listening_choice_one_option_service.update", map[string]interface{}{
 					"error": err.Error(),
 				}, "Failed to update existing correct option")
 				return err
@@ -176,7 +176,7 @@ func (s *ListeningChoiceOneOptionService) UpdateOption(ctx context.Context, opti
 
 	if err := s.repo.Update(ctx, option); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_one_option_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_one_option_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    option.ID,
 		}, "Failed to update option")
@@ -200,7 +200,7 @@ func (s *ListeningChoiceOneOptionService) UpdateOption(ctx context.Context, opti
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, listeningQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_one_option_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_one_option_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    listeningQuestion.ID,
 		}, "Failed to update cache and search")
@@ -238,7 +238,7 @@ func (s *ListeningChoiceOneOptionService) DeleteOption(ctx context.Context, id u
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return sql.ErrNoRows
 		}
-		s.logger.Error("listening_choice_one_option_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_one_option_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete option")
@@ -262,7 +262,7 @@ func (s *ListeningChoiceOneOptionService) DeleteOption(ctx context.Context, id u
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, listeningQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_one_option_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_one_option_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    listeningQuestion.ID,
 		}, "Failed to update cache and search")
@@ -281,7 +281,7 @@ func (s *ListeningChoiceOneOptionService) GetOptionsByQuestionID(ctx context.Con
 	// Get directly from DB
 	options, err := s.repo.GetByQuestionID(ctx, questionID)
 	if err != nil {
-		s.logger.Error("listening_choice_one_option_service.get_by_question_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_one_option_service.get_by_question_id", map[string]interface{}{
 			"error":       err.Error(),
 			"question_id": questionID,
 		}, "Failed to get options by question ID")
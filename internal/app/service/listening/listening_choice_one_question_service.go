@@ -73,7 +73,7 @@ func (s *ListeningChoiceOneQuestionService) CreateQuestion(ctx context.Context,
 
 	if err := s.repo.Create(ctx, question); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_one_question_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_one_question_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create question")
 		return err
@@ -83,7 +83,7 @@ func (s *ListeningChoiceOneQuestionService) CreateQuestion(ctx context.Context,
 	parentQuestion, err := s.listeningQuestionRepo.GetListeningQuestionByID(ctx, question.ListeningQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_one_question_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_one_question_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ListeningQuestionID,
 		}, "Failed to get parent question")
@@ -93,7 +93,7 @@ func (s *ListeningChoiceOneQuestionService) CreateQuestion(ctx context.Context,
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_one_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_one_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -114,7 +114,7 @@ func (s *ListeningChoiceOneQuestionService) GetQuestion(ctx context.Context, id
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, sql.ErrNoRows
 		}
-		s.logger.Error("listening_choice_one_question_service.get", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_one_question_service.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get question")
@@ -142,7 +142,7 @@ func (s *ListeningChoiceOneQuestionService) UpdateQuestion(ctx context.Context,
 
 	if err := s.repo.Update(ctx, question); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_one_question_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_one_question_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to update question")
@@ -153,7 +153,7 @@ func (s *ListeningChoiceOneQuestionService) UpdateQuestion(ctx context.Context,
 	parentQuestion, err := s.listeningQuestionRepo.GetListeningQuestionByID(ctx, question.ListeningQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_one_question_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_one_question_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ListeningQuestionID,
 		}, "Failed to get parent question")
@@ -163,7 +163,7 @@ func (s *ListeningChoiceOneQuestionService) UpdateQuestion(ctx context.Context,
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_one_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_one_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -202,7 +202,7 @@ func (s *ListeningChoiceOneQuestionService) DeleteQuestion(ctx context.Context,
 
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_one_question_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_one_question_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete question")
@@ -213,7 +213,7 @@ func (s *ListeningChoiceOneQuestionService) DeleteQuestion(ctx context.Context,
 	parentQuestion, err := s.listeningQuestionRepo.GetListeningQuestionByID(ctx, question.ListeningQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_one_question_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_one_question_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ListeningQuestionID,
 		}, "Failed to get parent question")
@@ -223,7 +223,7 @@ func (s *ListeningChoiceOneQuestionService) DeleteQuestion(ctx context.Context,
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_choice_one_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_one_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -241,7 +241,7 @@ func (s *ListeningChoiceOneQuestionService) DeleteQuestion(ctx context.Context,
 func (s *ListeningChoiceOneQuestionService) GetQuestionsByListeningQuestionID(ctx context.Context, listeningQuestionID uuid.UUID) ([]*listening.ListeningChoiceOneQuestion, error) {
 	questions, err := s.repo.GetByListeningQuestionID(ctx, listeningQuestionID)
 	if err != nil {
-		s.logger.Error("listening_choice_one_question_service.get_by_listening_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_choice_one_question_service.get_by_listening_id", map[string]interface{}{
 			"error":                 err.Error(),
 			"listening_question_id": listeningQuestionID,
 		}, "Failed to get questions by listening question ID")
@@ -75,7 +75,7 @@ func (s *ListeningFillInTheBlankAnswerService) CreateAnswer(ctx context.Context,
 
 	// Create answer in database
 	if err := s.repo.Create(ctx, answer); err != nil {
-		s.logger.Error("listening_fill_in_the_blank_answer_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_fill_in_the_blank_answer_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create answer")
 		return err
@@ -84,7 +84,7 @@ func (s *ListeningFillInTheBlankAnswerService) CreateAnswer(ctx context.Context,
 	// Get parent listening question for cache/search update
 	parentQuestion, err := s.listeningQuestionRepo.GetListeningQuestionByID(ctx, fillInBlankQuestion.ListeningQuestionID)
 	if err != nil {
-		s.logger.Error("listening_fill_in_the_blank_answer_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_fill_in_the_blank_answer_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    fillInBlankQuestion.ListeningQuestionID,
 		}, "Failed to get parent listening question")
@@ -93,7 +93,7 @@ func (s *ListeningFillInTheBlankAnswerService) CreateAnswer(ctx context.Context,
 
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
-		s.logger.Error("listening_fill_in_the_blank_answer_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_fill_in_the_blank_answer_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -108,7 +108,7 @@ func (s *ListeningFillInTheBlankAnswerService) GetAnswer(ctx context.Context, id
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, sql.ErrNoRows
 		}
-		s.logger.Error("listening_fill_in_the_blank_answer_service.get", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_fill_in_the_blank_answer_service.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get answer")
@@ -130,7 +130,7 @@ func (s *ListeningFillInTheBlankAnswerService) UpdateAnswer(ctx context.Context,
 
 	// Update answer in database
 	if err := s.repo.Update(ctx, answer); err != nil {
-		s.logger.Error("listening_fill_in_the_blank_answer_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_fill_in_the_blank_answer_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    answer.ID,
 		}, "Failed to update answer")
@@ -140,7 +140,7 @@ func (s *ListeningFillInTheBlankAnswerService) UpdateAnswer(ctx context.Context,
 	// Get parent listening question for cache/search update
 	parentQuestion, err := s.listeningQuestionRepo.GetListeningQuestionByID(ctx, fillInBlankQuestion.ListeningQuestionID)
 	if err != nil {
-		s.logger.Error("listening_fill_in_the_blank_answer_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_fill_in_the_blank_answer_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    fillInBlankQuestion.ListeningQuestionID,
 		}, "Failed to get parent listening question")
@@ -149,7 +149,7 @@ func (s *ListeningFillInTheBlankAnswerService) UpdateAnswer(ctx context.Context,
 
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
-		s.logger.Error("listening_fill_in_the_blank_answer_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_fill_in_the_blank_answer_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -185,7 +185,7 @@ func (s *ListeningFillInTheBlankAnswerService) DeleteAnswer(ctx context.Context,
 	// Delete from database
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_fill_in_the_blank_answer_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_fill_in_the_blank_answer_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete answer")
@@ -197,7 +197,7 @@ func (s *ListeningFillInTheBlankAnswerService) DeleteAnswer(ctx context.Context,
 	parentQuestion, err := s.listeningQuestionRepo.GetListeningQuestionByID(ctx, fillInBlankQuestion.ListeningQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_fill_in_the_blank_answer_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_fill_in_the_blank_answer_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    fillInBlankQuestion.ListeningQuestionID,
 		}, "Failed to get parent listening question")
@@ -207,7 +207,7 @@ func (s *ListeningFillInTheBlankAnswerService) DeleteAnswer(ctx context.Context,
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_fill_in_the_blank_answer_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_fill_in_the_blank_answer_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -225,7 +225,7 @@ func (s *ListeningFillInTheBlankAnswerService) DeleteAnswer(ctx context.Context,
 func (s *ListeningFillInTheBlankAnswerService) GetAnswersByListeningFillInTheBlankQuestionID(ctx context.Context, questionID uuid.UUID) ([]*listening.ListeningFillInTheBlankAnswer, error) {
 	answers, err := s.repo.GetByListeningFillInTheBlankQuestionID(ctx, questionID)
 	if err != nil {
-		s.logger.Error("listening_fill_in_the_blank_answer_service.get_by_question", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_fill_in_the_blank_answer_service.get_by_question", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get answers")
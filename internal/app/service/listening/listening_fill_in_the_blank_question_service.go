@@ -86,7 +86,7 @@ func (s *ListeningFillInTheBlankQuestionService) CreateQuestion(ctx context.Cont
 		if errors.Is(err, ErrDuplicateQuestion) {
 			return ErrDuplicateQuestion
 		}
-		s.logger.Error("listening_fill_in_the_blank_question_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_fill_in_the_blank_question_service.create", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to create question")
@@ -97,7 +97,7 @@ func (s *ListeningFillInTheBlankQuestionService) CreateQuestion(ctx context.Cont
 	parentQuestion, err := s.listeningQuestionRepo.GetListeningQuestionByID(ctx, question.ListeningQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_fill_in_the_blank_question_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_fill_in_the_blank_question_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ListeningQuestionID,
 		}, "Failed to get parent question")
@@ -107,7 +107,7 @@ func (s *ListeningFillInTheBlankQuestionService) CreateQuestion(ctx context.Cont
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_fill_in_the_blank_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_fill_in_the_blank_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -127,7 +127,7 @@ func (s *ListeningFillInTheBlankQuestionService) GetQuestion(ctx context.Context
 	// Get directly from database without caching
 	question, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		s.logger.Error("listening_fill_in_the_blank_question_service.get", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_fill_in_the_blank_question_service.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get question")
@@ -156,7 +156,7 @@ func (s *ListeningFillInTheBlankQuestionService) UpdateQuestion(ctx context.Cont
 	// Update in database
 	if err := s.repo.Update(ctx, question); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_fill_in_the_blank_question_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_fill_in_the_blank_question_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to update question")
@@ -167,7 +167,7 @@ func (s *ListeningFillInTheBlankQuestionService) UpdateQuestion(ctx context.Cont
 	parentQuestion, err := s.listeningQuestionRepo.GetListeningQuestionByID(ctx, question.ListeningQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_fill_in_the_blank_question_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_fill_in_the_blank_question_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ListeningQuestionID,
 		}, "Failed to get parent question")
@@ -177,7 +177,7 @@ func (s *ListeningFillInTheBlankQuestionService) UpdateQuestion(ctx context.Cont
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_fill_in_the_blank_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_fill_in_the_blank_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -204,7 +204,7 @@ func (s *ListeningFillInTheBlankQuestionService) GetQuestionsByListeningQuestion
 	if err == nil {
 		var questions []*listening.ListeningFillInTheBlankQuestion
 		if err := json.Unmarshal([]byte(cachedData), &questions); err == nil {
-			s.logger.Info("get_by_listening_question.cache", map[string]interface{}{
+			s.logger.WithContext(ctx).Info("get_by_listening_question.cache", map[string]interface{}{
 				"id":           listeningQuestionID,
 				"cache_status": "hit",
 			}, "Cache hit for fill in blank questions")
@@ -215,14 +215,14 @@ func (s *ListeningFillInTheBlankQuestionService) GetQuestionsByListeningQuestion
 	// If not in cache or error, get from DB
 	questions, err := s.repo.GetByListeningQuestionID(ctx, listeningQuestionID)
 	if err != nil {
-		s.logger.Error("get_by_listening_question", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("get_by_listening_question", map[string]interface{}{
 			"error": err.Error(),
 			"id":    listeningQuestionID,
 		}, "Failed to get questions")
 		return nil, err
 	}
 
-	s.logger.Debug("get_by_listening_question.db", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("get_by_listening_question.db", map[string]interface{}{
 		"id":    listeningQuestionID,
 		"count": len(questions),
 	}, "Retrieved questions from database")
@@ -251,7 +251,7 @@ func (s *ListeningFillInTheBlankQuestionService) DeleteQuestion(ctx context.Cont
 	// Delete from database
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_fill_in_the_blank_question_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_fill_in_the_blank_question_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete question")
@@ -262,7 +262,7 @@ func (s *ListeningFillInTheBlankQuestionService) DeleteQuestion(ctx context.Cont
 	parentQuestion, err := s.listeningQuestionRepo.GetListeningQuestionByID(ctx, question.ListeningQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_fill_in_the_blank_question_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_fill_in_the_blank_question_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ListeningQuestionID,
 		}, "Failed to get parent question")
@@ -272,7 +272,7 @@ func (s *ListeningFillInTheBlankQuestionService) DeleteQuestion(ctx context.Cont
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_fill_in_the_blank_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_fill_in_the_blank_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
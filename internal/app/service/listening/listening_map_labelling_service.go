@@ -63,7 +63,7 @@ func (s *ListeningMapLabellingService) validateMapLabelling(qa *listening.Listen
 func (s *ListeningMapLabellingService) GetByID(ctx context.Context, id uuid.UUID) (*listening.ListeningMapLabelling, error) {
 	qa, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		s.logger.Error("listening_map_labelling_service.get_by_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_map_labelling_service.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get map labelling by ID")
@@ -75,7 +75,7 @@ func (s *ListeningMapLabellingService) GetByID(ctx context.Context, id uuid.UUID
 func (s *ListeningMapLabellingService) GetByListeningQuestionID(ctx context.Context, listeningQuestionID uuid.UUID) ([]*listening.ListeningMapLabelling, error) {
 	qas, err := s.repo.GetByListeningQuestionID(ctx, listeningQuestionID)
 	if err != nil {
-		s.logger.Error("listening_map_labelling_service.get_by_listening_question_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_map_labelling_service.get_by_listening_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    listeningQuestionID,
 		}, "Failed to get map labellings")
@@ -103,7 +103,7 @@ func (s *ListeningMapLabellingService) Create(ctx context.Context, qa *listening
 	// Create QA
 	if err := s.repo.Create(ctx, qa); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_map_labelling_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_map_labelling_service.create", map[string]interface{}{
 			"error": err.Error(),
 			"id":    qa.ID,
 		}, "Failed to create map labelling")
@@ -120,7 +120,7 @@ func (s *ListeningMapLabellingService) Create(ctx context.Context, qa *listening
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, listeningQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_map_labelling_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_map_labelling_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    listeningQuestion.ID,
 		}, "Failed to update cache and search")
@@ -154,7 +154,7 @@ func (s *ListeningMapLabellingService) Update(ctx context.Context, qa *listening
 	// Update QA
 	if err := s.repo.Update(ctx, qa); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_map_labelling_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_map_labelling_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    qa.ID,
 		}, "Failed to update map labelling")
@@ -171,7 +171,7 @@ func (s *ListeningMapLabellingService) Update(ctx context.Context, qa *listening
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, listeningQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_map_labelling_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_map_labelling_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    listeningQuestion.ID,
 		}, "Failed to update cache and search")
@@ -207,7 +207,7 @@ func (s *ListeningMapLabellingService) Delete(ctx context.Context, id uuid.UUID)
 	// Delete QA
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_map_labelling_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_map_labelling_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete map labelling")
@@ -224,7 +224,7 @@ func (s *ListeningMapLabellingService) Delete(ctx context.Context, id uuid.UUID)
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, listeningQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_map_labelling_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_map_labelling_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    listeningQuestion.ID,
 		}, "Failed to update cache and search")
@@ -63,7 +63,7 @@ func (s *ListeningMatchingService) validateMatching(matching *listening.Listenin
 func (s *ListeningMatchingService) GetByID(ctx context.Context, id uuid.UUID) (*listening.ListeningMatching, error) {
 	matching, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		s.logger.Error("listening_matching_service.get_by_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_matching_service.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get matching by ID")
@@ -75,7 +75,7 @@ func (s *ListeningMatchingService) GetByID(ctx context.Context, id uuid.UUID) (*
 func (s *ListeningMatchingService) GetByListeningQuestionID(ctx context.Context, listeningQuestionID uuid.UUID) ([]*listening.ListeningMatching, error) {
 	matchings, err := s.repo.GetByListeningQuestionID(ctx, listeningQuestionID)
 	if err != nil {
-		s.logger.Error("listening_matching_service.get_by_listening_question_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_matching_service.get_by_listening_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    listeningQuestionID,
 		}, "Failed to get matchings")
@@ -103,7 +103,7 @@ func (s *ListeningMatchingService) Create(ctx context.Context, matching *listeni
 	// Create matching
 	if err := s.repo.Create(ctx, matching); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_matching_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_matching_service.create", map[string]interface{}{
 			"error": err.Error(),
 			"id":    matching.ID,
 		}, "Failed to create matching")
@@ -120,7 +120,7 @@ func (s *ListeningMatchingService) Create(ctx context.Context, matching *listeni
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, listeningQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_matching_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_matching_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    listeningQuestion.ID,
 		}, "Failed to update cache and search")
@@ -154,7 +154,7 @@ func (s *ListeningMatchingService) Update(ctx context.Context, matching *listeni
 	// Update matching
 	if err := s.repo.Update(ctx, matching); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_matching_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_matching_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    matching.ID,
 		}, "Failed to update matching")
@@ -171,7 +171,7 @@ func (s *ListeningMatchingService) Update(ctx context.Context, matching *listeni
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, listeningQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_matching_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_matching_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    listeningQuestion.ID,
 		}, "Failed to update cache and search")
@@ -207,7 +207,7 @@ func (s *ListeningMatchingService) Delete(ctx context.Context, id uuid.UUID) err
 	// Delete matching
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_matching_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_matching_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete matching")
@@ -224,7 +224,7 @@ func (s *ListeningMatchingService) Delete(ctx context.Context, id uuid.UUID) err
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, listeningQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("listening_matching_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_matching_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    listeningQuestion.ID,
 		}, "Failed to update cache and search")
@@ -0,0 +1,67 @@
+package listening
+
+import (
+	"context"
+	listeningDTO "fluencybe/internal/app/dto"
+	searchRepo "fluencybe/internal/app/repository/search"
+	searchService "fluencybe/internal/app/service/search"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// ListeningQuestionDatabaseSearch answers search filters from Postgres with the same clauses
+// ListeningQuestionSearch sends to OpenSearch, it is used while OpenSearch is unavailable
+type ListeningQuestionDatabaseSearch struct {
+	repo *searchRepo.QuestionFullTextRepository
+	load func(ctx context.Context, ids []uuid.UUID) ([]*listeningDTO.ListeningQuestionDetail, error)
+}
+
+func NewListeningQuestionDatabaseSearch(
+	repo *searchRepo.QuestionFullTextRepository,
+	load func(ctx context.Context, ids []uuid.UUID) ([]*listeningDTO.ListeningQuestionDetail, error),
+) *ListeningQuestionDatabaseSearch {
+	return &ListeningQuestionDatabaseSearch{
+		repo: repo,
+		load: load,
+	}
+}
+
+func (s *ListeningQuestionDatabaseSearch) SearchQuestions(ctx context.Context, filter listeningDTO.ListeningQuestionSearchFilter) (*listeningDTO.ListListeningQuestionsPagination, error) {
+	ids, total, err := s.repo.Search(ctx, "listening", searchRepo.QuestionSearchQuery{
+		Type:  filter.Type,
+		Topic: filter.Topic,
+		Matches: []searchRepo.TextMatch{
+			{Columns: []string{"instruction"}, Text: filter.Instruction},
+			{Columns: []string{"transcript"}, Text: filter.Transcript},
+		},
+		ArrayTerms: map[string]string{
+			"audio_urls": filter.AudioURLs,
+			"image_urls": filter.ImageURLs,
+		},
+		MaxTime:    filter.MaxTime,
+		Metadata:   filter.Metadata,
+		ChildText:  filter.ChildText,
+		HasChild:   filter.HasChild,
+		MinOptions: filter.MinOptions,
+		Page:       filter.Page,
+		PageSize:   filter.PageSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute database search: %w", err)
+	}
+
+	questions, err := s.load(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load questions: %w", err)
+	}
+
+	return &listeningDTO.ListListeningQuestionsPagination{
+		Questions: searchService.InIDOrder(ids, questions, func(q *listeningDTO.ListeningQuestionDetail) uuid.UUID {
+			return q.ID
+		}),
+		Total:    total,
+		Page:     filter.Page,
+		PageSize: filter.PageSize,
+	}, nil
+}
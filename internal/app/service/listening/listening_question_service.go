@@ -1,7 +1,6 @@
 package listening
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -11,18 +10,17 @@ import (
 	searchClient "fluencybe/internal/app/opensearch"
 	redisClient "fluencybe/internal/app/redis"
 	ListeningRepository "fluencybe/internal/app/repository/listening"
+	searchRepo "fluencybe/internal/app/repository/search"
+	searchService "fluencybe/internal/app/service/search"
 	listeningValidator "fluencybe/internal/app/validator"
 	"fluencybe/pkg/cache"
 	"fluencybe/pkg/logger"
 	"fmt"
-	"io/ioutil"
-	"strconv"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/opensearch-project/opensearch-go/v2"
-	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
 )
 
 var (
@@ -35,9 +33,12 @@ type ListeningQuestionService struct {
 	logger                            *logger.PrettyLogger
 	redis                             *redisClient.ListeningQuestionRedis
 	search                            *searchClient.ListeningQuestionSearch
+	searcher                          searchService.QuestionSearcher[listeningDTO.ListeningQuestionSearchFilter, *listeningDTO.ListListeningQuestionsPagination]
+	similarity                        *searchService.QuestionSimilarityService
 	completion                        *listeningHelper.ListeningQuestionCompletionHelper
 	updater                           *listeningHelper.ListeningQuestionFieldUpdater
 	questionUpdator                   *listeningHelper.ListeningQuestionUpdator
+	warmup                            redisClient.CacheWarmup
 	fillInBlankQuestionService        *ListeningFillInTheBlankQuestionService
 	fillInBlankAnswerService          *ListeningFillInTheBlankAnswerService
 	choiceOneQuestionService          *ListeningChoiceOneQuestionService
@@ -64,11 +65,12 @@ func NewListeningQuestionService(
 	questionUpdator *listeningHelper.ListeningQuestionUpdator,
 
 ) *ListeningQuestionService {
-	return &ListeningQuestionService{
+	s := &ListeningQuestionService{
 		repo:                              repo,
 		logger:                            logger,
 		redis:                             redisClient.NewListeningQuestionRedis(cache, logger),
 		search:                            searchClient.NewListeningQuestionSearch(openSearch, logger),
+		similarity:                        searchService.NewQuestionSimilarityService(searchClient.NewQuestionSimilarity(openSearch, logger), logger),
 		completion:                        listeningHelper.NewListeningQuestionCompletionHelper(logger),
 		updater:                           listeningHelper.NewListeningQuestionFieldUpdater(logger),
 		fillInBlankQuestionService:        fillInBlankQuestionService,
@@ -81,25 +83,33 @@ func NewListeningQuestionService(
 		matchingQuestionAnswerService:     matchingQuestionAnswerService,
 		questionUpdator:                   questionUpdator,
 	}
+	s.searcher = searchService.NewFallbackSearcher[listeningDTO.ListeningQuestionSearchFilter, *listeningDTO.ListListeningQuestionsPagination](
+		"listening",
+		s.search,
+		NewListeningQuestionDatabaseSearch(searchRepo.NewQuestionFullTextRepository(repo.GetDB(), logger), s.GetListeningByListID),
+		logger,
+	)
+	return s
 }
 
-func (s *ListeningQuestionService) CreateQuestion(ctx context.Context, question *listening.ListeningQuestion) error {
+// CreateQuestion stores a new question and returns the existing questions it nearly duplicates
+func (s *ListeningQuestionService) CreateQuestion(ctx context.Context, question *listening.ListeningQuestion) ([]listeningDTO.SimilarQuestion, error) {
 	if question == nil {
-		return ErrInvalidInput
+		return nil, ErrInvalidInput
 	}
 
 	// Validate question
 	if err := listeningValidator.ValidateListeningQuestion(question); err != nil {
-		return fmt.Errorf("validation error: %w", err)
+		return nil, fmt.Errorf("validation error: %w", err)
 	}
 
 	// Create in database
 	if err := s.repo.CreateListeningQuestion(ctx, question); err != nil {
-		s.logger.Error("listening_question_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_question_service.create", map[string]interface{}{
 			"error":         err.Error(),
 			"question_type": question.Type,
 		}, "Failed to create listening question")
-		return err
+		return nil, err
 	}
 
 	questionDetail := listeningDTO.ListeningQuestionDetail{
@@ -124,67 +134,59 @@ func (s *ListeningQuestionService) CreateQuestion(ctx context.Context, question
 	// Index to OpenSearch with correct status
 	status := map[bool]string{true: "complete", false: "uncomplete"}[false]
 	if err := s.search.UpsertListeningQuestion(ctx, &questionDetail, status); err != nil {
-		s.logger.Error("listening_question_service.create.opensearch", map[string]interface{}{
+		// The question is committed, the outbox relay indexes it once OpenSearch is back
+		s.logger.WithContext(ctx).Warning("listening_question_service.create.opensearch", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
-		}, "Failed to index question in OpenSearch")
-		return err
+		}, "Failed to index question in OpenSearch, leaving it to the outbox relay")
 	}
 
-	return nil
+	// Near-duplicates only warn the author, the question is created either way
+	return s.similarity.NearDuplicates(ctx, "listening", question.ID, question.Instruction+"\n"+question.Transcript), nil
 }
 
 func (s *ListeningQuestionService) GetListeningQuestionDetail(ctx context.Context, id uuid.UUID) (*listeningDTO.ListeningQuestionDetail, error) {
 	start := time.Now()
 	defer func() {
-		s.logger.Info("listening_question_service.get.timing", map[string]interface{}{
+		s.logger.WithContext(ctx).Info("listening_question_service.get.timing", map[string]interface{}{
 			"id":          id,
 			"duration_ms": time.Since(start).Milliseconds(),
 		}, "Question retrieval timing")
 	}()
 
-	// Try to get from cache with both complete and uncomplete status
-	pattern := fmt.Sprintf("listening_question:%s:*", id)
-	keys, err := s.redis.GetCache().Keys(ctx, pattern)
-	if err == nil && len(keys) > 0 {
-		// Found in cache, try to get the data
-		cachedData, err := s.redis.GetCache().Get(ctx, keys[0])
-		if err == nil {
-			var response listeningDTO.ListeningQuestionDetail
-			if err := json.Unmarshal([]byte(cachedData), &response); err == nil {
-				return &response, nil
-			}
+	response, err := s.redis.GetListeningQuestionDetail(ctx, id, func(ctx context.Context) (*listeningDTO.ListeningQuestionDetail, bool, error) {
+		question, err := s.repo.GetListeningQuestionByID(ctx, id)
+		if errors.Is(err, ListeningRepository.ErrQuestionNotFound) {
+			return nil, false, nil
+		}
+		if err != nil {
+			s.logger.WithContext(ctx).Error("listening_question_service.get", map[string]interface{}{
+				"error": err.Error(),
+				"id":    id,
+			}, "Failed to get listening question")
+			return nil, false, err
 		}
-	}
 
-	// If not in cache or error, get from database
-	question, err := s.repo.GetListeningQuestionByID(ctx, id)
-	if err != nil {
-		s.logger.Error("listening_question_service.get", map[string]interface{}{
-			"error": err.Error(),
-			"id":    id,
-		}, "Failed to get listening question")
-		return nil, err
-	}
+		response, err := s.getListeningQuestionDetail(ctx, question)
+		if err != nil {
+			s.logger.WithContext(ctx).Error("listening_question_service.get.detail", map[string]interface{}{
+				"error": err.Error(),
+				"id":    id,
+			}, "Failed to get question details")
+			return nil, false, err
+		}
 
-	response, err := s.getListeningQuestionDetail(ctx, question)
+		return response, s.completion.IsQuestionComplete(response), nil
+	})
 	if err != nil {
-		s.logger.Error("listening_question_service.get.detail", map[string]interface{}{
-			"error": err.Error(),
-			"id":    id,
-		}, "Failed to get question details")
 		return nil, err
 	}
+	if response == nil {
+		return nil, ErrQuestionNotFound
+	}
 
-	// Check completion status
-	isComplete := s.completion.IsQuestionComplete(response)
-
-	// Cache with status and version
-	if err := s.redis.SetCacheListeningQuestionDetail(ctx, response, isComplete); err != nil {
-		s.logger.Error("listening_question_service.get.cache", map[string]interface{}{
-			"error": err.Error(),
-			"id":    id,
-		}, "Failed to cache question detail")
+	if s.warmup != nil {
+		s.warmup.RecordRead("listening", id)
 	}
 
 	return response, nil
@@ -211,7 +213,7 @@ func (s *ListeningQuestionService) UpdateQuestion(ctx context.Context, id uuid.U
 
 	// Update in database
 	if err := s.repo.UpdateListeningQuestion(ctx, baseQuestion); err != nil {
-		s.logger.Error("listening_question_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_question_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    baseQuestion.ID,
 		}, "Failed to update question in database")
@@ -220,7 +222,7 @@ func (s *ListeningQuestionService) UpdateQuestion(ctx context.Context, id uuid.U
 
 	// Use the updator to update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, baseQuestion); err != nil {
-		s.logger.Error("listening_question_service.update.cache_and_search", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_question_service.update.cache_and_search", map[string]interface{}{
 			"error": err.Error(),
 			"id":    baseQuestion.ID,
 		}, "Failed to update cache and search")
@@ -270,7 +272,7 @@ func (s *ListeningQuestionService) GetNewUpdatedQuestions(ctx context.Context, v
 		Order("created_at DESC")
 
 	if err := query.Find(&questions).Error; err != nil {
-		s.logger.Error("listening_question_service.get_new_listening_questions", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_question_service.get_new_listening_questions", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get updated questions")
 		return nil, fmt.Errorf("failed to get updated questions: %w", err)
@@ -324,27 +326,27 @@ func (s *ListeningQuestionService) getListeningQuestionDetail(ctx context.Contex
 
 func (s *ListeningQuestionService) loadFillInTheBlankData(ctx context.Context, questionID uuid.UUID, response *listeningDTO.ListeningQuestionDetail) error {
 	// Add debug logging
-	s.logger.Debug("loadFillInTheBlankData.start", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("loadFillInTheBlankData.start", map[string]interface{}{
 		"questionID": questionID,
 		"type":       response.Type,
 	}, "Starting to load fill in blank data")
 
 	questions, err := s.fillInBlankQuestionService.GetQuestionsByListeningQuestionID(ctx, questionID)
 	if err != nil {
-		s.logger.Error("loadFillInTheBlankData.get_questions", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("loadFillInTheBlankData.get_questions", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get fill in blank questions")
 		return fmt.Errorf("failed to get fill in blank questions: %w", err)
 	}
 
-	s.logger.Debug("loadFillInTheBlankData.questions", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("loadFillInTheBlankData.questions", map[string]interface{}{
 		"questionCount": len(questions),
 		"questionID":    questionID,
 	}, "Retrieved fill in blank questions")
 
 	if len(questions) == 0 {
-		s.logger.Warning("loadFillInTheBlankData.no_questions", map[string]interface{}{
+		s.logger.WithContext(ctx).Warning("loadFillInTheBlankData.no_questions", map[string]interface{}{
 			"questionID": questionID,
 		}, "No fill in blank questions found")
 		return nil
@@ -356,21 +358,21 @@ func (s *ListeningQuestionService) loadFillInTheBlankData(ctx context.Context, q
 		Question: question.Question,
 	}
 
-	s.logger.Debug("loadFillInTheBlankData.question_loaded", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("loadFillInTheBlankData.question_loaded", map[string]interface{}{
 		"questionID":       question.ID,
 		"parentQuestionID": questionID,
 	}, "Loaded fill in blank question")
 
 	answers, err := s.fillInBlankAnswerService.GetAnswersByListeningFillInTheBlankQuestionID(ctx, question.ID)
 	if err != nil {
-		s.logger.Error("loadFillInTheBlankData.get_answers", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("loadFillInTheBlankData.get_answers", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": question.ID,
 		}, "Failed to get fill in blank answers")
 		return fmt.Errorf("failed to get fill in blank answers: %w", err)
 	}
 
-	s.logger.Debug("loadFillInTheBlankData.answers", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("loadFillInTheBlankData.answers", map[string]interface{}{
 		"answerCount": len(answers),
 		"questionID":  question.ID,
 	}, "Retrieved fill in blank answers")
@@ -384,7 +386,7 @@ func (s *ListeningQuestionService) loadFillInTheBlankData(ctx context.Context, q
 		}
 	}
 
-	s.logger.Debug("loadFillInTheBlankData.complete", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("loadFillInTheBlankData.complete", map[string]interface{}{
 		"questionID":    questionID,
 		"answersLoaded": len(response.FillInTheBlankAnswers),
 	}, "Successfully loaded fill in blank data")
@@ -394,21 +396,21 @@ func (s *ListeningQuestionService) loadFillInTheBlankData(ctx context.Context, q
 
 func (s *ListeningQuestionService) loadChoiceOneData(ctx context.Context, questionID uuid.UUID, response *listeningDTO.ListeningQuestionDetail) error {
 	// Add debug logging
-	s.logger.Debug("loadChoiceOneData.start", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("loadChoiceOneData.start", map[string]interface{}{
 		"questionID": questionID,
 		"type":       response.Type,
 	}, "Starting to load choice one data")
 
 	questions, err := s.choiceOneQuestionService.GetQuestionsByListeningQuestionID(ctx, questionID)
 	if err != nil {
-		s.logger.Error("loadChoiceOneData.get_questions", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("loadChoiceOneData.get_questions", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get choice one questions")
 		return fmt.Errorf("failed to get choice one questions: %w", err)
 	}
 
-	s.logger.Debug("loadChoiceOneData.questions", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("loadChoiceOneData.questions", map[string]interface{}{
 		"questionCount": len(questions),
 		"questionID":    questionID,
 	}, "Retrieved choice one questions")
@@ -421,14 +423,14 @@ func (s *ListeningQuestionService) loadChoiceOneData(ctx context.Context, questi
 			Explain:  question.Explain,
 		}
 
-		s.logger.Debug("loadChoiceOneData.question_loaded", map[string]interface{}{
+		s.logger.WithContext(ctx).Debug("loadChoiceOneData.question_loaded", map[string]interface{}{
 			"questionID":       question.ID,
 			"parentQuestionID": questionID,
 		}, "Loaded choice one question")
 
 		options, err := s.choiceOneOptionService.GetOptionsByQuestionID(ctx, question.ID)
 		if err != nil {
-			s.logger.Error("loadChoiceOneData.get_options", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("loadChoiceOneData.get_options", map[string]interface{}{
 				"error":      err.Error(),
 				"questionID": question.ID,
 			}, "Failed to get choice one options")
@@ -444,7 +446,7 @@ func (s *ListeningQuestionService) loadChoiceOneData(ctx context.Context, questi
 			}
 		}
 
-		s.logger.Debug("loadChoiceOneData.complete", map[string]interface{}{
+		s.logger.WithContext(ctx).Debug("loadChoiceOneData.complete", map[string]interface{}{
 			"questionID":    questionID,
 			"optionsLoaded": len(response.ChoiceOneOptions),
 		}, "Successfully loaded choice one data")
@@ -454,21 +456,21 @@ func (s *ListeningQuestionService) loadChoiceOneData(ctx context.Context, questi
 }
 
 func (s *ListeningQuestionService) loadChoiceMultiData(ctx context.Context, questionID uuid.UUID, response *listeningDTO.ListeningQuestionDetail) error {
-	s.logger.Debug("loadChoiceMultiData.start", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("loadChoiceMultiData.start", map[string]interface{}{
 		"questionID": questionID,
 		"type":       response.Type,
 	}, "Starting to load choice multi data")
 
 	questions, err := s.choiceMultiQuestionService.GetQuestionsByListeningQuestionID(ctx, questionID)
 	if err != nil {
-		s.logger.Error("loadChoiceMultiData.get_questions", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("loadChoiceMultiData.get_questions", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get choice multi questions")
 		return fmt.Errorf("failed to get choice multi questions: %w", err)
 	}
 
-	s.logger.Debug("loadChoiceMultiData.questions", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("loadChoiceMultiData.questions", map[string]interface{}{
 		"questionCount": len(questions),
 		"questionID":    questionID,
 	}, "Retrieved choice multi questions")
@@ -481,14 +483,14 @@ func (s *ListeningQuestionService) loadChoiceMultiData(ctx context.Context, ques
 			Explain:  question.Explain,
 		}
 
-		s.logger.Debug("loadChoiceMultiData.question_loaded", map[string]interface{}{
+		s.logger.WithContext(ctx).Debug("loadChoiceMultiData.question_loaded", map[string]interface{}{
 			"questionID":       question.ID,
 			"parentQuestionID": questionID,
 		}, "Loaded choice multi question")
 
 		options, err := s.choiceMultiOptionService.GetOptionsByQuestionID(ctx, question.ID)
 		if err != nil {
-			s.logger.Error("loadChoiceMultiData.get_options", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("loadChoiceMultiData.get_options", map[string]interface{}{
 				"error":      err.Error(),
 				"questionID": question.ID,
 			}, "Failed to get choice multi options")
@@ -504,7 +506,7 @@ func (s *ListeningQuestionService) loadChoiceMultiData(ctx context.Context, ques
 			}
 		}
 
-		s.logger.Debug("loadChoiceMultiData.complete", map[string]interface{}{
+		s.logger.WithContext(ctx).Debug("loadChoiceMultiData.complete", map[string]interface{}{
 			"questionID":    questionID,
 			"optionsLoaded": len(response.ChoiceMultiOptions),
 		}, "Successfully loaded choice multi data")
@@ -514,7 +516,7 @@ func (s *ListeningQuestionService) loadChoiceMultiData(ctx context.Context, ques
 }
 
 func (s *ListeningQuestionService) loadMapLabellingData(ctx context.Context, questionID uuid.UUID, response *listeningDTO.ListeningQuestionDetail) error {
-	s.logger.Debug("loadMapLabellingData.start", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("loadMapLabellingData.start", map[string]interface{}{
 		"questionID": questionID,
 		"type":       response.Type,
 	}, "Starting to load map labelling data")
@@ -522,7 +524,7 @@ func (s *ListeningQuestionService) loadMapLabellingData(ctx context.Context, que
 	// Get map labelling questions directly - no need for parent record
 	qas, err := s.mapLabellingQuestionAnswerService.GetByListeningQuestionID(ctx, questionID)
 	if err != nil {
-		s.logger.Error("loadMapLabellingData.get_qas", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("loadMapLabellingData.get_qas", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get map labelling QAs")
@@ -540,7 +542,7 @@ func (s *ListeningQuestionService) loadMapLabellingData(ctx context.Context, que
 		}
 	}
 
-	s.logger.Debug("loadMapLabellingData.complete", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("loadMapLabellingData.complete", map[string]interface{}{
 		"questionID": questionID,
 		"qasLoaded":  len(response.MapLabelling),
 	}, "Successfully loaded map labelling data")
@@ -549,7 +551,7 @@ func (s *ListeningQuestionService) loadMapLabellingData(ctx context.Context, que
 }
 
 func (s *ListeningQuestionService) loadMatchingData(ctx context.Context, questionID uuid.UUID, response *listeningDTO.ListeningQuestionDetail) error {
-	s.logger.Debug("loadMatchingData.start", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("loadMatchingData.start", map[string]interface{}{
 		"questionID": questionID,
 		"type":       response.Type,
 	}, "Starting to load matching data")
@@ -557,7 +559,7 @@ func (s *ListeningQuestionService) loadMatchingData(ctx context.Context, questio
 	// Get matching questions directly - no need for parent record
 	qas, err := s.matchingQuestionAnswerService.GetByListeningQuestionID(ctx, questionID)
 	if err != nil {
-		s.logger.Error("loadMatchingData.get_qas", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("loadMatchingData.get_qas", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get matching QAs")
@@ -575,7 +577,7 @@ func (s *ListeningQuestionService) loadMatchingData(ctx context.Context, questio
 		}
 	}
 
-	s.logger.Debug("loadMatchingData.complete", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("loadMatchingData.complete", map[string]interface{}{
 		"questionID": questionID,
 		"qasLoaded":  len(response.Matching),
 	}, "Successfully loaded matching data")
@@ -623,7 +625,7 @@ func (s *ListeningQuestionService) GetListeningByListID(ctx context.Context, ids
 			Find(&dbQuestions)
 
 		if query.Error != nil {
-			s.logger.Error("listening_question_service.get_by_list_id", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("listening_question_service.get_by_list_id", map[string]interface{}{
 				"error": query.Error.Error(),
 			}, "Failed to get questions from database")
 			return nil, query.Error
@@ -634,7 +636,7 @@ func (s *ListeningQuestionService) GetListeningByListID(ctx context.Context, ids
 			// Build complete response with details
 			questionDetail, err := s.getListeningQuestionDetail(ctx, q)
 			if err != nil {
-				s.logger.Error("listening_question_service.get_by_list_id.build_response", map[string]interface{}{
+				s.logger.WithContext(ctx).Error("listening_question_service.get_by_list_id.build_response", map[string]interface{}{
 					"error": err.Error(),
 					"id":    q.ID,
 				}, "Failed to build complete response")
@@ -652,7 +654,7 @@ func (s *ListeningQuestionService) GetListeningByListID(ctx context.Context, ids
 			cacheKey := fmt.Sprintf("listening_question:%s:%s:%d", q.ID, status, q.Version)
 			if questionJSON, err := json.Marshal(questionDetail); err == nil {
 				if err := s.redis.GetCache().Set(ctx, cacheKey, string(questionJSON), 24*time.Hour); err != nil {
-					s.logger.Error("listening_question_service.get_by_list_id.cache", map[string]interface{}{
+					s.logger.WithContext(ctx).Error("listening_question_service.get_by_list_id.cache", map[string]interface{}{
 						"error": err.Error(),
 						"id":    q.ID,
 					}, "Failed to cache question")
@@ -669,7 +671,7 @@ func (s *ListeningQuestionService) GetListeningByListID(ctx context.Context, ids
 func (s *ListeningQuestionService) DeleteQuestion(ctx context.Context, id uuid.UUID) error {
 	// Delete from database
 	if err := s.repo.DeleteListeningQuestion(ctx, id); err != nil {
-		s.logger.Error("listening_question_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_question_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete question")
@@ -681,7 +683,7 @@ func (s *ListeningQuestionService) DeleteQuestion(ctx context.Context, id uuid.U
 
 	// Delete from OpenSearch
 	if err := s.search.DeleteListeningQuestionFromIndex(ctx, id); err != nil {
-		s.logger.Error("listening_question_service.delete.opensearch", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_question_service.delete.opensearch", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete question from OpenSearch")
@@ -692,365 +694,105 @@ func (s *ListeningQuestionService) DeleteQuestion(ctx context.Context, id uuid.U
 }
 
 func (s *ListeningQuestionService) SearchQuestionsWithFilter(ctx context.Context, filter listeningDTO.ListeningQuestionSearchFilter) (*listeningDTO.ListListeningQuestionsPagination, error) {
-	// Calculate offset
-	from := (filter.Page - 1) * filter.PageSize
+	s.logger.WithContext(ctx).Debug("listening_question_service.search.start", map[string]interface{}{
+		"filter": filter,
+	}, "Starting question search")
 
-	// Build bool query
-	boolQuery := map[string]interface{}{
-		"bool": map[string]interface{}{
-			"must": []map[string]interface{}{},
-		},
-	}
-
-	// Add type filter
-	if filter.Type != "" {
-		boolQuery["bool"].(map[string]interface{})["must"] = append(
-			boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
-			map[string]interface{}{
-				"match": map[string]interface{}{
-					"type": filter.Type,
-				},
-			},
-		)
-	}
-
-	// Add topic filter
-	if filter.Topic != "" {
-		topics := strings.Split(filter.Topic, ",")
-		topicTerms := make([]interface{}, len(topics))
-		for i, topic := range topics {
-			topicTerms[i] = strings.TrimSpace(topic)
-		}
-		boolQuery["bool"].(map[string]interface{})["must"] = append(
-			boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
-			map[string]interface{}{
-				"terms": map[string]interface{}{
-					"topic.keyword": topicTerms,
-				},
-			},
-		)
-	}
-
-	// Add instruction filter
-	if filter.Instruction != "" {
-		boolQuery["bool"].(map[string]interface{})["must"] = append(
-			boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
-			map[string]interface{}{
-				"match": map[string]interface{}{
-					"instruction": filter.Instruction,
-				},
-			},
-		)
-	}
-
-	// Add audio_urls filter
-	if filter.AudioURLs != "" {
-		boolQuery["bool"].(map[string]interface{})["must"] = append(
-			boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
-			map[string]interface{}{
-				"term": map[string]interface{}{
-					"audio_urls": filter.AudioURLs,
-				},
-			},
-		)
-	}
-
-	// Add image_urls filter
-	if filter.ImageURLs != "" {
-		boolQuery["bool"].(map[string]interface{})["must"] = append(
-			boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
-			map[string]interface{}{
-				"term": map[string]interface{}{
-					"image_urls": filter.ImageURLs,
-				},
-			},
-		)
-	}
-
-	// Add transcript filter
-	if filter.Transcript != "" {
-		boolQuery["bool"].(map[string]interface{})["must"] = append(
-			boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
-			map[string]interface{}{
-				"match": map[string]interface{}{
-					"transcript": filter.Transcript,
-				},
-			},
-		)
-	}
-
-	// Add max_time range filter
-	if filter.MaxTime != "" {
-		parts := strings.Split(filter.MaxTime, "-")
-		if len(parts) == 2 {
-			minTime, err1 := strconv.Atoi(strings.TrimSpace(parts[0]))
-			maxTime, err2 := strconv.Atoi(strings.TrimSpace(parts[1]))
-			if err1 == nil && err2 == nil {
-				rangeQuery := map[string]interface{}{
-					"range": map[string]interface{}{
-						"max_time": map[string]interface{}{
-							"gte": minTime,
-							"lte": maxTime,
-						},
-					},
-				}
-				boolQuery["bool"].(map[string]interface{})["must"] = append(
-					boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
-					rangeQuery,
-				)
-			}
-		}
-	}
-
-	// Add metadata filter based on type
-	if filter.Metadata != "" && filter.Type != "" {
-		metadataQuery := map[string]interface{}{}
-
-		switch filter.Type {
-		case "FILL_IN_THE_BLANK":
-			metadataQuery = map[string]interface{}{
-				"multi_match": map[string]interface{}{
-					"query": filter.Metadata,
-					"fields": []string{
-						"fill_in_the_blank_question",
-						"fill_in_the_blank_answers",
-					},
-				},
-			}
-		case "CHOICE_ONE", "CHOICE_MULTI":
-			metadataQuery = map[string]interface{}{
-				"multi_match": map[string]interface{}{
-					"query": filter.Metadata,
-					"fields": []string{
-						filter.Type + "_question",
-						filter.Type + "_options",
-					},
-				},
-			}
-		case "MAP_LABELLING", "MATCHING":
-			metadataQuery = map[string]interface{}{
-				"multi_match": map[string]interface{}{
-					"query": filter.Metadata,
-					"fields": []string{
-						filter.Type + "_questions_and_answers",
-					},
-				},
-			}
-		}
-
-		if len(metadataQuery) > 0 {
-			boolQuery["bool"].(map[string]interface{})["must"] = append(
-				boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
-				metadataQuery,
-			)
-		}
-	}
-
-	// Build final search body
-	searchBody := map[string]interface{}{
-		"query":            boolQuery,
-		"from":             from,
-		"size":             filter.PageSize,
-		"track_total_hits": true,
-	}
-
-	// Add debug logging
-	searchJSON, err := json.Marshal(searchBody)
+	result, err := s.searcher.SearchQuestions(ctx, filter)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal search body: %w", err)
+		s.logger.WithContext(ctx).Error("listening_question_service.search", map[string]interface{}{
+			"error":  err.Error(),
+			"filter": filter,
+		}, "Failed to search questions")
+		return nil, fmt.Errorf("failed to search questions: %w", err)
 	}
 
-	s.logger.Debug("opensearch_query_details", map[string]interface{}{
-		"query_json": string(searchJSON),
-		"type":       filter.Type,
-		"topics":     filter.Topic,
-		"max_time":   filter.MaxTime,
-		"page":       filter.Page,
-		"page_size":  filter.PageSize,
-		"bool_query": boolQuery,
-	}, "OpenSearch query details")
-
-	// Execute search
-	searchReq := opensearchapi.SearchRequest{
-		Index: []string{"listening_questions"},
-		Body:  bytes.NewReader(searchJSON),
-	}
+	s.logger.WithContext(ctx).Debug("listening_question_service.search.complete", map[string]interface{}{
+		"total_results": result.Total,
+		"page":          result.Page,
+		"page_size":     result.PageSize,
+	}, "Search completed")
 
-	searchRes, err := searchReq.Do(ctx, s.search.GetClient())
-	if err != nil {
-		s.logger.Error("opensearch_search_error", map[string]interface{}{
+	return result, nil
+}
+
+func (s *ListeningQuestionService) DeleteAllQuestions(ctx context.Context) error {
+	// The outbox trigger queues a delete event per row in this same statement, so cache and
+	// search are cleared by the relay even when the bulk cleanup below fails
+	if err := s.repo.GetDB().WithContext(ctx).Exec("DELETE FROM listening_questions").Error; err != nil {
+		s.logger.WithContext(ctx).Error("listening_question_service.delete_all", map[string]interface{}{
 			"error": err.Error(),
-			"query": string(searchJSON),
-		}, "Failed to execute OpenSearch query")
-		return nil, fmt.Errorf("failed to execute search: %w", err)
+		}, "Failed to delete all listening questions")
+		return err
 	}
-	defer searchRes.Body.Close()
 
-	// Add more detailed debug logging for response
-	var buf bytes.Buffer
-	if _, err := buf.ReadFrom(searchRes.Body); err != nil {
-		s.logger.Error("opensearch_read_error", map[string]interface{}{
+	// Delete all Redis cache with pattern listening_question:*
+	if err := s.redis.GetCache().DeletePattern(ctx, "listening_question:*"); err != nil {
+		s.logger.WithContext(ctx).Warning("listening_question_service.delete_all.cache", map[string]interface{}{
 			"error": err.Error(),
-		}, "Failed to read OpenSearch response")
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
-	searchRes.Body = ioutil.NopCloser(&buf)
-
-	s.logger.Debug("opensearch_response_details", map[string]interface{}{
-		"response": buf.String(),
-	}, "OpenSearch response details")
-
-	var searchResult struct {
-		Hits struct {
-			Total struct {
-				Value int64 `json:"value"`
-			} `json:"total"`
-			Hits []struct {
-				Source struct {
-					ID                              uuid.UUID `json:"id"`
-					Type                            string    `json:"type"`
-					Topic                           []string  `json:"topic"`
-					Instruction                     string    `json:"instruction"`
-					AudioURLs                       []string  `json:"audio_urls"`
-					ImageURLs                       []string  `json:"image_urls"`
-					Transcript                      string    `json:"transcript"`
-					MaxTime                         int       `json:"max_time"`
-					Version                         int       `json:"version"`
-					Status                          string    `json:"status"`
-					ChoiceMultiQuestion             string    `json:"choice_multi_question"`
-					ChoiceMultiOptions              string    `json:"choice_multi_options"`
-					ChoiceOneQuestion               string    `json:"choice_one_question"`
-					ChoiceOneOptions                string    `json:"choice_one_options"`
-					FillInTheBlankQuestion          string    `json:"fill_in_the_blank_question"`
-					FillInTheBlankAnswers           string    `json:"fill_in_the_blank_answers"`
-					MapLabelling                    string    `json:"map_labelling"`
-					MapLabellingQuestionsAndAnswers string    `json:"map_labelling_questions_and_answers"`
-					Matching                        string    `json:"MATCHING"`
-					MatchingQuestionsAndAnswers     string    `json:"matching_questions_and_answers"`
-				} `json:"_source"`
-			} `json:"hits"`
-		} `json:"hits"`
+		}, "Failed to delete Redis cache, leaving it to the outbox relay")
 	}
 
-	if err := json.NewDecoder(&buf).Decode(&searchResult); err != nil {
-		return nil, fmt.Errorf("failed to decode search response: %w", err)
+	// Delete OpenSearch index
+	if err := s.search.RemoveListeningQuestionsIndex(ctx); err != nil {
+		s.logger.WithContext(ctx).Warning("listening_question_service.delete_all.search", map[string]interface{}{
+			"error": err.Error(),
+		}, "Failed to delete OpenSearch index, leaving it to the outbox relay")
 	}
 
-	var questions []listeningDTO.ListeningQuestionDetail
-	for _, hit := range searchResult.Hits.Hits {
-		question := listeningDTO.ListeningQuestionDetail{
-			ListeningQuestionResponse: listeningDTO.ListeningQuestionResponse{
-				ID:          hit.Source.ID,
-				Type:        hit.Source.Type,
-				Topic:       hit.Source.Topic,
-				Instruction: hit.Source.Instruction,
-				AudioURLs:   hit.Source.AudioURLs,
-				ImageURLs:   hit.Source.ImageURLs,
-				Transcript:  hit.Source.Transcript,
-				MaxTime:     hit.Source.MaxTime,
-				Version:     hit.Source.Version,
-			},
-		}
-
-		// Parse additional fields based on question type
-		switch hit.Source.Type {
-		case "CHOICE_MULTI":
-			if hit.Source.ChoiceMultiQuestion != "" && hit.Source.ChoiceMultiQuestion != "null" {
-				var choiceMultiQuestion listeningDTO.ListeningChoiceMultiQuestionResponse
-				if err := json.Unmarshal([]byte(hit.Source.ChoiceMultiQuestion), &choiceMultiQuestion); err == nil {
-					question.ChoiceMultiQuestion = &choiceMultiQuestion
-				}
-			}
-			if hit.Source.ChoiceMultiOptions != "" && hit.Source.ChoiceMultiOptions != "null" {
-				var choiceMultiOptions []listeningDTO.ListeningChoiceMultiOptionResponse
-				if err := json.Unmarshal([]byte(hit.Source.ChoiceMultiOptions), &choiceMultiOptions); err == nil {
-					question.ChoiceMultiOptions = choiceMultiOptions
-				}
-			}
-		case "CHOICE_ONE":
-			if hit.Source.ChoiceOneQuestion != "" && hit.Source.ChoiceOneQuestion != "null" {
-				var choiceOneQuestion listeningDTO.ListeningChoiceOneQuestionResponse
-				if err := json.Unmarshal([]byte(hit.Source.ChoiceOneQuestion), &choiceOneQuestion); err == nil {
-					question.ChoiceOneQuestion = &choiceOneQuestion
-				}
-			}
-			if hit.Source.ChoiceOneOptions != "" && hit.Source.ChoiceOneOptions != "null" {
-				var choiceOneOptions []listeningDTO.ListeningChoiceOneOptionResponse
-				if err := json.Unmarshal([]byte(hit.Source.ChoiceOneOptions), &choiceOneOptions); err == nil {
-					question.ChoiceOneOptions = choiceOneOptions
-				}
-			}
-		case "FILL_IN_THE_BLANK":
-			if hit.Source.FillInTheBlankQuestion != "" && hit.Source.FillInTheBlankQuestion != "null" {
-				var fillInBlankQuestion listeningDTO.ListeningFillInTheBlankQuestionResponse
-				if err := json.Unmarshal([]byte(hit.Source.FillInTheBlankQuestion), &fillInBlankQuestion); err == nil {
-					question.FillInTheBlankQuestion = &fillInBlankQuestion
-				}
-			}
-			if hit.Source.FillInTheBlankAnswers != "" && hit.Source.FillInTheBlankAnswers != "null" {
-				var fillInBlankAnswers []listeningDTO.ListeningFillInTheBlankAnswerResponse
-				if err := json.Unmarshal([]byte(hit.Source.FillInTheBlankAnswers), &fillInBlankAnswers); err == nil {
-					question.FillInTheBlankAnswers = fillInBlankAnswers
-				}
-			}
-		}
-
-		questions = append(questions, question)
+	// The cache is empty now, warm it again for the questions that come back
+	if s.warmup != nil {
+		s.warmup.Reset("listening")
 	}
 
-	result := &listeningDTO.ListListeningQuestionsPagination{
-		Questions: questions,
-		Total:     searchResult.Hits.Total.Value,
-		Page:      filter.Page,
-		PageSize:  filter.PageSize,
-	}
+	return nil
+}
 
-	return result, nil
+// SetCacheWarmup reports reads to warmup and resets it once all questions are deleted
+func (s *ListeningQuestionService) SetCacheWarmup(warmup redisClient.CacheWarmup) {
+	s.warmup = warmup
 }
 
-func (s *ListeningQuestionService) DeleteAllQuestions(ctx context.Context) error {
-	// Start transaction
-	tx := s.repo.GetDB().WithContext(ctx).Begin()
-	if tx.Error != nil {
-		return fmt.Errorf("failed to start transaction: %w", tx.Error)
+// WarmQuestion writes the detail of a question to the cache, a deleted question is not an error.
+// It does not count as a read.
+func (s *ListeningQuestionService) WarmQuestion(ctx context.Context, id uuid.UUID) error {
+	question, err := s.repo.GetListeningQuestionByID(ctx, id)
+	if errors.Is(err, ListeningRepository.ErrQuestionNotFound) {
+		return nil
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
-		}
-	}()
-
-	// Delete all records from listening_questions
-	if err := tx.Exec("DELETE FROM listening_questions").Error; err != nil {
-		tx.Rollback()
-		s.logger.Error("listening_question_service.delete_all", map[string]interface{}{
-			"error": err.Error(),
-		}, "Failed to delete all listening questions")
+	if err != nil {
 		return err
 	}
 
-	// Delete all Redis cache with pattern listening_question:*
-	if err := s.redis.GetCache().DeletePattern(ctx, "listening_question:*"); err != nil {
-		tx.Rollback()
-		s.logger.Error("listening_question_service.delete_all.cache", map[string]interface{}{
-			"error": err.Error(),
-		}, "Failed to delete Redis cache")
+	detail, err := s.getListeningQuestionDetail(ctx, question)
+	if err != nil {
 		return err
 	}
+	return s.redis.SetCacheListeningQuestionDetail(ctx, detail, s.completion.IsQuestionComplete(detail))
+}
 
-	// Delete OpenSearch index
-	if err := s.search.RemoveListeningQuestionsIndex(ctx); err != nil {
-		tx.Rollback()
-		s.logger.Error("listening_question_service.delete_all.search", map[string]interface{}{
-			"error": err.Error(),
-		}, "Failed to delete OpenSearch index")
+// SyncQuestionByID refreshes the cache entries and search document of a question from the
+// database, removing them if the question is gone. The outbox relay calls it for every event.
+func (s *ListeningQuestionService) SyncQuestionByID(ctx context.Context, id uuid.UUID) error {
+	question, err := s.repo.GetListeningQuestionByID(ctx, id)
+	if errors.Is(err, ListeningRepository.ErrQuestionNotFound) {
+		return s.questionUpdator.RemoveQuestion(ctx, id)
+	}
+	if err != nil {
 		return err
 	}
+	return s.questionUpdator.SyncQuestion(ctx, question)
+}
 
-	if err := tx.Commit().Error; err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to commit transaction: %w", err)
+// BuildSearchDocument returns the search document of a question for index rebuilds, or nil
+// when the question has been deleted in the meantime
+func (s *ListeningQuestionService) BuildSearchDocument(ctx context.Context, id uuid.UUID) (map[string]interface{}, error) {
+	question, err := s.repo.GetListeningQuestionByID(ctx, id)
+	if errors.Is(err, ListeningRepository.ErrQuestionNotFound) {
+		return nil, nil
 	}
-
-	return nil
+	if err != nil {
+		return nil, err
+	}
+	return s.questionUpdator.BuildSearchDocument(ctx, question)
 }
@@ -0,0 +1,230 @@
+package outbox
+
+import (
+	"context"
+	"fluencybe/internal/app/model/outbox"
+	outboxRepo "fluencybe/internal/app/repository/outbox"
+	"fluencybe/internal/core/constants"
+	"fluencybe/internal/core/status"
+	"fluencybe/pkg/logger"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// QuestionSyncer brings the cache entries and search document of a question in line with
+// the database, removing them when the question no longer exists
+type QuestionSyncer interface {
+	SyncQuestionByID(ctx context.Context, id uuid.UUID) error
+}
+
+// OutboxRelay applies the outbox events written by the question triggers to Redis and
+// OpenSearch, retrying failures with exponential backoff until they are parked as dead
+type OutboxRelay struct {
+	repo    *outboxRepo.OutboxRepository
+	syncers map[string]QuestionSyncer
+	logger  *logger.PrettyLogger
+
+	cancel context.CancelFunc
+	done   chan struct{}
+	mu     sync.Mutex
+}
+
+func NewOutboxRelay(repo *outboxRepo.OutboxRepository, syncers map[string]QuestionSyncer, logger *logger.PrettyLogger) *OutboxRelay {
+	return &OutboxRelay{
+		repo:    repo,
+		syncers: syncers,
+		logger:  logger,
+	}
+}
+
+// Start launches the relay loop, calling it again while running is a no-op
+func (r *OutboxRelay) Start() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.done = make(chan struct{})
+
+	r.logger.Info("OUTBOX_RELAY_START", map[string]interface{}{
+		"interval":   constants.OutboxPollInterval.String(),
+		"batch_size": constants.OutboxBatchSize,
+	}, "Starting outbox relay")
+
+	go r.run(ctx)
+}
+
+// Stop ends the relay loop and waits for the batch in flight to finish
+func (r *OutboxRelay) Stop() {
+	r.mu.Lock()
+	cancel, done := r.cancel, r.done
+	r.cancel = nil
+	r.mu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+}
+
+func (r *OutboxRelay) run(ctx context.Context) {
+	defer close(r.done)
+
+	ticker := time.NewTicker(constants.OutboxPollInterval)
+	defer ticker.Stop()
+
+	lastPurge := time.Time{}
+	for {
+		// Drain the backlog without waiting for the next tick while batches come back full
+		for {
+			processed, err := r.ProcessBatch(ctx)
+			if err != nil || processed < constants.OutboxBatchSize || ctx.Err() != nil {
+				break
+			}
+		}
+
+		if time.Since(lastPurge) >= constants.OutboxPurgeInterval {
+			r.purge(ctx)
+			lastPurge = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessBatch claims and applies one batch of due events and returns how many were claimed.
+// Nothing is claimed while Redis or OpenSearch is down, since their adapters skip writes
+// silently in that state and the events would be marked done without being applied.
+func (r *OutboxRelay) ProcessBatch(ctx context.Context) (int, error) {
+	if !status.GetRedisStatus() || !status.GetOpenSearchStatus() {
+		return 0, nil
+	}
+
+	events, err := r.repo.ClaimBatch(ctx, constants.OutboxBatchSize, constants.OutboxLeaseDuration)
+	if err != nil {
+		return 0, err
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	// The syncers read the current row, so one sync per question covers every event of it
+	type aggregateKey struct {
+		aggregateType string
+		id            uuid.UUID
+	}
+	grouped := make(map[aggregateKey][]*outbox.OutboxEvent)
+	var order []aggregateKey
+	for _, event := range events {
+		key := aggregateKey{event.AggregateType, event.AggregateID}
+		if _, ok := grouped[key]; !ok {
+			order = append(order, key)
+		}
+		grouped[key] = append(grouped[key], event)
+	}
+
+	var doneIDs []int64
+	for _, key := range order {
+		group := grouped[key]
+
+		if err := r.apply(ctx, key.aggregateType, key.id); err != nil {
+			if ctx.Err() != nil {
+				// Shutting down: leave the lease to expire so the events are picked up again
+				break
+			}
+			r.fail(ctx, group, err)
+			continue
+		}
+		for _, event := range group {
+			doneIDs = append(doneIDs, event.ID)
+		}
+	}
+
+	if err := r.repo.MarkDone(context.WithoutCancel(ctx), doneIDs); err != nil {
+		r.logger.WithContext(ctx).Error("outbox_relay.mark_done", map[string]interface{}{
+			"error": err.Error(),
+			"count": len(doneIDs),
+		}, "Failed to mark outbox events as done")
+		return len(events), err
+	}
+
+	return len(events), nil
+}
+
+func (r *OutboxRelay) apply(ctx context.Context, aggregateType string, id uuid.UUID) error {
+	syncer, ok := r.syncers[aggregateType]
+	if !ok {
+		return fmt.Errorf("no syncer registered for %q", aggregateType)
+	}
+	return syncer.SyncQuestionByID(ctx, id)
+}
+
+func (r *OutboxRelay) fail(ctx context.Context, group []*outbox.OutboxEvent, cause error) {
+	attempts := 0
+	ids := make([]int64, 0, len(group))
+	for _, event := range group {
+		ids = append(ids, event.ID)
+		attempts = max(attempts, event.Attempts)
+	}
+
+	dead := attempts >= constants.OutboxMaxAttempts
+	retryAt := time.Now().UTC().Add(backoff(attempts))
+
+	if dead {
+		r.logger.WithContext(ctx).Error("outbox_relay.dead", map[string]interface{}{
+			"error":          cause.Error(),
+			"aggregate_type": group[0].AggregateType,
+			"aggregate_id":   group[0].AggregateID,
+			"attempts":       attempts,
+		}, "Outbox event moved to dead-letter queue")
+	} else {
+		r.logger.WithContext(ctx).Warning("outbox_relay.retry", map[string]interface{}{
+			"error":          cause.Error(),
+			"aggregate_type": group[0].AggregateType,
+			"aggregate_id":   group[0].AggregateID,
+			"attempts":       attempts,
+			"retry_at":       retryAt,
+		}, "Failed to apply outbox event, will retry")
+	}
+
+	if err := r.repo.MarkFailed(ctx, ids, cause.Error(), retryAt, dead); err != nil {
+		r.logger.WithContext(ctx).Error("outbox_relay.mark_failed", map[string]interface{}{
+			"error": err.Error(),
+		}, "Failed to record outbox failure")
+	}
+}
+
+func (r *OutboxRelay) purge(ctx context.Context) {
+	deleted, err := r.repo.PurgeProcessed(ctx, time.Now().UTC().Add(-constants.OutboxRetention))
+	if err != nil {
+		r.logger.WithContext(ctx).Error("outbox_relay.purge", map[string]interface{}{
+			"error": err.Error(),
+		}, "Failed to purge processed outbox events")
+		return
+	}
+	if deleted > 0 {
+		r.logger.WithContext(ctx).Debug("outbox_relay.purge", map[string]interface{}{
+			"deleted": deleted,
+		}, "Purged processed outbox events")
+	}
+}
+
+// backoff doubles the delay with each attempt, capped at OutboxMaxBackoff
+func backoff(attempts int) time.Duration {
+	delay := constants.OutboxBaseBackoff
+	for i := 1; i < attempts && delay < constants.OutboxMaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, constants.OutboxMaxBackoff)
+}
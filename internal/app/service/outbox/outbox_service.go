@@ -0,0 +1,121 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fluencybe/internal/app/dto"
+	"fluencybe/internal/app/model/outbox"
+	outboxRepo "fluencybe/internal/app/repository/outbox"
+	"fluencybe/internal/core/constants"
+	"fluencybe/pkg/logger"
+	"slices"
+)
+
+var (
+	ErrUnknownSkill  = errors.New("unknown skill")
+	ErrEventNotFound = outboxRepo.ErrEventNotFound
+)
+
+// OutboxService exposes the dead-letter queue of the relay to developers
+type OutboxService struct {
+	repo   *outboxRepo.OutboxRepository
+	logger *logger.PrettyLogger
+}
+
+func NewOutboxService(repo *outboxRepo.OutboxRepository, logger *logger.PrettyLogger) *OutboxService {
+	return &OutboxService{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (s *OutboxService) ListDeadEvents(ctx context.Context, skill string, page, pageSize int) (*dto.OutboxDeadLetterPagination, error) {
+	if err := validateSkill(skill); err != nil {
+		return nil, err
+	}
+	page = max(page, 1)
+	if pageSize <= 0 {
+		pageSize = constants.OutboxDeadDefaultSize
+	}
+	pageSize = min(pageSize, constants.OutboxDeadMaxSize)
+
+	events, total, err := s.repo.ListDead(ctx, skill, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.OutboxDeadLetterPagination{
+		Events:   make([]dto.OutboxEventResponse, 0, len(events)),
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	for _, event := range events {
+		result.Events = append(result.Events, toOutboxEventResponse(event))
+	}
+	return result, nil
+}
+
+// RetryDeadEvent puts a dead event back in front of the relay
+func (s *OutboxService) RetryDeadEvent(ctx context.Context, id int64) error {
+	if err := s.repo.Requeue(ctx, id); err != nil {
+		return err
+	}
+	s.logger.WithContext(ctx).Info("outbox_service.retry", map[string]interface{}{
+		"id": id,
+	}, "Dead outbox event requeued")
+	return nil
+}
+
+// RetryAllDeadEvents requeues every dead event, optionally limited to one skill
+func (s *OutboxService) RetryAllDeadEvents(ctx context.Context, skill string) (int64, error) {
+	if err := validateSkill(skill); err != nil {
+		return 0, err
+	}
+	count, err := s.repo.RequeueAll(ctx, skill)
+	if err != nil {
+		return 0, err
+	}
+	s.logger.WithContext(ctx).Info("outbox_service.retry_all", map[string]interface{}{
+		"skill": skill,
+		"count": count,
+	}, "Dead outbox events requeued")
+	return count, nil
+}
+
+func (s *OutboxService) GetStats(ctx context.Context) (*dto.OutboxStatsResponse, error) {
+	counts, err := s.repo.CountByStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.OutboxStatsResponse{
+		Pending: counts[outbox.StatusPending],
+		Done:    counts[outbox.StatusDone],
+		Dead:    counts[outbox.StatusDead],
+	}, nil
+}
+
+func validateSkill(skill string) error {
+	if skill != "" && !slices.Contains(constants.ChangeFeedSkills, skill) {
+		return ErrUnknownSkill
+	}
+	return nil
+}
+
+func toOutboxEventResponse(event *outbox.OutboxEvent) dto.OutboxEventResponse {
+	response := dto.OutboxEventResponse{
+		ID:            event.ID,
+		Skill:         event.AggregateType,
+		QuestionID:    event.AggregateID,
+		EventType:     string(event.EventType),
+		Version:       event.Version,
+		Attempts:      event.Attempts,
+		CreatedAt:     event.CreatedAt,
+		NextAttemptAt: event.NextAttemptAt,
+		ProcessedAt:   event.ProcessedAt,
+	}
+	if event.LastError != nil {
+		response.LastError = *event.LastError
+	}
+	return response
+}
@@ -60,7 +60,7 @@ func (s *ReadingChoiceMultiOptionService) CreateOption(ctx context.Context, opti
 
 	if err := s.repo.Create(ctx, option); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_multi_option_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_multi_option_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create option")
 		return err
@@ -83,7 +83,7 @@ func (s *ReadingChoiceMultiOptionService) CreateOption(ctx context.Context, opti
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, readingQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_multi_option_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_multi_option_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    readingQuestion.ID,
 		}, "Failed to update cache and search")
@@ -104,7 +104,7 @@ func (s *ReadingChoiceMultiOptionService) GetOption(ctx context.Context, id uuid
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, sql.ErrNoRows
 		}
-		s.logger.Error("reading_choice_multi_option_service.get", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_multi_option_service.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get option")
@@ -128,7 +128,7 @@ func (s *ReadingChoiceMultiOptionService) UpdateOption(ctx context.Context, opti
 
 	if err := s.repo.Update(ctx, option); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_multi_option_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_multi_option_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    option.ID,
 		}, "Failed to update option")
@@ -152,7 +152,7 @@ func (s *ReadingChoiceMultiOptionService) UpdateOption(ctx context.Context, opti
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, readingQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_multi_option_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_multi_option_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    readingQuestion.ID,
 		}, "Failed to update cache and search")
@@ -190,7 +190,7 @@ func (s *ReadingChoiceMultiOptionService) DeleteOption(ctx context.Context, id u
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return sql.ErrNoRows
 		}
-		s.logger.Error("reading_choice_multi_option_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_multi_option_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete option")
@@ -214,7 +214,7 @@ func (s *ReadingChoiceMultiOptionService) DeleteOption(ctx context.Context, id u
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, readingQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_multi_option_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_multi_option_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    readingQuestion.ID,
 		}, "Failed to update cache and search")
@@ -232,7 +232,7 @@ func (s *ReadingChoiceMultiOptionService) DeleteOption(ctx context.Context, id u
 func (s *ReadingChoiceMultiOptionService) GetOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]*reading.ReadingChoiceMultiOption, error) {
 	options, err := s.repo.GetByReadingChoiceMultiQuestionID(ctx, questionID)
 	if err != nil {
-		s.logger.Error("reading_choice_multi_option_service.get_by_question_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_multi_option_service.get_by_question_id", map[string]interface{}{
 			"error":       err.Error(),
 			"question_id": questionID,
 		}, "Failed to get options by question ID")
@@ -73,7 +73,7 @@ func (s *ReadingChoiceMultiQuestionService) CreateQuestion(ctx context.Context,
 
 	if err := s.repo.Create(ctx, question); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_multi_question_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_multi_question_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create question")
 		return err
@@ -83,7 +83,7 @@ func (s *ReadingChoiceMultiQuestionService) CreateQuestion(ctx context.Context,
 	parentQuestion, err := s.readingQuestionRepo.GetReadingQuestionByID(ctx, question.ReadingQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_multi_question_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_multi_question_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ReadingQuestionID,
 		}, "Failed to get parent question")
@@ -93,7 +93,7 @@ func (s *ReadingChoiceMultiQuestionService) CreateQuestion(ctx context.Context,
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_multi_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_multi_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -114,7 +114,7 @@ func (s *ReadingChoiceMultiQuestionService) GetQuestion(ctx context.Context, id
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, sql.ErrNoRows
 		}
-		s.logger.Error("reading_choice_multi_question_service.get", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_multi_question_service.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get question")
@@ -141,7 +141,7 @@ func (s *ReadingChoiceMultiQuestionService) UpdateQuestion(ctx context.Context,
 
 	if err := s.repo.Update(ctx, question); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_multi_question_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_multi_question_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to update question")
@@ -152,7 +152,7 @@ func (s *ReadingChoiceMultiQuestionService) UpdateQuestion(ctx context.Context,
 	parentQuestion, err := s.readingQuestionRepo.GetReadingQuestionByID(ctx, question.ReadingQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_multi_question_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_multi_question_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ReadingQuestionID,
 		}, "Failed to get parent question")
@@ -162,7 +162,7 @@ func (s *ReadingChoiceMultiQuestionService) UpdateQuestion(ctx context.Context,
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_multi_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_multi_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -197,7 +197,7 @@ func (s *ReadingChoiceMultiQuestionService) DeleteQuestion(ctx context.Context,
 
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_multi_question_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_multi_question_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete question")
@@ -208,7 +208,7 @@ func (s *ReadingChoiceMultiQuestionService) DeleteQuestion(ctx context.Context,
 	parentQuestion, err := s.readingQuestionRepo.GetReadingQuestionByID(ctx, question.ReadingQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_multi_question_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_multi_question_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ReadingQuestionID,
 		}, "Failed to get parent question")
@@ -218,7 +218,7 @@ func (s *ReadingChoiceMultiQuestionService) DeleteQuestion(ctx context.Context,
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_multi_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_multi_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -236,7 +236,7 @@ func (s *ReadingChoiceMultiQuestionService) DeleteQuestion(ctx context.Context,
 func (s *ReadingChoiceMultiQuestionService) GetQuestionsByReadingQuestionID(ctx context.Context, readingQuestionID uuid.UUID) ([]*reading.ReadingChoiceMultiQuestion, error) {
 	questions, err := s.repo.GetByReadingQuestionID(ctx, readingQuestionID)
 	if err != nil {
-		s.logger.Error("reading_choice_multi_question_service.get_by_reading_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_multi_question_service.get_by_reading_id", map[string]interface{}{
 			"error":               err.Error(),
 			"reading_question_id": readingQuestionID,
 		}, "Failed to get questions by reading question ID")
@@ -63,7 +63,7 @@ func (s *ReadingChoiceOneOptionService) CreateOption(ctx context.Context, option
 		existingCorrectOption, err := s.repo.GetCorrectOption(ctx, option.ReadingChoiceOneQuestionID)
 		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 			tx.Rollback()
-			s.logger.Error("reading_choice_one_option_service.create", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("reading_choice_one_option_service.create", map[string]interface{}{
 				"error": err.Error(),
 			}, "Failed to check existing correct option")
 			return err
@@ -74,7 +74,7 @@ func (s *ReadingChoiceOneOptionService) CreateOption(ctx context.Context, option
 			existingCorrectOption.IsCorrect = false
 			if err := s.repo.Update(ctx, existingCorrectOption); err != nil {
 				tx.Rollback()
-				s.logger.Error("reading_choice_one_option_service.create", map[string]interface{}{
+				s.logger.WithContext(ctx).Error("reading_choice_one_option_service.create", map[string]interface{}{
 					"error": err.Error(),
 				}, "Failed to update existing correct option")
 				return err
@@ -84,7 +84,7 @@ func (s *ReadingChoiceOneOptionService) CreateOption(ctx context.Context, option
 
 	if err := s.repo.Create(ctx, option); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_one_option_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_one_option_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create option")
 		return err
@@ -107,7 +107,7 @@ func (s *ReadingChoiceOneOptionService) CreateOption(ctx context.Context, option
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, readingQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_one_option_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_one_option_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    readingQuestion.ID,
 		}, "Failed to update cache and search")
@@ -128,7 +128,7 @@ func (s *ReadingChoiceOneOptionService) GetOption(ctx context.Context, id uuid.U
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, sql.ErrNoRows
 		}
-		s.logger.Error("reading_choice_one_option_service.get", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_one_option_service.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get option")
@@ -155,7 +155,7 @@ func (s *ReadingChoiceOneOptionService) UpdateOption(ctx context.Context, option
 		existingCorrectOption, err := s.repo.GetCorrectOption(ctx, option.ReadingChoiceOneQuestionID)
 		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 			tx.Rollback()
-			s.logger.Error("reading_choice_one_option_service.update", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("reading_choice_one_option_service.update", map[string]interface{}{
 				"error": err.Error(),
 			}, "Failed to check existing correct option")
 			return err
@@ -166,7 +166,7 @@ func (s *ReadingChoiceOneOptionService) UpdateOption(ctx context.Context, option
 			existingCorrectOption.IsCorrect = false
 			if err := s.repo.Update(ctx, existingCorrectOption); err != nil {
 				tx.Rollback()
-				s.logger.Error("reading_choice_one_option_service.update", map[string]interface{}{
+				s.logger.WithContext(ctx).Error("reading_choice_one_option_service.update", map[string]interface{}{
 					"error": err.Error(),
 				}, "Failed to update existing correct option")
 				return err
@@ -176,7 +176,7 @@ func (s *ReadingChoiceOneOptionService) UpdateOption(ctx context.Context, option
 
 	if err := s.repo.Update(ctx, option); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_one_option_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_one_option_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    option.ID,
 		}, "Failed to update option")
@@ -200,7 +200,7 @@ func (s *ReadingChoiceOneOptionService) UpdateOption(ctx context.Context, option
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, readingQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_one_option_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_one_option_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    readingQuestion.ID,
 		}, "Failed to update cache and search")
@@ -238,7 +238,7 @@ func (s *ReadingChoiceOneOptionService) DeleteOption(ctx context.Context, id uui
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return sql.ErrNoRows
 		}
-		s.logger.Error("reading_choice_one_option_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_one_option_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete option")
@@ -262,7 +262,7 @@ func (s *ReadingChoiceOneOptionService) DeleteOption(ctx context.Context, id uui
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, readingQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_one_option_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_one_option_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    readingQuestion.ID,
 		}, "Failed to update cache and search")
@@ -280,7 +280,7 @@ func (s *ReadingChoiceOneOptionService) DeleteOption(ctx context.Context, id uui
 func (s *ReadingChoiceOneOptionService) GetOptionsByQuestionID(ctx context.Context, questionID uuid.UUID) ([]*reading.ReadingChoiceOneOption, error) {
 	options, err := s.repo.GetByReadingChoiceOneQuestionID(ctx, questionID)
 	if err != nil {
-		s.logger.Error("reading_choice_one_option_service.get_by_question_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_one_option_service.get_by_question_id", map[string]interface{}{
 			"error":       err.Error(),
 			"question_id": questionID,
 		}, "Failed to get options by question ID")
@@ -69,7 +69,7 @@ func (s *ReadingChoiceOneQuestionService) CreateQuestion(ctx context.Context, qu
 
 	if err := s.repo.Create(ctx, question); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_one_question_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_one_question_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create question")
 		return err
@@ -79,7 +79,7 @@ func (s *ReadingChoiceOneQuestionService) CreateQuestion(ctx context.Context, qu
 	parentQuestion, err := s.readingQuestionRepo.GetReadingQuestionByID(ctx, question.ReadingQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_one_question_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_one_question_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ReadingQuestionID,
 		}, "Failed to get parent question")
@@ -89,7 +89,7 @@ func (s *ReadingChoiceOneQuestionService) CreateQuestion(ctx context.Context, qu
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_one_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_one_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -110,7 +110,7 @@ func (s *ReadingChoiceOneQuestionService) GetQuestion(ctx context.Context, id uu
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, sql.ErrNoRows
 		}
-		s.logger.Error("reading_choice_one_question_service.get", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_one_question_service.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get question")
@@ -137,7 +137,7 @@ func (s *ReadingChoiceOneQuestionService) UpdateQuestion(ctx context.Context, qu
 
 	if err := s.repo.Update(ctx, question); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_one_question_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_one_question_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to update question")
@@ -148,7 +148,7 @@ func (s *ReadingChoiceOneQuestionService) UpdateQuestion(ctx context.Context, qu
 	parentQuestion, err := s.readingQuestionRepo.GetReadingQuestionByID(ctx, question.ReadingQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_one_question_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_one_question_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ReadingQuestionID,
 		}, "Failed to get parent question")
@@ -158,7 +158,7 @@ func (s *ReadingChoiceOneQuestionService) UpdateQuestion(ctx context.Context, qu
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_one_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_one_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -197,7 +197,7 @@ func (s *ReadingChoiceOneQuestionService) DeleteQuestion(ctx context.Context, id
 
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_one_question_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_one_question_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete question")
@@ -208,7 +208,7 @@ func (s *ReadingChoiceOneQuestionService) DeleteQuestion(ctx context.Context, id
 	parentQuestion, err := s.readingQuestionRepo.GetReadingQuestionByID(ctx, question.ReadingQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_one_question_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_one_question_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ReadingQuestionID,
 		}, "Failed to get parent question")
@@ -218,7 +218,7 @@ func (s *ReadingChoiceOneQuestionService) DeleteQuestion(ctx context.Context, id
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_choice_one_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_one_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -236,7 +236,7 @@ func (s *ReadingChoiceOneQuestionService) DeleteQuestion(ctx context.Context, id
 func (s *ReadingChoiceOneQuestionService) GetQuestionsByReadingQuestionID(ctx context.Context, readingQuestionID uuid.UUID) ([]*reading.ReadingChoiceOneQuestion, error) {
 	questions, err := s.repo.GetByReadingQuestionID(ctx, readingQuestionID)
 	if err != nil {
-		s.logger.Error("reading_choice_one_question_service.get_by_reading_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_choice_one_question_service.get_by_reading_id", map[string]interface{}{
 			"error":               err.Error(),
 			"reading_question_id": readingQuestionID,
 		}, "Failed to get questions by reading question ID")
@@ -75,7 +75,7 @@ func (s *ReadingFillInTheBlankAnswerService) CreateAnswer(ctx context.Context, a
 
 	// Create answer in database
 	if err := s.repo.Create(ctx, answer); err != nil {
-		s.logger.Error("reading_fill_in_the_blank_answer_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_fill_in_the_blank_answer_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create answer")
 		return err
@@ -84,7 +84,7 @@ func (s *ReadingFillInTheBlankAnswerService) CreateAnswer(ctx context.Context, a
 	// Get parent reading question for cache/search update
 	parentQuestion, err := s.readingQuestionRepo.GetReadingQuestionByID(ctx, fillInBlankQuestion.ReadingQuestionID)
 	if err != nil {
-		s.logger.Error("reading_fill_in_the_blank_answer_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_fill_in_the_blank_answer_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    fillInBlankQuestion.ReadingQuestionID,
 		}, "Failed to get parent reading question")
@@ -93,7 +93,7 @@ func (s *ReadingFillInTheBlankAnswerService) CreateAnswer(ctx context.Context, a
 
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
-		s.logger.Error("reading_fill_in_the_blank_answer_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_fill_in_the_blank_answer_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -108,7 +108,7 @@ func (s *ReadingFillInTheBlankAnswerService) GetAnswer(ctx context.Context, id u
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, sql.ErrNoRows
 		}
-		s.logger.Error("reading_fill_in_the_blank_answer_service.get", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_fill_in_the_blank_answer_service.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get answer")
@@ -130,7 +130,7 @@ func (s *ReadingFillInTheBlankAnswerService) UpdateAnswer(ctx context.Context, a
 
 	// Update answer in database
 	if err := s.repo.Update(ctx, answer); err != nil {
-		s.logger.Error("reading_fill_in_the_blank_answer_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_fill_in_the_blank_answer_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    answer.ID,
 		}, "Failed to update answer")
@@ -140,7 +140,7 @@ func (s *ReadingFillInTheBlankAnswerService) UpdateAnswer(ctx context.Context, a
 	// Get parent reading question for cache/search update
 	parentQuestion, err := s.readingQuestionRepo.GetReadingQuestionByID(ctx, fillInBlankQuestion.ReadingQuestionID)
 	if err != nil {
-		s.logger.Error("reading_fill_in_the_blank_answer_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_fill_in_the_blank_answer_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    fillInBlankQuestion.ReadingQuestionID,
 		}, "Failed to get parent reading question")
@@ -149,7 +149,7 @@ func (s *ReadingFillInTheBlankAnswerService) UpdateAnswer(ctx context.Context, a
 
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
-		s.logger.Error("reading_fill_in_the_blank_answer_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_fill_in_the_blank_answer_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -185,7 +185,7 @@ func (s *ReadingFillInTheBlankAnswerService) DeleteAnswer(ctx context.Context, i
 	// Delete from database
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_fill_in_the_blank_answer_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_fill_in_the_blank_answer_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete answer")
@@ -196,7 +196,7 @@ func (s *ReadingFillInTheBlankAnswerService) DeleteAnswer(ctx context.Context, i
 	parentQuestion, err := s.readingQuestionRepo.GetReadingQuestionByID(ctx, fillInBlankQuestion.ReadingQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_fill_in_the_blank_answer_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_fill_in_the_blank_answer_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    fillInBlankQuestion.ReadingQuestionID,
 		}, "Failed to get parent reading question")
@@ -206,7 +206,7 @@ func (s *ReadingFillInTheBlankAnswerService) DeleteAnswer(ctx context.Context, i
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_fill_in_the_blank_answer_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_fill_in_the_blank_answer_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -224,7 +224,7 @@ func (s *ReadingFillInTheBlankAnswerService) DeleteAnswer(ctx context.Context, i
 func (s *ReadingFillInTheBlankAnswerService) GetAnswersByReadingFillInTheBlankQuestionID(ctx context.Context, questionID uuid.UUID) ([]*reading.ReadingFillInTheBlankAnswer, error) {
 	answers, err := s.repo.GetByReadingFillInTheBlankQuestionID(ctx, questionID)
 	if err != nil {
-		s.logger.Error("reading_fill_in_the_blank_answer_service.get_by_question", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_fill_in_the_blank_answer_service.get_by_question", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get answers")
@@ -85,7 +85,7 @@ func (s *ReadingFillInTheBlankQuestionService) CreateQuestion(ctx context.Contex
 		if errors.Is(err, ErrDuplicateQuestion) {
 			return ErrDuplicateQuestion
 		}
-		s.logger.Error("reading_fill_in_the_blank_question_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_fill_in_the_blank_question_service.create", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to create question")
@@ -96,7 +96,7 @@ func (s *ReadingFillInTheBlankQuestionService) CreateQuestion(ctx context.Contex
 	parentQuestion, err := s.readingQuestionRepo.GetReadingQuestionByID(ctx, question.ReadingQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_fill_in_the_blank_question_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_fill_in_the_blank_question_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ReadingQuestionID,
 		}, "Failed to get parent question")
@@ -106,7 +106,7 @@ func (s *ReadingFillInTheBlankQuestionService) CreateQuestion(ctx context.Contex
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_fill_in_the_blank_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_fill_in_the_blank_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -125,7 +125,7 @@ func (s *ReadingFillInTheBlankQuestionService) CreateQuestion(ctx context.Contex
 func (s *ReadingFillInTheBlankQuestionService) GetQuestion(ctx context.Context, id uuid.UUID) (*reading.ReadingFillInTheBlankQuestion, error) {
 	question, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		s.logger.Error("reading_fill_in_the_blank_question_service.get", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_fill_in_the_blank_question_service.get", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get question")
@@ -153,7 +153,7 @@ func (s *ReadingFillInTheBlankQuestionService) UpdateQuestion(ctx context.Contex
 	// Update in database
 	if err := s.repo.Update(ctx, question); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_fill_in_the_blank_question_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_fill_in_the_blank_question_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
 		}, "Failed to update question")
@@ -164,7 +164,7 @@ func (s *ReadingFillInTheBlankQuestionService) UpdateQuestion(ctx context.Contex
 	parentQuestion, err := s.readingQuestionRepo.GetReadingQuestionByID(ctx, question.ReadingQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_fill_in_the_blank_question_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_fill_in_the_blank_question_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ReadingQuestionID,
 		}, "Failed to get parent question")
@@ -174,7 +174,7 @@ func (s *ReadingFillInTheBlankQuestionService) UpdateQuestion(ctx context.Contex
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_fill_in_the_blank_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_fill_in_the_blank_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -211,7 +211,7 @@ func (s *ReadingFillInTheBlankQuestionService) DeleteQuestion(ctx context.Contex
 	// Delete from database
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_fill_in_the_blank_question_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_fill_in_the_blank_question_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete question")
@@ -222,7 +222,7 @@ func (s *ReadingFillInTheBlankQuestionService) DeleteQuestion(ctx context.Contex
 	parentQuestion, err := s.readingQuestionRepo.GetReadingQuestionByID(ctx, question.ReadingQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_fill_in_the_blank_question_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_fill_in_the_blank_question_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ReadingQuestionID,
 		}, "Failed to get parent question")
@@ -232,7 +232,7 @@ func (s *ReadingFillInTheBlankQuestionService) DeleteQuestion(ctx context.Contex
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_fill_in_the_blank_question_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_fill_in_the_blank_question_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -251,7 +251,7 @@ func (s *ReadingFillInTheBlankQuestionService) DeleteQuestion(ctx context.Contex
 func (s *ReadingFillInTheBlankQuestionService) GetQuestionsByReadingQuestionID(ctx context.Context, readingQuestionID uuid.UUID) ([]*reading.ReadingFillInTheBlankQuestion, error) {
 	questions, err := s.repo.GetByReadingQuestionID(ctx, readingQuestionID)
 	if err != nil {
-		s.logger.Error("reading_fill_in_the_blank_question_service.get_by_reading_question_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_fill_in_the_blank_question_service.get_by_reading_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    readingQuestionID,
 		}, "Failed to get questions")
@@ -78,7 +78,7 @@ func (s *ReadingMatchingService) Create(ctx context.Context, matching *reading.R
 
 	if err := s.repo.Create(ctx, matching); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_matching_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_matching_service.create", map[string]interface{}{
 			"error": err.Error(),
 			"id":    matching.ID,
 		}, "Failed to create matching")
@@ -89,7 +89,7 @@ func (s *ReadingMatchingService) Create(ctx context.Context, matching *reading.R
 	parentQuestion, err := s.readingQuestionRepo.GetReadingQuestionByID(ctx, matching.ReadingQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_matching_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_matching_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    matching.ReadingQuestionID,
 		}, "Failed to get parent question")
@@ -99,7 +99,7 @@ func (s *ReadingMatchingService) Create(ctx context.Context, matching *reading.R
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_matching_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_matching_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -117,7 +117,7 @@ func (s *ReadingMatchingService) Create(ctx context.Context, matching *reading.R
 func (s *ReadingMatchingService) GetByID(ctx context.Context, id uuid.UUID) (*reading.ReadingMatching, error) {
 	matching, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		s.logger.Error("reading_matching_service.get_by_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_matching_service.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get matching by ID")
@@ -144,7 +144,7 @@ func (s *ReadingMatchingService) Update(ctx context.Context, matching *reading.R
 
 	if err := s.repo.Update(ctx, matching); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_matching_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_matching_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    matching.ID,
 		}, "Failed to update matching")
@@ -155,7 +155,7 @@ func (s *ReadingMatchingService) Update(ctx context.Context, matching *reading.R
 	parentQuestion, err := s.readingQuestionRepo.GetReadingQuestionByID(ctx, matching.ReadingQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_matching_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_matching_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    matching.ReadingQuestionID,
 		}, "Failed to get parent question")
@@ -165,7 +165,7 @@ func (s *ReadingMatchingService) Update(ctx context.Context, matching *reading.R
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_matching_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_matching_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -200,7 +200,7 @@ func (s *ReadingMatchingService) Delete(ctx context.Context, id uuid.UUID) error
 
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_matching_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_matching_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete matching")
@@ -211,7 +211,7 @@ func (s *ReadingMatchingService) Delete(ctx context.Context, id uuid.UUID) error
 	parentQuestion, err := s.readingQuestionRepo.GetReadingQuestionByID(ctx, matching.ReadingQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_matching_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_matching_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    matching.ReadingQuestionID,
 		}, "Failed to get parent question")
@@ -221,7 +221,7 @@ func (s *ReadingMatchingService) Delete(ctx context.Context, id uuid.UUID) error
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_matching_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_matching_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -239,7 +239,7 @@ func (s *ReadingMatchingService) Delete(ctx context.Context, id uuid.UUID) error
 func (s *ReadingMatchingService) GetByReadingQuestionID(ctx context.Context, readingQuestionID uuid.UUID) ([]*reading.ReadingMatching, error) {
 	matchings, err := s.repo.GetByReadingQuestionID(ctx, readingQuestionID)
 	if err != nil {
-		s.logger.Error("reading_matching_service.get_by_reading_question_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_matching_service.get_by_reading_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    readingQuestionID,
 		}, "Failed to get matchings")
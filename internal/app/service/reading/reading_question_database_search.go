@@ -0,0 +1,68 @@
+package reading
+
+import (
+	"context"
+	readingDTO "fluencybe/internal/app/dto"
+	searchRepo "fluencybe/internal/app/repository/search"
+	searchService "fluencybe/internal/app/service/search"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// ReadingQuestionDatabaseSearch answers search filters from Postgres with the same clauses
+// ReadingQuestionSearch sends to OpenSearch, it is used while OpenSearch is unavailable
+type ReadingQuestionDatabaseSearch struct {
+	repo *searchRepo.QuestionFullTextRepository
+	load func(ctx context.Context, ids []uuid.UUID) ([]*readingDTO.ReadingQuestionDetail, error)
+}
+
+func NewReadingQuestionDatabaseSearch(
+	repo *searchRepo.QuestionFullTextRepository,
+	load func(ctx context.Context, ids []uuid.UUID) ([]*readingDTO.ReadingQuestionDetail, error),
+) *ReadingQuestionDatabaseSearch {
+	return &ReadingQuestionDatabaseSearch{
+		repo: repo,
+		load: load,
+	}
+}
+
+func (s *ReadingQuestionDatabaseSearch) SearchQuestions(ctx context.Context, filter readingDTO.ReadingQuestionSearchFilter) (*readingDTO.ListReadingQuestionsPagination, error) {
+	ids, total, err := s.repo.Search(ctx, "reading", searchRepo.QuestionSearchQuery{
+		Type:  filter.Type,
+		Topic: filter.Topic,
+		Matches: []searchRepo.TextMatch{
+			{Columns: []string{"instruction", "title", "passages", "topic"}, Text: filter.Query, AllTerms: true},
+			{Columns: []string{"instruction"}, Text: filter.Instruction},
+			{Columns: []string{"title"}, Text: filter.Title},
+			{Columns: []string{"passages"}, Text: filter.Passages},
+		},
+		ArrayTerms: map[string]string{
+			"image_urls": filter.ImageURLs,
+		},
+		MaxTime:    filter.MaxTime,
+		Metadata:   filter.Metadata,
+		ChildText:  filter.ChildText,
+		HasChild:   filter.HasChild,
+		MinOptions: filter.MinOptions,
+		Page:       filter.Page,
+		PageSize:   filter.PageSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute database search: %w", err)
+	}
+
+	questions, err := s.load(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load questions: %w", err)
+	}
+
+	return &readingDTO.ListReadingQuestionsPagination{
+		Questions: searchService.InIDOrder(ids, questions, func(q *readingDTO.ReadingQuestionDetail) uuid.UUID {
+			return q.ID
+		}),
+		Total:    total,
+		Page:     filter.Page,
+		PageSize: filter.PageSize,
+	}, nil
+}
@@ -1,7 +1,6 @@
 package reading
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -11,18 +10,17 @@ import (
 	searchClient "fluencybe/internal/app/opensearch"
 	redisClient "fluencybe/internal/app/redis"
 	ReadingRepository "fluencybe/internal/app/repository/reading"
+	searchRepo "fluencybe/internal/app/repository/search"
+	searchService "fluencybe/internal/app/service/search"
 	readingValidator "fluencybe/internal/app/validator"
 	"fluencybe/pkg/cache"
 	"fluencybe/pkg/logger"
 	"fmt"
-	"io/ioutil"
-	"strconv"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/opensearch-project/opensearch-go/v2"
-	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
 )
 
 var (
@@ -35,9 +33,12 @@ type ReadingQuestionService struct {
 	logger                     *logger.PrettyLogger
 	redis                      *redisClient.ReadingQuestionRedis
 	search                     *searchClient.ReadingQuestionSearch
+	searcher                   searchService.QuestionSearcher[readingDTO.ReadingQuestionSearchFilter, *readingDTO.ListReadingQuestionsPagination]
+	similarity                 *searchService.QuestionSimilarityService
 	completion                 *readingHelper.ReadingQuestionCompletionHelper
 	updater                    *readingHelper.ReadingQuestionFieldUpdater
 	questionUpdator            *readingHelper.ReadingQuestionUpdator
+	warmup                     redisClient.CacheWarmup
 	fillInBlankQuestionService *ReadingFillInTheBlankQuestionService
 	fillInBlankAnswerService   *ReadingFillInTheBlankAnswerService
 	choiceOneQuestionService   *ReadingChoiceOneQuestionService
@@ -63,11 +64,12 @@ func NewReadingQuestionService(
 	matchingService *ReadingMatchingService,
 	questionUpdator *readingHelper.ReadingQuestionUpdator,
 ) *ReadingQuestionService {
-	return &ReadingQuestionService{
+	s := &ReadingQuestionService{
 		repo:                       repo,
 		logger:                     logger,
 		redis:                      redisClient.NewReadingQuestionRedis(cache, logger),
 		search:                     searchClient.NewReadingQuestionSearch(openSearch, logger),
+		similarity:                 searchService.NewQuestionSimilarityService(searchClient.NewQuestionSimilarity(openSearch, logger), logger),
 		completion:                 readingHelper.NewReadingQuestionCompletionHelper(logger),
 		updater:                    readingHelper.NewReadingQuestionFieldUpdater(logger),
 		fillInBlankQuestionService: fillInBlankQuestionService,
@@ -80,82 +82,82 @@ func NewReadingQuestionService(
 		matchingService:            matchingService,
 		questionUpdator:            questionUpdator,
 	}
+	s.searcher = searchService.NewFallbackSearcher[readingDTO.ReadingQuestionSearchFilter, *readingDTO.ListReadingQuestionsPagination](
+		"reading",
+		s.search,
+		NewReadingQuestionDatabaseSearch(searchRepo.NewQuestionFullTextRepository(repo.GetDB(), logger), s.GetReadingByListID),
+		logger,
+	)
+	return s
 }
 
-func (s *ReadingQuestionService) CreateQuestion(ctx context.Context, question *reading.ReadingQuestion) error {
+// CreateQuestion stores a new question and returns the existing questions it nearly duplicates
+func (s *ReadingQuestionService) CreateQuestion(ctx context.Context, question *reading.ReadingQuestion) ([]readingDTO.SimilarQuestion, error) {
 	if question == nil {
-		return ErrInvalidInput
+		return nil, ErrInvalidInput
 	}
 
 	// Validate question
 	if err := readingValidator.ValidateReadingQuestion(question); err != nil {
-		return fmt.Errorf("validation error: %w", err)
+		return nil, fmt.Errorf("validation error: %w", err)
 	}
 
 	// Create in database
 	if err := s.repo.CreateReadingQuestion(ctx, question); err != nil {
-		s.logger.Error("reading_question_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_question_service.create", map[string]interface{}{
 			"error":         err.Error(),
 			"question_type": question.Type,
 		}, "Failed to create reading question")
-		return err
+		return nil, err
 	}
 
 	// Use questionUpdator to build complete question detail and update cache/search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, question); err != nil {
-		s.logger.Error("reading_question_service.create.cache_and_search", map[string]interface{}{
+		// The question is committed, the outbox relay syncs cache and search once they are back
+		s.logger.WithContext(ctx).Warning("reading_question_service.create.cache_and_search", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
-		}, "Failed to update cache and search")
-		return err
+		}, "Failed to update cache and search, leaving it to the outbox relay")
 	}
 
-	return nil
+	// Near-duplicates only warn the author, the question is created either way
+	return s.similarity.NearDuplicates(ctx, "reading", question.ID, strings.Join(append([]string{question.Instruction, question.Title}, question.Passages...), "\n")), nil
 }
 
 func (s *ReadingQuestionService) GetReadingQuestionDetail(ctx context.Context, id uuid.UUID) (*readingDTO.ReadingQuestionDetail, error) {
-	// Try to get from cache with both complete and uncomplete status
-	pattern := fmt.Sprintf("reading_question:%s:*", id)
-	keys, err := s.redis.GetCache().Keys(ctx, pattern)
-	if err == nil && len(keys) > 0 {
-		// Found in cache, try to get the data
-		cachedData, err := s.redis.GetCache().Get(ctx, keys[0])
-		if err == nil {
-			var response readingDTO.ReadingQuestionDetail
-			if err := json.Unmarshal([]byte(cachedData), &response); err == nil {
-				return &response, nil
-			}
+	response, err := s.redis.GetReadingQuestionDetail(ctx, id, func(ctx context.Context) (*readingDTO.ReadingQuestionDetail, bool, error) {
+		question, err := s.repo.GetReadingQuestionByID(ctx, id)
+		if errors.Is(err, ReadingRepository.ErrQuestionNotFound) {
+			return nil, false, nil
+		}
+		if err != nil {
+			s.logger.WithContext(ctx).Error("reading_question_service.get", map[string]interface{}{
+				"error": err.Error(),
+				"id":    id,
+			}, "Failed to get reading question")
+			return nil, false, err
 		}
-	}
 
-	// If not in cache or error, get from database
-	question, err := s.repo.GetReadingQuestionByID(ctx, id)
-	if err != nil {
-		s.logger.Error("reading_question_service.get", map[string]interface{}{
-			"error": err.Error(),
-			"id":    id,
-		}, "Failed to get reading question")
-		return nil, err
-	}
+		response, err := s.getReadingQuestionDetail(ctx, question)
+		if err != nil {
+			s.logger.WithContext(ctx).Error("reading_question_service.get.detail", map[string]interface{}{
+				"error": err.Error(),
+				"id":    id,
+			}, "Failed to get question details")
+			return nil, false, err
+		}
 
-	response, err := s.getReadingQuestionDetail(ctx, question)
+		return response, s.completion.IsQuestionComplete(response), nil
+	})
 	if err != nil {
-		s.logger.Error("reading_question_service.get.detail", map[string]interface{}{
-			"error": err.Error(),
-			"id":    id,
-		}, "Failed to get question details")
 		return nil, err
 	}
+	if response == nil {
+		return nil, ErrQuestionNotFound
+	}
 
-	// Check completion status
-	isComplete := s.completion.IsQuestionComplete(response)
-
-	// Cache with status and version
-	if err := s.redis.SetCacheReadingQuestionDetail(ctx, response, isComplete); err != nil {
-		s.logger.Error("reading_question_service.get.cache", map[string]interface{}{
-			"error": err.Error(),
-			"id":    id,
-		}, "Failed to cache question detail")
+	if s.warmup != nil {
+		s.warmup.RecordRead("reading", id)
 	}
 
 	return response, nil
@@ -207,7 +209,7 @@ func (s *ReadingQuestionService) getReadingQuestionDetail(ctx context.Context, q
 func (s *ReadingQuestionService) loadFillInTheBlankData(ctx context.Context, questionID uuid.UUID, response *readingDTO.ReadingQuestionDetail) error {
 	questions, err := s.fillInBlankQuestionService.GetQuestionsByReadingQuestionID(ctx, questionID)
 	if err != nil {
-		s.logger.Error("loadFillInTheBlankData.get_questions", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("loadFillInTheBlankData.get_questions", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get fill in blank questions")
@@ -223,7 +225,7 @@ func (s *ReadingQuestionService) loadFillInTheBlankData(ctx context.Context, que
 
 		answers, err := s.fillInBlankAnswerService.GetAnswersByReadingFillInTheBlankQuestionID(ctx, question.ID)
 		if err != nil {
-			s.logger.Error("loadFillInTheBlankData.get_answers", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("loadFillInTheBlankData.get_answers", map[string]interface{}{
 				"error":      err.Error(),
 				"questionID": question.ID,
 			}, "Failed to get fill in blank answers")
@@ -246,7 +248,7 @@ func (s *ReadingQuestionService) loadFillInTheBlankData(ctx context.Context, que
 func (s *ReadingQuestionService) loadChoiceOneData(ctx context.Context, questionID uuid.UUID, response *readingDTO.ReadingQuestionDetail) error {
 	questions, err := s.choiceOneQuestionService.GetQuestionsByReadingQuestionID(ctx, questionID)
 	if err != nil {
-		s.logger.Error("loadChoiceOneData.get_questions", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("loadChoiceOneData.get_questions", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get choice one questions")
@@ -263,7 +265,7 @@ func (s *ReadingQuestionService) loadChoiceOneData(ctx context.Context, question
 
 		options, err := s.choiceOneOptionService.GetOptionsByQuestionID(ctx, question.ID)
 		if err != nil {
-			s.logger.Error("loadChoiceOneData.get_options", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("loadChoiceOneData.get_options", map[string]interface{}{
 				"error":      err.Error(),
 				"questionID": question.ID,
 			}, "Failed to get choice one options")
@@ -286,7 +288,7 @@ func (s *ReadingQuestionService) loadChoiceOneData(ctx context.Context, question
 func (s *ReadingQuestionService) loadChoiceMultiData(ctx context.Context, questionID uuid.UUID, response *readingDTO.ReadingQuestionDetail) error {
 	questions, err := s.choiceMultiQuestionService.GetQuestionsByReadingQuestionID(ctx, questionID)
 	if err != nil {
-		s.logger.Error("loadChoiceMultiData.get_questions", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("loadChoiceMultiData.get_questions", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get choice multi questions")
@@ -303,7 +305,7 @@ func (s *ReadingQuestionService) loadChoiceMultiData(ctx context.Context, questi
 
 		options, err := s.choiceMultiOptionService.GetOptionsByQuestionID(ctx, question.ID)
 		if err != nil {
-			s.logger.Error("loadChoiceMultiData.get_options", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("loadChoiceMultiData.get_options", map[string]interface{}{
 				"error":      err.Error(),
 				"questionID": question.ID,
 			}, "Failed to get choice multi options")
@@ -326,7 +328,7 @@ func (s *ReadingQuestionService) loadChoiceMultiData(ctx context.Context, questi
 func (s *ReadingQuestionService) loadTrueFalseData(ctx context.Context, questionID uuid.UUID, response *readingDTO.ReadingQuestionDetail) error {
 	trueFalses, err := s.trueFalseService.GetByReadingQuestionID(ctx, questionID)
 	if err != nil {
-		s.logger.Error("loadTrueFalseData.get_questions", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("loadTrueFalseData.get_questions", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get true/false questions")
@@ -349,7 +351,7 @@ func (s *ReadingQuestionService) loadTrueFalseData(ctx context.Context, question
 func (s *ReadingQuestionService) loadMatchingData(ctx context.Context, questionID uuid.UUID, response *readingDTO.ReadingQuestionDetail) error {
 	matchings, err := s.matchingService.GetByReadingQuestionID(ctx, questionID)
 	if err != nil {
-		s.logger.Error("loadMatchingData.get_matchings", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("loadMatchingData.get_matchings", map[string]interface{}{
 			"error":      err.Error(),
 			"questionID": questionID,
 		}, "Failed to get matching questions")
@@ -372,7 +374,7 @@ func (s *ReadingQuestionService) loadMatchingData(ctx context.Context, questionI
 func (s *ReadingQuestionService) DeleteQuestion(ctx context.Context, id uuid.UUID) error {
 	// Delete from database
 	if err := s.repo.DeleteReadingQuestion(ctx, id); err != nil {
-		s.logger.Error("listening_question_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_question_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete question")
@@ -384,7 +386,7 @@ func (s *ReadingQuestionService) DeleteQuestion(ctx context.Context, id uuid.UUI
 
 	// Delete from OpenSearch
 	if err := s.search.DeleteReadingQuestionFromIndex(ctx, id); err != nil {
-		s.logger.Error("listening_question_service.delete.opensearch", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("listening_question_service.delete.opensearch", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete question from OpenSearch")
@@ -395,382 +397,107 @@ func (s *ReadingQuestionService) DeleteQuestion(ctx context.Context, id uuid.UUI
 }
 
 func (s *ReadingQuestionService) SearchQuestionsWithFilter(ctx context.Context, filter readingDTO.ReadingQuestionSearchFilter) (*readingDTO.ListReadingQuestionsPagination, error) {
-	// Calculate offset
-	from := (filter.Page - 1) * filter.PageSize
+	s.logger.WithContext(ctx).Debug("reading_question_service.search.start", map[string]interface{}{
+		"filter": filter,
+	}, "Starting question search")
 
-	// Build bool query
-	boolQuery := map[string]interface{}{
-		"bool": map[string]interface{}{
-			"must": []map[string]interface{}{},
-		},
-	}
-
-	// Add type filter
-	if filter.Type != "" {
-		boolQuery["bool"].(map[string]interface{})["must"] = append(
-			boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
-			map[string]interface{}{
-				"match": map[string]interface{}{
-					"type": filter.Type,
-				},
-			},
-		)
-	}
-
-	// Add topic filter
-	if filter.Topic != "" {
-		topics := strings.Split(filter.Topic, ",")
-		topicTerms := make([]interface{}, len(topics))
-		for i, topic := range topics {
-			topicTerms[i] = strings.TrimSpace(topic)
-		}
-		boolQuery["bool"].(map[string]interface{})["must"] = append(
-			boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
-			map[string]interface{}{
-				"terms": map[string]interface{}{
-					"topic.keyword": topicTerms,
-				},
-			},
-		)
-	}
-
-	// Add instruction filter
-	if filter.Instruction != "" {
-		boolQuery["bool"].(map[string]interface{})["must"] = append(
-			boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
-			map[string]interface{}{
-				"match": map[string]interface{}{
-					"instruction": filter.Instruction,
-				},
-			},
-		)
-	}
-
-	// Add title filter
-	if filter.Title != "" {
-		boolQuery["bool"].(map[string]interface{})["must"] = append(
-			boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
-			map[string]interface{}{
-				"match": map[string]interface{}{
-					"title": filter.Title,
-				},
-			},
-		)
-	}
-
-	// Add passages filter
-	if filter.Passages != "" {
-		boolQuery["bool"].(map[string]interface{})["must"] = append(
-			boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
-			map[string]interface{}{
-				"match": map[string]interface{}{
-					"passages": filter.Passages,
-				},
-			},
-		)
-	}
-
-	// Add image_urls filter
-	if filter.ImageURLs != "" {
-		boolQuery["bool"].(map[string]interface{})["must"] = append(
-			boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
-			map[string]interface{}{
-				"term": map[string]interface{}{
-					"image_urls": filter.ImageURLs,
-				},
-			},
-		)
-	}
-
-	// Add max_time range filter
-	if filter.MaxTime != "" {
-		parts := strings.Split(filter.MaxTime, "-")
-		if len(parts) == 2 {
-			minTime, err1 := strconv.Atoi(strings.TrimSpace(parts[0]))
-			maxTime, err2 := strconv.Atoi(strings.TrimSpace(parts[1]))
-			if err1 == nil && err2 == nil {
-				rangeQuery := map[string]interface{}{
-					"range": map[string]interface{}{
-						"max_time": map[string]interface{}{
-							"gte": minTime,
-							"lte": maxTime,
-						},
-					},
-				}
-				boolQuery["bool"].(map[string]interface{})["must"] = append(
-					boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
-					rangeQuery,
-				)
-			}
-		}
-	}
-
-	// Add metadata filter based on type
-	if filter.Metadata != "" && filter.Type != "" {
-		metadataQuery := map[string]interface{}{}
-
-		switch filter.Type {
-		case "FILL_IN_THE_BLANK":
-			metadataQuery = map[string]interface{}{
-				"multi_match": map[string]interface{}{
-					"query": filter.Metadata,
-					"fields": []string{
-						"fill_in_the_blank_question",
-						"fill_in_the_blank_answers",
-					},
-				},
-			}
-		case "CHOICE_ONE", "CHOICE_MULTI":
-			metadataQuery = map[string]interface{}{
-				"multi_match": map[string]interface{}{
-					"query": filter.Metadata,
-					"fields": []string{
-						filter.Type + "_question",
-						filter.Type + "_options",
-					},
-				},
-			}
-		case "TRUE_FALSE":
-			metadataQuery = map[string]interface{}{
-				"multi_match": map[string]interface{}{
-					"query": filter.Metadata,
-					"fields": []string{
-						"true_false_question",
-						"true_false_answer",
-					},
-				},
-			}
-		case "MATCHING":
-			metadataQuery = map[string]interface{}{
-				"multi_match": map[string]interface{}{
-					"query": filter.Metadata,
-					"fields": []string{
-						filter.Type + "_questions_and_answers",
-					},
-				},
-			}
-		}
-
-		if len(metadataQuery) > 0 {
-			boolQuery["bool"].(map[string]interface{})["must"] = append(
-				boolQuery["bool"].(map[string]interface{})["must"].([]map[string]interface{}),
-				metadataQuery,
-			)
-		}
-	}
-
-	// Build final search body
-	searchBody := map[string]interface{}{
-		"query":            boolQuery,
-		"from":             from,
-		"size":             filter.PageSize,
-		"track_total_hits": true,
-	}
-
-	// Add debug logging
-	searchJSON, err := json.Marshal(searchBody)
+	result, err := s.searcher.SearchQuestions(ctx, filter)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal search body: %w", err)
+		s.logger.WithContext(ctx).Error("reading_question_service.search", map[string]interface{}{
+			"error":  err.Error(),
+			"filter": filter,
+		}, "Failed to search questions")
+		return nil, fmt.Errorf("failed to search questions: %w", err)
 	}
 
-	s.logger.Debug("opensearch_query_details", map[string]interface{}{
-		"query_json": string(searchJSON),
-		"type":       filter.Type,
-		"topics":     filter.Topic,
-		"max_time":   filter.MaxTime,
-		"page":       filter.Page,
-		"page_size":  filter.PageSize,
-		"bool_query": boolQuery,
-	}, "OpenSearch query details")
+	s.logger.WithContext(ctx).Debug("reading_question_service.search.complete", map[string]interface{}{
+		"total_results": result.Total,
+		"page":          result.Page,
+		"page_size":     result.PageSize,
+	}, "Search completed")
 
-	// Execute search
-	searchReq := opensearchapi.SearchRequest{
-		Index: []string{"reading_questions"},
-		Body:  bytes.NewReader(searchJSON),
-	}
+	return result, nil
+}
 
-	searchRes, err := searchReq.Do(ctx, s.search.GetClient())
-	if err != nil {
-		s.logger.Error("opensearch_search_error", map[string]interface{}{
+func (s *ReadingQuestionService) DeleteAllQuestions(ctx context.Context) error {
+	// The outbox trigger queues a delete event per row in this same statement, so cache and
+	// search are cleared by the relay even when the bulk cleanup below fails
+	if err := s.repo.GetDB().WithContext(ctx).Exec("DELETE FROM reading_questions").Error; err != nil {
+		s.logger.WithContext(ctx).Error("reading_question_service.delete_all", map[string]interface{}{
 			"error": err.Error(),
-			"query": string(searchJSON),
-		}, "Failed to execute OpenSearch query")
-		return nil, fmt.Errorf("failed to execute search: %w", err)
+		}, "Failed to delete all reading questions")
+		return err
 	}
-	defer searchRes.Body.Close()
 
-	// Add more detailed debug logging for response
-	var buf bytes.Buffer
-	if _, err := buf.ReadFrom(searchRes.Body); err != nil {
-		s.logger.Error("opensearch_read_error", map[string]interface{}{
+	// Delete all Redis cache with pattern reading_question:*
+	if err := s.redis.GetCache().DeletePattern(ctx, "reading_question:*"); err != nil {
+		s.logger.WithContext(ctx).Warning("reading_question_service.delete_all.cache", map[string]interface{}{
 			"error": err.Error(),
-		}, "Failed to read OpenSearch response")
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
-	searchRes.Body = ioutil.NopCloser(&buf)
-
-	s.logger.Debug("opensearch_response_details", map[string]interface{}{
-		"response": buf.String(),
-	}, "OpenSearch response details")
-
-	var searchResult struct {
-		Hits struct {
-			Total struct {
-				Value int64 `json:"value"`
-			} `json:"total"`
-			Hits []struct {
-				Source struct {
-					ID                     uuid.UUID `json:"id"`
-					Type                   string    `json:"type"`
-					Topic                  []string  `json:"topic"`
-					Instruction            string    `json:"instruction"`
-					Title                  string    `json:"title"`
-					Passages               []string  `json:"passages"`
-					ImageURLs              []string  `json:"image_urls"`
-					MaxTime                int       `json:"max_time"`
-					Version                int       `json:"version"`
-					Status                 string    `json:"status"`
-					TrueFalse              string    `json:"true_false"`
-					ChoiceMultiQuestion    string    `json:"choice_multi_question"`
-					ChoiceMultiOptions     string    `json:"choice_multi_options"`
-					ChoiceOneQuestion      string    `json:"choice_one_question"`
-					ChoiceOneOptions       string    `json:"choice_one_options"`
-					FillInTheBlankQuestion string    `json:"fill_in_the_blank_question"`
-					FillInTheBlankAnswers  string    `json:"fill_in_the_blank_answers"`
-					Matching               string    `json:"MATCHING"`
-				} `json:"_source"`
-			} `json:"hits"`
-		} `json:"hits"`
-	}
-
-	if err := json.NewDecoder(&buf).Decode(&searchResult); err != nil {
-		return nil, fmt.Errorf("failed to decode search response: %w", err)
-	}
-
-	var questions []readingDTO.ReadingQuestionDetail
-	for _, hit := range searchResult.Hits.Hits {
-		question := readingDTO.ReadingQuestionDetail{
-			ReadingQuestionResponse: readingDTO.ReadingQuestionResponse{
-				ID:          hit.Source.ID,
-				Type:        hit.Source.Type,
-				Topic:       hit.Source.Topic,
-				Instruction: hit.Source.Instruction,
-				Title:       hit.Source.Title,
-				Passages:    hit.Source.Passages,
-				ImageURLs:   hit.Source.ImageURLs,
-				MaxTime:     hit.Source.MaxTime,
-				Version:     hit.Source.Version,
-			},
-		}
-
-		// Parse additional fields based on question type
-		switch hit.Source.Type {
-		case "TRUE_FALSE":
-			if hit.Source.TrueFalse != "" && hit.Source.TrueFalse != "null" {
-				var trueFalse []readingDTO.ReadingTrueFalseResponse
-				if err := json.Unmarshal([]byte(hit.Source.TrueFalse), &trueFalse); err == nil {
-					question.TrueFalse = trueFalse
-				}
-			}
-		case "CHOICE_MULTI":
-			if hit.Source.ChoiceMultiQuestion != "" && hit.Source.ChoiceMultiQuestion != "null" {
-				var choiceMultiQuestion readingDTO.ReadingChoiceMultiQuestionResponse
-				if err := json.Unmarshal([]byte(hit.Source.ChoiceMultiQuestion), &choiceMultiQuestion); err == nil {
-					question.ChoiceMultiQuestion = &choiceMultiQuestion
-				}
-			}
-			if hit.Source.ChoiceMultiOptions != "" && hit.Source.ChoiceMultiOptions != "null" {
-				var choiceMultiOptions []readingDTO.ReadingChoiceMultiOptionResponse
-				if err := json.Unmarshal([]byte(hit.Source.ChoiceMultiOptions), &choiceMultiOptions); err == nil {
-					question.ChoiceMultiOptions = choiceMultiOptions
-				}
-			}
-		case "CHOICE_ONE":
-			if hit.Source.ChoiceOneQuestion != "" && hit.Source.ChoiceOneQuestion != "null" {
-				var choiceOneQuestion readingDTO.ReadingChoiceOneQuestionResponse
-				if err := json.Unmarshal([]byte(hit.Source.ChoiceOneQuestion), &choiceOneQuestion); err == nil {
-					question.ChoiceOneQuestion = &choiceOneQuestion
-				}
-			}
-			if hit.Source.ChoiceOneOptions != "" && hit.Source.ChoiceOneOptions != "null" {
-				var choiceOneOptions []readingDTO.ReadingChoiceOneOptionResponse
-				if err := json.Unmarshal([]byte(hit.Source.ChoiceOneOptions), &choiceOneOptions); err == nil {
-					question.ChoiceOneOptions = choiceOneOptions
-				}
-			}
-		case "FILL_IN_THE_BLANK":
-			if hit.Source.FillInTheBlankQuestion != "" && hit.Source.FillInTheBlankQuestion != "null" {
-				var fillInBlankQuestion readingDTO.ReadingFillInTheBlankQuestionResponse
-				if err := json.Unmarshal([]byte(hit.Source.FillInTheBlankQuestion), &fillInBlankQuestion); err == nil {
-					question.FillInTheBlankQuestion = &fillInBlankQuestion
-				}
-			}
-			if hit.Source.FillInTheBlankAnswers != "" && hit.Source.FillInTheBlankAnswers != "null" {
-				var fillInBlankAnswers []readingDTO.ReadingFillInTheBlankAnswerResponse
-				if err := json.Unmarshal([]byte(hit.Source.FillInTheBlankAnswers), &fillInBlankAnswers); err == nil {
-					question.FillInTheBlankAnswers = fillInBlankAnswers
-				}
-			}
-		}
+		}, "Failed to delete Redis cache, leaving it to the outbox relay")
+	}
 
-		questions = append(questions, question)
+	// Delete OpenSearch index
+	if err := s.search.RemoveReadingQuestionsIndex(ctx); err != nil {
+		s.logger.WithContext(ctx).Warning("reading_question_service.delete_all.search", map[string]interface{}{
+			"error": err.Error(),
+		}, "Failed to delete OpenSearch index, leaving it to the outbox relay")
 	}
 
-	result := &readingDTO.ListReadingQuestionsPagination{
-		Questions: questions,
-		Total:     searchResult.Hits.Total.Value,
-		Page:      filter.Page,
-		PageSize:  filter.PageSize,
+	// The cache is empty now, warm it again for the questions that come back
+	if s.warmup != nil {
+		s.warmup.Reset("reading")
 	}
 
-	return result, nil
+	return nil
 }
 
-func (s *ReadingQuestionService) DeleteAllQuestions(ctx context.Context) error {
-	// Start transaction
-	tx := s.repo.GetDB().WithContext(ctx).Begin()
-	if tx.Error != nil {
-		return fmt.Errorf("failed to start transaction: %w", tx.Error)
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
-		}
-	}()
+// SetCacheWarmup reports reads to warmup and resets it once all questions are deleted
+func (s *ReadingQuestionService) SetCacheWarmup(warmup redisClient.CacheWarmup) {
+	s.warmup = warmup
+}
 
-	// Delete all records from reading_questions
-	if err := tx.Exec("DELETE FROM reading_questions").Error; err != nil {
-		tx.Rollback()
-		s.logger.Error("reading_question_service.delete_all", map[string]interface{}{
-			"error": err.Error(),
-		}, "Failed to delete all reading questions")
+// WarmQuestion writes the detail of a question to the cache, a deleted question is not an error.
+// It does not count as a read.
+func (s *ReadingQuestionService) WarmQuestion(ctx context.Context, id uuid.UUID) error {
+	question, err := s.repo.GetReadingQuestionByID(ctx, id)
+	if errors.Is(err, ReadingRepository.ErrQuestionNotFound) {
+		return nil
+	}
+	if err != nil {
 		return err
 	}
 
-	// Delete all Redis cache with pattern reading_question:*
-	if err := s.redis.GetCache().DeletePattern(ctx, "reading_question:*"); err != nil {
-		tx.Rollback()
-		s.logger.Error("reading_question_service.delete_all.cache", map[string]interface{}{
-			"error": err.Error(),
-		}, "Failed to delete Redis cache")
+	detail, err := s.getReadingQuestionDetail(ctx, question)
+	if err != nil {
 		return err
 	}
+	return s.redis.SetCacheReadingQuestionDetail(ctx, detail, s.completion.IsQuestionComplete(detail))
+}
 
-	// Delete OpenSearch index
-	if err := s.search.RemoveReadingQuestionsIndex(ctx); err != nil {
-		tx.Rollback()
-		s.logger.Error("reading_question_service.delete_all.search", map[string]interface{}{
-			"error": err.Error(),
-		}, "Failed to delete OpenSearch index")
+// SyncQuestionByID refreshes the cache entries and search document of a question from the
+// database, removing them if the question is gone. The outbox relay calls it for every event.
+func (s *ReadingQuestionService) SyncQuestionByID(ctx context.Context, id uuid.UUID) error {
+	question, err := s.repo.GetReadingQuestionByID(ctx, id)
+	if errors.Is(err, ReadingRepository.ErrQuestionNotFound) {
+		return s.questionUpdator.RemoveQuestion(ctx, id)
+	}
+	if err != nil {
 		return err
 	}
+	return s.questionUpdator.SyncQuestion(ctx, question)
+}
 
-	if err := tx.Commit().Error; err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to commit transaction: %w", err)
+// BuildSearchDocument returns the search document of a question for index rebuilds, or nil
+// when the question has been deleted in the meantime
+func (s *ReadingQuestionService) BuildSearchDocument(ctx context.Context, id uuid.UUID) (map[string]interface{}, error) {
+	question, err := s.repo.GetReadingQuestionByID(ctx, id)
+	if errors.Is(err, ReadingRepository.ErrQuestionNotFound) {
+		return nil, nil
 	}
-
-	return nil
+	if err != nil {
+		return nil, err
+	}
+	return s.questionUpdator.BuildSearchDocument(ctx, question)
 }
 
 func (s *ReadingQuestionService) GetNewUpdatedQuestions(ctx context.Context, versionChecks []struct {
@@ -842,7 +569,7 @@ func (s *ReadingQuestionService) GetReadingByListID(ctx context.Context, ids []u
 			Find(&dbQuestions)
 
 		if query.Error != nil {
-			s.logger.Error("reading_question_service.get_by_list_id", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("reading_question_service.get_by_list_id", map[string]interface{}{
 				"error": query.Error.Error(),
 			}, "Failed to get questions from database")
 			return nil, query.Error
@@ -853,7 +580,7 @@ func (s *ReadingQuestionService) GetReadingByListID(ctx context.Context, ids []u
 			// Build complete response with details
 			questionDetail, err := s.getReadingQuestionDetail(ctx, q)
 			if err != nil {
-				s.logger.Error("reading_question_service.get_by_list_id.build_response", map[string]interface{}{
+				s.logger.WithContext(ctx).Error("reading_question_service.get_by_list_id.build_response", map[string]interface{}{
 					"error": err.Error(),
 					"id":    q.ID,
 				}, "Failed to build complete response")
@@ -865,7 +592,7 @@ func (s *ReadingQuestionService) GetReadingByListID(ctx context.Context, ids []u
 
 			// Cache with status and version
 			if err := s.redis.SetCacheReadingQuestionDetail(ctx, questionDetail, isComplete); err != nil {
-				s.logger.Error("reading_question_service.get_by_list_id.cache", map[string]interface{}{
+				s.logger.WithContext(ctx).Error("reading_question_service.get_by_list_id.cache", map[string]interface{}{
 					"error": err.Error(),
 					"id":    q.ID,
 				}, "Failed to cache question")
@@ -899,7 +626,7 @@ func (s *ReadingQuestionService) UpdateQuestion(ctx context.Context, id uuid.UUI
 
 	// Update in database
 	if err := s.repo.UpdateReadingQuestion(ctx, baseQuestion); err != nil {
-		s.logger.Error("Reading_question_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("Reading_question_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    baseQuestion.ID,
 		}, "Failed to update question in database")
@@ -908,7 +635,7 @@ func (s *ReadingQuestionService) UpdateQuestion(ctx context.Context, id uuid.UUI
 
 	// Use the updator to update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, baseQuestion); err != nil {
-		s.logger.Error("Reading_question_service.update.cache_and_search", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("Reading_question_service.update.cache_and_search", map[string]interface{}{
 			"error": err.Error(),
 			"id":    baseQuestion.ID,
 		}, "Failed to update cache and search")
@@ -78,7 +78,7 @@ func (s *ReadingTrueFalseService) Create(ctx context.Context, trueFalse *reading
 
 	if err := s.repo.Create(ctx, trueFalse); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_true_false_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_true_false_service.create", map[string]interface{}{
 			"error": err.Error(),
 			"id":    trueFalse.ID,
 		}, "Failed to create true/false question")
@@ -89,7 +89,7 @@ func (s *ReadingTrueFalseService) Create(ctx context.Context, trueFalse *reading
 	parentQuestion, err := s.readingQuestionRepo.GetReadingQuestionByID(ctx, trueFalse.ReadingQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_true_false_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_true_false_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    trueFalse.ReadingQuestionID,
 		}, "Failed to get parent question")
@@ -99,7 +99,7 @@ func (s *ReadingTrueFalseService) Create(ctx context.Context, trueFalse *reading
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_true_false_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_true_false_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -117,7 +117,7 @@ func (s *ReadingTrueFalseService) Create(ctx context.Context, trueFalse *reading
 func (s *ReadingTrueFalseService) GetByID(ctx context.Context, id uuid.UUID) (*reading.ReadingTrueFalse, error) {
 	trueFalse, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		s.logger.Error("reading_true_false_service.get_by_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_true_false_service.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get true/false question")
@@ -144,7 +144,7 @@ func (s *ReadingTrueFalseService) Update(ctx context.Context, trueFalse *reading
 
 	if err := s.repo.Update(ctx, trueFalse); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_true_false_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_true_false_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    trueFalse.ID,
 		}, "Failed to update true/false question")
@@ -155,7 +155,7 @@ func (s *ReadingTrueFalseService) Update(ctx context.Context, trueFalse *reading
 	parentQuestion, err := s.readingQuestionRepo.GetReadingQuestionByID(ctx, trueFalse.ReadingQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_true_false_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_true_false_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    trueFalse.ReadingQuestionID,
 		}, "Failed to get parent question")
@@ -165,7 +165,7 @@ func (s *ReadingTrueFalseService) Update(ctx context.Context, trueFalse *reading
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_true_false_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_true_false_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -200,7 +200,7 @@ func (s *ReadingTrueFalseService) Delete(ctx context.Context, id uuid.UUID) erro
 
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_true_false_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_true_false_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete true/false question")
@@ -211,7 +211,7 @@ func (s *ReadingTrueFalseService) Delete(ctx context.Context, id uuid.UUID) erro
 	parentQuestion, err := s.readingQuestionRepo.GetReadingQuestionByID(ctx, trueFalse.ReadingQuestionID)
 	if err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_true_false_service.get_parent", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_true_false_service.get_parent", map[string]interface{}{
 			"error": err.Error(),
 			"id":    trueFalse.ReadingQuestionID,
 		}, "Failed to get parent question")
@@ -221,7 +221,7 @@ func (s *ReadingTrueFalseService) Delete(ctx context.Context, id uuid.UUID) erro
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("reading_true_false_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_true_false_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -239,7 +239,7 @@ func (s *ReadingTrueFalseService) Delete(ctx context.Context, id uuid.UUID) erro
 func (s *ReadingTrueFalseService) GetByReadingQuestionID(ctx context.Context, readingQuestionID uuid.UUID) ([]*reading.ReadingTrueFalse, error) {
 	trueFalses, err := s.repo.GetByReadingQuestionID(ctx, readingQuestionID)
 	if err != nil {
-		s.logger.Error("reading_true_false_service.get_by_reading_question_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("reading_true_false_service.get_by_reading_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    readingQuestionID,
 		}, "Failed to get true/false questions")
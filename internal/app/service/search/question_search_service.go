@@ -0,0 +1,73 @@
+package search
+
+import (
+	"context"
+	"errors"
+	searchDTO "fluencybe/internal/app/dto"
+	searchClient "fluencybe/internal/app/opensearch"
+	"fluencybe/internal/core/constants"
+	"fluencybe/internal/core/status"
+	"fluencybe/pkg/logger"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrUnknownSkill      = errors.New("unknown skill")
+	ErrQueryTooLong      = errors.New("search query is too long")
+	ErrSearchUnavailable = errors.New("search is temporarily unavailable")
+)
+
+// QuestionSearchService searches the questions of every skill at once
+type QuestionSearchService struct {
+	search *searchClient.QuestionSearch
+	logger *logger.PrettyLogger
+}
+
+func NewQuestionSearchService(search *searchClient.QuestionSearch, logger *logger.PrettyLogger) *QuestionSearchService {
+	return &QuestionSearchService{
+		search: search,
+		logger: logger,
+	}
+}
+
+func (s *QuestionSearchService) SearchQuestions(ctx context.Context, filter searchDTO.QuestionSearchFilter) (*searchDTO.QuestionSearchResult, error) {
+	filter.Query = strings.TrimSpace(filter.Query)
+	if len(filter.Query) > constants.QuestionSearchMaxQueryLength {
+		return nil, ErrQueryTooLong
+	}
+
+	skills := constants.ChangeFeedSkills
+	if filter.Skill != "" {
+		skills = nil
+		for _, skill := range strings.Split(filter.Skill, ",") {
+			skill = strings.TrimSpace(skill)
+			if _, ok := searchClient.QuestionIndexes[skill]; !ok {
+				return nil, fmt.Errorf("%w: %q", ErrUnknownSkill, skill)
+			}
+			skills = append(skills, skill)
+		}
+	}
+
+	if !status.GetOpenSearchStatus() {
+		return nil, ErrSearchUnavailable
+	}
+
+	result, err := s.search.Search(ctx, filter, skills)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("question_search_service.search", map[string]interface{}{
+			"error":  err.Error(),
+			"filter": filter,
+		}, "Failed to search questions")
+		return nil, fmt.Errorf("failed to search questions: %w", err)
+	}
+
+	s.logger.WithContext(ctx).Debug("question_search_service.search.complete", map[string]interface{}{
+		"total_results": result.Total,
+		"page":          result.Page,
+		"page_size":     result.PageSize,
+		"skills":        skills,
+	}, "Search completed")
+
+	return result, nil
+}
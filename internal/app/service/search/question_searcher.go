@@ -0,0 +1,74 @@
+package search
+
+import (
+	"context"
+	"fluencybe/internal/core/status"
+	"fluencybe/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// QuestionSearcher answers a skill search filter with one page of questions
+type QuestionSearcher[F any, R any] interface {
+	SearchQuestions(ctx context.Context, filter F) (R, error)
+}
+
+// FallbackSearcher sends searches to OpenSearch while it is healthy and to Postgres full-text
+// search otherwise. A failed OpenSearch query is retried on Postgres, since the health status
+// is only refreshed periodically.
+type FallbackSearcher[F any, R any] struct {
+	skill      string
+	openSearch QuestionSearcher[F, R]
+	database   QuestionSearcher[F, R]
+	logger     *logger.PrettyLogger
+}
+
+func NewFallbackSearcher[F any, R any](
+	skill string,
+	openSearch QuestionSearcher[F, R],
+	database QuestionSearcher[F, R],
+	logger *logger.PrettyLogger,
+) *FallbackSearcher[F, R] {
+	return &FallbackSearcher[F, R]{
+		skill:      skill,
+		openSearch: openSearch,
+		database:   database,
+		logger:     logger,
+	}
+}
+
+func (s *FallbackSearcher[F, R]) SearchQuestions(ctx context.Context, filter F) (R, error) {
+	if status.GetOpenSearchStatus() {
+		result, err := s.openSearch.SearchQuestions(ctx, filter)
+		if err == nil {
+			return result, nil
+		}
+		s.logger.WithContext(ctx).Warning("question_searcher.opensearch_failed", map[string]interface{}{
+			"error": err.Error(),
+			"skill": s.skill,
+		}, "OpenSearch query failed, falling back to Postgres full-text search")
+	} else {
+		s.logger.WithContext(ctx).Debug("question_searcher.fallback", map[string]interface{}{
+			"skill": s.skill,
+		}, "OpenSearch is down, searching with Postgres full-text search")
+	}
+
+	return s.database.SearchQuestions(ctx, filter)
+}
+
+// InIDOrder arranges loaded questions in the order of ids, skipping the ones that could not be
+// loaded, since loaders return cached questions before the ones read from the database
+func InIDOrder[T any](ids []uuid.UUID, questions []*T, idOf func(*T) uuid.UUID) []T {
+	byID := make(map[uuid.UUID]*T, len(questions))
+	for _, question := range questions {
+		byID[idOf(question)] = question
+	}
+
+	ordered := make([]T, 0, len(ids))
+	for _, id := range ids {
+		if question, ok := byID[id]; ok {
+			ordered = append(ordered, *question)
+		}
+	}
+	return ordered
+}
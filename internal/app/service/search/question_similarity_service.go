@@ -0,0 +1,137 @@
+package search
+
+import (
+	"context"
+	"errors"
+	searchDTO "fluencybe/internal/app/dto"
+	searchClient "fluencybe/internal/app/opensearch"
+	"fluencybe/internal/core/constants"
+	"fluencybe/internal/core/status"
+	"fluencybe/pkg/logger"
+	"fluencybe/pkg/similarity"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+var ErrQuestionNotIndexed = errors.New("question is not indexed")
+
+// QuestionSimilarityService finds similar and near-duplicate questions. OpenSearch more_like_this
+// picks the candidates, which are then compared by MinHash over their word shingles.
+type QuestionSimilarityService struct {
+	similarity *searchClient.QuestionSimilarity
+	logger     *logger.PrettyLogger
+}
+
+func NewQuestionSimilarityService(similarity *searchClient.QuestionSimilarity, logger *logger.PrettyLogger) *QuestionSimilarityService {
+	return &QuestionSimilarityService{
+		similarity: similarity,
+		logger:     logger,
+	}
+}
+
+// SimilarQuestions returns the questions of a skill most like an indexed question, most similar first
+func (s *QuestionSimilarityService) SimilarQuestions(ctx context.Context, skill string, id uuid.UUID, size int) ([]searchDTO.SimilarQuestion, error) {
+	if _, ok := searchClient.QuestionIndexes[skill]; !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownSkill, skill)
+	}
+	if size <= 0 {
+		size = constants.SimilarQuestionsDefaultSize
+	}
+	size = min(size, constants.SimilarQuestionsMaxSize)
+
+	if !status.GetOpenSearchStatus() {
+		return nil, ErrSearchUnavailable
+	}
+
+	text, found, err := s.similarity.QuestionText(ctx, skill, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read question: %w", err)
+	}
+	if !found {
+		return nil, ErrQuestionNotIndexed
+	}
+
+	candidates, err := s.similarity.LikeQuestion(ctx, skill, id, size)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("question_similarity_service.similar", map[string]interface{}{
+			"error": err.Error(),
+			"skill": skill,
+			"id":    id,
+		}, "Failed to find similar questions")
+		return nil, fmt.Errorf("failed to find similar questions: %w", err)
+	}
+
+	return rankSimilar(text, candidates), nil
+}
+
+// NearDuplicates returns the questions of a skill whose content is nearly the same as text,
+// leaving out the question id. It only feeds a warning, so failures are logged and yield nothing.
+func (s *QuestionSimilarityService) NearDuplicates(ctx context.Context, skill string, id uuid.UUID, text string) []searchDTO.SimilarQuestion {
+	if strings.TrimSpace(text) == "" || !status.GetOpenSearchStatus() {
+		return nil
+	}
+
+	candidates, err := s.similarity.LikeText(ctx, skill, text, id, constants.SimilarQuestionsDefaultSize)
+	if err != nil {
+		s.logger.WithContext(ctx).Warning("question_similarity_service.near_duplicates", map[string]interface{}{
+			"error": err.Error(),
+			"skill": skill,
+			"id":    id,
+		}, "Failed to check for near-duplicate questions")
+		return nil
+	}
+
+	var duplicates []searchDTO.SimilarQuestion
+	for _, question := range rankSimilar(text, candidates) {
+		if question.NearDuplicate {
+			duplicates = append(duplicates, question)
+		}
+	}
+
+	if len(duplicates) > 0 {
+		ids := make([]uuid.UUID, len(duplicates))
+		for i, question := range duplicates {
+			ids[i] = question.ID
+		}
+		s.logger.WithContext(ctx).Warning("question_similarity_service.near_duplicates.found", map[string]interface{}{
+			"skill":      skill,
+			"id":         id,
+			"duplicates": ids,
+		}, "Question is a near-duplicate of existing questions")
+	}
+
+	return duplicates
+}
+
+// rankSimilar scores the candidates against text and sorts them, most similar first
+func rankSimilar(text string, candidates []searchClient.SimilarCandidate) []searchDTO.SimilarQuestion {
+	signature := similarity.NewSignature(similarity.Shingles(text, constants.SimilarShingleSize), constants.SimilarSignatureSize)
+
+	questions := make([]searchDTO.SimilarQuestion, 0, len(candidates))
+	for _, candidate := range candidates {
+		candidateSignature := similarity.NewSignature(similarity.Shingles(candidate.Text, constants.SimilarShingleSize), constants.SimilarSignatureSize)
+		score := signature.Similarity(candidateSignature)
+		questions = append(questions, searchDTO.SimilarQuestion{
+			ID:            candidate.ID,
+			Skill:         candidate.Skill,
+			Type:          candidate.Type,
+			Topic:         candidate.Topic,
+			Instruction:   candidate.Instruction,
+			Title:         candidate.Title,
+			Score:         candidate.Score,
+			Similarity:    score,
+			NearDuplicate: score >= constants.NearDuplicateThreshold,
+		})
+	}
+
+	sort.SliceStable(questions, func(i, j int) bool {
+		if questions[i].Similarity != questions[j].Similarity {
+			return questions[i].Similarity > questions[j].Similarity
+		}
+		return questions[i].Score > questions[j].Score
+	})
+	return questions
+}
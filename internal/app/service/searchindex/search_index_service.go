@@ -0,0 +1,444 @@
+package searchindex
+
+import (
+	"context"
+	"errors"
+	"fluencybe/internal/app/dto"
+	searchClient "fluencybe/internal/app/opensearch"
+	searchindexRepo "fluencybe/internal/app/repository/searchindex"
+	"fluencybe/internal/core/constants"
+	"fluencybe/pkg/logger"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrUnknownSkill = errors.New("unknown skill")
+	ErrIndexBusy    = errors.New("a reindex or verification of this skill is already running")
+)
+
+const (
+	TaskReindex = "reindex"
+	TaskVerify  = "verify"
+
+	TaskRunning   = "running"
+	TaskSucceeded = "succeeded"
+	TaskFailed    = "failed"
+)
+
+// QuestionIndex is implemented by the skill search adapters
+type QuestionIndex interface {
+	IndexAlias() string
+	CreateVersionedIndex(ctx context.Context, index string) error
+}
+
+// QuestionSource is implemented by the skill question services
+type QuestionSource interface {
+	BuildSearchDocument(ctx context.Context, id uuid.UUID) (map[string]interface{}, error)
+	SyncQuestionByID(ctx context.Context, id uuid.UUID) error
+}
+
+type SkillIndex struct {
+	Index  QuestionIndex
+	Source QuestionSource
+}
+
+// SearchIndexService rebuilds skill indexes from Postgres behind their alias and checks
+// indexed documents against the database
+type SearchIndexService struct {
+	manager *searchClient.IndexManager
+	repo    *searchindexRepo.QuestionVersionRepository
+	skills  map[string]SkillIndex
+	logger  *logger.PrettyLogger
+
+	mu      sync.Mutex
+	running map[string]bool
+	tasks   map[string]*dto.SearchIndexTask
+}
+
+func NewSearchIndexService(
+	manager *searchClient.IndexManager,
+	repo *searchindexRepo.QuestionVersionRepository,
+	skills map[string]SkillIndex,
+	logger *logger.PrettyLogger,
+) *SearchIndexService {
+	return &SearchIndexService{
+		manager: manager,
+		repo:    repo,
+		skills:  skills,
+		logger:  logger,
+		running: make(map[string]bool),
+		tasks:   make(map[string]*dto.SearchIndexTask),
+	}
+}
+
+// Skills returns the registered skills in a stable order
+func (s *SearchIndexService) Skills() []string {
+	skills := make([]string, 0, len(s.skills))
+	for skill := range s.skills {
+		skills = append(skills, skill)
+	}
+	sort.Strings(skills)
+	return skills
+}
+
+// Reindex rebuilds the index of a skill and waits for it to finish
+func (s *SearchIndexService) Reindex(ctx context.Context, skill string) (*dto.ReindexResult, error) {
+	target, err := s.acquire(skill)
+	if err != nil {
+		return nil, err
+	}
+	defer s.release(skill)
+
+	return s.reindex(ctx, skill, target)
+}
+
+// Verify compares the index of a skill with the database, repairing mismatches when asked to
+func (s *SearchIndexService) Verify(ctx context.Context, skill string, repair bool) (*dto.IndexConsistencyReport, error) {
+	target, err := s.acquire(skill)
+	if err != nil {
+		return nil, err
+	}
+	defer s.release(skill)
+
+	return s.verify(ctx, skill, target, repair)
+}
+
+// StartReindex runs Reindex in the background and returns the task tracking it
+func (s *SearchIndexService) StartReindex(skill string) (*dto.SearchIndexTask, error) {
+	target, err := s.acquire(skill)
+	if err != nil {
+		return nil, err
+	}
+	return s.startTask(TaskReindex, skill, func(ctx context.Context) (interface{}, error) {
+		return s.reindex(ctx, skill, target)
+	}), nil
+}
+
+// StartVerify runs Verify in the background and returns the task tracking it
+func (s *SearchIndexService) StartVerify(skill string, repair bool) (*dto.SearchIndexTask, error) {
+	target, err := s.acquire(skill)
+	if err != nil {
+		return nil, err
+	}
+	return s.startTask(TaskVerify, skill, func(ctx context.Context) (interface{}, error) {
+		return s.verify(ctx, skill, target, repair)
+	}), nil
+}
+
+// GetTasks returns the latest reindex and verification tasks of a skill
+func (s *SearchIndexService) GetTasks(skill string) ([]dto.SearchIndexTask, error) {
+	if _, ok := s.skills[skill]; !ok {
+		return nil, ErrUnknownSkill
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tasks := make([]dto.SearchIndexTask, 0, 2)
+	for _, kind := range []string{TaskReindex, TaskVerify} {
+		if task, ok := s.tasks[kind+":"+skill]; ok {
+			tasks = append(tasks, *task)
+		}
+	}
+	return tasks, nil
+}
+
+func (s *SearchIndexService) acquire(skill string) (SkillIndex, error) {
+	target, ok := s.skills[skill]
+	if !ok {
+		return SkillIndex{}, ErrUnknownSkill
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running[skill] {
+		return SkillIndex{}, ErrIndexBusy
+	}
+	s.running[skill] = true
+	return target, nil
+}
+
+func (s *SearchIndexService) release(skill string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.running, skill)
+}
+
+// startTask runs fn detached from the request with the skill already acquired
+func (s *SearchIndexService) startTask(kind, skill string, fn func(ctx context.Context) (interface{}, error)) *dto.SearchIndexTask {
+	task := &dto.SearchIndexTask{
+		Kind:      kind,
+		Skill:     skill,
+		State:     TaskRunning,
+		StartedAt: time.Now().UTC(),
+	}
+
+	s.mu.Lock()
+	s.tasks[kind+":"+skill] = task
+	snapshot := *task
+	s.mu.Unlock()
+
+	go func() {
+		defer s.release(skill)
+
+		ctx, cancel := context.WithTimeout(context.Background(), constants.IndexTaskTimeout)
+		defer cancel()
+
+		result, err := fn(ctx)
+		finishedAt := time.Now().UTC()
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		task.FinishedAt = &finishedAt
+		if err != nil {
+			task.State = TaskFailed
+			task.Error = err.Error()
+			s.logger.Error("search_index_service."+kind, map[string]interface{}{
+				"error": err.Error(),
+				"skill": skill,
+			}, "Search index task failed")
+			return
+		}
+		task.State = TaskSucceeded
+		task.Result = result
+	}()
+
+	return &snapshot
+}
+
+func (s *SearchIndexService) reindex(ctx context.Context, skill string, target SkillIndex) (*dto.ReindexResult, error) {
+	startedAt := time.Now().UTC()
+	alias := target.Index.IndexAlias()
+	index := searchClient.VersionedIndexName(alias, startedAt)
+
+	result := &dto.ReindexResult{
+		Skill:     skill,
+		Index:     index,
+		StartedAt: startedAt,
+	}
+
+	s.logger.WithContext(ctx).Info("search_index_service.reindex.start", map[string]interface{}{
+		"skill": skill,
+		"index": index,
+	}, "Rebuilding search index")
+
+	if err := target.Index.CreateVersionedIndex(ctx, index); err != nil {
+		return nil, fmt.Errorf("failed to create index %s: %w", index, err)
+	}
+
+	abort := func(err error) (*dto.ReindexResult, error) {
+		if dropErr := s.manager.DeleteIndices(context.WithoutCancel(ctx), []string{index}); dropErr != nil {
+			s.logger.WithContext(ctx).Error("search_index_service.reindex.cleanup", map[string]interface{}{
+				"error": dropErr.Error(),
+				"index": index,
+			}, "Failed to drop partially built index")
+		}
+		return nil, err
+	}
+
+	fail := func(id uuid.UUID, reason string) {
+		result.Failed++
+		if len(result.FailedIDs) < constants.IndexVerifyMaxListed {
+			result.FailedIDs = append(result.FailedIDs, id)
+		}
+		s.logger.WithContext(ctx).Warning("search_index_service.reindex.document", map[string]interface{}{
+			"error": reason,
+			"skill": skill,
+			"id":    id,
+		}, "Failed to index question")
+	}
+
+	after := uuid.Nil
+	for {
+		batch, err := s.repo.ListVersions(ctx, skill, after, constants.OpenSearchBulkSize)
+		if err != nil {
+			return abort(err)
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		docs := make(map[string]map[string]interface{}, len(batch))
+		for _, question := range batch {
+			doc, err := target.Source.BuildSearchDocument(ctx, question.ID)
+			if err != nil {
+				if ctx.Err() != nil {
+					return abort(ctx.Err())
+				}
+				fail(question.ID, err.Error())
+				continue
+			}
+			if doc == nil {
+				result.Skipped++
+				continue
+			}
+			docs[question.ID.String()] = doc
+		}
+
+		rejected, err := s.manager.BulkIndex(ctx, index, docs)
+		if err != nil {
+			return abort(err)
+		}
+		for id, reason := range rejected {
+			fail(uuid.MustParse(id), reason)
+		}
+		result.Indexed += len(docs) - len(rejected)
+
+		after = batch[len(batch)-1].ID
+		if len(batch) < constants.OpenSearchBulkSize {
+			break
+		}
+	}
+
+	if err := s.manager.Refresh(ctx, index); err != nil {
+		return abort(err)
+	}
+
+	previous, err := s.manager.SwapAlias(ctx, alias, index)
+	if err != nil {
+		return abort(err)
+	}
+	result.PreviousIndices = previous
+
+	if err := s.manager.DeleteIndices(ctx, previous); err != nil {
+		s.logger.WithContext(ctx).Error("search_index_service.reindex.drop_previous", map[string]interface{}{
+			"error":   err.Error(),
+			"indices": previous,
+		}, "Failed to drop previous indices")
+	}
+
+	// Writes made while the new index was loading went to the previous one, replay them
+	changed, err := s.repo.ListChangedSince(ctx, skill, startedAt)
+	if err != nil {
+		s.logger.WithContext(ctx).Error("search_index_service.reindex.catch_up", map[string]interface{}{
+			"error": err.Error(),
+			"skill": skill,
+		}, "Failed to list questions changed during reindex, run a verification")
+	}
+	for _, id := range changed {
+		if err := target.Source.SyncQuestionByID(ctx, id); err != nil {
+			fail(id, err.Error())
+			continue
+		}
+		result.CaughtUp++
+	}
+
+	result.FinishedAt = time.Now().UTC()
+
+	s.logger.WithContext(ctx).Info("search_index_service.reindex.done", map[string]interface{}{
+		"skill":     skill,
+		"index":     index,
+		"indexed":   result.Indexed,
+		"failed":    result.Failed,
+		"caught_up": result.CaughtUp,
+		"duration":  result.FinishedAt.Sub(startedAt).String(),
+	}, "Search index rebuilt")
+
+	return result, nil
+}
+
+func (s *SearchIndexService) verify(ctx context.Context, skill string, target SkillIndex, repair bool) (*dto.IndexConsistencyReport, error) {
+	stored := make(map[uuid.UUID]int)
+	after := uuid.Nil
+	for {
+		batch, err := s.repo.ListVersions(ctx, skill, after, constants.OpenSearchBulkSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, question := range batch {
+			stored[question.ID] = question.Version
+		}
+		if len(batch) < constants.OpenSearchBulkSize {
+			break
+		}
+		after = batch[len(batch)-1].ID
+	}
+
+	indexed, err := s.manager.ScanVersions(ctx, target.Index.IndexAlias(), constants.OpenSearchBulkSize)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &dto.IndexConsistencyReport{
+		Skill:         skill,
+		DatabaseCount: len(stored),
+		IndexCount:    len(indexed),
+		Missing:       []uuid.UUID{},
+		Stale:         []dto.StaleIndexDocument{},
+		Orphaned:      []string{},
+		Repair:        repair,
+	}
+
+	var toRepair []uuid.UUID
+	for id, version := range stored {
+		doc, ok := indexed[id.String()]
+		switch {
+		case !ok:
+			report.MissingCount++
+			if len(report.Missing) < constants.IndexVerifyMaxListed {
+				report.Missing = append(report.Missing, id)
+			}
+			toRepair = append(toRepair, id)
+		case doc.Version != version:
+			report.StaleCount++
+			if len(report.Stale) < constants.IndexVerifyMaxListed {
+				report.Stale = append(report.Stale, dto.StaleIndexDocument{
+					ID:              id,
+					DatabaseVersion: version,
+					IndexVersion:    doc.Version,
+				})
+			}
+			toRepair = append(toRepair, id)
+		}
+	}
+	for docID := range indexed {
+		id, err := uuid.Parse(docID)
+		if err == nil {
+			if _, ok := stored[id]; ok {
+				continue
+			}
+			toRepair = append(toRepair, id)
+		}
+		report.OrphanedCount++
+		if len(report.Orphaned) < constants.IndexVerifyMaxListed {
+			report.Orphaned = append(report.Orphaned, docID)
+		}
+	}
+
+	sort.Slice(report.Missing, func(i, j int) bool { return report.Missing[i].String() < report.Missing[j].String() })
+	sort.Slice(report.Stale, func(i, j int) bool { return report.Stale[i].ID.String() < report.Stale[j].ID.String() })
+	sort.Strings(report.Orphaned)
+
+	if repair {
+		// SyncQuestionByID reindexes questions that exist and removes the documents of those that don't
+		for _, id := range toRepair {
+			if err := target.Source.SyncQuestionByID(ctx, id); err != nil {
+				report.RepairFailed++
+				s.logger.WithContext(ctx).Warning("search_index_service.verify.repair", map[string]interface{}{
+					"error": err.Error(),
+					"skill": skill,
+					"id":    id,
+				}, "Failed to repair indexed question")
+				continue
+			}
+			report.Repaired++
+		}
+	}
+
+	report.CheckedAt = time.Now().UTC()
+
+	s.logger.WithContext(ctx).Info("search_index_service.verify.done", map[string]interface{}{
+		"skill":    skill,
+		"missing":  report.MissingCount,
+		"stale":    report.StaleCount,
+		"orphaned": report.OrphanedCount,
+		"repaired": report.Repaired,
+	}, "Search index verified")
+
+	return report, nil
+}
@@ -78,7 +78,7 @@ func (s *SpeakingConversationalOpenService) Create(ctx context.Context, conversa
 
 	if err := s.repo.Create(ctx, conversationalOpen); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_conversational_open_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_conversational_open_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create conversational open")
 		return err
@@ -94,7 +94,7 @@ func (s *SpeakingConversationalOpenService) Create(ctx context.Context, conversa
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_conversational_open_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_conversational_open_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -112,7 +112,7 @@ func (s *SpeakingConversationalOpenService) Create(ctx context.Context, conversa
 func (s *SpeakingConversationalOpenService) GetByID(ctx context.Context, id uuid.UUID) (*speaking.SpeakingConversationalOpen, error) {
 	conversationalOpen, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		s.logger.Error("speaking_conversational_open_service.get_by_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_conversational_open_service.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get conversational open by ID")
@@ -124,7 +124,7 @@ func (s *SpeakingConversationalOpenService) GetByID(ctx context.Context, id uuid
 func (s *SpeakingConversationalOpenService) GetBySpeakingQuestionID(ctx context.Context, speakingQuestionID uuid.UUID) ([]*speaking.SpeakingConversationalOpen, error) {
 	conversationalOpens, err := s.repo.GetBySpeakingQuestionID(ctx, speakingQuestionID)
 	if err != nil {
-		s.logger.Error("speaking_conversational_open_service.get_by_speaking_question_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_conversational_open_service.get_by_speaking_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    speakingQuestionID,
 		}, "Failed to get conversational opens")
@@ -151,7 +151,7 @@ func (s *SpeakingConversationalOpenService) Update(ctx context.Context, conversa
 
 	if err := s.repo.Update(ctx, conversationalOpen); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_conversational_open_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_conversational_open_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    conversationalOpen.ID,
 		}, "Failed to update conversational open")
@@ -168,7 +168,7 @@ func (s *SpeakingConversationalOpenService) Update(ctx context.Context, conversa
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_conversational_open_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_conversational_open_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -203,7 +203,7 @@ func (s *SpeakingConversationalOpenService) Delete(ctx context.Context, id uuid.
 
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_conversational_open_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_conversational_open_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete conversational open")
@@ -220,7 +220,7 @@ func (s *SpeakingConversationalOpenService) Delete(ctx context.Context, id uuid.
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_conversational_open_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_conversational_open_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -87,7 +87,7 @@ func (s *SpeakingConversationalRepetitionQAService) Create(ctx context.Context,
 
 	if err := s.repo.Create(ctx, qa); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_conversational_repetition_qa_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_conversational_repetition_qa_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create QA")
 		return err
@@ -110,7 +110,7 @@ func (s *SpeakingConversationalRepetitionQAService) Create(ctx context.Context,
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, speakingQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_conversational_repetition_qa_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_conversational_repetition_qa_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    speakingQuestion.ID,
 		}, "Failed to update cache and search")
@@ -128,7 +128,7 @@ func (s *SpeakingConversationalRepetitionQAService) Create(ctx context.Context,
 func (s *SpeakingConversationalRepetitionQAService) GetByID(ctx context.Context, id uuid.UUID) (*speaking.SpeakingConversationalRepetitionQA, error) {
 	qa, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		s.logger.Error("speaking_conversational_repetition_qa_service.get_by_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_conversational_repetition_qa_service.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get QA by ID")
@@ -140,7 +140,7 @@ func (s *SpeakingConversationalRepetitionQAService) GetByID(ctx context.Context,
 func (s *SpeakingConversationalRepetitionQAService) GetBySpeakingConversationalRepetitionID(ctx context.Context, repetitionID uuid.UUID) ([]*speaking.SpeakingConversationalRepetitionQA, error) {
 	qas, err := s.repo.GetBySpeakingConversationalRepetitionID(ctx, repetitionID)
 	if err != nil {
-		s.logger.Error("speaking_conversational_repetition_qa_service.get_by_repetition_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_conversational_repetition_qa_service.get_by_repetition_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    repetitionID,
 		}, "Failed to get QAs")
@@ -167,7 +167,7 @@ func (s *SpeakingConversationalRepetitionQAService) Update(ctx context.Context,
 
 	if err := s.repo.Update(ctx, qa); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_conversational_repetition_qa_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_conversational_repetition_qa_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    qa.ID,
 		}, "Failed to update QA")
@@ -191,7 +191,7 @@ func (s *SpeakingConversationalRepetitionQAService) Update(ctx context.Context,
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, speakingQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_conversational_repetition_qa_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_conversational_repetition_qa_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    speakingQuestion.ID,
 		}, "Failed to update cache and search")
@@ -226,7 +226,7 @@ func (s *SpeakingConversationalRepetitionQAService) Delete(ctx context.Context,
 
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_conversational_repetition_qa_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_conversational_repetition_qa_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete QA")
@@ -250,7 +250,7 @@ func (s *SpeakingConversationalRepetitionQAService) Delete(ctx context.Context,
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, speakingQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_conversational_repetition_qa_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_conversational_repetition_qa_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    speakingQuestion.ID,
 		}, "Failed to update cache and search")
@@ -75,7 +75,7 @@ func (s *SpeakingConversationalRepetitionService) Create(ctx context.Context, co
 
 	if err := s.repo.Create(ctx, conversation); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_conversational_repetition_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_conversational_repetition_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create conversational repetition")
 		return err
@@ -91,7 +91,7 @@ func (s *SpeakingConversationalRepetitionService) Create(ctx context.Context, co
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_conversational_repetition_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_conversational_repetition_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -109,7 +109,7 @@ func (s *SpeakingConversationalRepetitionService) Create(ctx context.Context, co
 func (s *SpeakingConversationalRepetitionService) GetByID(ctx context.Context, id uuid.UUID) (*speaking.SpeakingConversationalRepetition, error) {
 	conversation, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		s.logger.Error("speaking_conversational_repetition_service.get_by_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_conversational_repetition_service.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get conversational repetition by ID")
@@ -121,7 +121,7 @@ func (s *SpeakingConversationalRepetitionService) GetByID(ctx context.Context, i
 func (s *SpeakingConversationalRepetitionService) GetBySpeakingQuestionID(ctx context.Context, speakingQuestionID uuid.UUID) ([]*speaking.SpeakingConversationalRepetition, error) {
 	conversations, err := s.repo.GetBySpeakingQuestionID(ctx, speakingQuestionID)
 	if err != nil {
-		s.logger.Error("speaking_conversational_repetition_service.get_by_speaking_question_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_conversational_repetition_service.get_by_speaking_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    speakingQuestionID,
 		}, "Failed to get conversational repetitions")
@@ -148,7 +148,7 @@ func (s *SpeakingConversationalRepetitionService) Update(ctx context.Context, co
 
 	if err := s.repo.Update(ctx, conversation); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_conversational_repetition_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_conversational_repetition_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    conversation.ID,
 		}, "Failed to update conversational repetition")
@@ -165,7 +165,7 @@ func (s *SpeakingConversationalRepetitionService) Update(ctx context.Context, co
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_conversational_repetition_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_conversational_repetition_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -200,7 +200,7 @@ func (s *SpeakingConversationalRepetitionService) Delete(ctx context.Context, id
 
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_conversational_repetition_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_conversational_repetition_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete conversational repetition")
@@ -217,7 +217,7 @@ func (s *SpeakingConversationalRepetitionService) Delete(ctx context.Context, id
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_conversational_repetition_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_conversational_repetition_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -78,7 +78,7 @@ func (s *SpeakingOpenParagraphService) Create(ctx context.Context, paragraph *sp
 
 	if err := s.repo.Create(ctx, paragraph); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_open_paragraph_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_open_paragraph_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create open paragraph")
 		return err
@@ -94,7 +94,7 @@ func (s *SpeakingOpenParagraphService) Create(ctx context.Context, paragraph *sp
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_open_paragraph_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_open_paragraph_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -112,7 +112,7 @@ func (s *SpeakingOpenParagraphService) Create(ctx context.Context, paragraph *sp
 func (s *SpeakingOpenParagraphService) GetByID(ctx context.Context, id uuid.UUID) (*speaking.SpeakingOpenParagraph, error) {
 	paragraph, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		s.logger.Error("speaking_open_paragraph_service.get_by_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_open_paragraph_service.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get open paragraph by ID")
@@ -124,7 +124,7 @@ func (s *SpeakingOpenParagraphService) GetByID(ctx context.Context, id uuid.UUID
 func (s *SpeakingOpenParagraphService) GetBySpeakingQuestionID(ctx context.Context, speakingQuestionID uuid.UUID) ([]*speaking.SpeakingOpenParagraph, error) {
 	paragraphs, err := s.repo.GetBySpeakingQuestionID(ctx, speakingQuestionID)
 	if err != nil {
-		s.logger.Error("speaking_open_paragraph_service.get_by_speaking_question_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_open_paragraph_service.get_by_speaking_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    speakingQuestionID,
 		}, "Failed to get open paragraphs")
@@ -151,7 +151,7 @@ func (s *SpeakingOpenParagraphService) Update(ctx context.Context, paragraph *sp
 
 	if err := s.repo.Update(ctx, paragraph); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_open_paragraph_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_open_paragraph_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    paragraph.ID,
 		}, "Failed to update open paragraph")
@@ -168,7 +168,7 @@ func (s *SpeakingOpenParagraphService) Update(ctx context.Context, paragraph *sp
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_open_paragraph_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_open_paragraph_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -203,7 +203,7 @@ func (s *SpeakingOpenParagraphService) Delete(ctx context.Context, id uuid.UUID)
 
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_open_paragraph_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_open_paragraph_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete open paragraph")
@@ -220,7 +220,7 @@ func (s *SpeakingOpenParagraphService) Delete(ctx context.Context, id uuid.UUID)
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_open_paragraph_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_open_paragraph_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -75,7 +75,7 @@ func (s *SpeakingParagraphRepetitionService) Create(ctx context.Context, paragra
 
 	if err := s.repo.Create(ctx, paragraph); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_paragraph_repetition_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_paragraph_repetition_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create paragraph repetition")
 		return err
@@ -91,7 +91,7 @@ func (s *SpeakingParagraphRepetitionService) Create(ctx context.Context, paragra
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_paragraph_repetition_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_paragraph_repetition_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -109,7 +109,7 @@ func (s *SpeakingParagraphRepetitionService) Create(ctx context.Context, paragra
 func (s *SpeakingParagraphRepetitionService) GetByID(ctx context.Context, id uuid.UUID) (*speaking.SpeakingParagraphRepetition, error) {
 	paragraph, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		s.logger.Error("speaking_paragraph_repetition_service.get_by_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_paragraph_repetition_service.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get paragraph repetition by ID")
@@ -121,7 +121,7 @@ func (s *SpeakingParagraphRepetitionService) GetByID(ctx context.Context, id uui
 func (s *SpeakingParagraphRepetitionService) GetBySpeakingQuestionID(ctx context.Context, speakingQuestionID uuid.UUID) ([]*speaking.SpeakingParagraphRepetition, error) {
 	paragraphs, err := s.repo.GetBySpeakingQuestionID(ctx, speakingQuestionID)
 	if err != nil {
-		s.logger.Error("speaking_paragraph_repetition_service.get_by_speaking_question_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_paragraph_repetition_service.get_by_speaking_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    speakingQuestionID,
 		}, "Failed to get paragraph repetitions")
@@ -148,7 +148,7 @@ func (s *SpeakingParagraphRepetitionService) Update(ctx context.Context, paragra
 
 	if err := s.repo.Update(ctx, paragraph); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_paragraph_repetition_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_paragraph_repetition_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    paragraph.ID,
 		}, "Failed to update paragraph repetition")
@@ -165,7 +165,7 @@ func (s *SpeakingParagraphRepetitionService) Update(ctx context.Context, paragra
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_paragraph_repetition_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_paragraph_repetition_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -200,7 +200,7 @@ func (s *SpeakingParagraphRepetitionService) Delete(ctx context.Context, id uuid
 
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_paragraph_repetition_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_paragraph_repetition_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete paragraph repetition")
@@ -217,7 +217,7 @@ func (s *SpeakingParagraphRepetitionService) Delete(ctx context.Context, id uuid
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_paragraph_repetition_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_paragraph_repetition_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
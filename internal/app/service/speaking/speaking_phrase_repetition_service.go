@@ -75,7 +75,7 @@ func (s *SpeakingPhraseRepetitionService) Create(ctx context.Context, phrase *sp
 
 	if err := s.repo.Create(ctx, phrase); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_phrase_repetition_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_phrase_repetition_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create phrase repetition")
 		return err
@@ -91,7 +91,7 @@ func (s *SpeakingPhraseRepetitionService) Create(ctx context.Context, phrase *sp
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_phrase_repetition_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_phrase_repetition_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -109,7 +109,7 @@ func (s *SpeakingPhraseRepetitionService) Create(ctx context.Context, phrase *sp
 func (s *SpeakingPhraseRepetitionService) GetByID(ctx context.Context, id uuid.UUID) (*speaking.SpeakingPhraseRepetition, error) {
 	phrase, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		s.logger.Error("speaking_phrase_repetition_service.get_by_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_phrase_repetition_service.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get phrase repetition by ID")
@@ -121,7 +121,7 @@ func (s *SpeakingPhraseRepetitionService) GetByID(ctx context.Context, id uuid.U
 func (s *SpeakingPhraseRepetitionService) GetBySpeakingQuestionID(ctx context.Context, speakingQuestionID uuid.UUID) ([]*speaking.SpeakingPhraseRepetition, error) {
 	phrases, err := s.repo.GetBySpeakingQuestionID(ctx, speakingQuestionID)
 	if err != nil {
-		s.logger.Error("speaking_phrase_repetition_service.get_by_speaking_question_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_phrase_repetition_service.get_by_speaking_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    speakingQuestionID,
 		}, "Failed to get phrase repetitions")
@@ -148,7 +148,7 @@ func (s *SpeakingPhraseRepetitionService) Update(ctx context.Context, phrase *sp
 
 	if err := s.repo.Update(ctx, phrase); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_phrase_repetition_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_phrase_repetition_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    phrase.ID,
 		}, "Failed to update phrase repetition")
@@ -165,7 +165,7 @@ func (s *SpeakingPhraseRepetitionService) Update(ctx context.Context, phrase *sp
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_phrase_repetition_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_phrase_repetition_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -200,7 +200,7 @@ func (s *SpeakingPhraseRepetitionService) Delete(ctx context.Context, id uuid.UU
 
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_phrase_repetition_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_phrase_repetition_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete phrase repetition")
@@ -217,7 +217,7 @@ func (s *SpeakingPhraseRepetitionService) Delete(ctx context.Context, id uuid.UU
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_phrase_repetition_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_phrase_repetition_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -0,0 +1,57 @@
+package speaking
+
+import (
+	"context"
+	speakingDTO "fluencybe/internal/app/dto"
+	searchRepo "fluencybe/internal/app/repository/search"
+	searchService "fluencybe/internal/app/service/search"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// SpeakingQuestionDatabaseSearch answers search filters from Postgres with the same clauses
+// SpeakingQuestionSearch sends to OpenSearch, it is used while OpenSearch is unavailable
+type SpeakingQuestionDatabaseSearch struct {
+	repo *searchRepo.QuestionFullTextRepository
+	load func(ctx context.Context, ids []uuid.UUID) ([]*speakingDTO.SpeakingQuestionDetail, error)
+}
+
+func NewSpeakingQuestionDatabaseSearch(
+	repo *searchRepo.QuestionFullTextRepository,
+	load func(ctx context.Context, ids []uuid.UUID) ([]*speakingDTO.SpeakingQuestionDetail, error),
+) *SpeakingQuestionDatabaseSearch {
+	return &SpeakingQuestionDatabaseSearch{
+		repo: repo,
+		load: load,
+	}
+}
+
+func (s *SpeakingQuestionDatabaseSearch) SearchQuestions(ctx context.Context, filter speakingDTO.SpeakingQuestionSearchFilter) (*speakingDTO.ListSpeakingQuestionsPagination, error) {
+	ids, total, err := s.repo.Search(ctx, "speaking", searchRepo.QuestionSearchQuery{
+		Type:       filter.Type,
+		Topic:      filter.Topic,
+		ChildText:  filter.ChildText,
+		HasChild:   filter.HasChild,
+		MinOptions: filter.MinOptions,
+		Page:       filter.Page,
+		PageSize:   filter.PageSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute database search: %w", err)
+	}
+
+	questions, err := s.load(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load questions: %w", err)
+	}
+
+	return &speakingDTO.ListSpeakingQuestionsPagination{
+		Questions: searchService.InIDOrder(ids, questions, func(q *speakingDTO.SpeakingQuestionDetail) uuid.UUID {
+			return q.ID
+		}),
+		Total:    total,
+		Page:     filter.Page,
+		PageSize: filter.PageSize,
+	}, nil
+}
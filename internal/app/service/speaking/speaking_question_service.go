@@ -13,7 +13,9 @@ import (
 	"fluencybe/internal/app/model/speaking"
 	searchClient "fluencybe/internal/app/opensearch"
 	redisClient "fluencybe/internal/app/redis"
+	searchRepo "fluencybe/internal/app/repository/search"
 	speakingRepository "fluencybe/internal/app/repository/speaking"
+	searchService "fluencybe/internal/app/service/search"
 	speakingValidator "fluencybe/internal/app/validator"
 	"fluencybe/pkg/cache"
 	"fluencybe/pkg/logger"
@@ -31,9 +33,12 @@ type SpeakingQuestionService struct {
 	logger                            *logger.PrettyLogger
 	redis                             *redisClient.SpeakingQuestionRedis
 	search                            *searchClient.SpeakingQuestionSearch
+	searcher                          searchService.QuestionSearcher[speakingDTO.SpeakingQuestionSearchFilter, *speakingDTO.ListSpeakingQuestionsPagination]
+	similarity                        *searchService.QuestionSimilarityService
 	completion                        *speakingHelper.SpeakingQuestionCompletionHelper
 	updater                           *speakingHelper.SpeakingQuestionFieldUpdater
 	questionUpdator                   *speakingHelper.SpeakingQuestionUpdator
+	warmup                            redisClient.CacheWarmup
 	wordRepetitionService             *SpeakingWordRepetitionService
 	phraseRepetitionService           *SpeakingPhraseRepetitionService
 	paragraphRepetitionService        *SpeakingParagraphRepetitionService
@@ -48,6 +53,7 @@ func NewSpeakingQuestionService(
 	logger *logger.PrettyLogger,
 	cache cache.Cache,
 	search *searchClient.SpeakingQuestionSearch,
+	similarity *searchService.QuestionSimilarityService,
 	wordRepetitionService *SpeakingWordRepetitionService,
 	phraseRepetitionService *SpeakingPhraseRepetitionService,
 	paragraphRepetitionService *SpeakingParagraphRepetitionService,
@@ -57,11 +63,12 @@ func NewSpeakingQuestionService(
 	conversationalOpenService *SpeakingConversationalOpenService,
 	questionUpdator *speakingHelper.SpeakingQuestionUpdator,
 ) *SpeakingQuestionService {
-	return &SpeakingQuestionService{
+	s := &SpeakingQuestionService{
 		repo:                              repo,
 		logger:                            logger,
 		redis:                             redisClient.NewSpeakingQuestionRedis(cache, logger),
 		search:                            search,
+		similarity:                        similarity,
 		completion:                        speakingHelper.NewSpeakingQuestionCompletionHelper(logger),
 		updater:                           speakingHelper.NewSpeakingQuestionFieldUpdater(logger),
 		wordRepetitionService:             wordRepetitionService,
@@ -73,19 +80,27 @@ func NewSpeakingQuestionService(
 		conversationalOpenService:         conversationalOpenService,
 		questionUpdator:                   questionUpdator,
 	}
+	s.searcher = searchService.NewFallbackSearcher[speakingDTO.SpeakingQuestionSearchFilter, *speakingDTO.ListSpeakingQuestionsPagination](
+		"speaking",
+		s.search,
+		NewSpeakingQuestionDatabaseSearch(searchRepo.NewQuestionFullTextRepository(repo.GetDB(), logger), s.GetSpeakingByListID),
+		logger,
+	)
+	return s
 }
 
-func (s *SpeakingQuestionService) CreateQuestion(ctx context.Context, question *speaking.SpeakingQuestion) error {
+// CreateQuestion stores a new question and returns the existing questions it nearly duplicates
+func (s *SpeakingQuestionService) CreateQuestion(ctx context.Context, question *speaking.SpeakingQuestion) ([]speakingDTO.SimilarQuestion, error) {
 	if err := speakingValidator.ValidateSpeakingQuestion(question); err != nil {
-		return fmt.Errorf("validation error: %w", err)
+		return nil, fmt.Errorf("validation error: %w", err)
 	}
 
 	if err := s.repo.CreateSpeakingQuestion(ctx, question); err != nil {
-		s.logger.Error("speaking_question_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_question_service.create", map[string]interface{}{
 			"error":         err.Error(),
 			"question_type": question.Type,
 		}, "Failed to create speaking question")
-		return err
+		return nil, err
 	}
 
 	questionDetail := speakingDTO.SpeakingQuestionDetail{
@@ -108,31 +123,22 @@ func (s *SpeakingQuestionService) CreateQuestion(ctx context.Context, question *
 	// Index to OpenSearch with correct status
 	status := map[bool]string{true: "complete", false: "uncomplete"}[false]
 	if err := s.search.UpsertSpeakingQuestion(ctx, &questionDetail, status); err != nil {
-		s.logger.Error("speaking_question_service.create.opensearch", map[string]interface{}{
+		// The question is committed, the outbox relay indexes it once OpenSearch is back
+		s.logger.WithContext(ctx).Warning("speaking_question_service.create.opensearch", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
-		}, "Failed to index question in OpenSearch")
+		}, "Failed to index question in OpenSearch, leaving it to the outbox relay")
 	}
 
-	return nil
+	// Near-duplicates only warn the author, the question is created either way
+	return s.similarity.NearDuplicates(ctx, "speaking", question.ID, question.Instruction), nil
 }
 
 func (s *SpeakingQuestionService) DeleteAllQuestions(ctx context.Context) error {
-	// Start transaction
-	tx := s.repo.GetDB().WithContext(ctx).Begin()
-	if tx.Error != nil {
-		return fmt.Errorf("failed to start transaction: %w", tx.Error)
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
-		}
-	}()
-
-	// Delete all records from speaking_questions
-	if err := tx.Exec("DELETE FROM speaking_questions").Error; err != nil {
-		tx.Rollback()
-		s.logger.Error("speaking_question_service.delete_all", map[string]interface{}{
+	// The outbox trigger queues a delete event per row in this same statement, so cache and
+	// search are cleared by the relay even when the bulk cleanup below fails
+	if err := s.repo.GetDB().WithContext(ctx).Exec("DELETE FROM speaking_questions").Error; err != nil {
+		s.logger.WithContext(ctx).Error("speaking_question_service.delete_all", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to delete all speaking questions")
 		return err
@@ -140,39 +146,84 @@ func (s *SpeakingQuestionService) DeleteAllQuestions(ctx context.Context) error
 
 	// Delete all Redis cache with pattern speaking_question:*
 	if err := s.redis.GetCache().DeletePattern(ctx, "speaking_question:*"); err != nil {
-		tx.Rollback()
-		s.logger.Error("speaking_question_service.delete_all.cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Warning("speaking_question_service.delete_all.cache", map[string]interface{}{
 			"error": err.Error(),
-		}, "Failed to delete Redis cache")
-		return err
+		}, "Failed to delete Redis cache, leaving it to the outbox relay")
 	}
 
 	// Delete OpenSearch index
 	if err := s.search.RemoveSpeakingQuestionsIndex(ctx); err != nil {
-		tx.Rollback()
-		s.logger.Error("speaking_question_service.delete_all.search", map[string]interface{}{
+		s.logger.WithContext(ctx).Warning("speaking_question_service.delete_all.search", map[string]interface{}{
 			"error": err.Error(),
-		}, "Failed to delete OpenSearch index")
-		return err
+		}, "Failed to delete OpenSearch index, leaving it to the outbox relay")
 	}
 
-	if err := tx.Commit().Error; err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to commit transaction: %w", err)
+	// The cache is empty now, warm it again for the questions that come back
+	if s.warmup != nil {
+		s.warmup.Reset("speaking")
 	}
 
 	return nil
 }
 
+// SetCacheWarmup reports reads to warmup and resets it once all questions are deleted
+func (s *SpeakingQuestionService) SetCacheWarmup(warmup redisClient.CacheWarmup) {
+	s.warmup = warmup
+}
+
+// WarmQuestion writes the detail of a question to the cache, a deleted question is not an error.
+// It does not count as a read.
+func (s *SpeakingQuestionService) WarmQuestion(ctx context.Context, id uuid.UUID) error {
+	question, err := s.repo.GetSpeakingQuestionByID(ctx, id)
+	if errors.Is(err, speakingRepository.ErrQuestionNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	detail, err := s.buildQuestionDetail(ctx, question)
+	if err != nil {
+		return err
+	}
+	return s.redis.SetCacheSpeakingQuestionDetail(ctx, detail, s.completion.IsQuestionComplete(detail))
+}
+
+// SyncQuestionByID refreshes the cache entries and search document of a question from the
+// database, removing them if the question is gone. The outbox relay calls it for every event.
+func (s *SpeakingQuestionService) SyncQuestionByID(ctx context.Context, id uuid.UUID) error {
+	question, err := s.repo.GetSpeakingQuestionByID(ctx, id)
+	if errors.Is(err, speakingRepository.ErrQuestionNotFound) {
+		return s.questionUpdator.RemoveQuestion(ctx, id)
+	}
+	if err != nil {
+		return err
+	}
+	return s.questionUpdator.SyncQuestion(ctx, question)
+}
+
+// BuildSearchDocument returns the search document of a question for index rebuilds, or nil
+// when the question has been deleted in the meantime
+func (s *SpeakingQuestionService) BuildSearchDocument(ctx context.Context, id uuid.UUID) (map[string]interface{}, error) {
+	question, err := s.repo.GetSpeakingQuestionByID(ctx, id)
+	if errors.Is(err, speakingRepository.ErrQuestionNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.questionUpdator.BuildSearchDocument(ctx, question)
+}
+
 func (s *SpeakingQuestionService) SearchQuestionsWithFilter(ctx context.Context, filter speakingDTO.SpeakingQuestionSearchFilter) (*speakingDTO.ListSpeakingQuestionsPagination, error) {
 	// Add debug logging
-	s.logger.Debug("speaking_question_service.search.start", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("speaking_question_service.search.start", map[string]interface{}{
 		"filter": filter,
 	}, "Starting question search")
 
-	result, err := s.search.SearchQuestions(ctx, filter)
+	result, err := s.searcher.SearchQuestions(ctx, filter)
 	if err != nil {
-		s.logger.Error("speaking_question_service.search", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_question_service.search", map[string]interface{}{
 			"error":  err.Error(),
 			"filter": filter,
 		}, "Failed to search questions")
@@ -180,7 +231,7 @@ func (s *SpeakingQuestionService) SearchQuestionsWithFilter(ctx context.Context,
 	}
 
 	// Add debug logging for results
-	s.logger.Debug("speaking_question_service.search.complete", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("speaking_question_service.search.complete", map[string]interface{}{
 		"total_results": result.Total,
 		"page":          result.Page,
 		"page_size":     result.PageSize,
@@ -229,7 +280,7 @@ func (s *SpeakingQuestionService) GetNewUpdatedQuestions(ctx context.Context, ve
 		Order("created_at DESC")
 
 	if err := query.Find(&questions).Error; err != nil {
-		s.logger.Error("speaking_question_service.get_new_speaking_questions", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_question_service.get_new_speaking_questions", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get updated questions")
 		return nil, fmt.Errorf("failed to get updated questions: %w", err)
@@ -239,33 +290,39 @@ func (s *SpeakingQuestionService) GetNewUpdatedQuestions(ctx context.Context, ve
 }
 
 func (s *SpeakingQuestionService) GetSpeakingQuestionDetail(ctx context.Context, id uuid.UUID) (*speakingDTO.SpeakingQuestionDetail, error) {
-	question, err := s.repo.GetSpeakingQuestionByID(ctx, id)
-	if err != nil {
-		s.logger.Error("speaking_question_service.get", map[string]interface{}{
-			"error": err.Error(),
-			"id":    id,
-		}, "Failed to get speaking question")
-		return nil, err
-	}
+	response, err := s.redis.GetSpeakingQuestionDetail(ctx, id, func(ctx context.Context) (*speakingDTO.SpeakingQuestionDetail, bool, error) {
+		question, err := s.repo.GetSpeakingQuestionByID(ctx, id)
+		if errors.Is(err, speakingRepository.ErrQuestionNotFound) {
+			return nil, false, nil
+		}
+		if err != nil {
+			s.logger.WithContext(ctx).Error("speaking_question_service.get", map[string]interface{}{
+				"error": err.Error(),
+				"id":    id,
+			}, "Failed to get speaking question")
+			return nil, false, err
+		}
+
+		response, err := s.buildQuestionDetail(ctx, question)
+		if err != nil {
+			s.logger.WithContext(ctx).Error("speaking_question_service.get.detail", map[string]interface{}{
+				"error": err.Error(),
+				"id":    id,
+			}, "Failed to get question details")
+			return nil, false, err
+		}
 
-	response, err := s.buildQuestionDetail(ctx, question)
+		return response, s.completion.IsQuestionComplete(response), nil
+	})
 	if err != nil {
-		s.logger.Error("speaking_question_service.get.detail", map[string]interface{}{
-			"error": err.Error(),
-			"id":    id,
-		}, "Failed to get question details")
 		return nil, err
 	}
+	if response == nil {
+		return nil, ErrQuestionNotFound
+	}
 
-	// Check completion status
-	isComplete := s.completion.IsQuestionComplete(response)
-
-	// Cache with status and version
-	if err := s.redis.SetCacheSpeakingQuestionDetail(ctx, response, isComplete); err != nil {
-		s.logger.Error("speaking_question_service.get.cache", map[string]interface{}{
-			"error": err.Error(),
-			"id":    id,
-		}, "Failed to cache question detail")
+	if s.warmup != nil {
+		s.warmup.RecordRead("speaking", id)
 	}
 
 	return response, nil
@@ -451,7 +508,7 @@ func (s *SpeakingQuestionService) UpdateQuestion(ctx context.Context, id uuid.UU
 
 	// Update in database
 	if err := s.repo.UpdateSpeakingQuestion(ctx, baseQuestion); err != nil {
-		s.logger.Error("speaking_question_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_question_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    baseQuestion.ID,
 		}, "Failed to update question in database")
@@ -460,7 +517,7 @@ func (s *SpeakingQuestionService) UpdateQuestion(ctx context.Context, id uuid.UU
 
 	// Use the updator to update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, baseQuestion); err != nil {
-		s.logger.Error("speaking_question_service.update.cache_and_search", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_question_service.update.cache_and_search", map[string]interface{}{
 			"error": err.Error(),
 			"id":    baseQuestion.ID,
 		}, "Failed to update cache and search")
@@ -472,7 +529,7 @@ func (s *SpeakingQuestionService) UpdateQuestion(ctx context.Context, id uuid.UU
 
 func (s *SpeakingQuestionService) DeleteQuestion(ctx context.Context, id uuid.UUID) error {
 	if err := s.repo.DeleteSpeakingQuestion(ctx, id); err != nil {
-		s.logger.Error("speaking_question_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_question_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete speaking question")
@@ -484,7 +541,7 @@ func (s *SpeakingQuestionService) DeleteQuestion(ctx context.Context, id uuid.UU
 
 	// Delete from OpenSearch
 	if err := s.search.DeleteSpeakingQuestionFromIndex(ctx, id); err != nil {
-		s.logger.Error("speaking_question_service.delete.opensearch", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_question_service.delete.opensearch", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete question from OpenSearch")
@@ -534,7 +591,7 @@ func (s *SpeakingQuestionService) GetSpeakingByListID(ctx context.Context, ids [
 			Find(&dbQuestions)
 
 		if query.Error != nil {
-			s.logger.Error("speaking_question_service.get_by_list_id", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("speaking_question_service.get_by_list_id", map[string]interface{}{
 				"error": query.Error.Error(),
 			}, "Failed to get questions from database")
 			return nil, query.Error
@@ -545,7 +602,7 @@ func (s *SpeakingQuestionService) GetSpeakingByListID(ctx context.Context, ids [
 			// Build complete response with details
 			questionDetail, err := s.buildQuestionDetail(ctx, q)
 			if err != nil {
-				s.logger.Error("speaking_question_service.get_by_list_id.build_response", map[string]interface{}{
+				s.logger.WithContext(ctx).Error("speaking_question_service.get_by_list_id.build_response", map[string]interface{}{
 					"error": err.Error(),
 					"id":    q.ID,
 				}, "Failed to build complete response")
@@ -557,7 +614,7 @@ func (s *SpeakingQuestionService) GetSpeakingByListID(ctx context.Context, ids [
 
 			// Cache with status and version
 			if err := s.redis.SetCacheSpeakingQuestionDetail(ctx, questionDetail, isComplete); err != nil {
-				s.logger.Error("speaking_question_service.get_by_list_id.cache", map[string]interface{}{
+				s.logger.WithContext(ctx).Error("speaking_question_service.get_by_list_id.cache", map[string]interface{}{
 					"error": err.Error(),
 					"id":    q.ID,
 				}, "Failed to cache question")
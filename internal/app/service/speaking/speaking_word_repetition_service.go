@@ -75,7 +75,7 @@ func (s *SpeakingWordRepetitionService) Create(ctx context.Context, word *speaki
 
 	if err := s.repo.Create(ctx, word); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_word_repetition_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_word_repetition_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create word repetition")
 		return err
@@ -91,7 +91,7 @@ func (s *SpeakingWordRepetitionService) Create(ctx context.Context, word *speaki
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_word_repetition_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_word_repetition_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -109,7 +109,7 @@ func (s *SpeakingWordRepetitionService) Create(ctx context.Context, word *speaki
 func (s *SpeakingWordRepetitionService) GetByID(ctx context.Context, id uuid.UUID) (*speaking.SpeakingWordRepetition, error) {
 	word, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		s.logger.Error("speaking_word_repetition_service.get_by_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_word_repetition_service.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get word repetition by ID")
@@ -121,7 +121,7 @@ func (s *SpeakingWordRepetitionService) GetByID(ctx context.Context, id uuid.UUI
 func (s *SpeakingWordRepetitionService) GetBySpeakingQuestionID(ctx context.Context, speakingQuestionID uuid.UUID) ([]*speaking.SpeakingWordRepetition, error) {
 	words, err := s.repo.GetBySpeakingQuestionID(ctx, speakingQuestionID)
 	if err != nil {
-		s.logger.Error("speaking_word_repetition_service.get_by_speaking_question_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_word_repetition_service.get_by_speaking_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    speakingQuestionID,
 		}, "Failed to get word repetitions")
@@ -148,7 +148,7 @@ func (s *SpeakingWordRepetitionService) Update(ctx context.Context, word *speaki
 
 	if err := s.repo.Update(ctx, word); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_word_repetition_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_word_repetition_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    word.ID,
 		}, "Failed to update word repetition")
@@ -165,7 +165,7 @@ func (s *SpeakingWordRepetitionService) Update(ctx context.Context, word *speaki
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_word_repetition_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_word_repetition_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -200,7 +200,7 @@ func (s *SpeakingWordRepetitionService) Delete(ctx context.Context, id uuid.UUID
 
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_word_repetition_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_word_repetition_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete word repetition")
@@ -217,7 +217,7 @@ func (s *SpeakingWordRepetitionService) Delete(ctx context.Context, id uuid.UUID
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("speaking_word_repetition_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("speaking_word_repetition_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
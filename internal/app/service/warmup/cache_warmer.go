@@ -0,0 +1,308 @@
+package warmup
+
+import (
+	"context"
+	"fluencybe/internal/app/dto"
+	courseRepo "fluencybe/internal/app/repository/course"
+	"fluencybe/internal/core/constants"
+	"fluencybe/pkg/cache"
+	"fluencybe/pkg/logger"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// QuestionWarmer writes the detail of a question to the cache without counting it as a read
+type QuestionWarmer interface {
+	WarmQuestion(ctx context.Context, id uuid.UUID) error
+}
+
+// CacheWarmer prebuilds the cached details of the questions used in lessons and of the most read
+// questions, on start and whenever a skill has lost all its questions. Reads are counted in
+// memory and flushed to Redis so every replica warms the same questions.
+type CacheWarmer struct {
+	lessonQuestions *courseRepo.LessonQuestionRepository
+	popularity      *cache.Popularity
+	warmers         map[string]QuestionWarmer
+	logger          *logger.PrettyLogger
+	// redisReady is closed once Redis is healthy, passes wait for it since the details would
+	// only reach the memory tier of this process before
+	redisReady <-chan struct{}
+
+	// Skills waiting for a new pass, trigger wakes the loop up
+	pendingMu sync.Mutex
+	pending   map[string]struct{}
+	trigger   chan struct{}
+
+	cancel context.CancelFunc
+	done   chan struct{}
+	mu     sync.Mutex
+}
+
+func NewCacheWarmer(
+	lessonQuestions *courseRepo.LessonQuestionRepository,
+	popularity *cache.Popularity,
+	warmers map[string]QuestionWarmer,
+	redisReady <-chan struct{},
+	logger *logger.PrettyLogger,
+) *CacheWarmer {
+	return &CacheWarmer{
+		lessonQuestions: lessonQuestions,
+		popularity:      popularity,
+		warmers:         warmers,
+		logger:          logger,
+		redisReady:      redisReady,
+		pending:         make(map[string]struct{}),
+		trigger:         make(chan struct{}, 1),
+	}
+}
+
+// Start launches the warm-up loop with a pass over every skill once Redis is healthy, calling it
+// again while running is a no-op
+func (w *CacheWarmer) Start() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+	w.done = make(chan struct{})
+
+	w.logger.Info("CACHE_WARMUP_START", map[string]interface{}{
+		"skills":         w.Skills(),
+		"popular_limit":  constants.CacheWarmupPopularLimit,
+		"concurrency":    constants.CacheWarmupConcurrency,
+		"flush_interval": constants.CachePopularityFlushInterval.String(),
+	}, "Starting cache warm-up")
+
+	w.schedule(w.Skills()...)
+	go w.run(ctx)
+}
+
+// Stop ends the warm-up loop, abandoning the pass in flight, and flushes the counted reads
+func (w *CacheWarmer) Stop() {
+	w.mu.Lock()
+	cancel, done := w.cancel, w.done
+	w.cancel = nil
+	w.mu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+
+	ctx, cancel := context.WithTimeout(context.Background(), constants.CacheLoadTimeout)
+	defer cancel()
+	w.flush(ctx)
+}
+
+// Skills returns the skills the warmer knows, sorted
+func (w *CacheWarmer) Skills() []string {
+	skills := make([]string, 0, len(w.warmers))
+	for skill := range w.warmers {
+		skills = append(skills, skill)
+	}
+	sort.Strings(skills)
+	return skills
+}
+
+// RecordRead counts one read of a question
+func (w *CacheWarmer) RecordRead(skill string, id uuid.UUID) {
+	w.popularity.Record(skill, id.String())
+}
+
+// Reset drops the reads counted for skill and schedules a new pass over it. It does not block,
+// the pass runs on the warm-up loop.
+func (w *CacheWarmer) Reset(skill string) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.CacheLoadTimeout)
+	defer cancel()
+	if err := w.popularity.Forget(ctx, skill); err != nil {
+		w.logger.Warning("cache_warmer.reset", map[string]interface{}{
+			"error": err.Error(),
+			"skill": skill,
+		}, "Failed to drop question reads")
+	}
+	w.schedule(skill)
+}
+
+func (w *CacheWarmer) schedule(skills ...string) {
+	w.pendingMu.Lock()
+	for _, skill := range skills {
+		w.pending[skill] = struct{}{}
+	}
+	w.pendingMu.Unlock()
+
+	select {
+	case w.trigger <- struct{}{}:
+	default:
+	}
+}
+
+func (w *CacheWarmer) run(ctx context.Context) {
+	defer close(w.done)
+
+	ticker := time.NewTicker(constants.CachePopularityFlushInterval)
+	defer ticker.Stop()
+
+	// Scheduled passes stay pending until Redis is ready
+	ready := w.redisReady
+	var trigger <-chan struct{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ready:
+			ready = nil
+			trigger = w.trigger
+		case <-ticker.C:
+			w.flush(ctx)
+		case <-trigger:
+			w.pendingMu.Lock()
+			skills := make([]string, 0, len(w.pending))
+			for skill := range w.pending {
+				skills = append(skills, skill)
+			}
+			w.pending = make(map[string]struct{})
+			w.pendingMu.Unlock()
+
+			sort.Strings(skills)
+			for _, skill := range skills {
+				if ctx.Err() != nil {
+					return
+				}
+				w.Warm(ctx, skill)
+			}
+		}
+	}
+}
+
+func (w *CacheWarmer) flush(ctx context.Context) {
+	if err := w.popularity.Flush(ctx); err != nil {
+		w.logger.Warning("cache_warmer.flush", map[string]interface{}{
+			"error": err.Error(),
+		}, "Failed to flush question reads, keeping them for the next flush")
+	}
+}
+
+// Warm writes the details of the questions of skill used in lessons and of its most read
+// questions to the cache, with at most CacheWarmupConcurrency loads at a time
+func (w *CacheWarmer) Warm(ctx context.Context, skill string) *dto.CacheWarmResult {
+	result := &dto.CacheWarmResult{
+		Skill:     skill,
+		StartedAt: time.Now().UTC(),
+	}
+	warmer, ok := w.warmers[skill]
+	if !ok {
+		result.FinishedAt = result.StartedAt
+		return result
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, constants.CacheWarmupTimeout)
+	defer cancel()
+
+	ids := w.collect(ctx, skill)
+
+	queue := make(chan uuid.UUID)
+	var resultMu sync.Mutex
+	var wg sync.WaitGroup
+	for i := 0; i < constants.CacheWarmupConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range queue {
+				err := warmer.WarmQuestion(ctx, id)
+
+				resultMu.Lock()
+				if err != nil {
+					result.Failed++
+					if len(result.FailedIDs) < constants.AdminMaxListed {
+						result.FailedIDs = append(result.FailedIDs, id)
+					}
+				} else {
+					result.Warmed++
+				}
+				resultMu.Unlock()
+
+				if err != nil && ctx.Err() == nil {
+					w.logger.Warning("cache_warmer.warm.question", map[string]interface{}{
+						"error": err.Error(),
+						"skill": skill,
+						"id":    id,
+					}, "Failed to warm question")
+				}
+			}
+		}()
+	}
+
+feed:
+	for _, id := range ids {
+		select {
+		case queue <- id:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	result.FinishedAt = time.Now().UTC()
+
+	w.logger.Info("cache_warmer.warm.done", map[string]interface{}{
+		"skill":    skill,
+		"total":    len(ids),
+		"warmed":   result.Warmed,
+		"failed":   result.Failed,
+		"duration": result.FinishedAt.Sub(result.StartedAt).String(),
+	}, "Cache warmed")
+
+	return result
+}
+
+// collect returns the lesson linked questions of skill followed by its most read ones, without
+// duplicates. A source that fails is logged and skipped, the other one is still warmed.
+func (w *CacheWarmer) collect(ctx context.Context, skill string) []uuid.UUID {
+	seen := make(map[uuid.UUID]struct{})
+	var ids []uuid.UUID
+	add := func(id uuid.UUID) {
+		if _, ok := seen[id]; ok {
+			return
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+
+	linked, err := w.lessonQuestions.ListQuestionIDsByType(ctx, strings.ToUpper(skill))
+	if err != nil {
+		w.logger.Warning("cache_warmer.collect.lessons", map[string]interface{}{
+			"error": err.Error(),
+			"skill": skill,
+		}, "Failed to list lesson questions, warming popular questions only")
+	}
+	for _, id := range linked {
+		add(id)
+	}
+
+	popular, err := w.popularity.Top(ctx, skill, constants.CacheWarmupPopularLimit)
+	if err != nil {
+		w.logger.Warning("cache_warmer.collect.popular", map[string]interface{}{
+			"error": err.Error(),
+			"skill": skill,
+		}, "Failed to read popular questions, warming lesson questions only")
+	}
+	for _, raw := range popular {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			continue
+		}
+		add(id)
+	}
+
+	return ids
+}
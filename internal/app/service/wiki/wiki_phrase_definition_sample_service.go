@@ -55,13 +55,13 @@ func (s *WikiPhraseDefinitionSampleService) Create(ctx context.Context, sample *
 	// Update phrase cache since samples are part of phrase details
 	phrase, err := s.phraseRepo.GetByID(ctx, definition.WikiPhraseID)
 	if err != nil {
-		s.logger.Error("wiki_phrase_definition_sample_service.create.get_phrase", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("wiki_phrase_definition_sample_service.create.get_phrase", map[string]interface{}{
 			"error":     err.Error(),
 			"phrase_id": definition.WikiPhraseID,
 		}, "Failed to get phrase for cache update")
 	} else {
 		if err := s.updator.UpdatePhraseCache(ctx, phrase); err != nil {
-			s.logger.Error("wiki_phrase_definition_sample_service.create.cache", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("wiki_phrase_definition_sample_service.create.cache", map[string]interface{}{
 				"error":     err.Error(),
 				"phrase_id": definition.WikiPhraseID,
 			}, "Failed to update phrase cache")
@@ -112,13 +112,13 @@ func (s *WikiPhraseDefinitionSampleService) Update(ctx context.Context, id uuid.
 	// Update phrase cache since samples are part of phrase details
 	phrase, err := s.phraseRepo.GetByID(ctx, definition.WikiPhraseID)
 	if err != nil {
-		s.logger.Error("wiki_phrase_definition_sample_service.update.get_phrase", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("wiki_phrase_definition_sample_service.update.get_phrase", map[string]interface{}{
 			"error":     err.Error(),
 			"phrase_id": definition.WikiPhraseID,
 		}, "Failed to get phrase for cache update")
 	} else {
 		if err := s.updator.UpdatePhraseCache(ctx, phrase); err != nil {
-			s.logger.Error("wiki_phrase_definition_sample_service.update.cache", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("wiki_phrase_definition_sample_service.update.cache", map[string]interface{}{
 				"error":     err.Error(),
 				"phrase_id": definition.WikiPhraseID,
 			}, "Failed to update phrase cache")
@@ -146,13 +146,13 @@ func (s *WikiPhraseDefinitionSampleService) Delete(ctx context.Context, id uuid.
 	// Update phrase cache since samples are part of phrase details
 	phrase, err := s.phraseRepo.GetByID(ctx, definition.WikiPhraseID)
 	if err != nil {
-		s.logger.Error("wiki_phrase_definition_sample_service.delete.get_phrase", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("wiki_phrase_definition_sample_service.delete.get_phrase", map[string]interface{}{
 			"error":     err.Error(),
 			"phrase_id": definition.WikiPhraseID,
 		}, "Failed to get phrase for cache update")
 	} else {
 		if err := s.updator.UpdatePhraseCache(ctx, phrase); err != nil {
-			s.logger.Error("wiki_phrase_definition_sample_service.delete.cache", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("wiki_phrase_definition_sample_service.delete.cache", map[string]interface{}{
 				"error":     err.Error(),
 				"phrase_id": definition.WikiPhraseID,
 			}, "Failed to update phrase cache")
@@ -58,13 +58,13 @@ func (s *WikiPhraseDefinitionService) Create(ctx context.Context, definition *wi
 	// Update phrase cache since definitions are part of phrase details
 	phrase, err := s.phraseRepo.GetByID(ctx, definition.WikiPhraseID)
 	if err != nil {
-		s.logger.Error("wiki_phrase_definition_service.create.get_phrase", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("wiki_phrase_definition_service.create.get_phrase", map[string]interface{}{
 			"error":     err.Error(),
 			"phrase_id": definition.WikiPhraseID,
 		}, "Failed to get phrase for cache update")
 	} else {
 		if err := s.updator.UpdatePhraseCache(ctx, phrase); err != nil {
-			s.logger.Error("wiki_phrase_definition_service.create.cache", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("wiki_phrase_definition_service.create.cache", map[string]interface{}{
 				"error":     err.Error(),
 				"phrase_id": definition.WikiPhraseID,
 			}, "Failed to update phrase cache")
@@ -117,13 +117,13 @@ func (s *WikiPhraseDefinitionService) Update(ctx context.Context, id uuid.UUID,
 	// Update phrase cache since definitions are part of phrase details
 	phrase, err := s.phraseRepo.GetByID(ctx, definition.WikiPhraseID)
 	if err != nil {
-		s.logger.Error("wiki_phrase_definition_service.update.get_phrase", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("wiki_phrase_definition_service.update.get_phrase", map[string]interface{}{
 			"error":     err.Error(),
 			"phrase_id": definition.WikiPhraseID,
 		}, "Failed to get phrase for cache update")
 	} else {
 		if err := s.updator.UpdatePhraseCache(ctx, phrase); err != nil {
-			s.logger.Error("wiki_phrase_definition_service.update.cache", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("wiki_phrase_definition_service.update.cache", map[string]interface{}{
 				"error":     err.Error(),
 				"phrase_id": definition.WikiPhraseID,
 			}, "Failed to update phrase cache")
@@ -146,13 +146,13 @@ func (s *WikiPhraseDefinitionService) Delete(ctx context.Context, id uuid.UUID)
 	// Update phrase cache since definitions are part of phrase details
 	phrase, err := s.phraseRepo.GetByID(ctx, definition.WikiPhraseID)
 	if err != nil {
-		s.logger.Error("wiki_phrase_definition_service.delete.get_phrase", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("wiki_phrase_definition_service.delete.get_phrase", map[string]interface{}{
 			"error":     err.Error(),
 			"phrase_id": definition.WikiPhraseID,
 		}, "Failed to get phrase for cache update")
 	} else {
 		if err := s.updator.UpdatePhraseCache(ctx, phrase); err != nil {
-			s.logger.Error("wiki_phrase_definition_service.delete.cache", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("wiki_phrase_definition_service.delete.cache", map[string]interface{}{
 				"error":     err.Error(),
 				"phrase_id": definition.WikiPhraseID,
 			}, "Failed to update phrase cache")
@@ -2,6 +2,7 @@ package wiki
 
 import (
 	"context"
+	"errors"
 	"fluencybe/internal/app/dto"
 	"fluencybe/internal/app/helper/wiki"
 	wikiModel "fluencybe/internal/app/model/wiki"
@@ -12,6 +13,7 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type WikiPhraseService struct {
@@ -41,7 +43,7 @@ func (s *WikiPhraseService) Create(ctx context.Context, phrase *wikiModel.WikiPh
 	}
 
 	if err := s.updator.UpdatePhraseCache(ctx, phrase); err != nil {
-		s.logger.Error("wiki_phrase_service.create.cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("wiki_phrase_service.create.cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    phrase.ID,
 		}, "Failed to update phrase cache")
@@ -51,35 +53,37 @@ func (s *WikiPhraseService) Create(ctx context.Context, phrase *wikiModel.WikiPh
 }
 
 func (s *WikiPhraseService) GetByID(ctx context.Context, id uuid.UUID) (*wikiModel.WikiPhrase, error) {
-	// Try to get from cache first
-	cachedPhrase, err := s.redis.GetCachePhrase(ctx, id)
-	if err == nil {
-		// Convert DTO to model
-		return &wikiModel.WikiPhrase{
-			ID:              cachedPhrase.ID,
-			Phrase:          cachedPhrase.Phrase,
-			Type:            cachedPhrase.Type,
-			DifficultyLevel: cachedPhrase.DifficultyLevel,
-			CreatedAt:       cachedPhrase.CreatedAt,
-			UpdatedAt:       cachedPhrase.UpdatedAt,
-		}, nil
-	}
-
-	// If not in cache, get from database
-	phrase, err := s.repository.GetByID(ctx, id)
+	cached, err := s.redis.GetPhrase(ctx, id, func(ctx context.Context) (*dto.WikiPhraseResponse, bool, error) {
+		phrase, err := s.repository.GetByID(ctx, id)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, false, nil
+		}
+		if err != nil {
+			return nil, false, err
+		}
+
+		response, err := s.updator.BuildPhraseResponse(ctx, phrase)
+		if err != nil {
+			return nil, false, err
+		}
+		return response, false, nil
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get phrase: %w", err)
 	}
-
-	// Update cache
-	if err := s.updator.UpdatePhraseCache(ctx, phrase); err != nil {
-		s.logger.Error("wiki_phrase_service.get.cache", map[string]interface{}{
-			"error": err.Error(),
-			"id":    id,
-		}, "Failed to update phrase cache")
+	if cached == nil {
+		return nil, fmt.Errorf("failed to get phrase: %w", gorm.ErrRecordNotFound)
 	}
 
-	return phrase, nil
+	// Convert DTO to model
+	return &wikiModel.WikiPhrase{
+		ID:              cached.ID,
+		Phrase:          cached.Phrase,
+		Type:            cached.Type,
+		DifficultyLevel: cached.DifficultyLevel,
+		CreatedAt:       cached.CreatedAt,
+		UpdatedAt:       cached.UpdatedAt,
+	}, nil
 }
 
 func (s *WikiPhraseService) Update(ctx context.Context, id uuid.UUID, req dto.UpdateWikiPhraseRequest) error {
@@ -103,7 +107,7 @@ func (s *WikiPhraseService) Update(ctx context.Context, id uuid.UUID, req dto.Up
 	}
 
 	if err := s.updator.UpdatePhraseCache(ctx, phrase); err != nil {
-		s.logger.Error("wiki_phrase_service.update.cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("wiki_phrase_service.update.cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to update phrase cache")
@@ -118,7 +122,7 @@ func (s *WikiPhraseService) Delete(ctx context.Context, id uuid.UUID) error {
 	}
 
 	if err := s.redis.RemovePhraseCacheEntries(ctx, id); err != nil {
-		s.logger.Error("wiki_phrase_service.delete.cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("wiki_phrase_service.delete.cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to remove phrase cache entries")
@@ -70,13 +70,13 @@ func (s *WikiWordAntonymService) Create(ctx context.Context, antonym *wikiModel.
 	// Update word cache since antonyms are part of word details
 	word, err := s.wordRepo.GetByID(ctx, definition.WikiWordID)
 	if err != nil {
-		s.logger.Error("wiki_word_antonym_service.create.get_word", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("wiki_word_antonym_service.create.get_word", map[string]interface{}{
 			"error":   err.Error(),
 			"word_id": definition.WikiWordID,
 		}, "Failed to get word for cache update")
 	} else {
 		if err := s.updator.UpdateWordCache(ctx, word); err != nil {
-			s.logger.Error("wiki_word_antonym_service.create.cache", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("wiki_word_antonym_service.create.cache", map[string]interface{}{
 				"error":   err.Error(),
 				"word_id": definition.WikiWordID,
 			}, "Failed to update word cache")
@@ -120,13 +120,13 @@ func (s *WikiWordAntonymService) Delete(ctx context.Context, id uuid.UUID) error
 	// Update word cache since antonyms are part of word details
 	word, err := s.wordRepo.GetByID(ctx, definition.WikiWordID)
 	if err != nil {
-		s.logger.Error("wiki_word_antonym_service.delete.get_word", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("wiki_word_antonym_service.delete.get_word", map[string]interface{}{
 			"error":   err.Error(),
 			"word_id": definition.WikiWordID,
 		}, "Failed to get word for cache update")
 	} else {
 		if err := s.updator.UpdateWordCache(ctx, word); err != nil {
-			s.logger.Error("wiki_word_antonym_service.delete.cache", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("wiki_word_antonym_service.delete.cache", map[string]interface{}{
 				"error":   err.Error(),
 				"word_id": definition.WikiWordID,
 			}, "Failed to update word cache")
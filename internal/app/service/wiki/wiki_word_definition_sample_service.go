@@ -55,13 +55,13 @@ func (s *WikiWordDefinitionSampleService) Create(ctx context.Context, sample *wi
 	// Update word cache since samples are part of word details
 	word, err := s.wordRepo.GetByID(ctx, definition.WikiWordID)
 	if err != nil {
-		s.logger.Error("wiki_word_definition_sample_service.create.get_word", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("wiki_word_definition_sample_service.create.get_word", map[string]interface{}{
 			"error":   err.Error(),
 			"word_id": definition.WikiWordID,
 		}, "Failed to get word for cache update")
 	} else {
 		if err := s.updator.UpdateWordCache(ctx, word); err != nil {
-			s.logger.Error("wiki_word_definition_sample_service.create.cache", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("wiki_word_definition_sample_service.create.cache", map[string]interface{}{
 				"error":   err.Error(),
 				"word_id": definition.WikiWordID,
 			}, "Failed to update word cache")
@@ -112,13 +112,13 @@ func (s *WikiWordDefinitionSampleService) Update(ctx context.Context, id uuid.UU
 	// Update word cache since samples are part of word details
 	word, err := s.wordRepo.GetByID(ctx, definition.WikiWordID)
 	if err != nil {
-		s.logger.Error("wiki_word_definition_sample_service.update.get_word", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("wiki_word_definition_sample_service.update.get_word", map[string]interface{}{
 			"error":   err.Error(),
 			"word_id": definition.WikiWordID,
 		}, "Failed to get word for cache update")
 	} else {
 		if err := s.updator.UpdateWordCache(ctx, word); err != nil {
-			s.logger.Error("wiki_word_definition_sample_service.update.cache", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("wiki_word_definition_sample_service.update.cache", map[string]interface{}{
 				"error":   err.Error(),
 				"word_id": definition.WikiWordID,
 			}, "Failed to update word cache")
@@ -146,13 +146,13 @@ func (s *WikiWordDefinitionSampleService) Delete(ctx context.Context, id uuid.UU
 	// Update word cache since samples are part of word details
 	word, err := s.wordRepo.GetByID(ctx, definition.WikiWordID)
 	if err != nil {
-		s.logger.Error("wiki_word_definition_sample_service.delete.get_word", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("wiki_word_definition_sample_service.delete.get_word", map[string]interface{}{
 			"error":   err.Error(),
 			"word_id": definition.WikiWordID,
 		}, "Failed to get word for cache update")
 	} else {
 		if err := s.updator.UpdateWordCache(ctx, word); err != nil {
-			s.logger.Error("wiki_word_definition_sample_service.delete.cache", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("wiki_word_definition_sample_service.delete.cache", map[string]interface{}{
 				"error":   err.Error(),
 				"word_id": definition.WikiWordID,
 			}, "Failed to update word cache")
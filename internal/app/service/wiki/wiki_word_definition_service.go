@@ -58,13 +58,13 @@ func (s *WikiWordDefinitionService) Create(ctx context.Context, definition *wiki
 	// Update word cache since definitions are part of word details
 	word, err := s.wordRepo.GetByID(ctx, definition.WikiWordID)
 	if err != nil {
-		s.logger.Error("wiki_word_definition_service.create.get_word", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("wiki_word_definition_service.create.get_word", map[string]interface{}{
 			"error":   err.Error(),
 			"word_id": definition.WikiWordID,
 		}, "Failed to get word for cache update")
 	} else {
 		if err := s.updator.UpdateWordCache(ctx, word); err != nil {
-			s.logger.Error("wiki_word_definition_service.create.cache", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("wiki_word_definition_service.create.cache", map[string]interface{}{
 				"error":   err.Error(),
 				"word_id": definition.WikiWordID,
 			}, "Failed to update word cache")
@@ -117,13 +117,13 @@ func (s *WikiWordDefinitionService) Update(ctx context.Context, id uuid.UUID, re
 	// Update word cache since definitions are part of word details
 	word, err := s.wordRepo.GetByID(ctx, definition.WikiWordID)
 	if err != nil {
-		s.logger.Error("wiki_word_definition_service.update.get_word", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("wiki_word_definition_service.update.get_word", map[string]interface{}{
 			"error":   err.Error(),
 			"word_id": definition.WikiWordID,
 		}, "Failed to get word for cache update")
 	} else {
 		if err := s.updator.UpdateWordCache(ctx, word); err != nil {
-			s.logger.Error("wiki_word_definition_service.update.cache", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("wiki_word_definition_service.update.cache", map[string]interface{}{
 				"error":   err.Error(),
 				"word_id": definition.WikiWordID,
 			}, "Failed to update word cache")
@@ -146,13 +146,13 @@ func (s *WikiWordDefinitionService) Delete(ctx context.Context, id uuid.UUID) er
 	// Update word cache since definitions are part of word details
 	word, err := s.wordRepo.GetByID(ctx, definition.WikiWordID)
 	if err != nil {
-		s.logger.Error("wiki_word_definition_service.delete.get_word", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("wiki_word_definition_service.delete.get_word", map[string]interface{}{
 			"error":   err.Error(),
 			"word_id": definition.WikiWordID,
 		}, "Failed to get word for cache update")
 	} else {
 		if err := s.updator.UpdateWordCache(ctx, word); err != nil {
-			s.logger.Error("wiki_word_definition_service.delete.cache", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("wiki_word_definition_service.delete.cache", map[string]interface{}{
 				"error":   err.Error(),
 				"word_id": definition.WikiWordID,
 			}, "Failed to update word cache")
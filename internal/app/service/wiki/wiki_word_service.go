@@ -2,6 +2,7 @@ package wiki
 
 import (
 	"context"
+	"errors"
 	"fluencybe/internal/app/dto"
 	"fluencybe/internal/app/helper/wiki"
 	wikiModel "fluencybe/internal/app/model/wiki"
@@ -12,6 +13,7 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type WikiWordService struct {
@@ -41,7 +43,7 @@ func (s *WikiWordService) Create(ctx context.Context, word *wikiModel.WikiWord)
 	}
 
 	if err := s.updator.UpdateWordCache(ctx, word); err != nil {
-		s.logger.Error("wiki_word_service.create.cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("wiki_word_service.create.cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    word.ID,
 		}, "Failed to update word cache")
@@ -51,34 +53,36 @@ func (s *WikiWordService) Create(ctx context.Context, word *wikiModel.WikiWord)
 }
 
 func (s *WikiWordService) GetByID(ctx context.Context, id uuid.UUID) (*wikiModel.WikiWord, error) {
-	// Try to get from cache first
-	cachedWord, err := s.redis.GetCacheWord(ctx, id)
-	if err == nil {
-		// Convert DTO to model
-		return &wikiModel.WikiWord{
-			ID:            cachedWord.ID,
-			Word:          cachedWord.Word,
-			Pronunciation: cachedWord.Pronunciation,
-			CreatedAt:     cachedWord.CreatedAt,
-			UpdatedAt:     cachedWord.UpdatedAt,
-		}, nil
-	}
+	cached, err := s.redis.GetWord(ctx, id, func(ctx context.Context) (*dto.WikiWordResponse, bool, error) {
+		word, err := s.repository.GetByID(ctx, id)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, false, nil
+		}
+		if err != nil {
+			return nil, false, err
+		}
 
-	// If not in cache, get from database
-	word, err := s.repository.GetByID(ctx, id)
+		response, err := s.updator.BuildWordResponse(ctx, word)
+		if err != nil {
+			return nil, false, err
+		}
+		return response, false, nil
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get word: %w", err)
 	}
-
-	// Update cache
-	if err := s.updator.UpdateWordCache(ctx, word); err != nil {
-		s.logger.Error("wiki_word_service.get.cache", map[string]interface{}{
-			"error": err.Error(),
-			"id":    id,
-		}, "Failed to update word cache")
+	if cached == nil {
+		return nil, fmt.Errorf("failed to get word: %w", gorm.ErrRecordNotFound)
 	}
 
-	return word, nil
+	// Convert DTO to model
+	return &wikiModel.WikiWord{
+		ID:            cached.ID,
+		Word:          cached.Word,
+		Pronunciation: cached.Pronunciation,
+		CreatedAt:     cached.CreatedAt,
+		UpdatedAt:     cached.UpdatedAt,
+	}, nil
 }
 
 func (s *WikiWordService) Update(ctx context.Context, id uuid.UUID, req dto.UpdateWikiWordRequest) error {
@@ -99,7 +103,7 @@ func (s *WikiWordService) Update(ctx context.Context, id uuid.UUID, req dto.Upda
 	}
 
 	if err := s.updator.UpdateWordCache(ctx, word); err != nil {
-		s.logger.Error("wiki_word_service.update.cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("wiki_word_service.update.cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to update word cache")
@@ -114,7 +118,7 @@ func (s *WikiWordService) Delete(ctx context.Context, id uuid.UUID) error {
 	}
 
 	if err := s.redis.RemoveWordCacheEntries(ctx, id); err != nil {
-		s.logger.Error("wiki_word_service.delete.cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("wiki_word_service.delete.cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to remove word cache entries")
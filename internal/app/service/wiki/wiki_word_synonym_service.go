@@ -70,13 +70,13 @@ func (s *WikiWordSynonymService) Create(ctx context.Context, synonym *wikiModel.
 	// Update word cache since synonyms are part of word details
 	word, err := s.wordRepo.GetByID(ctx, definition.WikiWordID)
 	if err != nil {
-		s.logger.Error("wiki_word_synonym_service.create.get_word", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("wiki_word_synonym_service.create.get_word", map[string]interface{}{
 			"error":   err.Error(),
 			"word_id": definition.WikiWordID,
 		}, "Failed to get word for cache update")
 	} else {
 		if err := s.updator.UpdateWordCache(ctx, word); err != nil {
-			s.logger.Error("wiki_word_synonym_service.create.cache", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("wiki_word_synonym_service.create.cache", map[string]interface{}{
 				"error":   err.Error(),
 				"word_id": definition.WikiWordID,
 			}, "Failed to update word cache")
@@ -120,13 +120,13 @@ func (s *WikiWordSynonymService) Delete(ctx context.Context, id uuid.UUID) error
 	// Update word cache since synonyms are part of word details
 	word, err := s.wordRepo.GetByID(ctx, definition.WikiWordID)
 	if err != nil {
-		s.logger.Error("wiki_word_synonym_service.delete.get_word", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("wiki_word_synonym_service.delete.get_word", map[string]interface{}{
 			"error":   err.Error(),
 			"word_id": definition.WikiWordID,
 		}, "Failed to get word for cache update")
 	} else {
 		if err := s.updator.UpdateWordCache(ctx, word); err != nil {
-			s.logger.Error("wiki_word_synonym_service.delete.cache", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("wiki_word_synonym_service.delete.cache", map[string]interface{}{
 				"error":   err.Error(),
 				"word_id": definition.WikiWordID,
 			}, "Failed to update word cache")
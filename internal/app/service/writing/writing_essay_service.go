@@ -87,7 +87,7 @@ func (s *WritingEssayService) Create(ctx context.Context, essay *writing.Writing
 
 	if err := s.repo.Create(ctx, essay); err != nil {
 		tx.Rollback()
-		s.logger.Error("writing_essay_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("writing_essay_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create essay")
 		return err
@@ -103,7 +103,7 @@ func (s *WritingEssayService) Create(ctx context.Context, essay *writing.Writing
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("writing_essay_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("writing_essay_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -121,7 +121,7 @@ func (s *WritingEssayService) Create(ctx context.Context, essay *writing.Writing
 func (s *WritingEssayService) GetByID(ctx context.Context, id uuid.UUID) (*writing.WritingEssay, error) {
 	essay, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		s.logger.Error("writing_essay_service.get_by_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("writing_essay_service.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get essay by ID")
@@ -133,7 +133,7 @@ func (s *WritingEssayService) GetByID(ctx context.Context, id uuid.UUID) (*writi
 func (s *WritingEssayService) GetByWritingQuestionID(ctx context.Context, writingQuestionID uuid.UUID) ([]*writing.WritingEssay, error) {
 	essays, err := s.repo.GetByWritingQuestionID(ctx, writingQuestionID)
 	if err != nil {
-		s.logger.Error("writing_essay_service.get_by_writing_question_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("writing_essay_service.get_by_writing_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    writingQuestionID,
 		}, "Failed to get essays")
@@ -160,7 +160,7 @@ func (s *WritingEssayService) Update(ctx context.Context, essay *writing.Writing
 
 	if err := s.repo.Update(ctx, essay); err != nil {
 		tx.Rollback()
-		s.logger.Error("writing_essay_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("writing_essay_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    essay.ID,
 		}, "Failed to update essay")
@@ -177,7 +177,7 @@ func (s *WritingEssayService) Update(ctx context.Context, essay *writing.Writing
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("writing_essay_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("writing_essay_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -212,7 +212,7 @@ func (s *WritingEssayService) Delete(ctx context.Context, id uuid.UUID) error {
 
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("writing_essay_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("writing_essay_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete essay")
@@ -229,7 +229,7 @@ func (s *WritingEssayService) Delete(ctx context.Context, id uuid.UUID) error {
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("writing_essay_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("writing_essay_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -0,0 +1,57 @@
+package writing
+
+import (
+	"context"
+	writingDTO "fluencybe/internal/app/dto"
+	searchRepo "fluencybe/internal/app/repository/search"
+	searchService "fluencybe/internal/app/service/search"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// WritingQuestionDatabaseSearch answers search filters from Postgres with the same clauses
+// WritingQuestionSearch sends to OpenSearch, it is used while OpenSearch is unavailable
+type WritingQuestionDatabaseSearch struct {
+	repo *searchRepo.QuestionFullTextRepository
+	load func(ctx context.Context, ids []uuid.UUID) ([]*writingDTO.WritingQuestionDetail, error)
+}
+
+func NewWritingQuestionDatabaseSearch(
+	repo *searchRepo.QuestionFullTextRepository,
+	load func(ctx context.Context, ids []uuid.UUID) ([]*writingDTO.WritingQuestionDetail, error),
+) *WritingQuestionDatabaseSearch {
+	return &WritingQuestionDatabaseSearch{
+		repo: repo,
+		load: load,
+	}
+}
+
+func (s *WritingQuestionDatabaseSearch) SearchQuestions(ctx context.Context, filter writingDTO.WritingQuestionSearchFilter) (*writingDTO.ListWritingQuestionsPagination, error) {
+	ids, total, err := s.repo.Search(ctx, "writing", searchRepo.QuestionSearchQuery{
+		Type:       filter.Type,
+		Topic:      filter.Topic,
+		ChildText:  filter.ChildText,
+		HasChild:   filter.HasChild,
+		MinOptions: filter.MinOptions,
+		Page:       filter.Page,
+		PageSize:   filter.PageSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute database search: %w", err)
+	}
+
+	questions, err := s.load(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load questions: %w", err)
+	}
+
+	return &writingDTO.ListWritingQuestionsPagination{
+		Questions: searchService.InIDOrder(ids, questions, func(q *writingDTO.WritingQuestionDetail) uuid.UUID {
+			return q.ID
+		}),
+		Total:    total,
+		Page:     filter.Page,
+		PageSize: filter.PageSize,
+	}, nil
+}
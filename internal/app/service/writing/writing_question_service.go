@@ -13,7 +13,9 @@ import (
 	"fluencybe/internal/app/model/writing"
 	searchClient "fluencybe/internal/app/opensearch"
 	redisClient "fluencybe/internal/app/redis"
+	searchRepo "fluencybe/internal/app/repository/search"
 	writingRepository "fluencybe/internal/app/repository/writing"
+	searchService "fluencybe/internal/app/service/search"
 	writingValidator "fluencybe/internal/app/validator"
 	"fluencybe/pkg/cache"
 	"fluencybe/pkg/logger"
@@ -31,9 +33,12 @@ type WritingQuestionService struct {
 	logger                    *logger.PrettyLogger
 	redis                     *redisClient.WritingQuestionRedis
 	search                    *searchClient.WritingQuestionSearch
+	searcher                  searchService.QuestionSearcher[writingDTO.WritingQuestionSearchFilter, *writingDTO.ListWritingQuestionsPagination]
+	similarity                *searchService.QuestionSimilarityService
 	completion                *writingHelper.WritingQuestionCompletionHelper
 	updater                   *writingHelper.WritingQuestionFieldUpdater
 	questionUpdator           *writingHelper.WritingQuestionUpdator
+	warmup                    redisClient.CacheWarmup
 	sentenceCompletionService *WritingSentenceCompletionService
 	essayService              *WritingEssayService
 }
@@ -43,34 +48,44 @@ func NewWritingQuestionService(
 	logger *logger.PrettyLogger,
 	cache cache.Cache,
 	search *searchClient.WritingQuestionSearch,
+	similarity *searchService.QuestionSimilarityService,
 	sentenceCompletionService *WritingSentenceCompletionService,
 	essayService *WritingEssayService,
 	questionUpdator *writingHelper.WritingQuestionUpdator,
 ) *WritingQuestionService {
-	return &WritingQuestionService{
+	s := &WritingQuestionService{
 		repo:                      repo,
 		logger:                    logger,
 		redis:                     redisClient.NewWritingQuestionRedis(cache, logger),
 		search:                    search,
+		similarity:                similarity,
 		completion:                writingHelper.NewWritingQuestionCompletionHelper(logger),
 		updater:                   writingHelper.NewWritingQuestionFieldUpdater(logger),
 		sentenceCompletionService: sentenceCompletionService,
 		essayService:              essayService,
 		questionUpdator:           questionUpdator,
 	}
+	s.searcher = searchService.NewFallbackSearcher[writingDTO.WritingQuestionSearchFilter, *writingDTO.ListWritingQuestionsPagination](
+		"writing",
+		s.search,
+		NewWritingQuestionDatabaseSearch(searchRepo.NewQuestionFullTextRepository(repo.GetDB(), logger), s.GetWritingByListID),
+		logger,
+	)
+	return s
 }
 
-func (s *WritingQuestionService) CreateQuestion(ctx context.Context, question *writing.WritingQuestion) error {
+// CreateQuestion stores a new question and returns the existing questions it nearly duplicates
+func (s *WritingQuestionService) CreateQuestion(ctx context.Context, question *writing.WritingQuestion) ([]writingDTO.SimilarQuestion, error) {
 	if err := writingValidator.ValidateWritingQuestion(question); err != nil {
-		return fmt.Errorf("validation error: %w", err)
+		return nil, fmt.Errorf("validation error: %w", err)
 	}
 
 	if err := s.repo.CreateWritingQuestion(ctx, question); err != nil {
-		s.logger.Error("writing_question_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("writing_question_service.create", map[string]interface{}{
 			"error":         err.Error(),
 			"question_type": question.Type,
 		}, "Failed to create writing question")
-		return err
+		return nil, err
 	}
 
 	questionDetail := writingDTO.WritingQuestionDetail{
@@ -93,31 +108,22 @@ func (s *WritingQuestionService) CreateQuestion(ctx context.Context, question *w
 	// Index to OpenSearch with correct status
 	status := map[bool]string{true: "complete", false: "uncomplete"}[false]
 	if err := s.search.UpsertWritingQuestion(ctx, &questionDetail, status); err != nil {
-		s.logger.Error("writing_question_service.create.opensearch", map[string]interface{}{
+		// The question is committed, the outbox relay indexes it once OpenSearch is back
+		s.logger.WithContext(ctx).Warning("writing_question_service.create.opensearch", map[string]interface{}{
 			"error": err.Error(),
 			"id":    question.ID,
-		}, "Failed to index question in OpenSearch")
+		}, "Failed to index question in OpenSearch, leaving it to the outbox relay")
 	}
 
-	return nil
+	// Near-duplicates only warn the author, the question is created either way
+	return s.similarity.NearDuplicates(ctx, "writing", question.ID, question.Instruction), nil
 }
 
 func (s *WritingQuestionService) DeleteAllQuestions(ctx context.Context) error {
-	// Start transaction
-	tx := s.repo.GetDB().WithContext(ctx).Begin()
-	if tx.Error != nil {
-		return fmt.Errorf("failed to start transaction: %w", tx.Error)
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
-		}
-	}()
-
-	// Delete all records from writing_questions
-	if err := tx.Exec("DELETE FROM writing_questions").Error; err != nil {
-		tx.Rollback()
-		s.logger.Error("writing_question_service.delete_all", map[string]interface{}{
+	// The outbox trigger queues a delete event per row in this same statement, so cache and
+	// search are cleared by the relay even when the bulk cleanup below fails
+	if err := s.repo.GetDB().WithContext(ctx).Exec("DELETE FROM writing_questions").Error; err != nil {
+		s.logger.WithContext(ctx).Error("writing_question_service.delete_all", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to delete all writing questions")
 		return err
@@ -125,45 +131,90 @@ func (s *WritingQuestionService) DeleteAllQuestions(ctx context.Context) error {
 
 	// Delete all Redis cache with pattern writing_question:*
 	if err := s.redis.GetCache().DeletePattern(ctx, "writing_question:*"); err != nil {
-		tx.Rollback()
-		s.logger.Error("writing_question_service.delete_all.cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Warning("writing_question_service.delete_all.cache", map[string]interface{}{
 			"error": err.Error(),
-		}, "Failed to delete Redis cache")
-		return err
+		}, "Failed to delete Redis cache, leaving it to the outbox relay")
 	}
 
 	// Delete OpenSearch index
 	if err := s.search.RemoveWritingQuestionsIndex(ctx); err != nil {
-		tx.Rollback()
-		s.logger.Error("writing_question_service.delete_all.search", map[string]interface{}{
+		s.logger.WithContext(ctx).Warning("writing_question_service.delete_all.search", map[string]interface{}{
 			"error": err.Error(),
-		}, "Failed to delete OpenSearch index")
-		return err
+		}, "Failed to delete OpenSearch index, leaving it to the outbox relay")
 	}
 
-	if err := tx.Commit().Error; err != nil {
-		tx.Rollback()
-		return fmt.Errorf("failed to commit transaction: %w", err)
+	// The cache is empty now, warm it again for the questions that come back
+	if s.warmup != nil {
+		s.warmup.Reset("writing")
 	}
 
 	return nil
 }
 
+// SetCacheWarmup reports reads to warmup and resets it once all questions are deleted
+func (s *WritingQuestionService) SetCacheWarmup(warmup redisClient.CacheWarmup) {
+	s.warmup = warmup
+}
+
+// WarmQuestion writes the detail of a question to the cache, a deleted question is not an error.
+// It does not count as a read.
+func (s *WritingQuestionService) WarmQuestion(ctx context.Context, id uuid.UUID) error {
+	question, err := s.repo.GetWritingQuestionByID(ctx, id)
+	if errors.Is(err, writingRepository.ErrQuestionNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	detail, err := s.buildQuestionDetail(ctx, question)
+	if err != nil {
+		return err
+	}
+	return s.redis.SetCacheWritingQuestionDetail(ctx, detail, s.completion.IsQuestionComplete(detail))
+}
+
+// SyncQuestionByID refreshes the cache entries and search document of a question from the
+// database, removing them if the question is gone. The outbox relay calls it for every event.
+func (s *WritingQuestionService) SyncQuestionByID(ctx context.Context, id uuid.UUID) error {
+	question, err := s.repo.GetWritingQuestionByID(ctx, id)
+	if errors.Is(err, writingRepository.ErrQuestionNotFound) {
+		return s.questionUpdator.RemoveQuestion(ctx, id)
+	}
+	if err != nil {
+		return err
+	}
+	return s.questionUpdator.SyncQuestion(ctx, question)
+}
+
+// BuildSearchDocument returns the search document of a question for index rebuilds, or nil
+// when the question has been deleted in the meantime
+func (s *WritingQuestionService) BuildSearchDocument(ctx context.Context, id uuid.UUID) (map[string]interface{}, error) {
+	question, err := s.repo.GetWritingQuestionByID(ctx, id)
+	if errors.Is(err, writingRepository.ErrQuestionNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.questionUpdator.BuildSearchDocument(ctx, question)
+}
+
 func (s *WritingQuestionService) SearchQuestionsWithFilter(ctx context.Context, filter writingDTO.WritingQuestionSearchFilter) (*writingDTO.ListWritingQuestionsPagination, error) {
-	s.logger.Debug("writing_question_service.search.start", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("writing_question_service.search.start", map[string]interface{}{
 		"filter": filter,
 	}, "Starting question search")
 
-	result, err := s.search.SearchQuestions(ctx, filter)
+	result, err := s.searcher.SearchQuestions(ctx, filter)
 	if err != nil {
-		s.logger.Error("writing_question_service.search", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("writing_question_service.search", map[string]interface{}{
 			"error":  err.Error(),
 			"filter": filter,
 		}, "Failed to search questions")
 		return nil, fmt.Errorf("failed to search questions: %w", err)
 	}
 
-	s.logger.Debug("writing_question_service.search.complete", map[string]interface{}{
+	s.logger.WithContext(ctx).Debug("writing_question_service.search.complete", map[string]interface{}{
 		"total_results": result.Total,
 		"page":          result.Page,
 		"page_size":     result.PageSize,
@@ -212,7 +263,7 @@ func (s *WritingQuestionService) GetNewUpdatedQuestions(ctx context.Context, ver
 		Order("created_at DESC")
 
 	if err := query.Find(&questions).Error; err != nil {
-		s.logger.Error("writing_question_service.get_new_writing_questions", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("writing_question_service.get_new_writing_questions", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to get updated questions")
 		return nil, fmt.Errorf("failed to get updated questions: %w", err)
@@ -222,33 +273,39 @@ func (s *WritingQuestionService) GetNewUpdatedQuestions(ctx context.Context, ver
 }
 
 func (s *WritingQuestionService) GetWritingQuestionDetail(ctx context.Context, id uuid.UUID) (*writingDTO.WritingQuestionDetail, error) {
-	question, err := s.repo.GetWritingQuestionByID(ctx, id)
-	if err != nil {
-		s.logger.Error("writing_question_service.get", map[string]interface{}{
-			"error": err.Error(),
-			"id":    id,
-		}, "Failed to get writing question")
-		return nil, err
-	}
+	response, err := s.redis.GetWritingQuestionDetail(ctx, id, func(ctx context.Context) (*writingDTO.WritingQuestionDetail, bool, error) {
+		question, err := s.repo.GetWritingQuestionByID(ctx, id)
+		if errors.Is(err, writingRepository.ErrQuestionNotFound) {
+			return nil, false, nil
+		}
+		if err != nil {
+			s.logger.WithContext(ctx).Error("writing_question_service.get", map[string]interface{}{
+				"error": err.Error(),
+				"id":    id,
+			}, "Failed to get writing question")
+			return nil, false, err
+		}
+
+		response, err := s.buildQuestionDetail(ctx, question)
+		if err != nil {
+			s.logger.WithContext(ctx).Error("writing_question_service.get.detail", map[string]interface{}{
+				"error": err.Error(),
+				"id":    id,
+			}, "Failed to get question details")
+			return nil, false, err
+		}
 
-	response, err := s.buildQuestionDetail(ctx, question)
+		return response, s.completion.IsQuestionComplete(response), nil
+	})
 	if err != nil {
-		s.logger.Error("writing_question_service.get.detail", map[string]interface{}{
-			"error": err.Error(),
-			"id":    id,
-		}, "Failed to get question details")
 		return nil, err
 	}
+	if response == nil {
+		return nil, ErrQuestionNotFound
+	}
 
-	// Check completion status
-	isComplete := s.completion.IsQuestionComplete(response)
-
-	// Cache with status and version
-	if err := s.redis.SetCacheWritingQuestionDetail(ctx, response, isComplete); err != nil {
-		s.logger.Error("writing_question_service.get.cache", map[string]interface{}{
-			"error": err.Error(),
-			"id":    id,
-		}, "Failed to cache question detail")
+	if s.warmup != nil {
+		s.warmup.RecordRead("writing", id)
 	}
 
 	return response, nil
@@ -348,7 +405,7 @@ func (s *WritingQuestionService) UpdateQuestion(ctx context.Context, id uuid.UUI
 
 	// Update in database
 	if err := s.repo.UpdateWritingQuestion(ctx, baseQuestion); err != nil {
-		s.logger.Error("writing_question_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("writing_question_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    baseQuestion.ID,
 		}, "Failed to update question in database")
@@ -357,7 +414,7 @@ func (s *WritingQuestionService) UpdateQuestion(ctx context.Context, id uuid.UUI
 
 	// Use the updator to update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, baseQuestion); err != nil {
-		s.logger.Error("writing_question_service.update.cache_and_search", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("writing_question_service.update.cache_and_search", map[string]interface{}{
 			"error": err.Error(),
 			"id":    baseQuestion.ID,
 		}, "Failed to update cache and search")
@@ -369,7 +426,7 @@ func (s *WritingQuestionService) UpdateQuestion(ctx context.Context, id uuid.UUI
 
 func (s *WritingQuestionService) DeleteQuestion(ctx context.Context, id uuid.UUID) error {
 	if err := s.repo.DeleteWritingQuestion(ctx, id); err != nil {
-		s.logger.Error("writing_question_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("writing_question_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete writing question")
@@ -381,7 +438,7 @@ func (s *WritingQuestionService) DeleteQuestion(ctx context.Context, id uuid.UUI
 
 	// Delete from OpenSearch
 	if err := s.search.DeleteWritingQuestionFromIndex(ctx, id); err != nil {
-		s.logger.Error("writing_question_service.delete.opensearch", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("writing_question_service.delete.opensearch", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete question from OpenSearch")
@@ -431,7 +488,7 @@ func (s *WritingQuestionService) GetWritingByListID(ctx context.Context, ids []u
 			Find(&dbQuestions)
 
 		if query.Error != nil {
-			s.logger.Error("writing_question_service.get_by_list_id", map[string]interface{}{
+			s.logger.WithContext(ctx).Error("writing_question_service.get_by_list_id", map[string]interface{}{
 				"error": query.Error.Error(),
 			}, "Failed to get questions from database")
 			return nil, query.Error
@@ -442,7 +499,7 @@ func (s *WritingQuestionService) GetWritingByListID(ctx context.Context, ids []u
 			// Build complete response with details
 			questionDetail, err := s.buildQuestionDetail(ctx, q)
 			if err != nil {
-				s.logger.Error("writing_question_service.get_by_list_id.build_response", map[string]interface{}{
+				s.logger.WithContext(ctx).Error("writing_question_service.get_by_list_id.build_response", map[string]interface{}{
 					"error": err.Error(),
 					"id":    q.ID,
 				}, "Failed to build complete response")
@@ -454,7 +511,7 @@ func (s *WritingQuestionService) GetWritingByListID(ctx context.Context, ids []u
 
 			// Cache with status and version
 			if err := s.redis.SetCacheWritingQuestionDetail(ctx, questionDetail, isComplete); err != nil {
-				s.logger.Error("writing_question_service.get_by_list_id.cache", map[string]interface{}{
+				s.logger.WithContext(ctx).Error("writing_question_service.get_by_list_id.cache", map[string]interface{}{
 					"error": err.Error(),
 					"id":    q.ID,
 				}, "Failed to cache question")
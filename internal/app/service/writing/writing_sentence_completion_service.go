@@ -90,7 +90,7 @@ func (s *WritingSentenceCompletionService) Create(ctx context.Context, sentence
 
 	if err := s.repo.Create(ctx, sentence); err != nil {
 		tx.Rollback()
-		s.logger.Error("writing_sentence_completion_service.create", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("writing_sentence_completion_service.create", map[string]interface{}{
 			"error": err.Error(),
 		}, "Failed to create sentence completion")
 		return err
@@ -106,7 +106,7 @@ func (s *WritingSentenceCompletionService) Create(ctx context.Context, sentence
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("writing_sentence_completion_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("writing_sentence_completion_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -124,7 +124,7 @@ func (s *WritingSentenceCompletionService) Create(ctx context.Context, sentence
 func (s *WritingSentenceCompletionService) GetByID(ctx context.Context, id uuid.UUID) (*writing.WritingSentenceCompletion, error) {
 	sentence, err := s.repo.GetByID(ctx, id)
 	if err != nil {
-		s.logger.Error("writing_sentence_completion_service.get_by_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("writing_sentence_completion_service.get_by_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to get sentence completion by ID")
@@ -136,7 +136,7 @@ func (s *WritingSentenceCompletionService) GetByID(ctx context.Context, id uuid.
 func (s *WritingSentenceCompletionService) GetByWritingQuestionID(ctx context.Context, writingQuestionID uuid.UUID) ([]*writing.WritingSentenceCompletion, error) {
 	sentences, err := s.repo.GetByWritingQuestionID(ctx, writingQuestionID)
 	if err != nil {
-		s.logger.Error("writing_sentence_completion_service.get_by_writing_question_id", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("writing_sentence_completion_service.get_by_writing_question_id", map[string]interface{}{
 			"error": err.Error(),
 			"id":    writingQuestionID,
 		}, "Failed to get sentence completions")
@@ -163,7 +163,7 @@ func (s *WritingSentenceCompletionService) Update(ctx context.Context, sentence
 
 	if err := s.repo.Update(ctx, sentence); err != nil {
 		tx.Rollback()
-		s.logger.Error("writing_sentence_completion_service.update", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("writing_sentence_completion_service.update", map[string]interface{}{
 			"error": err.Error(),
 			"id":    sentence.ID,
 		}, "Failed to update sentence completion")
@@ -180,7 +180,7 @@ func (s *WritingSentenceCompletionService) Update(ctx context.Context, sentence
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("writing_sentence_completion_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("writing_sentence_completion_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -215,7 +215,7 @@ func (s *WritingSentenceCompletionService) Delete(ctx context.Context, id uuid.U
 
 	if err := s.repo.Delete(ctx, id); err != nil {
 		tx.Rollback()
-		s.logger.Error("writing_sentence_completion_service.delete", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("writing_sentence_completion_service.delete", map[string]interface{}{
 			"error": err.Error(),
 			"id":    id,
 		}, "Failed to delete sentence completion")
@@ -232,7 +232,7 @@ func (s *WritingSentenceCompletionService) Delete(ctx context.Context, id uuid.U
 	// Update cache and search
 	if err := s.questionUpdator.UpdateCacheAndSearch(ctx, parentQuestion); err != nil {
 		tx.Rollback()
-		s.logger.Error("writing_sentence_completion_service.update_cache", map[string]interface{}{
+		s.logger.WithContext(ctx).Error("writing_sentence_completion_service.update_cache", map[string]interface{}{
 			"error": err.Error(),
 			"id":    parentQuestion.ID,
 		}, "Failed to update cache and search")
@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -15,6 +18,12 @@ type Config struct {
 	JWTSecret        string
 	RedisConfig      RedisConfig
 	OpenSearchConfig OpenSearchConfig
+	OAuthConfig      OAuthConfig
+	MailerConfig     MailerConfig
+	AccountConfig    AccountConfig
+	TracingConfig    TracingConfig
+	LogConfig        LogConfig
+	HealthConfig     HealthConfig
 }
 
 type DBConfig struct {
@@ -42,8 +51,97 @@ type OpenSearchConfig struct {
 	InsecureSkipVerify bool
 }
 
+type OAuthProviderConfig struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+}
+
+type OAuthConfig struct {
+	// Providers are keyed by the user type they create (google, facebook, github)
+	Providers       map[string]OAuthProviderConfig
+	RedirectBaseURL string
+	MockEnabled     bool
+	MockEmail       string
+	MockSubject     string
+}
+
+type MailerConfig struct {
+	// Driver is "smtp" or "log", the log driver also appends to FilePath when set
+	Driver       string
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+	From         string
+	FilePath     string
+}
+
+type AccountConfig struct {
+	// AppBaseURL is the frontend address used to build links sent by email
+	AppBaseURL               string
+	RequireEmailVerification bool
+}
+
+type TracingConfig struct {
+	// Exporter is "none", "otlp" or "stdout"
+	Exporter string
+	// Endpoint is the host:port of the OTLP HTTP receiver
+	Endpoint string
+	Insecure bool
+	// SampleRatio is the share of new traces recorded, traces started upstream keep their decision
+	SampleRatio float64
+}
+
+type LogConfig struct {
+	// Format is "text" or "json", it applies to stdout, the file sink always writes JSON lines
+	Format string
+	// Sinks lists the outputs, any of "stdout", "file" and "discord"
+	Sinks []string
+	// FilePath is rotated once it grows past FileMaxSizeMB, FileMaxBackups rotated files are kept
+	FilePath       string
+	FileMaxSizeMB  int
+	FileMaxBackups int
+	// BufferSize is the number of entries a sink queues, entries are dropped while it is full
+	BufferSize        int
+	DiscordWebhookURL string
+	// DiscordLevel is the lowest level forwarded to Discord
+	DiscordLevel string
+	Alerts       AlertConfig
+}
+
+type AlertConfig struct {
+	// Routes are keyed by level name (DEBUG, INFO, SUCCESS, WARNING, ERROR, CRITICAL), a level
+	// without a route is not sent
+	Routes map[string]AlertRoute
+	// GroupWindow is how long repeats of an event code are counted before a summary is sent
+	GroupWindow time.Duration
+	// RateLimit is the number of messages sent to one webhook per minute
+	RateLimit int
+}
+
+type AlertRoute struct {
+	WebhookURL string
+	// Mention is put in the message content, such as <@&role id>
+	Mention string
+}
+
+type HealthConfig struct {
+	// HardDependencies fail readiness while down, the other dependencies of "postgres", "redis",
+	// "opensearch" and "migrations" only mark it degraded
+	HardDependencies []string
+	// PoolSaturation is the share of the Postgres connections in use past which the pool is
+	// reported degraded
+	PoolSaturation float64
+	// StaleAfter is the age past which a Redis or OpenSearch check no longer counts
+	StaleAfter time.Duration
+}
+
 type ServerConfig struct {
 	Port string
+	// TrustedProxies are the addresses or CIDRs whose X-Forwarded-For is believed, with none the
+	// client IP is the peer address
+	TrustedProxies []string
 }
 
 func getEnvAsInt(key string, defaultValue int) int {
@@ -59,6 +157,32 @@ func getEnvAsInt(key string, defaultValue int) int {
 	return intValue
 }
 
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("Error parsing %s as duration, using default value: %v", key, err)
+		return defaultValue
+	}
+	return duration
+}
+
+func getEnvAsFloat(key string, defaultValue float64) float64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	floatValue, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		log.Printf("Error parsing %s as float, using default value: %v", key, err)
+		return defaultValue
+	}
+	return floatValue
+}
+
 func parseInt(value string) (int, error) {
 	var intValue int
 	_, err := fmt.Sscanf(value, "%d", &intValue)
@@ -72,6 +196,25 @@ func getEnvWithDefault(key, defaultValue string) string {
 	return defaultValue
 }
 
+func loadOAuthProvider(name string, baseURL string) (OAuthProviderConfig, bool) {
+	prefix := "OAUTH_" + strings.ToUpper(name) + "_"
+	clientID := os.Getenv(prefix + "CLIENT_ID")
+	if clientID == "" {
+		return OAuthProviderConfig{}, false
+	}
+
+	return OAuthProviderConfig{
+		ClientID:     clientID,
+		ClientSecret: os.Getenv(prefix + "CLIENT_SECRET"),
+		RedirectURL:  getEnvWithDefault(prefix+"REDIRECT_URL", OAuthCallbackURL(baseURL, name)),
+	}, true
+}
+
+// OAuthCallbackURL is the default callback registered for a provider
+func OAuthCallbackURL(baseURL string, provider string) string {
+	return strings.TrimSuffix(baseURL, "/") + "/v1/user/oauth/" + provider + "/callback"
+}
+
 func (c *Config) Validate() error {
 	if c.DBConfig.URL == "" {
 		return errors.New("database URL is required")
@@ -82,9 +225,82 @@ func (c *Config) Validate() error {
 	if c.JWTSecret == "" {
 		return errors.New("JWT secret is required")
 	}
+	switch c.TracingConfig.Exporter {
+	case "none", "otlp", "stdout":
+	default:
+		return fmt.Errorf("unknown tracing exporter %q", c.TracingConfig.Exporter)
+	}
+	switch c.LogConfig.Format {
+	case "text", "json":
+	default:
+		return fmt.Errorf("unknown log format %q", c.LogConfig.Format)
+	}
+	if c.LogConfig.Alerts.GroupWindow <= 0 {
+		return errors.New("alert group window must be positive")
+	}
+	if c.LogConfig.Alerts.RateLimit <= 0 {
+		return errors.New("alert rate limit must be positive")
+	}
+	for _, sink := range c.LogConfig.Sinks {
+		switch sink {
+		case "stdout", "file", "discord":
+		default:
+			return fmt.Errorf("unknown log sink %q", sink)
+		}
+	}
+	for _, dependency := range c.HealthConfig.HardDependencies {
+		switch dependency {
+		case "postgres", "redis", "opensearch", "migrations":
+		default:
+			return fmt.Errorf("unknown readiness dependency %q", dependency)
+		}
+	}
+	if c.HealthConfig.PoolSaturation <= 0 || c.HealthConfig.PoolSaturation > 1 {
+		return errors.New("readiness pool saturation must be in (0, 1]")
+	}
+	if c.HealthConfig.StaleAfter <= 0 {
+		return errors.New("readiness stale after must be positive")
+	}
 	return nil
 }
 
+// defaultLogSinks keeps production logging to Discord when LOG_SINKS is not set
+func defaultLogSinks() string {
+	if os.Getenv("ENV") == "production" {
+		return "stdout,discord"
+	}
+	return "stdout"
+}
+
+// loadAlertRoutes gives every level the webhook of ALERT_WEBHOOK_URL_<LEVEL>, falling back to
+// defaultWebhook, and the mention of ALERT_MENTION_<LEVEL>
+func loadAlertRoutes(defaultWebhook string) map[string]AlertRoute {
+	defaultMentions := map[string]string{"CRITICAL": "<@&1335118454113566720>"}
+
+	routes := make(map[string]AlertRoute)
+	for _, level := range []string{"DEBUG", "INFO", "SUCCESS", "WARNING", "ERROR", "CRITICAL"} {
+		webhookURL := getEnvWithDefault("ALERT_WEBHOOK_URL_"+level, defaultWebhook)
+		if webhookURL == "" {
+			continue
+		}
+		routes[level] = AlertRoute{
+			WebhookURL: webhookURL,
+			Mention:    getEnvWithDefault("ALERT_MENTION_"+level, defaultMentions[level]),
+		}
+	}
+	return routes
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.ToLower(strings.TrimSpace(item)); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 func LoadConfig() (*Config, error) {
 	// Try to load .env file, but don't fail if it doesn't exist
 	if err := godotenv.Load(".env"); err != nil {
@@ -99,7 +315,8 @@ func LoadConfig() (*Config, error) {
 			MaxIdleConns: getEnvAsInt("DB_MAX_IDLE_CONNS", 5),
 		},
 		Server: ServerConfig{
-			Port: getEnvWithDefault("SERVER_PORT", "8080"),
+			Port:           getEnvWithDefault("SERVER_PORT", "8080"),
+			TrustedProxies: splitList(os.Getenv("TRUSTED_PROXIES")),
 		},
 		JWTSecret: getEnvWithDefault("JWT_SECRET", "default-development-secret"),
 		RedisConfig: RedisConfig{
@@ -119,6 +336,62 @@ func LoadConfig() (*Config, error) {
 		},
 	}
 
+	config.MailerConfig = MailerConfig{
+		Driver:       getEnvWithDefault("MAILER_DRIVER", "log"),
+		SMTPHost:     getEnvWithDefault("SMTP_HOST", "localhost"),
+		SMTPPort:     getEnvWithDefault("SMTP_PORT", "587"),
+		SMTPUsername: getEnvWithDefault("SMTP_USERNAME", ""),
+		SMTPPassword: getEnvWithDefault("SMTP_PASSWORD", ""),
+		From:         getEnvWithDefault("MAILER_FROM", "FluencyBE <no-reply@fluency.local>"),
+		FilePath:     getEnvWithDefault("MAILER_FILE_PATH", ""),
+	}
+	config.AccountConfig = AccountConfig{
+		AppBaseURL:               getEnvWithDefault("APP_BASE_URL", "http://localhost:3000"),
+		RequireEmailVerification: os.Getenv("REQUIRE_EMAIL_VERIFICATION") == "true",
+	}
+
+	config.TracingConfig = TracingConfig{
+		Exporter:    strings.ToLower(getEnvWithDefault("TRACING_EXPORTER", "none")),
+		Endpoint:    getEnvWithDefault("TRACING_OTLP_ENDPOINT", "localhost:4318"),
+		Insecure:    os.Getenv("TRACING_OTLP_INSECURE") == "true",
+		SampleRatio: getEnvAsFloat("TRACING_SAMPLE_RATIO", 1),
+	}
+
+	config.LogConfig = LogConfig{
+		Format:            strings.ToLower(getEnvWithDefault("LOG_FORMAT", "text")),
+		Sinks:             splitList(getEnvWithDefault("LOG_SINKS", defaultLogSinks())),
+		FilePath:          getEnvWithDefault("LOG_FILE_PATH", "logs/fluencybe.log"),
+		FileMaxSizeMB:     getEnvAsInt("LOG_FILE_MAX_SIZE_MB", 100),
+		FileMaxBackups:    getEnvAsInt("LOG_FILE_MAX_BACKUPS", 5),
+		BufferSize:        getEnvAsInt("LOG_BUFFER_SIZE", 4096),
+		DiscordWebhookURL: os.Getenv("DISCORD_ACCOUNT_WEBHOOK_URL"),
+		DiscordLevel:      strings.ToUpper(getEnvWithDefault("LOG_DISCORD_LEVEL", "WARNING")),
+		Alerts: AlertConfig{
+			Routes:      loadAlertRoutes(os.Getenv("DISCORD_ACCOUNT_WEBHOOK_URL")),
+			GroupWindow: getEnvAsDuration("ALERT_GROUP_WINDOW", time.Minute),
+			RateLimit:   getEnvAsInt("ALERT_RATE_LIMIT", 20),
+		},
+	}
+
+	config.HealthConfig = HealthConfig{
+		HardDependencies: splitList(getEnvWithDefault("READINESS_HARD_DEPENDENCIES", "postgres,migrations")),
+		PoolSaturation:   getEnvAsFloat("READINESS_POOL_SATURATION", 0.9),
+		StaleAfter:       getEnvAsDuration("READINESS_STALE_AFTER", 30*time.Second),
+	}
+
+	config.OAuthConfig = OAuthConfig{
+		Providers:       make(map[string]OAuthProviderConfig),
+		RedirectBaseURL: getEnvWithDefault("OAUTH_REDIRECT_BASE_URL", "http://localhost:"+config.Server.Port),
+		MockEnabled:     os.Getenv("OAUTH_MOCK_ENABLED") == "true",
+		MockEmail:       getEnvWithDefault("OAUTH_MOCK_EMAIL", "mock.user@example.com"),
+		MockSubject:     getEnvWithDefault("OAUTH_MOCK_SUBJECT", "mock-user"),
+	}
+	for _, name := range []string{"google", "facebook", "github"} {
+		if provider, ok := loadOAuthProvider(name, config.OAuthConfig.RedirectBaseURL); ok {
+			config.OAuthConfig.Providers[name] = provider
+		}
+	}
+
 	// Validate configuration
 	if err := config.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid configuration: %w", err)
@@ -16,10 +16,38 @@ const (
 	EnvRedisHost   = "REDIS_HOST"
 
 	// JWT settings
-	JWTAccessTokenTTL  = 7 * 24 * time.Hour // Token hết hạn sau 24h
+	JWTAccessTokenTTL  = 15 * time.Minute   // Access token hết hạn sau 15 phút
 	JWTRefreshTokenTTL = 7 * 24 * time.Hour // Refresh token hết hạn sau 7 ngày
 	JWTIssuer          = "FluencyBE"
 	JWTAlgorithm       = "HS256"
+	RefreshTokenBytes  = 32
+
+	// Session settings
+	SessionCacheTTL = 30 * time.Second
+
+	// Expired refresh and email tokens are deleted every TokenPurgeInterval
+	TokenPurgeInterval = time.Hour
+
+	// OAuth settings, the cookie holds the PKCE verifier of a login in progress
+	OAuthStateTTL    = 10 * time.Minute
+	OAuthStateCookie = "oauth_state"
+
+	// Email token settings
+	EmailVerificationTokenTTL = 24 * time.Hour
+	PasswordResetTokenTTL     = 1 * time.Hour
+	TokenPurposeVerifyEmail   = "verify_email"
+	TokenPurposeResetPassword = "reset_password"
+
+	// API key settings
+	APIKeyHeader              = "X-API-Key"
+	APIKeyPrefix              = "fk_"
+	APIKeyDisplayPrefixLength = 8
+	APIKeyLastUsedResolution  = time.Minute
+	APIKeyMaxPerDeveloper     = 20
+
+	// API key scopes, write scopes are "write:" followed by a content area
+	ScopeRead        = "read"
+	ScopeWritePrefix = "write:"
 
 	// Configuration defaults
 	DefaultDBMaxPoolSize     = 10
@@ -37,6 +65,30 @@ const (
 	CacheVersionTTL = 5 * time.Minute
 	CacheSearchTTL  = 1 * time.Minute
 
+	// Detail cache reads, a missing id is remembered for CacheNegativeTTL. Entries are refreshed
+	// early with a probability scaled by the last rebuild time (CacheRebuildEstimate until one is
+	// measured) times CacheEarlyRefreshBeta.
+	CacheNegativeTTL      = 30 * time.Second
+	CacheRebuildEstimate  = 200 * time.Millisecond
+	CacheEarlyRefreshBeta = 1.0
+	CacheLoadTimeout      = 10 * time.Second
+
+	// In-process cache in front of Redis, entries are capped at CacheLocalTTL since invalidations
+	// published on CacheInvalidationChannel are lost while a replica is cut off from Redis
+	CacheLocalMaxEntries     = 10000
+	CacheLocalTTL            = time.Minute
+	CacheInvalidationChannel = "cache:invalidate"
+
+	// Cache warm-up, reads are counted locally and added to the sorted set
+	// CachePopularityKeyPrefix+<skill> every CachePopularityFlushInterval. Each set keeps its
+	// CachePopularityMaxTracked highest scores, the warm-up takes the top CacheWarmupPopularLimit.
+	CachePopularityKeyPrefix     = "question_popularity:"
+	CachePopularityFlushInterval = 30 * time.Second
+	CachePopularityMaxTracked    = 5000
+	CacheWarmupPopularLimit      = 500
+	CacheWarmupConcurrency       = 8
+	CacheWarmupTimeout           = 30 * time.Minute
+
 	// HTTP timeouts
 	HTTPWriteTimeout = 15 * time.Second
 	HTTPReadTimeout  = 15 * time.Second
@@ -49,10 +101,32 @@ const (
 	RateLimitMinBackoff = 8 * time.Millisecond
 	RateLimitMaxBackoff = 512 * time.Millisecond
 
+	// Per client budgets, counted over RateLimitDuration for each route class
+	RateLimitAuthRequests   = 20
+	RateLimitReadRequests   = RateLimitRequests
+	RateLimitWriteRequests  = 300
+	RateLimitRedisKeyPrefix = "ratelimit:"
+	RateLimitMaxMemoryKeys  = 50000
+
 	// Log fields
 	LogComponentKey = "component"
 	LogVersionKey   = "version"
 
+	// Log sinks write queued entries in batches of at most LogSinkBatchSize
+	LogSinkBatchSize   = 256
+	LogDiscordTimeout  = 10 * time.Second
+	RequestIDHeader    = "X-Request-ID"
+	RequestIDMaxLength = 128
+
+	// Alerts are checked every AlertFlushInterval for summaries to send, an event code quiet for
+	// AlertIdleTTL is forgotten. At most AlertMaxDeferred recovery notices wait for the rate limit.
+	AlertFlushInterval = 5 * time.Second
+	AlertIdleTTL       = time.Hour
+	AlertMaxDeferred   = 100
+
+	// Tracing
+	TracingServiceName = "fluencybe"
+
 	// User roles
 	RoleUser      = "user"
 	RoleDeveloper = "developer"
@@ -110,8 +184,105 @@ const (
 	GinContextKey ContextKey = "GinContextKey"
 )
 
+// APIKeyWriteAreas are the content areas a write scope can target
+var APIKeyWriteAreas = []string{"grammar", "listening", "reading", "speaking", "writing", "course"}
+
+func WriteScope(area string) string {
+	return ScopeWritePrefix + area
+}
+
+// ChangeFeedSkills are the skills whose questions are recorded in the change log
+var ChangeFeedSkills = []string{"grammar", "listening", "reading", "speaking", "writing"}
+
+const (
+	ChangeFeedDefaultLimit = 500
+	ChangeFeedMaxLimit     = 2000
+)
+
+// Outbox relay settings
+const (
+	OutboxPollInterval    = 2 * time.Second
+	OutboxBatchSize       = 100
+	OutboxLeaseDuration   = time.Minute
+	OutboxMaxAttempts     = 10
+	OutboxBaseBackoff     = 5 * time.Second
+	OutboxMaxBackoff      = 30 * time.Minute
+	OutboxRetention       = 7 * 24 * time.Hour
+	OutboxPurgeInterval   = time.Hour
+	OutboxDeadDefaultSize = 50
+	OutboxDeadMaxSize     = 200
+)
+
+// Background job settings. A running job keeps its lease by a heartbeat every JobHeartbeatInterval,
+// a job whose lease ran out is claimed again by another runner.
+const (
+	JobPollInterval      = time.Second
+	JobWorkers           = 4
+	JobLeaseDuration     = time.Minute
+	JobHeartbeatInterval = 15 * time.Second
+	JobDefaultTimeout    = time.Hour
+	JobMaxAttempts       = 5
+	JobBaseBackoff       = 10 * time.Second
+	JobMaxBackoff        = 30 * time.Minute
+	JobRetention         = 7 * 24 * time.Hour
+	JobPurgeInterval     = time.Hour
+	JobListDefaultSize   = 50
+	JobListMaxSize       = 200
+	JobMaxPayloadSize    = 16 * 1024 * 1024
+)
+
+// Background job types
+const (
+	JobTypeSearchReindex = "search.reindex"
+	JobTypeCacheWarm     = "cache.warm"
+	JobTypeCourseBundle  = "course.bundle"
+	JobTypeDataImport    = "data.import"
+)
+
+// Schema migration settings, the lock id keeps two replicas from migrating at once
+const (
+	MigrationTable        = "schema_migrations"
+	MigrationLockID       = 7263554461
+	MigrationTimeout      = time.Hour
+	MigrationCheckTimeout = 10 * time.Second
+)
+
+// Search index maintenance settings
+const (
+	IndexVerifyMaxListed = 1000
+	IndexTaskTimeout     = time.Hour
+)
+
+// Admin CLI settings
+const (
+	AdminCommandTimeout    = time.Hour
+	AdminMaxListed         = 1000
+	AdminImportMaxLineSize = 16 * 1024 * 1024
+)
+
+// Cross-skill question search settings
+const (
+	QuestionSearchFacetSize       = 50
+	QuestionSearchFragmentSize    = 150
+	QuestionSearchFragmentsPerHit = 3
+	QuestionSearchMaxQueryLength  = 200
+)
+
+// Similar question settings, similarity is the MinHash estimate of the Jaccard similarity of the
+// word shingles of two questions
+const (
+	SimilarQuestionsDefaultSize = 10
+	SimilarQuestionsMaxSize     = 50
+	SimilarShingleSize          = 3
+	SimilarSignatureSize        = 128
+	NearDuplicateThreshold      = 0.8
+)
+
 // Authentication errors
 var (
 	ErrAuthHeaderRequired = errors.New("authorization header is required")
 	ErrInvalidAuthFormat  = errors.New("invalid authorization format")
+	ErrSessionRevoked     = errors.New("session has been revoked")
+	ErrInvalidAPIKey      = errors.New("invalid or revoked api key")
+	ErrInsufficientScope  = errors.New("api key does not grant the required scope")
 )
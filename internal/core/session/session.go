@@ -0,0 +1,139 @@
+package session
+
+import (
+	"sync"
+	"time"
+
+	constants "fluencybe/internal/core/constants"
+)
+
+// entry caches the result of a session lookup done by the auth middleware
+type entry struct {
+	subjectID string
+	active    bool
+	expiresAt time.Time
+}
+
+const maxEntries = 10000
+
+// APIKey is the cached result of an api key lookup, keyed by the key hash
+type APIKey struct {
+	ID          string
+	DeveloperID string
+	Scopes      []string
+	ExpiresAt   *time.Time
+	LastUsedAt  time.Time
+	cachedUntil time.Time
+}
+
+var (
+	entries = make(map[string]entry)
+	apiKeys = make(map[string]*APIKey)
+	mu      sync.RWMutex
+)
+
+// Lookup returns the cached state of a session, found is false when the entry is missing or stale
+func Lookup(sessionID string) (active bool, found bool) {
+	mu.RLock()
+	e, ok := entries[sessionID]
+	mu.RUnlock()
+
+	if !ok || time.Now().After(e.expiresAt) {
+		return false, false
+	}
+	return e.active, true
+}
+
+func Store(sessionID string, subjectID string, active bool) {
+	mu.Lock()
+	defer mu.Unlock()
+	if len(entries) >= maxEntries {
+		purgeExpiredLocked()
+	}
+	entries[sessionID] = entry{
+		subjectID: subjectID,
+		active:    active,
+		expiresAt: time.Now().Add(constants.SessionCacheTTL),
+	}
+}
+
+func InvalidateSession(sessionID string) {
+	mu.Lock()
+	defer mu.Unlock()
+	delete(entries, sessionID)
+}
+
+// InvalidateSubject drops every cached session and api key of a user or developer, used on logout-all and delete
+func InvalidateSubject(subjectID string) {
+	mu.Lock()
+	defer mu.Unlock()
+	for id, e := range entries {
+		if e.subjectID == subjectID {
+			delete(entries, id)
+		}
+	}
+	for hash, key := range apiKeys {
+		if key.DeveloperID == subjectID {
+			delete(apiKeys, hash)
+		}
+	}
+}
+
+// LookupAPIKey returns a copy of the cached key, nil when missing or stale
+func LookupAPIKey(keyHash string) *APIKey {
+	mu.RLock()
+	key, ok := apiKeys[keyHash]
+	mu.RUnlock()
+
+	if !ok || time.Now().After(key.cachedUntil) {
+		return nil
+	}
+	copied := *key
+	return &copied
+}
+
+func StoreAPIKey(keyHash string, key APIKey) {
+	mu.Lock()
+	defer mu.Unlock()
+	if len(apiKeys) >= maxEntries {
+		purgeExpiredLocked()
+	}
+	key.cachedUntil = time.Now().Add(constants.SessionCacheTTL)
+	apiKeys[keyHash] = &key
+}
+
+// TouchAPIKey records that last_used_at was persisted, it reports false when another
+// request already did it within the resolution window
+func TouchAPIKey(keyHash string, now time.Time) bool {
+	mu.Lock()
+	defer mu.Unlock()
+	key, ok := apiKeys[keyHash]
+	if !ok {
+		return true
+	}
+	if now.Sub(key.LastUsedAt) < constants.APIKeyLastUsedResolution {
+		return false
+	}
+	key.LastUsedAt = now
+	return true
+}
+
+func InvalidateAPIKey(keyHash string) {
+	mu.Lock()
+	defer mu.Unlock()
+	delete(apiKeys, keyHash)
+}
+
+func purgeExpiredLocked() {
+	now := time.Now()
+	for id, e := range entries {
+		if now.After(e.expiresAt) {
+			delete(entries, id)
+		}
+	}
+	for hash, key := range apiKeys {
+		if now.After(key.cachedUntil) {
+			delete(apiKeys, hash)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package status
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"time"
 
@@ -19,8 +20,22 @@ var (
 	OpenSearchStatus bool
 	mu               sync.RWMutex
 	log              *logger.PrettyLogger
+
+	redisState      DependencyState
+	openSearchState DependencyState
+
+	redisReady     = make(chan struct{})
+	redisReadyOnce sync.Once
 )
 
+// DependencyState is the outcome of the last health check of a dependency, CheckedAt is zero
+// until the first check ran
+type DependencyState struct {
+	Healthy   bool
+	CheckedAt time.Time
+	Error     string
+}
+
 func InitConnectionStatus(logger *logger.PrettyLogger) {
 	log = logger
 }
@@ -49,50 +64,142 @@ func GetOpenSearchStatus() bool {
 	return OpenSearchStatus
 }
 
-func StartHealthCheck(redisClient cache.Cache, openSearchClient *opensearch.Client) {
+// GetRedisState returns the outcome of the last Redis health check
+func GetRedisState() DependencyState {
+	mu.RLock()
+	defer mu.RUnlock()
+	return redisState
+}
+
+// GetOpenSearchState returns the outcome of the last OpenSearch health check
+func GetOpenSearchState() DependencyState {
+	mu.RLock()
+	defer mu.RUnlock()
+	return openSearchState
+}
+
+// RedisReady is closed once a Redis health check succeeded for the first time
+func RedisReady() <-chan struct{} {
+	return redisReady
+}
+
+func recordRedisCheck(reason string) {
+	mu.Lock()
+	defer mu.Unlock()
+	RedisStatus = reason == ""
+	redisState = DependencyState{Healthy: reason == "", CheckedAt: time.Now().UTC(), Error: reason}
+	if reason == "" {
+		redisReadyOnce.Do(func() { close(redisReady) })
+	}
+}
+
+func recordOpenSearchCheck(reason string) {
+	mu.Lock()
+	defer mu.Unlock()
+	OpenSearchStatus = reason == ""
+	openSearchState = DependencyState{Healthy: reason == "", CheckedAt: time.Now().UTC(), Error: reason}
+}
+
+// StartHealthCheck checks both dependencies right away and then every HealthCheckInterval. A nil
+// client is recorded as down without being checked.
+func StartHealthCheck(redisClient *cache.RedisClient, openSearchClient *opensearch.Client) {
 	go checkRedisHealth(redisClient)
 	go checkOpenSearchHealth(openSearchClient)
 }
 
-func checkRedisHealth(client cache.Cache) {
+func checkRedisHealth(client *cache.RedisClient) {
 	ticker := time.NewTicker(constants.HealthCheckInterval)
 	defer ticker.Stop()
 
-	for range ticker.C {
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		_, err := client.Get(ctx, "health_check")
-
-		cancel()
-
-		isHealthy := err == nil || err.Error() == "redis: nil"
-		SetRedisStatus(isHealthy)
+	failures := 0
+	for {
+		reason := redisHealth(client)
+		recordRedisCheck(reason)
 
-		if !isHealthy {
+		if reason != "" {
+			failures++
 			log.Error("redis_health_check", map[string]interface{}{
-				"error": err.Error(),
+				"error": reason,
 			}, "Redis health check failed")
+		} else if failures > 0 {
+			// Same event code as the failures, it closes their alert
+			log.Success("redis_health_check", map[string]interface{}{
+				"failed_checks": failures,
+			}, "Redis health check recovered")
+			failures = 0
 		}
+
+		<-ticker.C
+	}
+}
+
+// CheckRedis runs one Redis health check right away and records its outcome, for callers that
+// cannot wait for the first periodic check
+func CheckRedis(client *cache.RedisClient) error {
+	reason := redisHealth(client)
+	recordRedisCheck(reason)
+	if reason != "" {
+		return errors.New(reason)
+	}
+	return nil
+}
+
+// redisHealth returns why the health key could not be read, or "" when Redis answered
+func redisHealth(client *cache.RedisClient) string {
+	if client == nil {
+		return "not configured"
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), constants.HealthCheckTimeout)
+	defer cancel()
+
+	_, err := client.Get(ctx, "health_check")
+	if err != nil && err.Error() != "redis: nil" {
+		return err.Error()
 	}
+	return ""
 }
 
 func checkOpenSearchHealth(client *opensearch.Client) {
-	ticker := time.NewTicker(10 * time.Second)
+	ticker := time.NewTicker(constants.HealthCheckInterval)
 	defer ticker.Stop()
 
-	for range ticker.C {
-		req := opensearchapi.ClusterHealthRequest{}
-		res, err := req.Do(context.Background(), client)
-
-		isHealthy := err == nil && res != nil && res.StatusCode == 200
-		SetOpenSearchStatus(isHealthy)
+	failures := 0
+	for {
+		reason := "not configured"
+		if client != nil {
+			reason = openSearchHealth(client)
+		}
+		recordOpenSearchCheck(reason)
 
-		if !isHealthy {
+		if reason != "" {
+			failures++
 			log.Error("opensearch_health_check", map[string]interface{}{
-				"error": err.Error(),
+				"error": reason,
 			}, "OpenSearch health check failed")
+		} else if failures > 0 {
+			log.Success("opensearch_health_check", map[string]interface{}{
+				"failed_checks": failures,
+			}, "OpenSearch health check recovered")
+			failures = 0
 		}
-		if res != nil {
-			res.Body.Close()
-		}
+
+		<-ticker.C
+	}
+}
+
+// openSearchHealth returns why the cluster health request failed, or "" when it succeeded
+func openSearchHealth(client *opensearch.Client) string {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.HealthCheckTimeout)
+	defer cancel()
+
+	req := opensearchapi.ClusterHealthRequest{}
+	res, err := req.Do(ctx, client)
+	if err != nil {
+		return err.Error()
+	}
+	defer res.Body.Close()
+	if res.StatusCode != 200 {
+		return res.Status()
 	}
+	return ""
 }
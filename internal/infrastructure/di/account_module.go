@@ -0,0 +1,181 @@
+package di
+
+import (
+	accountHandler "fluencybe/internal/app/handler/account"
+	accountRepo "fluencybe/internal/app/repository/account"
+	accountSer "fluencybe/internal/app/service/account"
+	"fluencybe/internal/core/config"
+	"fluencybe/internal/infrastructure/router"
+	"fluencybe/pkg/logger"
+	"fluencybe/pkg/mailer"
+	"fluencybe/pkg/oauth"
+	"fluencybe/pkg/oauth/mockidp"
+	"strings"
+)
+
+type AccountModule struct {
+	UserHandler      *accountHandler.UserHandler
+	DeveloperHandler *accountHandler.DeveloperHandler
+	OAuthHandler     *accountHandler.OAuthHandler
+	APIKeyHandler    *accountHandler.APIKeyHandler
+
+	// DeveloperService creates developers from the CLI
+	DeveloperService *accountSer.DeveloperService
+	// TokenCleaner deletes expired tokens in the background
+	TokenCleaner *accountSer.TokenCleaner
+}
+
+func (m *AccountModule) Name() string {
+	return "account"
+}
+
+func (m *AccountModule) Register(deps *Deps, registry *Registry) error {
+	log := deps.Logger
+
+	// Repositories
+	userRepo := accountRepo.NewUserRepository(deps.DBConn, deps.Redis, log)
+	developerRepo := accountRepo.NewDeveloperRepository(deps.DBConn, deps.Redis)
+	refreshTokenRepo := accountRepo.NewRefreshTokenRepository(deps.DBConn, log)
+	userIdentityRepo := accountRepo.NewUserIdentityRepository(deps.DBConn, log)
+	userActionTokenRepo := accountRepo.NewUserActionTokenRepository(deps.DBConn, log)
+	apiKeyRepo := accountRepo.NewDeveloperAPIKeyRepository(deps.DBConn, log)
+
+	// Services
+	tokenService := accountSer.NewTokenService(refreshTokenRepo)
+	verificationService := accountSer.NewVerificationService(
+		userRepo,
+		userActionTokenRepo,
+		tokenService,
+		provideMailer(deps.Config.MailerConfig, log),
+		deps.Config.AccountConfig.AppBaseURL,
+		deps.Config.AccountConfig.RequireEmailVerification,
+	)
+	userService := accountSer.NewUserService(userRepo, tokenService, verificationService)
+	developerService := accountSer.NewDeveloperService(developerRepo, tokenService)
+	apiKeyService := accountSer.NewAPIKeyService(apiKeyRepo)
+	oauthService := accountSer.NewOAuthService(provideOAuthProviders(deps.Config.OAuthConfig, log), userRepo, userIdentityRepo, tokenService)
+	tokenCleaner := accountSer.NewTokenCleaner(map[string]accountSer.ExpiredTokens{
+		"refresh_tokens":     refreshTokenRepo,
+		"user_action_tokens": userActionTokenRepo,
+	}, log)
+
+	// Handlers
+	userHandler := accountHandler.NewUserHandler(userService)
+	developerHandler := accountHandler.NewDeveloperHandler(developerService)
+	oauthHandler := accountHandler.NewOAuthHandler(oauthService, strings.HasPrefix(deps.Config.OAuthConfig.RedirectBaseURL, "https://"))
+	apiKeyHandler := accountHandler.NewAPIKeyHandler(apiKeyService)
+
+	*m = AccountModule{
+		UserHandler:      userHandler,
+		DeveloperHandler: developerHandler,
+		OAuthHandler:     oauthHandler,
+		APIKeyHandler:    apiKeyHandler,
+		DeveloperService: developerService,
+		TokenCleaner:     tokenCleaner,
+	}
+	return nil
+}
+
+func (m *AccountModule) Routes(routes *router.Routes) {
+	api := routes.API
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Account - User
+	// ? ------------------------------------------------------------------------------
+	user := api.Group("/user")
+	{
+		user.POST("/register", routes.Wrap(m.UserHandler.Register))
+		user.POST("/login", routes.Wrap(m.UserHandler.Login))
+		user.POST("/verify-email", routes.Wrap(m.UserHandler.VerifyEmail))
+		user.POST("/verify-email/resend", routes.UserAuth(), routes.Wrap(m.UserHandler.ResendEmailVerification))
+		user.POST("/password/forgot", routes.Wrap(m.UserHandler.ForgotPassword))
+		user.POST("/password/reset", routes.Wrap(m.UserHandler.ResetPassword))
+		user.GET("/oauth/:provider", routes.Wrap(m.OAuthHandler.Authorize))
+		user.GET("/oauth/:provider/callback", routes.Wrap(m.OAuthHandler.Callback))
+		user.POST("/refresh", routes.Wrap(m.UserHandler.Refresh))
+		user.POST("/logout", routes.UserAuth(), routes.Wrap(m.UserHandler.Logout))
+		user.POST("/logout-all", routes.UserAuth(), routes.Wrap(m.UserHandler.LogoutAll))
+		user.GET("", routes.UserAuth(), routes.Wrap(m.UserHandler.GetMyUser))
+		user.GET("/:id", routes.DeveloperAuth(), routes.Wrap(m.UserHandler.GetUser))
+		user.PUT("", routes.UserAuth(), routes.Wrap(m.UserHandler.UpdateMyUser))
+		user.PUT("/:id", routes.DeveloperAuth(), routes.Wrap(m.UserHandler.UpdateUser))
+		user.DELETE("", routes.UserAuth(), routes.Wrap(m.UserHandler.DeleteMyUser))
+		user.DELETE("/:id", routes.DeveloperAuth(), routes.Wrap(m.UserHandler.DeleteUser))
+		user.GET("/list", routes.UserOrDeveloperAuth(), routes.Wrap(m.UserHandler.GetListUserWithPagination))
+	}
+	// ? ------------------------------------------------------------------------------
+	// ? - Account - Developer
+	// ? ------------------------------------------------------------------------------
+	developer := api.Group("/developer")
+	{
+		developer.POST("/register", routes.Wrap(m.DeveloperHandler.Register))
+		developer.POST("/login", routes.Wrap(m.DeveloperHandler.Login))
+		developer.POST("/refresh", routes.Wrap(m.DeveloperHandler.Refresh))
+		developer.POST("/logout", routes.DeveloperAuth(), routes.Wrap(m.DeveloperHandler.Logout))
+		developer.POST("/logout-all", routes.DeveloperAuth(), routes.Wrap(m.DeveloperHandler.LogoutAll))
+		developer.POST("/api-keys", routes.DeveloperAuth(), routes.Wrap(m.APIKeyHandler.Create))
+		developer.GET("/api-keys", routes.DeveloperAuth(), routes.Wrap(m.APIKeyHandler.List))
+		developer.POST("/api-keys/:key_id/rotate", routes.DeveloperAuth(), routes.Wrap(m.APIKeyHandler.Rotate))
+		developer.DELETE("/api-keys/:key_id", routes.DeveloperAuth(), routes.Wrap(m.APIKeyHandler.Revoke))
+		developer.GET("", routes.DeveloperAuth(), routes.Wrap(m.DeveloperHandler.GetMyDeveloper))
+		developer.GET("/:id", routes.DeveloperAuth(), routes.Wrap(m.DeveloperHandler.GetDeveloper))
+		developer.PUT("", routes.DeveloperAuth(), routes.Wrap(m.DeveloperHandler.UpdateMyDeveloper))
+		developer.PUT("/:id", routes.DeveloperAuth(), routes.Wrap(m.DeveloperHandler.UpdateDeveloper))
+		developer.DELETE("", routes.DeveloperAuth(), routes.Wrap(m.DeveloperHandler.DeleteMyDeveloper))
+		developer.DELETE("/:id", routes.DeveloperAuth(), routes.Wrap(m.DeveloperHandler.DeleteDeveloper))
+		developer.GET("/list", routes.DeveloperAuth(), routes.Wrap(m.DeveloperHandler.GetListDeveloperWithPagination))
+	}
+}
+
+func provideMailer(cfg config.MailerConfig, log *logger.PrettyLogger) mailer.Mailer {
+	if cfg.Driver == "smtp" {
+		return mailer.NewSMTPMailer(mailer.SMTPConfig{
+			Host:     cfg.SMTPHost,
+			Port:     cfg.SMTPPort,
+			Username: cfg.SMTPUsername,
+			Password: cfg.SMTPPassword,
+			From:     cfg.From,
+		})
+	}
+	return mailer.NewLogMailer(log, cfg.FilePath)
+}
+
+func provideOAuthProviders(cfg config.OAuthConfig, log *logger.PrettyLogger) *oauth.Registry {
+	var providers []oauth.ProviderConfig
+	for name, provider := range cfg.Providers {
+		providers = append(providers, oauth.ProviderConfig{
+			Name:         name,
+			ClientID:     provider.ClientID,
+			ClientSecret: provider.ClientSecret,
+			RedirectURL:  provider.RedirectURL,
+		})
+	}
+
+	if cfg.MockEnabled {
+		mockServer, err := mockidp.Start(oauth.Identity{
+			Subject:       cfg.MockSubject,
+			Email:         cfg.MockEmail,
+			EmailVerified: true,
+			Name:          "Mock User",
+		})
+		if err != nil {
+			log.Error("OAUTH_MOCK_IDP_START", map[string]interface{}{
+				"error": err.Error(),
+			}, "Failed to start mock identity provider")
+		} else {
+			log.Warning("OAUTH_MOCK_IDP_ENABLED", map[string]interface{}{
+				"url": mockServer.URL,
+			}, "Mock identity provider is enabled, do not use in production")
+			providers = append(providers, mockServer.ProviderConfig(config.OAuthCallbackURL(cfg.RedirectBaseURL, oauth.ProviderMock)))
+		}
+	}
+
+	registry, err := oauth.NewRegistry(providers)
+	if err != nil {
+		log.Error("OAUTH_PROVIDERS_INIT", map[string]interface{}{
+			"error": err.Error(),
+		}, "Invalid oauth provider configuration, oauth login disabled")
+		registry, _ = oauth.NewRegistry(nil)
+	}
+	return registry
+}
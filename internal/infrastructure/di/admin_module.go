@@ -0,0 +1,41 @@
+package di
+
+import (
+	adminRepo "fluencybe/internal/app/repository/admin"
+	searchindexRepo "fluencybe/internal/app/repository/searchindex"
+	adminSer "fluencybe/internal/app/service/admin"
+	jobSer "fluencybe/internal/app/service/job"
+	constants "fluencybe/internal/core/constants"
+	"fluencybe/internal/infrastructure/router"
+)
+
+// AdminModule backs the operational commands of the CLI, it has no routes
+type AdminModule struct {
+	Service *adminSer.AdminService
+}
+
+func (m *AdminModule) Name() string {
+	return "admin"
+}
+
+func (m *AdminModule) Register(deps *Deps, registry *Registry) error {
+	sources := make(map[string]adminSer.QuestionSource)
+	for _, skill := range registry.Skills() {
+		sources[skill.Name] = skill.Service
+	}
+
+	m.Service = adminSer.NewAdminService(
+		adminRepo.NewDataRepository(deps.GormDB, deps.Logger),
+		searchindexRepo.NewQuestionVersionRepository(deps.GormDB, deps.Logger),
+		deps.Cache,
+		sources,
+		deps.Logger,
+	)
+
+	if err := registry.AddJob(constants.JobTypeCacheWarm, jobSer.CacheWarmJob(m.Service)); err != nil {
+		return err
+	}
+	return registry.AddJob(constants.JobTypeDataImport, jobSer.DataImportJob(m.Service))
+}
+
+func (m *AdminModule) Routes(routes *router.Routes) {}
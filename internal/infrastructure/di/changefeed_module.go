@@ -0,0 +1,37 @@
+package di
+
+import (
+	changefeedHandler "fluencybe/internal/app/handler/changefeed"
+	changefeedRepo "fluencybe/internal/app/repository/changefeed"
+	changefeedSer "fluencybe/internal/app/service/changefeed"
+	"fluencybe/internal/infrastructure/router"
+)
+
+type ChangeFeedModule struct {
+	Handler *changefeedHandler.ChangeFeedHandler
+
+	// skills get a change feed of their own under /{skill}/question/changes
+	skills []string
+}
+
+func (m *ChangeFeedModule) Name() string {
+	return "changefeed"
+}
+
+func (m *ChangeFeedModule) Register(deps *Deps, registry *Registry) error {
+	repo := changefeedRepo.NewQuestionChangeRepository(deps.GormDB, deps.Logger)
+	service := changefeedSer.NewChangeFeedService(repo, deps.Logger)
+
+	m.Handler = changefeedHandler.NewChangeFeedHandler(service, deps.Logger)
+	m.skills = registry.SkillNames()
+	return nil
+}
+
+func (m *ChangeFeedModule) Routes(routes *router.Routes) {
+	api := routes.API
+
+	api.GET("/changes", routes.ContentReadAuth(), routes.Wrap(m.Handler.GetChanges))
+	for _, skill := range m.skills {
+		api.GET("/"+skill+"/question/changes", routes.ContentReadAuth(), routes.Wrap(m.Handler.GetSkillChanges(skill)))
+	}
+}
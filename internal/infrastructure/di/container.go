@@ -1,41 +1,82 @@
 package di
 
 import (
-	"database/sql"
+	"context"
 	"fluencybe/internal/core/config"
 	"fluencybe/internal/infrastructure/discord"
+	"fluencybe/internal/infrastructure/health"
 	"fluencybe/internal/infrastructure/metrics"
 	"fluencybe/internal/infrastructure/router"
-	"fluencybe/pkg/cache"
 	"fluencybe/pkg/logger"
 	"fmt"
 	"os"
 
 	"github.com/gin-gonic/gin"
-	"github.com/opensearch-project/opensearch-go/v2"
-	"gorm.io/gorm"
 )
 
 // Container holds all the dependencies for the application
 type Container struct {
-	Config     *config.Config
-	Logger     *logger.PrettyLogger
+	Deps
 	Router     *gin.Engine
-	DBConn     *sql.DB
-	GormDB     *gorm.DB
-	Redis      *cache.RedisClient
-	OpenSearch *opensearch.Client
 	DiscordBot *discord.Bot
 	Metrics    *metrics.Metrics
+	Health     *health.Checker
+
+	// ShutdownTracing flushes the spans still buffered
+	ShutdownTracing func(context.Context) error
 
 	// Feature Modules
-	Account   *AccountModule
-	Grammar   *GrammarModule
-	Listening *ListeningModule
-	Reading   *ReadingModule
-	Speaking  *SpeakingModule
-	Writing   *WritingModule
-	Course    *CourseModule
+	Account     *AccountModule
+	Grammar     *GrammarModule
+	Listening   *ListeningModule
+	Reading     *ReadingModule
+	Speaking    *SpeakingModule
+	Writing     *WritingModule
+	Course      *CourseModule
+	ChangeFeed  *ChangeFeedModule
+	Outbox      *OutboxModule
+	SearchIndex *SearchIndexModule
+	Search      *SearchModule
+	Admin       *AdminModule
+	Warmup      *WarmupModule
+	Jobs        *JobModule
+}
+
+// modules lists the feature modules in registration order. The skills come first, the modules
+// built on the skills or on the job types of others come after them. A module the CLI does not
+// reach needs no field on the container.
+func (c *Container) modules() []Module {
+	c.Account = &AccountModule{}
+	c.Grammar = &GrammarModule{}
+	c.Listening = &ListeningModule{}
+	c.Reading = &ReadingModule{}
+	c.Speaking = &SpeakingModule{}
+	c.Writing = &WritingModule{}
+	c.Course = &CourseModule{}
+	c.ChangeFeed = &ChangeFeedModule{}
+	c.Outbox = &OutboxModule{}
+	c.SearchIndex = &SearchIndexModule{}
+	c.Search = &SearchModule{}
+	c.Admin = &AdminModule{}
+	c.Warmup = &WarmupModule{}
+	c.Jobs = &JobModule{}
+
+	return []Module{
+		c.Account,
+		c.Grammar,
+		c.Listening,
+		c.Reading,
+		c.Speaking,
+		c.Writing,
+		c.Course,
+		c.ChangeFeed,
+		c.Outbox,
+		c.SearchIndex,
+		c.Search,
+		c.Admin,
+		c.Warmup,
+		c.Jobs,
+	}
 }
 
 // NewContainer creates a new dependency injection container
@@ -45,19 +86,28 @@ func NewContainer(cfg *config.Config) (*Container, error) {
 	}
 
 	// Initialize logger first
+	sinks, err := logger.NewSinks(cfg.LogConfig, os.Stdout, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize log sinks: %w", err)
+	}
 	log := logger.InitGlobalLogger(logger.GlobalLoggerOptions{
 		Level:    logger.LevelInfo,
 		Output:   os.Stdout,
 		Service:  "FluencyBE",
 		Colorful: true,
+		JSON:     cfg.LogConfig.Format == "json",
+		Sinks:    sinks,
 	})
 
 	container := &Container{
-		Config: cfg,
-		Logger: log,
+		Deps: Deps{
+			Config: cfg,
+			Logger: log,
+		},
 	}
 
-	var err error
+	// Tracing goes first so the database, Redis and OpenSearch clients report to its provider
+	container.ShutdownTracing = initializeTracing(cfg, log)
 
 	// Initialize infrastructure components
 	container.DBConn, err = initializeDB(cfg, log)
@@ -65,6 +115,10 @@ func NewContainer(cfg *config.Config) (*Container, error) {
 		return nil, fmt.Errorf("failed to initialize database: %w", err)
 	}
 
+	if err := checkMigrations(container.DBConn, log); err != nil {
+		return nil, fmt.Errorf("database schema is not up to date: %w", err)
+	}
+
 	container.GormDB, err = initializeGormDB(cfg, log)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize GORM: %w", err)
@@ -77,6 +131,8 @@ func NewContainer(cfg *config.Config) (*Container, error) {
 		}, "Failed to initialize Redis, continuing without caching")
 	}
 
+	container.Cache = initializeCache(container.Redis, log)
+
 	container.OpenSearch, err = initializeOpenSearch(cfg, log)
 	if err != nil {
 		log.Critical("OPENSEARCH_INIT_CRITICAL", map[string]interface{}{
@@ -91,77 +147,25 @@ func NewContainer(cfg *config.Config) (*Container, error) {
 		}, "Failed to initialize Discord bot")
 	}
 
-	container.Metrics = initializeMetrics(log, container.Redis)
+	container.Metrics = initializeMetrics(log, container.Redis, container.DBConn, container.GormDB)
 
 	// Initialize feature modules
-	container.Account = ProvideAccountModule(container.DBConn, container.Redis, log)
-	container.Grammar = ProvideGrammarModule(container.GormDB, container.Redis, container.OpenSearch, log)
-	container.Listening = ProvideListeningModule(container.GormDB, container.Redis, container.OpenSearch, log)
-	container.Reading = ProvideReadingModule(container.GormDB, container.Redis, container.OpenSearch, log)
-	container.Speaking = ProvideSpeakingModule(container.GormDB, container.Redis, container.OpenSearch, log)
-	container.Writing = ProvideWritingModule(container.GormDB, container.Redis, container.OpenSearch, log)
-	container.Course = ProvideCourseModule(container.GormDB, container.Redis, container.OpenSearch, log)
-
-	// Initialize router with all handlers
-	r := router.NewRouter(container.DBConn)
-	r.SetupRoutes(
-		// Account handlers
-		container.Account.UserHandler,
-		container.Account.DeveloperHandler,
-
-		// Grammar handlers
-		container.Grammar.QuestionHandler,
-		container.Grammar.FillInTheBlankQuestionHandler,
-		container.Grammar.FillInTheBlankAnswerHandler,
-		container.Grammar.ChoiceOneQuestionHandler,
-		container.Grammar.ChoiceOneOptionHandler,
-		container.Grammar.ErrorIdentificationHandler,
-		container.Grammar.SentenceTransformationHandler,
-
-		// Listening handlers
-		container.Listening.QuestionHandler,
-		container.Listening.FillInTheBlankQuestionHandler,
-		container.Listening.FillInTheBlankAnswerHandler,
-		container.Listening.ChoiceOneQuestionHandler,
-		container.Listening.ChoiceOneOptionHandler,
-		container.Listening.ChoiceMultiQuestionHandler,
-		container.Listening.ChoiceMultiOptionHandler,
-		container.Listening.MapLabellingHandler,
-		container.Listening.MatchingHandler,
-
-		// Reading handlers
-		container.Reading.QuestionHandler,
-		container.Reading.FillInTheBlankQuestionHandler,
-		container.Reading.FillInTheBlankAnswerHandler,
-		container.Reading.ChoiceOneQuestionHandler,
-		container.Reading.ChoiceOneOptionHandler,
-		container.Reading.ChoiceMultiQuestionHandler,
-		container.Reading.ChoiceMultiOptionHandler,
-		container.Reading.TrueFalseHandler,
-		container.Reading.MatchingHandler,
-
-		// Speaking handlers
-		container.Speaking.QuestionHandler,
-		container.Speaking.WordRepetitionHandler,
-		container.Speaking.PhraseRepetitionHandler,
-		container.Speaking.ParagraphRepetitionHandler,
-		container.Speaking.OpenParagraphHandler,
-		container.Speaking.ConversationalRepetitionHandler,
-		container.Speaking.ConversationalRepetitionQAHandler,
-		container.Speaking.ConversationalOpenHandler,
-
-		// Writing handlers
-		container.Writing.QuestionHandler,
-		container.Writing.SentenceCompletionHandler,
-		container.Writing.EssayHandler,
-
-		// Course handlers
-		container.Course.CourseHandler,
-		container.Course.CourseBookHandler,
-		container.Course.CourseOtherHandler,
-		container.Course.LessonHandler,
-		container.Course.LessonQuestionHandler,
-	)
+	routeModules, err := registerModules(&container.Deps, container.modules()...)
+	if err != nil {
+		return nil, err
+	}
+
+	container.Health, err = health.NewChecker(container.DBConn, cfg.HealthConfig, log)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize health checker: %w", err)
+	}
+
+	// Initialize router with the routes of every module
+	r, err := router.NewRouter(container.DBConn, container.Redis, cfg.Server.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	r.SetupRoutes(container.Health, routeModules...)
 
 	container.Router = r.Engine
 
@@ -0,0 +1,241 @@
+package di
+
+import (
+	courseHandler "fluencybe/internal/app/handler/course"
+	courseHelper "fluencybe/internal/app/helper/course"
+	searchClient "fluencybe/internal/app/opensearch"
+	courseRepo "fluencybe/internal/app/repository/course"
+	courseSer "fluencybe/internal/app/service/course"
+	jobSer "fluencybe/internal/app/service/job"
+	constants "fluencybe/internal/core/constants"
+	"fluencybe/internal/infrastructure/router"
+)
+
+type CourseModule struct {
+	CourseHandler         *courseHandler.CourseHandler
+	CourseBookHandler     *courseHandler.CourseBookHandler
+	CourseOtherHandler    *courseHandler.CourseOtherHandler
+	LessonHandler         *courseHandler.LessonHandler
+	LessonQuestionHandler *courseHandler.LessonQuestionHandler
+	BundleHandler         *courseHandler.CourseBundleHandler
+	BundleService         *courseSer.CourseBundleService
+}
+
+func (m *CourseModule) Name() string {
+	return "course"
+}
+
+// Register builds the courses, their bundles resolve lesson questions through the skills
+// registered before
+func (m *CourseModule) Register(deps *Deps, registry *Registry) error {
+	log := deps.Logger
+
+	questionSources := make(courseSer.BundleQuestionSources)
+	for _, skill := range registry.Skills() {
+		questionSources[skill.Name] = skill.Bundle
+	}
+
+	// Repositories
+	courseRepository := courseRepo.NewCourseRepository(deps.GormDB, log)
+	courseBookRepository := courseRepo.NewCourseBookRepository(deps.GormDB, log)
+	courseOtherRepository := courseRepo.NewCourseOtherRepository(deps.GormDB, log)
+	lessonRepository := courseRepo.NewLessonRepository(deps.GormDB, log)
+	lessonQuestionRepository := courseRepo.NewLessonQuestionRepository(deps.GormDB, log)
+	bundleRepository := courseRepo.NewCourseBundleRepository(deps.GormDB, log)
+
+	// Search
+	courseSearch := searchClient.NewCourseSearch(deps.OpenSearch, log)
+
+	// Services
+	lessonQuestionService := courseSer.NewLessonQuestionService(
+		lessonQuestionRepository,
+		lessonRepository,
+		courseRepository,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	lessonService := courseSer.NewLessonService(
+		lessonRepository,
+		courseRepository,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	courseOtherService := courseSer.NewCourseOtherService(
+		courseOtherRepository,
+		courseRepository,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	courseBookService := courseSer.NewCourseBookService(
+		courseBookRepository,
+		courseRepository,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	// Course Updator
+	courseUpdator := courseHelper.NewCourseUpdator(
+		log,
+		deps.Cache,
+		deps.OpenSearch,
+		lessonService,
+		lessonQuestionService,
+		courseBookService,
+		courseOtherService,
+	)
+
+	// Set updator for all services
+	lessonQuestionService.SetCourseUpdator(courseUpdator)
+	lessonService.SetCourseUpdator(courseUpdator)
+	courseOtherService.SetCourseUpdator(courseUpdator)
+	courseBookService.SetCourseUpdator(courseUpdator)
+
+	// Main course service
+	courseService := courseSer.NewCourseService(
+		courseRepository,
+		log,
+		deps.Cache,
+		courseSearch,
+		lessonService,
+		lessonQuestionService,
+		courseOtherService,
+		courseBookService,
+		courseUpdator,
+	)
+
+	courseBundleService := courseSer.NewCourseBundleService(
+		bundleRepository,
+		courseRepository,
+		courseService,
+		questionSources,
+		log,
+	)
+
+	// Handlers
+	mainCourseHandler := courseHandler.NewCourseHandler(
+		courseService,
+		courseBookService,
+		courseOtherService,
+		lessonService,
+		lessonQuestionService,
+		log,
+	)
+
+	bookHandler := courseHandler.NewCourseBookHandler(
+		courseBookService,
+		log,
+	)
+
+	otherHandler := courseHandler.NewCourseOtherHandler(
+		courseOtherService,
+		log,
+	)
+
+	lesHandler := courseHandler.NewLessonHandler(
+		lessonService,
+		log,
+	)
+
+	lesQuestionHandler := courseHandler.NewLessonQuestionHandler(
+		lessonQuestionService,
+		log,
+	)
+
+	courseBundleHandler := courseHandler.NewCourseBundleHandler(
+		courseBundleService,
+		log,
+	)
+
+	*m = CourseModule{
+		CourseHandler:         mainCourseHandler,
+		CourseBookHandler:     bookHandler,
+		CourseOtherHandler:    otherHandler,
+		LessonHandler:         lesHandler,
+		LessonQuestionHandler: lesQuestionHandler,
+		BundleHandler:         courseBundleHandler,
+		BundleService:         courseBundleService,
+	}
+
+	return registry.AddJob(constants.JobTypeCourseBundle, jobSer.CourseBundleJob(courseBundleService))
+}
+
+func (m *CourseModule) Routes(routes *router.Routes) {
+	api := routes.API
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Course - Course
+	// ? ------------------------------------------------------------------------------
+	course := api.Group("/course")
+	course.Use(routes.ContentWriteAuth("course"))
+	{
+		course.POST("", routes.Wrap(m.CourseHandler.Create))
+		course.GET("/:id", routes.Wrap(m.CourseHandler.GetByID))
+		course.GET("/search", routes.Wrap(m.CourseHandler.Search))
+		course.PUT("/:id", routes.Wrap(m.CourseHandler.Update))
+		course.DELETE("/:id", routes.Wrap(m.CourseHandler.Delete))
+		course.POST("/:id/bundles", routes.Wrap(m.BundleHandler.Build))
+
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Course - Bundle
+	// ? ------------------------------------------------------------------------------
+	// Learner apps list, diff and download the bundles, only building one needs write access
+	courseBundle := api.Group("/course")
+	courseBundle.Use(routes.ContentReadAuth())
+	{
+		courseBundle.GET("/:id/bundles", routes.Wrap(m.BundleHandler.List))
+		courseBundle.GET("/:id/bundles/diff", routes.Wrap(m.BundleHandler.Diff))
+		courseBundle.GET("/:id/bundles/:version", routes.Wrap(m.BundleHandler.Download))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Course - Course Book
+	// ? ------------------------------------------------------------------------------
+	courseBook := api.Group("/course-book")
+	courseBook.Use(routes.ContentWriteAuth("course"))
+	{
+		courseBook.POST("", routes.Wrap(m.CourseBookHandler.Create))
+		courseBook.PUT("", routes.Wrap(m.CourseBookHandler.Update))
+		courseBook.DELETE("/:id", routes.Wrap(m.CourseBookHandler.Delete))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Course - Course Book
+	// ? ------------------------------------------------------------------------------
+	courseOther := api.Group("/course-other")
+	courseOther.Use(routes.ContentWriteAuth("course"))
+	{
+		courseOther.POST("", routes.Wrap(m.CourseOtherHandler.Create))
+		courseOther.DELETE("/:id", routes.Wrap(m.CourseOtherHandler.Delete))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Course - Lesson
+	// ? ------------------------------------------------------------------------------
+	lesson := api.Group("/lesson")
+	lesson.Use(routes.ContentWriteAuth("course"))
+	{
+		lesson.POST("", routes.Wrap(m.LessonHandler.Create))
+		lesson.PUT("", routes.Wrap(m.LessonHandler.Update))
+		lesson.DELETE("/:id", routes.Wrap(m.LessonHandler.Delete))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Course - LessonQuestion
+	// ? ------------------------------------------------------------------------------
+	lessonQuestion := api.Group("/lesson-question")
+	lessonQuestion.Use(routes.ContentWriteAuth("course"))
+	{
+		lessonQuestion.POST("", routes.Wrap(m.LessonQuestionHandler.Create))
+		lessonQuestion.PUT("", routes.Wrap(m.LessonQuestionHandler.Update))
+		lessonQuestion.DELETE("/:id", routes.Wrap(m.LessonQuestionHandler.Delete))
+	}
+}
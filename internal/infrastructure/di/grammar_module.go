@@ -0,0 +1,277 @@
+package di
+
+import (
+	"context"
+	grammarHandler "fluencybe/internal/app/handler/grammar"
+	grammarHelper "fluencybe/internal/app/helper/grammar"
+	searchClient "fluencybe/internal/app/opensearch"
+	grammarRepo "fluencybe/internal/app/repository/grammar"
+	grammarSer "fluencybe/internal/app/service/grammar"
+	"fluencybe/internal/infrastructure/router"
+
+	"github.com/google/uuid"
+)
+
+type GrammarModule struct {
+	QuestionHandler               *grammarHandler.GrammarQuestionHandler
+	FillInTheBlankQuestionHandler *grammarHandler.GrammarFillInTheBlankQuestionHandler
+	FillInTheBlankAnswerHandler   *grammarHandler.GrammarFillInTheBlankAnswerHandler
+	ChoiceOneQuestionHandler      *grammarHandler.GrammarChoiceOneQuestionHandler
+	ChoiceOneOptionHandler        *grammarHandler.GrammarChoiceOneOptionHandler
+	ErrorIdentificationHandler    *grammarHandler.GrammarErrorIdentificationHandler
+	SentenceTransformationHandler *grammarHandler.GrammarSentenceTransformationHandler
+}
+
+func (m *GrammarModule) Name() string {
+	return "grammar"
+}
+
+// Register builds the grammar questions and adds the question service as the grammar skill
+func (m *GrammarModule) Register(deps *Deps, registry *Registry) error {
+	log := deps.Logger
+
+	// Repositories
+	questionRepo := grammarRepo.NewGrammarQuestionRepository(deps.GormDB, log)
+	fillInBlankQuestionRepo := grammarRepo.NewGrammarFillInTheBlankQuestionRepository(deps.GormDB, log)
+	fillInBlankAnswerRepo := grammarRepo.NewGrammarFillInTheBlankAnswerRepository(deps.GormDB, log)
+	choiceOneQuestionRepo := grammarRepo.NewGrammarChoiceOneQuestionRepository(deps.GormDB, log)
+	choiceOneOptionRepo := grammarRepo.NewGrammarChoiceOneOptionRepository(deps.GormDB, log)
+	errorIdentificationRepo := grammarRepo.NewGrammarErrorIdentificationRepository(deps.GormDB, log)
+	sentenceTransformationRepo := grammarRepo.NewGrammarSentenceTransformationRepository(deps.GormDB, log)
+
+	// Search
+	questionSearch := searchClient.NewGrammarQuestionSearch(deps.OpenSearch, log)
+
+	// Services
+	fillInBlankQuestionService := grammarSer.NewGrammarFillInTheBlankQuestionService(
+		fillInBlankQuestionRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		questionSearch,
+		nil,
+	)
+
+	fillInBlankAnswerService := grammarSer.NewGrammarFillInTheBlankAnswerService(
+		fillInBlankAnswerRepo,
+		fillInBlankQuestionRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	choiceOneQuestionService := grammarSer.NewGrammarChoiceOneQuestionService(
+		choiceOneQuestionRepo,
+		questionRepo,
+		log,
+		nil,
+	)
+
+	choiceOneOptionService := grammarSer.NewGrammarChoiceOneOptionService(
+		choiceOneOptionRepo,
+		choiceOneQuestionRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	errorIdentificationService := grammarSer.NewGrammarErrorIdentificationService(
+		errorIdentificationRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	sentenceTransformationService := grammarSer.NewGrammarSentenceTransformationService(
+		sentenceTransformationRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	// Question Updator
+	questionUpdator := grammarHelper.NewGrammarQuestionUpdator(
+		log,
+		deps.Cache,
+		deps.OpenSearch,
+		fillInBlankQuestionService,
+		fillInBlankAnswerService,
+		choiceOneQuestionService,
+		choiceOneOptionService,
+		errorIdentificationService,
+		sentenceTransformationService,
+	)
+
+	// Set updator for all services
+	fillInBlankQuestionService.SetQuestionUpdator(questionUpdator)
+	fillInBlankAnswerService.SetQuestionUpdator(questionUpdator)
+	choiceOneQuestionService.SetQuestionUpdator(questionUpdator)
+	choiceOneOptionService.SetQuestionUpdator(questionUpdator)
+	errorIdentificationService.SetQuestionUpdator(questionUpdator)
+	sentenceTransformationService.SetQuestionUpdator(questionUpdator)
+
+	// Main question service
+	questionService := grammarSer.NewGrammarQuestionService(
+		questionRepo,
+		log,
+		deps.Cache,
+		deps.OpenSearch,
+		fillInBlankQuestionService,
+		fillInBlankAnswerService,
+		choiceOneQuestionService,
+		choiceOneOptionService,
+		errorIdentificationService,
+		sentenceTransformationService,
+		questionUpdator,
+	)
+
+	// Handlers
+	questionHandler := grammarHandler.NewGrammarQuestionHandler(
+		questionService,
+		fillInBlankQuestionService,
+		fillInBlankAnswerService,
+		choiceOneQuestionService,
+		choiceOneOptionService,
+		errorIdentificationService,
+		sentenceTransformationService,
+		log,
+	)
+
+	fillInBlankQuestionHandler := grammarHandler.NewGrammarFillInTheBlankQuestionHandler(
+		fillInBlankQuestionService,
+		questionRepo,
+		log,
+	)
+
+	fillInBlankAnswerHandler := grammarHandler.NewGrammarFillInTheBlankAnswerHandler(
+		fillInBlankAnswerService,
+		log,
+	)
+
+	choiceOneQuestionHandler := grammarHandler.NewGrammarChoiceOneQuestionHandler(
+		choiceOneQuestionService,
+		choiceOneOptionService,
+		log,
+	)
+
+	choiceOneOptionHandler := grammarHandler.NewGrammarChoiceOneOptionHandler(
+		choiceOneOptionService,
+		log,
+	)
+
+	errorIdentificationHandler := grammarHandler.NewGrammarErrorIdentificationHandler(
+		errorIdentificationService,
+		log,
+	)
+
+	sentenceTransformationHandler := grammarHandler.NewGrammarSentenceTransformationHandler(
+		sentenceTransformationService,
+		log,
+	)
+
+	*m = GrammarModule{
+		QuestionHandler:               questionHandler,
+		FillInTheBlankQuestionHandler: fillInBlankQuestionHandler,
+		FillInTheBlankAnswerHandler:   fillInBlankAnswerHandler,
+		ChoiceOneQuestionHandler:      choiceOneQuestionHandler,
+		ChoiceOneOptionHandler:        choiceOneOptionHandler,
+		ErrorIdentificationHandler:    errorIdentificationHandler,
+		SentenceTransformationHandler: sentenceTransformationHandler,
+	}
+
+	return registry.AddSkill(QuestionSkill{
+		Name:    "grammar",
+		Service: questionService,
+		Index:   questionSearch,
+		Bundle: func(ctx context.Context, ids []uuid.UUID) (interface{}, error) {
+			return questionService.GetGrammarByListID(ctx, ids)
+		},
+	})
+}
+
+func (m *GrammarModule) Routes(routes *router.Routes) {
+	api := routes.API
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Grammar - GrammarQuestion
+	// ? ------------------------------------------------------------------------------
+	grammarQuestion := api.Group("/grammar/question")
+	grammarQuestion.POST("", routes.ContentWriteAuth("grammar"), routes.Wrap(m.QuestionHandler.CreateGrammarQuestion))
+	grammarQuestion.GET("/:id", routes.ContentReadAuth(), routes.Wrap(m.QuestionHandler.GetGrammarQuestionDetail))
+	grammarQuestion.PUT("/:id", routes.ContentWriteAuth("grammar"), routes.Wrap(m.QuestionHandler.UpdateGrammarQuestion))
+	grammarQuestion.DELETE("/:id", routes.ContentWriteAuth("grammar"), routes.Wrap(m.QuestionHandler.DeleteGrammarQuestion))
+	grammarQuestion.POST("/get-new-updates", routes.ContentReadAuth(), routes.Wrap(m.QuestionHandler.GetListNewGrammarQuestionByListVersionAndID))
+	grammarQuestion.POST("/get-by-list-id", routes.ContentReadAuth(), routes.Wrap(m.QuestionHandler.GetListGrammarByListID))
+	grammarQuestion.GET("/search", routes.ContentReadAuth(), routes.Wrap(m.QuestionHandler.GetListGrammarQuestiondetailPaginationWithFilter))
+	grammarQuestion.DELETE("/delete-all", routes.DeveloperAuth(), routes.Wrap(m.QuestionHandler.DeleteAllGrammarData))
+
+	// ? ------------------------------------------------------------------------------
+	// ? - grammar - grammar Fill In The Blank
+	// ? ------------------------------------------------------------------------------
+	grammarFillInTheBlankQuestion := api.Group("/grammar/fill-in-the-blank-question")
+	grammarFillInTheBlankQuestion.Use(routes.ContentWriteAuth("grammar"))
+	{
+		grammarFillInTheBlankQuestion.POST("", routes.Wrap(m.FillInTheBlankQuestionHandler.CreateQuestion))
+		grammarFillInTheBlankQuestion.PUT("", routes.Wrap(m.FillInTheBlankQuestionHandler.UpdateQuestion))
+		grammarFillInTheBlankQuestion.DELETE("/:id", routes.Wrap(m.FillInTheBlankQuestionHandler.DeleteQuestion))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - grammar - grammar Fill In The Blank Answer
+	// ? ------------------------------------------------------------------------------
+	grammarFillInTheBlankAnswer := api.Group("/grammar/fill-in-the-blank-answer")
+	grammarFillInTheBlankAnswer.Use(routes.ContentWriteAuth("grammar"))
+	{
+		grammarFillInTheBlankAnswer.POST("", routes.Wrap(m.FillInTheBlankAnswerHandler.CreateAnswer))
+		grammarFillInTheBlankAnswer.PUT("", routes.Wrap(m.FillInTheBlankAnswerHandler.UpdateAnswer))
+		grammarFillInTheBlankAnswer.DELETE("/:id", routes.Wrap(m.FillInTheBlankAnswerHandler.DeleteAnswer))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - grammar - grammar Choice One Question
+	// ? ------------------------------------------------------------------------------
+	grammarChoiceOneQuestion := api.Group("/grammar/choice-one-question")
+	grammarChoiceOneQuestion.Use(routes.ContentWriteAuth("grammar"))
+	{
+		grammarChoiceOneQuestion.POST("", routes.Wrap(m.ChoiceOneQuestionHandler.CreateQuestion))
+		grammarChoiceOneQuestion.PUT("", routes.Wrap(m.ChoiceOneQuestionHandler.UpdateQuestion))
+		grammarChoiceOneQuestion.DELETE("/:id", routes.Wrap(m.ChoiceOneQuestionHandler.DeleteQuestion))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - grammar - grammar Choice One Option
+	// ? ------------------------------------------------------------------------------
+	grammarChoiceOneOption := api.Group("/grammar/choice-one-option")
+	grammarChoiceOneOption.Use(routes.ContentWriteAuth("grammar"))
+	{
+		grammarChoiceOneOption.POST("", routes.Wrap(m.ChoiceOneOptionHandler.CreateOption))
+		grammarChoiceOneOption.PUT("", routes.Wrap(m.ChoiceOneOptionHandler.UpdateOption))
+		grammarChoiceOneOption.DELETE("/:id", routes.Wrap(m.ChoiceOneOptionHandler.DeleteOption))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Grammar - Error Identification
+	// ? ------------------------------------------------------------------------------
+	grammarErrorIdentification := api.Group("/grammar/error-identification")
+	grammarErrorIdentification.Use(routes.ContentWriteAuth("grammar"))
+	{
+		grammarErrorIdentification.POST("", routes.Wrap(m.ErrorIdentificationHandler.Create))
+		grammarErrorIdentification.PUT("", routes.Wrap(m.ErrorIdentificationHandler.Update))
+		grammarErrorIdentification.DELETE("/:id", routes.Wrap(m.ErrorIdentificationHandler.Delete))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Grammar - Sentence Transformation
+	// ? ------------------------------------------------------------------------------
+	grammarSentenceTransformation := api.Group("/grammar/sentence-transformation")
+	grammarSentenceTransformation.Use(routes.ContentWriteAuth("grammar"))
+	{
+		grammarSentenceTransformation.POST("", routes.Wrap(m.SentenceTransformationHandler.Create))
+		grammarSentenceTransformation.PUT("", routes.Wrap(m.SentenceTransformationHandler.Update))
+		grammarSentenceTransformation.DELETE("/:id", routes.Wrap(m.SentenceTransformationHandler.Delete))
+	}
+}
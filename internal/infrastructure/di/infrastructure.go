@@ -1,22 +1,40 @@
 package di
 
 import (
+	"context"
 	"database/sql"
 	"fluencybe/internal/core/config"
+	"fluencybe/internal/core/constants"
 	"fluencybe/internal/core/status"
 	"fluencybe/internal/infrastructure/discord"
 	"fluencybe/internal/infrastructure/metrics"
-	"fluencybe/internal/infrastructure/router"
+	"fluencybe/migrations"
 	"fluencybe/pkg/cache"
 	"fluencybe/pkg/db"
 	"fluencybe/pkg/logger"
+	"fluencybe/pkg/migrate"
 	"fluencybe/pkg/search"
+	"fluencybe/pkg/tracing"
+	"fmt"
+	"strings"
 
-	"github.com/gin-gonic/gin"
 	"github.com/opensearch-project/opensearch-go/v2"
 	"gorm.io/gorm"
 )
 
+// initializeTracing installs the tracer provider, a broken exporter leaves tracing off rather
+// than stopping the server
+func initializeTracing(cfg *config.Config, log *logger.PrettyLogger) func(context.Context) error {
+	shutdown, err := tracing.Init(cfg.TracingConfig, log)
+	if err != nil {
+		log.Critical("TRACING_INIT_CRITICAL", map[string]interface{}{
+			"error": err.Error(),
+		}, "Failed to initialize tracing, continuing without it")
+		return func(context.Context) error { return nil }
+	}
+	return shutdown
+}
+
 func initializeDB(cfg *config.Config, log *logger.PrettyLogger) (*sql.DB, error) {
 	return db.NewDBConnection(cfg.DBConfig, log)
 }
@@ -29,6 +47,11 @@ func initializeRedis(cfg *config.Config, log *logger.PrettyLogger) (*cache.Redis
 	return cache.NewRedisClient(cfg.RedisConfig, log)
 }
 
+// initializeCache puts an in-process tier in front of Redis for the content modules
+func initializeCache(redisClient *cache.RedisClient, log *logger.PrettyLogger) *cache.TieredCache {
+	return cache.NewTieredCache(redisClient, status.GetRedisStatus, log)
+}
+
 func initializeOpenSearch(cfg *config.Config, log *logger.PrettyLogger) (*opensearch.Client, error) {
 	return search.NewOpenSearchClient(cfg.OpenSearchConfig, log)
 }
@@ -46,18 +69,44 @@ func initializeDiscordBot(log *logger.PrettyLogger) (*discord.Bot, error) {
 	return bot, nil
 }
 
-func initializeMetrics(log *logger.PrettyLogger, redisClient *cache.RedisClient) *metrics.Metrics {
+func initializeMetrics(log *logger.PrettyLogger, redisClient *cache.RedisClient, dbConn *sql.DB, gormDB *gorm.DB) *metrics.Metrics {
+	metrics.RegisterLogSinks(log)
+	metrics.RegisterDB(dbConn, "sql")
+	if gormSQL, err := gormDB.DB(); err == nil {
+		metrics.RegisterDB(gormSQL, "gorm")
+	}
+
 	metricsCollector := metrics.NewMetrics(log, redisClient)
 	metricsCollector.StartMetricsCollection()
 	return metricsCollector
 }
 
-func initializeRouter(dbConn *sql.DB) *gin.Engine {
-	r := router.NewRouter(dbConn)
-	return r.Engine
-}
-
 func initializeHealthCheck(redisClient *cache.RedisClient, openSearchClient *opensearch.Client, log *logger.PrettyLogger) {
 	status.InitConnectionStatus(log)
 	status.StartHealthCheck(redisClient, openSearchClient)
 }
+
+// checkMigrations refuses to start on a schema older than this build, the handlers would fail on
+// missing tables and columns
+func checkMigrations(dbConn *sql.DB, log *logger.PrettyLogger) error {
+	runner, err := migrate.NewRunner(dbConn, migrations.FS, log)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), constants.MigrationCheckTimeout)
+	defer cancel()
+
+	pending, err := runner.Pending(ctx)
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		names := make([]string, len(pending))
+		for i, migration := range pending {
+			names[i] = migration.String()
+		}
+		return fmt.Errorf("%d pending migrations (%s), run `app migrate up` first", len(pending), strings.Join(names, ", "))
+	}
+	return nil
+}
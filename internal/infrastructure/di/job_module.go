@@ -0,0 +1,39 @@
+package di
+
+import (
+	jobHandler "fluencybe/internal/app/handler/job"
+	jobRepo "fluencybe/internal/app/repository/job"
+	jobSer "fluencybe/internal/app/service/job"
+	"fluencybe/internal/infrastructure/router"
+)
+
+type JobModule struct {
+	Handler *jobHandler.JobHandler
+	Service *jobSer.JobService
+	Runner  *jobSer.JobRunner
+}
+
+func (m *JobModule) Name() string {
+	return "jobs"
+}
+
+// Register builds the queue over the job types added by the modules before it. The runner is not
+// started here.
+func (m *JobModule) Register(deps *Deps, registry *Registry) error {
+	repo := jobRepo.NewJobRepository(deps.GormDB, deps.Logger)
+	definitions := registry.Jobs()
+
+	m.Service = jobSer.NewJobService(repo, definitions, deps.Logger)
+	m.Handler = jobHandler.NewJobHandler(m.Service, deps.Logger)
+	m.Runner = jobSer.NewJobRunner(repo, definitions, deps.Logger)
+	return nil
+}
+
+func (m *JobModule) Routes(routes *router.Routes) {
+	jobs := routes.API.Group("/jobs")
+	{
+		jobs.POST("", routes.DeveloperAuth(), routes.Wrap(m.Handler.CreateJob))
+		jobs.GET("", routes.DeveloperAuth(), routes.Wrap(m.Handler.ListJobs))
+		jobs.GET("/:id", routes.DeveloperAuth(), routes.Wrap(m.Handler.GetJob))
+	}
+}
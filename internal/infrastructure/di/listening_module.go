@@ -0,0 +1,341 @@
+package di
+
+import (
+	"context"
+	listeningHandler "fluencybe/internal/app/handler/listening"
+	listeningHelper "fluencybe/internal/app/helper/listening"
+	searchClient "fluencybe/internal/app/opensearch"
+	listeningRepo "fluencybe/internal/app/repository/listening"
+	listeningSer "fluencybe/internal/app/service/listening"
+	"fluencybe/internal/infrastructure/router"
+
+	"github.com/google/uuid"
+)
+
+type ListeningModule struct {
+	QuestionHandler               *listeningHandler.ListeningQuestionHandler
+	FillInTheBlankQuestionHandler *listeningHandler.ListeningFillInTheBlankQuestionHandler
+	FillInTheBlankAnswerHandler   *listeningHandler.ListeningFillInTheBlankAnswerHandler
+	ChoiceOneQuestionHandler      *listeningHandler.ListeningChoiceOneQuestionHandler
+	ChoiceOneOptionHandler        *listeningHandler.ListeningChoiceOneOptionHandler
+	ChoiceMultiQuestionHandler    *listeningHandler.ListeningChoiceMultiQuestionHandler
+	ChoiceMultiOptionHandler      *listeningHandler.ListeningChoiceMultiOptionHandler
+	MapLabellingHandler           *listeningHandler.ListeningMapLabellingHandler
+	MatchingHandler               *listeningHandler.ListeningMatchingHandler
+}
+
+func (m *ListeningModule) Name() string {
+	return "listening"
+}
+
+// Register builds the listening questions and adds the question service as the listening skill
+func (m *ListeningModule) Register(deps *Deps, registry *Registry) error {
+	log := deps.Logger
+
+	// Repositories
+	questionRepo := listeningRepo.NewListeningQuestionRepository(deps.GormDB, log)
+	fillInBlankQuestionRepo := listeningRepo.NewListeningFillInTheBlankQuestionRepository(deps.GormDB, log)
+	fillInBlankAnswerRepo := listeningRepo.NewListeningFillInTheBlankAnswerRepository(deps.GormDB, log)
+	choiceOneQuestionRepo := listeningRepo.NewListeningChoiceOneQuestionRepository(deps.GormDB, log)
+	choiceOneOptionRepo := listeningRepo.NewListeningChoiceOneOptionRepository(deps.GormDB, log)
+	choiceMultiQuestionRepo := listeningRepo.NewListeningChoiceMultiQuestionRepository(deps.GormDB, log)
+	choiceMultiOptionRepo := listeningRepo.NewListeningChoiceMultiOptionRepository(deps.GormDB, log)
+	mapLabellingRepo := listeningRepo.NewListeningMapLabellingRepository(deps.GormDB, log)
+	matchingRepo := listeningRepo.NewListeningMatchingRepository(deps.GormDB, log)
+
+	// Search
+	questionSearch := searchClient.NewListeningQuestionSearch(deps.OpenSearch, log)
+
+	// Services
+	fillInBlankQuestionService := listeningSer.NewListeningFillInTheBlankQuestionService(
+		fillInBlankQuestionRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		questionSearch,
+		nil,
+	)
+
+	fillInBlankAnswerService := listeningSer.NewListeningFillInTheBlankAnswerService(
+		fillInBlankAnswerRepo,
+		fillInBlankQuestionRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	choiceOneQuestionService := listeningSer.NewListeningChoiceOneQuestionService(
+		choiceOneQuestionRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	choiceOneOptionService := listeningSer.NewListeningChoiceOneOptionService(
+		choiceOneOptionRepo,
+		choiceOneQuestionRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	choiceMultiQuestionService := listeningSer.NewListeningChoiceMultiQuestionService(
+		choiceMultiQuestionRepo,
+		questionRepo,
+		log,
+		nil,
+	)
+
+	choiceMultiOptionService := listeningSer.NewListeningChoiceMultiOptionService(
+		choiceMultiOptionRepo,
+		choiceMultiQuestionRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	mapLabellingService := listeningSer.NewListeningMapLabellingService(
+		mapLabellingRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	matchingService := listeningSer.NewListeningMatchingService(
+		matchingRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	// Question Updator
+	questionUpdator := listeningHelper.NewListeningQuestionUpdator(
+		log,
+		deps.Cache,
+		deps.OpenSearch,
+		fillInBlankQuestionService,
+		fillInBlankAnswerService,
+		choiceOneQuestionService,
+		choiceOneOptionService,
+		choiceMultiQuestionService,
+		choiceMultiOptionService,
+		mapLabellingService,
+		matchingService,
+	)
+
+	// Set updator for all services
+	fillInBlankQuestionService.SetQuestionUpdator(questionUpdator)
+	fillInBlankAnswerService.SetQuestionUpdator(questionUpdator)
+	choiceOneQuestionService.SetQuestionUpdator(questionUpdator)
+	choiceOneOptionService.SetQuestionUpdator(questionUpdator)
+	choiceMultiQuestionService.SetQuestionUpdator(questionUpdator)
+	choiceMultiOptionService.SetQuestionUpdator(questionUpdator)
+	mapLabellingService.SetQuestionUpdator(questionUpdator)
+	matchingService.SetQuestionUpdator(questionUpdator)
+
+	// Main question service
+	questionService := listeningSer.NewListeningQuestionService(
+		questionRepo,
+		log,
+		deps.Cache,
+		deps.OpenSearch,
+		fillInBlankQuestionService,
+		fillInBlankAnswerService,
+		choiceOneQuestionService,
+		choiceOneOptionService,
+		choiceMultiQuestionService,
+		choiceMultiOptionService,
+		mapLabellingService,
+		matchingService,
+		questionUpdator,
+	)
+
+	// Handlers
+	questionHandler := listeningHandler.NewListeningQuestionHandler(
+		questionService,
+		fillInBlankQuestionService,
+		fillInBlankAnswerService,
+		choiceOneQuestionService,
+		choiceOneOptionService,
+		choiceMultiQuestionService,
+		choiceMultiOptionService,
+		mapLabellingService,
+		matchingService,
+		log,
+	)
+
+	fillInBlankQuestionHandler := listeningHandler.NewListeningFillInTheBlankQuestionHandler(
+		fillInBlankQuestionService,
+		questionRepo,
+		log,
+	)
+
+	fillInBlankAnswerHandler := listeningHandler.NewListeningFillInTheBlankAnswerHandler(
+		fillInBlankAnswerService,
+		log,
+	)
+
+	choiceOneQuestionHandler := listeningHandler.NewListeningChoiceOneQuestionHandler(
+		choiceOneQuestionService,
+		choiceOneOptionService,
+		log,
+	)
+
+	choiceOneOptionHandler := listeningHandler.NewListeningChoiceOneOptionHandler(
+		choiceOneOptionService,
+		log,
+	)
+
+	choiceMultiQuestionHandler := listeningHandler.NewListeningChoiceMultiQuestionHandler(
+		choiceMultiQuestionService,
+		choiceMultiOptionService,
+		log,
+	)
+
+	choiceMultiOptionHandler := listeningHandler.NewListeningChoiceMultiOptionHandler(
+		choiceMultiOptionService,
+		log,
+	)
+
+	mapLabellingHandler := listeningHandler.NewListeningMapLabellingHandler(
+		mapLabellingService,
+		log,
+	)
+
+	matchingHandler := listeningHandler.NewListeningMatchingHandler(
+		matchingService,
+		log,
+	)
+
+	*m = ListeningModule{
+		QuestionHandler:               questionHandler,
+		FillInTheBlankQuestionHandler: fillInBlankQuestionHandler,
+		FillInTheBlankAnswerHandler:   fillInBlankAnswerHandler,
+		ChoiceOneQuestionHandler:      choiceOneQuestionHandler,
+		ChoiceOneOptionHandler:        choiceOneOptionHandler,
+		ChoiceMultiQuestionHandler:    choiceMultiQuestionHandler,
+		ChoiceMultiOptionHandler:      choiceMultiOptionHandler,
+		MapLabellingHandler:           mapLabellingHandler,
+		MatchingHandler:               matchingHandler,
+	}
+
+	return registry.AddSkill(QuestionSkill{
+		Name:    "listening",
+		Service: questionService,
+		Index:   questionSearch,
+		Bundle: func(ctx context.Context, ids []uuid.UUID) (interface{}, error) {
+			return questionService.GetListeningByListID(ctx, ids)
+		},
+	})
+}
+
+func (m *ListeningModule) Routes(routes *router.Routes) {
+	api := routes.API
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Listening - ListeningQuestion
+	// ? ------------------------------------------------------------------------------
+	listeningQuestion := api.Group("/listening/question")
+	listeningQuestion.POST("", routes.ContentWriteAuth("listening"), routes.Wrap(m.QuestionHandler.CreateListeningQuestion))
+	listeningQuestion.GET("/:id", routes.ContentReadAuth(), routes.Wrap(m.QuestionHandler.GetListeningQuestionDetail))
+	listeningQuestion.PUT("/:id", routes.ContentWriteAuth("listening"), routes.Wrap(m.QuestionHandler.UpdateListeningQuestion))
+	listeningQuestion.DELETE("/:id", routes.ContentWriteAuth("listening"), routes.Wrap(m.QuestionHandler.DeleteListeningQuestion))
+	listeningQuestion.POST("/get-new-updates", routes.ContentReadAuth(), routes.Wrap(m.QuestionHandler.GetListNewListeningQuestionByListVersionAndID))
+	listeningQuestion.POST("/get-by-list-id", routes.ContentReadAuth(), routes.Wrap(m.QuestionHandler.GetListListeningByListID))
+	listeningQuestion.GET("/search", routes.ContentReadAuth(), routes.Wrap(m.QuestionHandler.GetListListeningQuestiondetailPaganationWithFilter))
+	listeningQuestion.DELETE("/delete-all", routes.DeveloperAuth(), routes.Wrap(m.QuestionHandler.DeleteAllListeningData))
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Listening - Listening Fill In The Blank
+	// ? ------------------------------------------------------------------------------
+	listeningFillInTheBlankQuestion := api.Group("/listening/fill-in-the-blank-question")
+	listeningFillInTheBlankQuestion.Use(routes.ContentWriteAuth("listening"))
+	{
+		listeningFillInTheBlankQuestion.POST("", routes.Wrap(m.FillInTheBlankQuestionHandler.CreateQuestion))
+		listeningFillInTheBlankQuestion.PUT("", routes.Wrap(m.FillInTheBlankQuestionHandler.UpdateQuestion))
+		listeningFillInTheBlankQuestion.DELETE("/:id", routes.Wrap(m.FillInTheBlankQuestionHandler.DeleteQuestion))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Listening - Listening Fill In The Blank Answer
+	// ? ------------------------------------------------------------------------------
+	listeningFillInTheBlankAnswer := api.Group("/listening/fill-in-the-blank-answer")
+	listeningFillInTheBlankAnswer.Use(routes.ContentWriteAuth("listening"))
+	{
+		listeningFillInTheBlankAnswer.POST("", routes.Wrap(m.FillInTheBlankAnswerHandler.CreateAnswer))
+		listeningFillInTheBlankAnswer.PUT("", routes.Wrap(m.FillInTheBlankAnswerHandler.UpdateAnswer))
+		listeningFillInTheBlankAnswer.DELETE("/:id", routes.Wrap(m.FillInTheBlankAnswerHandler.DeleteAnswer))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Listening - Listening Choice One Question
+	// ? ------------------------------------------------------------------------------
+	listeningChoiceOneQuestion := api.Group("/listening/choice-one-question")
+	listeningChoiceOneQuestion.Use(routes.ContentWriteAuth("listening"))
+	{
+		listeningChoiceOneQuestion.POST("", routes.Wrap(m.ChoiceOneQuestionHandler.CreateQuestion))
+		listeningChoiceOneQuestion.PUT("", routes.Wrap(m.ChoiceOneQuestionHandler.UpdateQuestion))
+		listeningChoiceOneQuestion.DELETE("/:id", routes.Wrap(m.ChoiceOneQuestionHandler.DeleteQuestion))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Listening - Listening Choice One Option
+	// ? ------------------------------------------------------------------------------
+	listeningChoiceOneOption := api.Group("/listening/choice-one-option")
+	listeningChoiceOneOption.Use(routes.ContentWriteAuth("listening"))
+	{
+		listeningChoiceOneOption.POST("", routes.Wrap(m.ChoiceOneOptionHandler.CreateOption))
+		listeningChoiceOneOption.PUT("", routes.Wrap(m.ChoiceOneOptionHandler.UpdateOption))
+		listeningChoiceOneOption.DELETE("/:id", routes.Wrap(m.ChoiceOneOptionHandler.DeleteOption))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Listening - Listening Choice Multi Question
+	// ? ------------------------------------------------------------------------------
+	listeningChoiceMultiQuestion := api.Group("/listening/choice-multi-question")
+	listeningChoiceMultiQuestion.Use(routes.ContentWriteAuth("listening"))
+	{
+		listeningChoiceMultiQuestion.POST("", routes.Wrap(m.ChoiceMultiQuestionHandler.CreateQuestion))
+		listeningChoiceMultiQuestion.PUT("", routes.Wrap(m.ChoiceMultiQuestionHandler.UpdateQuestion))
+		listeningChoiceMultiQuestion.DELETE("/:id", routes.Wrap(m.ChoiceMultiQuestionHandler.DeleteQuestion))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Listening - Listening Choice Multi Option
+	// ? ------------------------------------------------------------------------------
+	listeningChoiceMultiOption := api.Group("/listening/choice-multi-option")
+	listeningChoiceMultiOption.Use(routes.ContentWriteAuth("listening"))
+	{
+		listeningChoiceMultiOption.POST("", routes.Wrap(m.ChoiceMultiOptionHandler.CreateOption))
+		listeningChoiceMultiOption.PUT("", routes.Wrap(m.ChoiceMultiOptionHandler.UpdateOption))
+		listeningChoiceMultiOption.DELETE("/:id", routes.Wrap(m.ChoiceMultiOptionHandler.DeleteOption))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Listening - Listening Map Labelling
+	// ? ------------------------------------------------------------------------------
+	listeningMapLabelling := api.Group("/listening/map-labelling")
+	listeningMapLabelling.Use(routes.ContentWriteAuth("listening"))
+	{
+		listeningMapLabelling.POST("", routes.Wrap(m.MapLabellingHandler.Create))
+		listeningMapLabelling.PUT("", routes.Wrap(m.MapLabellingHandler.Update))
+		listeningMapLabelling.DELETE("/:id", routes.Wrap(m.MapLabellingHandler.Delete))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Listening - Listening Matching
+	// ? ------------------------------------------------------------------------------
+	listeningMatching := api.Group("/listening/matching")
+	listeningMatching.Use(routes.ContentWriteAuth("listening"))
+	{
+		listeningMatching.POST("", routes.Wrap(m.MatchingHandler.Create))
+		listeningMatching.PUT("", routes.Wrap(m.MatchingHandler.Update))
+		listeningMatching.DELETE("/:id", routes.Wrap(m.MatchingHandler.Delete))
+	}
+}
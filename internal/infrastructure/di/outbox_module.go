@@ -0,0 +1,43 @@
+package di
+
+import (
+	outboxHandler "fluencybe/internal/app/handler/outbox"
+	outboxRepo "fluencybe/internal/app/repository/outbox"
+	outboxSer "fluencybe/internal/app/service/outbox"
+	"fluencybe/internal/infrastructure/router"
+)
+
+type OutboxModule struct {
+	Handler *outboxHandler.OutboxHandler
+	Relay   *outboxSer.OutboxRelay
+}
+
+func (m *OutboxModule) Name() string {
+	return "outbox"
+}
+
+// Register builds the relay over the question services of the registered skills, keyed by the
+// aggregate_type the outbox trigger writes. The relay is not started here.
+func (m *OutboxModule) Register(deps *Deps, registry *Registry) error {
+	repo := outboxRepo.NewOutboxRepository(deps.GormDB, deps.Logger)
+	service := outboxSer.NewOutboxService(repo, deps.Logger)
+
+	syncers := make(map[string]outboxSer.QuestionSyncer)
+	for _, skill := range registry.Skills() {
+		syncers[skill.Name] = skill.Service
+	}
+
+	m.Handler = outboxHandler.NewOutboxHandler(service, deps.Logger)
+	m.Relay = outboxSer.NewOutboxRelay(repo, syncers, deps.Logger)
+	return nil
+}
+
+func (m *OutboxModule) Routes(routes *router.Routes) {
+	outbox := routes.API.Group("/outbox")
+	{
+		outbox.GET("/stats", routes.DeveloperAuth(), routes.Wrap(m.Handler.GetStats))
+		outbox.GET("/dead", routes.DeveloperAuth(), routes.Wrap(m.Handler.ListDeadEvents))
+		outbox.POST("/dead/retry", routes.DeveloperAuth(), routes.Wrap(m.Handler.RetryAllDeadEvents))
+		outbox.POST("/dead/:id/retry", routes.DeveloperAuth(), routes.Wrap(m.Handler.RetryDeadEvent))
+	}
+}
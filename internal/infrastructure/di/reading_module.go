@@ -0,0 +1,339 @@
+package di
+
+import (
+	"context"
+	readingHandler "fluencybe/internal/app/handler/reading"
+	readingHelper "fluencybe/internal/app/helper/reading"
+	searchClient "fluencybe/internal/app/opensearch"
+	readingRepo "fluencybe/internal/app/repository/reading"
+	readingSer "fluencybe/internal/app/service/reading"
+	"fluencybe/internal/infrastructure/router"
+
+	"github.com/google/uuid"
+)
+
+type ReadingModule struct {
+	QuestionHandler               *readingHandler.ReadingQuestionHandler
+	FillInTheBlankQuestionHandler *readingHandler.ReadingFillInTheBlankQuestionHandler
+	FillInTheBlankAnswerHandler   *readingHandler.ReadingFillInTheBlankAnswerHandler
+	ChoiceOneQuestionHandler      *readingHandler.ReadingChoiceOneQuestionHandler
+	ChoiceOneOptionHandler        *readingHandler.ReadingChoiceOneOptionHandler
+	ChoiceMultiQuestionHandler    *readingHandler.ReadingChoiceMultiQuestionHandler
+	ChoiceMultiOptionHandler      *readingHandler.ReadingChoiceMultiOptionHandler
+	TrueFalseHandler              *readingHandler.ReadingTrueFalseHandler
+	MatchingHandler               *readingHandler.ReadingMatchingHandler
+}
+
+func (m *ReadingModule) Name() string {
+	return "reading"
+}
+
+// Register builds the reading questions and adds the question service as the reading skill
+func (m *ReadingModule) Register(deps *Deps, registry *Registry) error {
+	log := deps.Logger
+
+	// Repositories
+	questionRepo := readingRepo.NewReadingQuestionRepository(deps.GormDB, log)
+	fillInBlankQuestionRepo := readingRepo.NewReadingFillInTheBlankQuestionRepository(deps.GormDB, log)
+	fillInBlankAnswerRepo := readingRepo.NewReadingFillInTheBlankAnswerRepository(deps.GormDB, log)
+	choiceOneQuestionRepo := readingRepo.NewReadingChoiceOneQuestionRepository(deps.GormDB, log)
+	choiceOneOptionRepo := readingRepo.NewReadingChoiceOneOptionRepository(deps.GormDB, log)
+	choiceMultiQuestionRepo := readingRepo.NewReadingChoiceMultiQuestionRepository(deps.GormDB, log)
+	choiceMultiOptionRepo := readingRepo.NewReadingChoiceMultiOptionRepository(deps.GormDB, log)
+	trueFalseRepo := readingRepo.NewReadingTrueFalseRepository(deps.GormDB, log)
+	matchingRepo := readingRepo.NewReadingMatchingRepository(deps.GormDB, log)
+
+	// Search
+	questionSearch := searchClient.NewReadingQuestionSearch(deps.OpenSearch, log)
+
+	// Services
+	fillInBlankQuestionService := readingSer.NewReadingFillInTheBlankQuestionService(
+		fillInBlankQuestionRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		questionSearch,
+		nil,
+	)
+
+	fillInBlankAnswerService := readingSer.NewReadingFillInTheBlankAnswerService(
+		fillInBlankAnswerRepo,
+		fillInBlankQuestionRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	choiceOneQuestionService := readingSer.NewReadingChoiceOneQuestionService(
+		choiceOneQuestionRepo,
+		questionRepo,
+		log,
+		nil,
+	)
+
+	choiceOneOptionService := readingSer.NewReadingChoiceOneOptionService(
+		choiceOneOptionRepo,
+		choiceOneQuestionRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	choiceMultiQuestionService := readingSer.NewReadingChoiceMultiQuestionService(
+		choiceMultiQuestionRepo,
+		questionRepo,
+		log,
+		nil,
+	)
+
+	choiceMultiOptionService := readingSer.NewReadingChoiceMultiOptionService(
+		choiceMultiOptionRepo,
+		choiceMultiQuestionRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	trueFalseService := readingSer.NewReadingTrueFalseService(
+		trueFalseRepo,
+		questionRepo,
+		log,
+		nil,
+	)
+
+	matchingService := readingSer.NewReadingMatchingService(
+		matchingRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	// Question Updator
+	questionUpdator := readingHelper.NewReadingQuestionUpdator(
+		log,
+		deps.Cache,
+		deps.OpenSearch,
+		fillInBlankQuestionService,
+		fillInBlankAnswerService,
+		choiceOneQuestionService,
+		choiceOneOptionService,
+		choiceMultiQuestionService,
+		choiceMultiOptionService,
+		trueFalseService,
+		matchingService,
+	)
+
+	// Set updator for all services
+	fillInBlankQuestionService.SetQuestionUpdator(questionUpdator)
+	fillInBlankAnswerService.SetQuestionUpdator(questionUpdator)
+	choiceOneQuestionService.SetQuestionUpdator(questionUpdator)
+	choiceOneOptionService.SetQuestionUpdator(questionUpdator)
+	choiceMultiQuestionService.SetQuestionUpdator(questionUpdator)
+	choiceMultiOptionService.SetQuestionUpdator(questionUpdator)
+	matchingService.SetQuestionUpdator(questionUpdator)
+	trueFalseService.SetQuestionUpdator(questionUpdator)
+
+	// Main question service
+	questionService := readingSer.NewReadingQuestionService(
+		questionRepo,
+		log,
+		deps.Cache,
+		deps.OpenSearch,
+		fillInBlankQuestionService,
+		fillInBlankAnswerService,
+		choiceOneQuestionService,
+		choiceOneOptionService,
+		choiceMultiQuestionService,
+		choiceMultiOptionService,
+		trueFalseService,
+		matchingService,
+		questionUpdator,
+	)
+
+	// Handlers
+	questionHandler := readingHandler.NewReadingQuestionHandler(
+		questionService,
+		fillInBlankQuestionService,
+		fillInBlankAnswerService,
+		choiceOneQuestionService,
+		choiceOneOptionService,
+		choiceMultiQuestionService,
+		choiceMultiOptionService,
+		trueFalseService,
+		matchingService,
+		log,
+	)
+
+	fillInBlankQuestionHandler := readingHandler.NewReadingFillInTheBlankQuestionHandler(
+		fillInBlankQuestionService,
+		questionRepo,
+		log,
+	)
+
+	fillInBlankAnswerHandler := readingHandler.NewReadingFillInTheBlankAnswerHandler(
+		fillInBlankAnswerService,
+		log,
+	)
+
+	choiceOneQuestionHandler := readingHandler.NewReadingChoiceOneQuestionHandler(
+		choiceOneQuestionService,
+		choiceOneOptionService,
+		log,
+	)
+
+	choiceOneOptionHandler := readingHandler.NewReadingChoiceOneOptionHandler(
+		choiceOneOptionService,
+		log,
+	)
+
+	choiceMultiQuestionHandler := readingHandler.NewReadingChoiceMultiQuestionHandler(
+		choiceMultiQuestionService,
+		choiceMultiOptionService,
+		log,
+	)
+
+	choiceMultiOptionHandler := readingHandler.NewReadingChoiceMultiOptionHandler(
+		choiceMultiOptionService,
+		log,
+	)
+
+	matchingHandler := readingHandler.NewReadingMatchingHandler(
+		matchingService,
+		log,
+	)
+
+	trueFalseHandler := readingHandler.NewReadingTrueFalseHandler(
+		trueFalseService,
+		log,
+	)
+
+	*m = ReadingModule{
+		QuestionHandler:               questionHandler,
+		FillInTheBlankQuestionHandler: fillInBlankQuestionHandler,
+		FillInTheBlankAnswerHandler:   fillInBlankAnswerHandler,
+		ChoiceOneQuestionHandler:      choiceOneQuestionHandler,
+		ChoiceOneOptionHandler:        choiceOneOptionHandler,
+		ChoiceMultiQuestionHandler:    choiceMultiQuestionHandler,
+		ChoiceMultiOptionHandler:      choiceMultiOptionHandler,
+		TrueFalseHandler:              trueFalseHandler,
+		MatchingHandler:               matchingHandler,
+	}
+
+	return registry.AddSkill(QuestionSkill{
+		Name:    "reading",
+		Service: questionService,
+		Index:   questionSearch,
+		Bundle: func(ctx context.Context, ids []uuid.UUID) (interface{}, error) {
+			return questionService.GetReadingByListID(ctx, ids)
+		},
+	})
+}
+
+func (m *ReadingModule) Routes(routes *router.Routes) {
+	api := routes.API
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Reading - Reading Question
+	// ? ------------------------------------------------------------------------------
+	readingQuestion := api.Group("/reading/question")
+	readingQuestion.POST("", routes.ContentWriteAuth("reading"), routes.Wrap(m.QuestionHandler.CreateReadingQuestion))
+	readingQuestion.GET("/:id", routes.ContentReadAuth(), routes.Wrap(m.QuestionHandler.GetReadingQuestionDetail))
+	readingQuestion.PUT("/:id", routes.ContentWriteAuth("reading"), routes.Wrap(m.QuestionHandler.UpdateReadingQuestion))
+	readingQuestion.DELETE("/:id", routes.ContentWriteAuth("reading"), routes.Wrap(m.QuestionHandler.DeleteReadingQuestion))
+	readingQuestion.POST("/get-new-updates", routes.ContentReadAuth(), routes.Wrap(m.QuestionHandler.GetListNewReadingQuestionByListVersionAndID))
+	readingQuestion.POST("/get-by-list-id", routes.ContentReadAuth(), routes.Wrap(m.QuestionHandler.GetListReadingByListID))
+	readingQuestion.GET("/search", routes.ContentReadAuth(), routes.Wrap(m.QuestionHandler.GetListReadingQuestiondetailPaganationWithFilter))
+	readingQuestion.DELETE("/delete-all", routes.DeveloperAuth(), routes.Wrap(m.QuestionHandler.DeleteAllReadingData))
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Reading - Reading Fill In The Blank Question
+	// ? ------------------------------------------------------------------------------
+	readingFillInTheBlankQuestion := api.Group("/reading/fill-in-the-blank-question")
+	readingFillInTheBlankQuestion.Use(routes.ContentWriteAuth("reading"))
+	{
+		readingFillInTheBlankQuestion.POST("", routes.Wrap(m.FillInTheBlankQuestionHandler.CreateQuestion))
+		readingFillInTheBlankQuestion.PUT("", routes.Wrap(m.FillInTheBlankQuestionHandler.UpdateQuestion))
+		readingFillInTheBlankQuestion.DELETE("/:id", routes.Wrap(m.FillInTheBlankQuestionHandler.DeleteQuestion))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Reading - Reading Fill In The Blank Answer
+	// ? ------------------------------------------------------------------------------
+	readingFillInTheBlankAnswer := api.Group("/reading/fill-in-the-blank-answer")
+	readingFillInTheBlankAnswer.Use(routes.ContentWriteAuth("reading"))
+	{
+		readingFillInTheBlankAnswer.POST("", routes.Wrap(m.FillInTheBlankAnswerHandler.CreateAnswer))
+		readingFillInTheBlankAnswer.PUT("", routes.Wrap(m.FillInTheBlankAnswerHandler.UpdateAnswer))
+		readingFillInTheBlankAnswer.DELETE("/:id", routes.Wrap(m.FillInTheBlankAnswerHandler.DeleteAnswer))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Reading - Reading Choice One Question
+	// ? ------------------------------------------------------------------------------
+	readingChoiceOneQuestion := api.Group("/reading/choice-one-question")
+	readingChoiceOneQuestion.Use(routes.ContentWriteAuth("reading"))
+	{
+		readingChoiceOneQuestion.POST("", routes.Wrap(m.ChoiceOneQuestionHandler.CreateQuestion))
+		readingChoiceOneQuestion.PUT("", routes.Wrap(m.ChoiceOneQuestionHandler.UpdateQuestion))
+		readingChoiceOneQuestion.DELETE("/:id", routes.Wrap(m.ChoiceOneQuestionHandler.DeleteQuestion))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Reading - Reading Choice One Option
+	// ? ------------------------------------------------------------------------------
+	readingChoiceOneOption := api.Group("/reading/choice-one-option")
+	readingChoiceOneOption.Use(routes.ContentWriteAuth("reading"))
+	{
+		readingChoiceOneOption.POST("", routes.Wrap(m.ChoiceOneOptionHandler.CreateOption))
+		readingChoiceOneOption.PUT("", routes.Wrap(m.ChoiceOneOptionHandler.UpdateOption))
+		readingChoiceOneOption.DELETE("/:id", routes.Wrap(m.ChoiceOneOptionHandler.DeleteOption))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Reading - Reading Choice Multi Question
+	// ? ------------------------------------------------------------------------------
+	readingChoiceMultiQuestion := api.Group("/reading/choice-multi-question")
+	readingChoiceMultiQuestion.Use(routes.ContentWriteAuth("reading"))
+	{
+		readingChoiceMultiQuestion.POST("", routes.Wrap(m.ChoiceMultiQuestionHandler.CreateQuestion))
+		readingChoiceMultiQuestion.PUT("", routes.Wrap(m.ChoiceMultiQuestionHandler.UpdateQuestion))
+		readingChoiceMultiQuestion.DELETE("/:id", routes.Wrap(m.ChoiceMultiQuestionHandler.DeleteQuestion))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Reading - Reading Choice Multi Option
+	// ? ------------------------------------------------------------------------------
+	readingChoiceMultiOption := api.Group("/reading/choice-multi-option")
+	readingChoiceMultiOption.Use(routes.ContentWriteAuth("reading"))
+	{
+		readingChoiceMultiOption.POST("", routes.Wrap(m.ChoiceMultiOptionHandler.CreateOption))
+		readingChoiceMultiOption.PUT("", routes.Wrap(m.ChoiceMultiOptionHandler.UpdateOption))
+		readingChoiceMultiOption.DELETE("/:id", routes.Wrap(m.ChoiceMultiOptionHandler.DeleteOption))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Reading - Reading Matching
+	// ? ------------------------------------------------------------------------------
+	readingMatching := api.Group("/reading/matching")
+	readingMatching.Use(routes.ContentWriteAuth("reading"))
+	{
+		readingMatching.POST("", routes.Wrap(m.MatchingHandler.Create))
+		readingMatching.PUT("", routes.Wrap(m.MatchingHandler.Update))
+		readingMatching.DELETE("/:id", routes.Wrap(m.MatchingHandler.Delete))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Reading - Reading True/False
+	// ? ------------------------------------------------------------------------------
+	readingTrueFalse := api.Group("/reading/true-false")
+	readingTrueFalse.Use(routes.ContentWriteAuth("reading"))
+	{
+		readingTrueFalse.POST("", routes.Wrap(m.TrueFalseHandler.Create))
+		readingTrueFalse.PUT("", routes.Wrap(m.TrueFalseHandler.Update))
+		readingTrueFalse.DELETE("/:id", routes.Wrap(m.TrueFalseHandler.Delete))
+	}
+}
@@ -0,0 +1,128 @@
+package di
+
+import (
+	"database/sql"
+	redisClient "fluencybe/internal/app/redis"
+	adminSer "fluencybe/internal/app/service/admin"
+	courseSer "fluencybe/internal/app/service/course"
+	jobSer "fluencybe/internal/app/service/job"
+	outboxSer "fluencybe/internal/app/service/outbox"
+	searchindexSer "fluencybe/internal/app/service/searchindex"
+	warmupSer "fluencybe/internal/app/service/warmup"
+	"fluencybe/internal/core/config"
+	"fluencybe/internal/infrastructure/router"
+	"fluencybe/pkg/cache"
+	"fluencybe/pkg/logger"
+	"fmt"
+
+	"github.com/opensearch-project/opensearch-go/v2"
+	"gorm.io/gorm"
+)
+
+// Deps are the shared clients the modules are built on. Redis and OpenSearch are nil when they
+// could not be reached at start.
+type Deps struct {
+	Config     *config.Config
+	Logger     *logger.PrettyLogger
+	DBConn     *sql.DB
+	GormDB     *gorm.DB
+	Redis      *cache.RedisClient
+	Cache      *cache.TieredCache
+	OpenSearch *opensearch.Client
+}
+
+// Module is one feature of the API. Register builds its repositories, services, updators and
+// handlers and adds what other modules build on to the registry, Routes mounts its handlers.
+type Module interface {
+	Name() string
+	Register(deps *Deps, registry *Registry) error
+	router.RouteModule
+}
+
+// QuestionService is implemented by the question service of every skill, it keeps the cached
+// details and the search documents of the questions of its skill
+type QuestionService interface {
+	outboxSer.QuestionSyncer
+	adminSer.QuestionSource
+	searchindexSer.QuestionSource
+	warmupSer.QuestionWarmer
+	SetCacheWarmup(warmup redisClient.CacheWarmup)
+}
+
+// QuestionSkill is what a skill module shares with the outbox relay, the search index
+// maintenance, the admin commands, the cache warm-up and the course bundles
+type QuestionSkill struct {
+	Name    string
+	Service QuestionService
+	// Index is the search index the questions are rebuilt into
+	Index searchindexSer.QuestionIndex
+	// Bundle resolves the lesson questions of the skill for course bundles
+	Bundle courseSer.BundleQuestionSource
+}
+
+// Registry collects what the modules share. Modules register in order, so a module only sees
+// what the modules before it added.
+type Registry struct {
+	skills []QuestionSkill
+	jobs   map[string]jobSer.Definition
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		jobs: make(map[string]jobSer.Definition),
+	}
+}
+
+func (r *Registry) AddSkill(skill QuestionSkill) error {
+	for _, existing := range r.skills {
+		if existing.Name == skill.Name {
+			return fmt.Errorf("skill %q is already registered", skill.Name)
+		}
+	}
+	r.skills = append(r.skills, skill)
+	return nil
+}
+
+// Skills returns the skills in registration order
+func (r *Registry) Skills() []QuestionSkill {
+	return append([]QuestionSkill(nil), r.skills...)
+}
+
+// SkillNames returns the names of the skills in registration order
+func (r *Registry) SkillNames() []string {
+	names := make([]string, len(r.skills))
+	for i, skill := range r.skills {
+		names[i] = skill.Name
+	}
+	return names
+}
+
+// AddJob makes a background job type available to the job queue
+func (r *Registry) AddJob(jobType string, definition jobSer.Definition) error {
+	if _, ok := r.jobs[jobType]; ok {
+		return fmt.Errorf("job type %q is already registered", jobType)
+	}
+	r.jobs[jobType] = definition
+	return nil
+}
+
+func (r *Registry) Jobs() map[string]jobSer.Definition {
+	jobs := make(map[string]jobSer.Definition, len(r.jobs))
+	for jobType, definition := range r.jobs {
+		jobs[jobType] = definition
+	}
+	return jobs
+}
+
+// registerModules builds the modules in order and returns them for their routes
+func registerModules(deps *Deps, modules ...Module) ([]router.RouteModule, error) {
+	registry := NewRegistry()
+	routes := make([]router.RouteModule, len(modules))
+	for i, module := range modules {
+		if err := module.Register(deps, registry); err != nil {
+			return nil, fmt.Errorf("failed to register module %s: %w", module.Name(), err)
+		}
+		routes[i] = module
+	}
+	return routes, nil
+}
@@ -0,0 +1,39 @@
+package di
+
+import (
+	searchHandler "fluencybe/internal/app/handler/search"
+	searchClient "fluencybe/internal/app/opensearch"
+	searchSer "fluencybe/internal/app/service/search"
+	"fluencybe/internal/infrastructure/router"
+)
+
+type SearchModule struct {
+	Handler           *searchHandler.QuestionSearchHandler
+	SimilarityHandler *searchHandler.QuestionSimilarityHandler
+
+	// skills get similar questions under /{skill}/question/:id/similar
+	skills []string
+}
+
+func (m *SearchModule) Name() string {
+	return "search"
+}
+
+func (m *SearchModule) Register(deps *Deps, registry *Registry) error {
+	service := searchSer.NewQuestionSearchService(searchClient.NewQuestionSearch(deps.OpenSearch, deps.Logger), deps.Logger)
+	similarityService := searchSer.NewQuestionSimilarityService(searchClient.NewQuestionSimilarity(deps.OpenSearch, deps.Logger), deps.Logger)
+
+	m.Handler = searchHandler.NewQuestionSearchHandler(service, deps.Logger)
+	m.SimilarityHandler = searchHandler.NewQuestionSimilarityHandler(similarityService, deps.Logger)
+	m.skills = registry.SkillNames()
+	return nil
+}
+
+func (m *SearchModule) Routes(routes *router.Routes) {
+	api := routes.API
+
+	api.GET("/search/questions", routes.ContentReadAuth(), routes.Wrap(m.Handler.SearchQuestions))
+	for _, skill := range m.skills {
+		api.GET("/"+skill+"/question/:id/similar", routes.ContentReadAuth(), routes.Wrap(m.SimilarityHandler.SimilarQuestions(skill)))
+	}
+}
@@ -0,0 +1,48 @@
+package di
+
+import (
+	searchindexHandler "fluencybe/internal/app/handler/searchindex"
+	searchClient "fluencybe/internal/app/opensearch"
+	searchindexRepo "fluencybe/internal/app/repository/searchindex"
+	jobSer "fluencybe/internal/app/service/job"
+	searchindexSer "fluencybe/internal/app/service/searchindex"
+	constants "fluencybe/internal/core/constants"
+	"fluencybe/internal/infrastructure/router"
+)
+
+type SearchIndexModule struct {
+	Handler *searchindexHandler.SearchIndexHandler
+	Service *searchindexSer.SearchIndexService
+}
+
+func (m *SearchIndexModule) Name() string {
+	return "searchindex"
+}
+
+// Register builds the index maintenance over the index and question service of every registered
+// skill, the indexes are rebuilt from those services
+func (m *SearchIndexModule) Register(deps *Deps, registry *Registry) error {
+	repo := searchindexRepo.NewQuestionVersionRepository(deps.GormDB, deps.Logger)
+	manager := searchClient.NewIndexManager(deps.OpenSearch, deps.Logger)
+
+	indexes := make(map[string]searchindexSer.SkillIndex)
+	for _, skill := range registry.Skills() {
+		indexes[skill.Name] = searchindexSer.SkillIndex{
+			Index:  skill.Index,
+			Source: skill.Service,
+		}
+	}
+
+	m.Service = searchindexSer.NewSearchIndexService(manager, repo, indexes, deps.Logger)
+	m.Handler = searchindexHandler.NewSearchIndexHandler(m.Service, deps.Logger)
+	return registry.AddJob(constants.JobTypeSearchReindex, jobSer.SearchReindexJob(m.Service))
+}
+
+func (m *SearchIndexModule) Routes(routes *router.Routes) {
+	searchIndex := routes.API.Group("/search/indexes")
+	{
+		searchIndex.POST("/:skill/reindex", routes.DeveloperAuth(), routes.Wrap(m.Handler.Reindex))
+		searchIndex.POST("/:skill/verify", routes.DeveloperAuth(), routes.Wrap(m.Handler.Verify))
+		searchIndex.GET("/:skill/tasks", routes.DeveloperAuth(), routes.Wrap(m.Handler.GetTasks))
+	}
+}
@@ -0,0 +1,307 @@
+package di
+
+import (
+	"context"
+	speakingHandler "fluencybe/internal/app/handler/speaking"
+	speakingHelper "fluencybe/internal/app/helper/speaking"
+	searchClient "fluencybe/internal/app/opensearch"
+	speakingRepo "fluencybe/internal/app/repository/speaking"
+	searchSer "fluencybe/internal/app/service/search"
+	speakingSer "fluencybe/internal/app/service/speaking"
+	"fluencybe/internal/infrastructure/router"
+
+	"github.com/google/uuid"
+)
+
+type SpeakingModule struct {
+	QuestionHandler                   *speakingHandler.SpeakingQuestionHandler
+	WordRepetitionHandler             *speakingHandler.SpeakingWordRepetitionHandler
+	PhraseRepetitionHandler           *speakingHandler.SpeakingPhraseRepetitionHandler
+	ParagraphRepetitionHandler        *speakingHandler.SpeakingParagraphRepetitionHandler
+	OpenParagraphHandler              *speakingHandler.SpeakingOpenParagraphHandler
+	ConversationalRepetitionHandler   *speakingHandler.SpeakingConversationalRepetitionHandler
+	ConversationalRepetitionQAHandler *speakingHandler.SpeakingConversationalRepetitionQAHandler
+	ConversationalOpenHandler         *speakingHandler.SpeakingConversationalOpenHandler
+}
+
+func (m *SpeakingModule) Name() string {
+	return "speaking"
+}
+
+// Register builds the speaking questions and adds the question service as the speaking skill
+func (m *SpeakingModule) Register(deps *Deps, registry *Registry) error {
+	log := deps.Logger
+
+	// Repositories
+	questionRepo := speakingRepo.NewSpeakingQuestionRepository(deps.GormDB, log)
+	wordRepetitionRepo := speakingRepo.NewSpeakingWordRepetitionRepository(deps.GormDB, log)
+	phraseRepetitionRepo := speakingRepo.NewSpeakingPhraseRepetitionRepository(deps.GormDB, log)
+	paragraphRepetitionRepo := speakingRepo.NewSpeakingParagraphRepetitionRepository(deps.GormDB, log)
+	openParagraphRepo := speakingRepo.NewSpeakingOpenParagraphRepository(deps.GormDB, log)
+	conversationalRepetitionRepo := speakingRepo.NewSpeakingConversationalRepetitionRepository(deps.GormDB, log)
+	conversationalRepetitionQARepo := speakingRepo.NewSpeakingConversationalRepetitionQARepository(deps.GormDB, log)
+	conversationalOpenRepo := speakingRepo.NewSpeakingConversationalOpenRepository(deps.GormDB, log)
+
+	// Search
+	questionSearch := searchClient.NewSpeakingQuestionSearch(deps.OpenSearch, log)
+
+	// Services
+	wordRepetitionService := speakingSer.NewSpeakingWordRepetitionService(
+		wordRepetitionRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	phraseRepetitionService := speakingSer.NewSpeakingPhraseRepetitionService(
+		phraseRepetitionRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	paragraphRepetitionService := speakingSer.NewSpeakingParagraphRepetitionService(
+		paragraphRepetitionRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	openParagraphService := speakingSer.NewSpeakingOpenParagraphService(
+		openParagraphRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	conversationalRepetitionService := speakingSer.NewSpeakingConversationalRepetitionService(
+		conversationalRepetitionRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	conversationalRepetitionQAService := speakingSer.NewSpeakingConversationalRepetitionQAService(
+		conversationalRepetitionQARepo,
+		conversationalRepetitionRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	conversationalOpenService := speakingSer.NewSpeakingConversationalOpenService(
+		conversationalOpenRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	// Question Updator
+	questionUpdator := speakingHelper.NewSpeakingQuestionUpdator(
+		log,
+		deps.Cache,
+		deps.OpenSearch,
+		wordRepetitionService,
+		phraseRepetitionService,
+		paragraphRepetitionService,
+		openParagraphService,
+		conversationalRepetitionService,
+		conversationalRepetitionQAService,
+		conversationalOpenService,
+	)
+
+	// Set updator for all services
+	wordRepetitionService.SetQuestionUpdator(questionUpdator)
+	phraseRepetitionService.SetQuestionUpdator(questionUpdator)
+	paragraphRepetitionService.SetQuestionUpdator(questionUpdator)
+	openParagraphService.SetQuestionUpdator(questionUpdator)
+	conversationalRepetitionService.SetQuestionUpdator(questionUpdator)
+	conversationalRepetitionQAService.SetQuestionUpdator(questionUpdator)
+	conversationalOpenService.SetQuestionUpdator(questionUpdator)
+
+	// Main question service
+	questionService := speakingSer.NewSpeakingQuestionService(
+		questionRepo,
+		log,
+		deps.Cache,
+		questionSearch,
+		searchSer.NewQuestionSimilarityService(searchClient.NewQuestionSimilarity(deps.OpenSearch, log), log),
+		wordRepetitionService,
+		phraseRepetitionService,
+		paragraphRepetitionService,
+		openParagraphService,
+		conversationalRepetitionService,
+		conversationalRepetitionQAService,
+		conversationalOpenService,
+		questionUpdator,
+	)
+
+	// Handlers
+	questionHandler := speakingHandler.NewSpeakingQuestionHandler(
+		questionService,
+		wordRepetitionService,
+		phraseRepetitionService,
+		paragraphRepetitionService,
+		openParagraphService,
+		conversationalRepetitionService,
+		conversationalRepetitionQAService,
+		conversationalOpenService,
+		log,
+	)
+
+	wordRepetitionHandler := speakingHandler.NewSpeakingWordRepetitionHandler(
+		wordRepetitionService,
+		log,
+	)
+
+	phraseRepetitionHandler := speakingHandler.NewSpeakingPhraseRepetitionHandler(
+		phraseRepetitionService,
+		log,
+	)
+
+	paragraphRepetitionHandler := speakingHandler.NewSpeakingParagraphRepetitionHandler(
+		paragraphRepetitionService,
+		log,
+	)
+
+	openParagraphHandler := speakingHandler.NewSpeakingOpenParagraphHandler(
+		openParagraphService,
+		log,
+	)
+
+	conversationalRepetitionHandler := speakingHandler.NewSpeakingConversationalRepetitionHandler(
+		conversationalRepetitionService,
+		log,
+	)
+
+	conversationalRepetitionQAHandler := speakingHandler.NewSpeakingConversationalRepetitionQAHandler(
+		conversationalRepetitionQAService,
+		log,
+	)
+
+	conversationalOpenHandler := speakingHandler.NewSpeakingConversationalOpenHandler(
+		conversationalOpenService,
+		log,
+	)
+
+	*m = SpeakingModule{
+		QuestionHandler:                   questionHandler,
+		WordRepetitionHandler:             wordRepetitionHandler,
+		PhraseRepetitionHandler:           phraseRepetitionHandler,
+		ParagraphRepetitionHandler:        paragraphRepetitionHandler,
+		OpenParagraphHandler:              openParagraphHandler,
+		ConversationalRepetitionHandler:   conversationalRepetitionHandler,
+		ConversationalRepetitionQAHandler: conversationalRepetitionQAHandler,
+		ConversationalOpenHandler:         conversationalOpenHandler,
+	}
+
+	return registry.AddSkill(QuestionSkill{
+		Name:    "speaking",
+		Service: questionService,
+		Index:   questionSearch,
+		Bundle: func(ctx context.Context, ids []uuid.UUID) (interface{}, error) {
+			return questionService.GetSpeakingByListID(ctx, ids)
+		},
+	})
+}
+
+func (m *SpeakingModule) Routes(routes *router.Routes) {
+	api := routes.API
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Speaking - SpeakingQuestion
+	// ? ------------------------------------------------------------------------------
+	speakingQuestion := api.Group("/speaking/question")
+	speakingQuestion.POST("", routes.ContentWriteAuth("speaking"), routes.Wrap(m.QuestionHandler.CreateSpeakingQuestion))
+	speakingQuestion.GET("/:id", routes.ContentReadAuth(), routes.Wrap(m.QuestionHandler.GetSpeakingQuestionDetail))
+	speakingQuestion.PUT("/:id", routes.ContentWriteAuth("speaking"), routes.Wrap(m.QuestionHandler.UpdateSpeakingQuestion))
+	speakingQuestion.DELETE("/:id", routes.ContentWriteAuth("speaking"), routes.Wrap(m.QuestionHandler.DeleteSpeakingQuestion))
+	speakingQuestion.POST("/get-new-updates", routes.ContentReadAuth(), routes.Wrap(m.QuestionHandler.GetListNewSpeakingQuestionByListVersionAndID))
+	speakingQuestion.POST("/get-by-list-id", routes.ContentReadAuth(), routes.Wrap(m.QuestionHandler.GetListSpeakingByListID))
+	speakingQuestion.GET("/search", routes.ContentReadAuth(), routes.Wrap(m.QuestionHandler.GetListSpeakingQuestiondetailPaganationWithFilter))
+	speakingQuestion.DELETE("/delete-all", routes.DeveloperAuth(), routes.Wrap(m.QuestionHandler.DeleteAllSpeakingData))
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Speaking - Word Repetition
+	// ? ------------------------------------------------------------------------------
+	speakingWordRepetition := api.Group("/speaking/word-repetition")
+	speakingWordRepetition.Use(routes.ContentWriteAuth("speaking"))
+	{
+		speakingWordRepetition.POST("", routes.Wrap(m.WordRepetitionHandler.Create))
+		speakingWordRepetition.PUT("", routes.Wrap(m.WordRepetitionHandler.Update))
+		speakingWordRepetition.DELETE("/:id", routes.Wrap(m.WordRepetitionHandler.Delete))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Speaking - Phrase Repetition
+	// ? ------------------------------------------------------------------------------
+	speakingPhraseRepetition := api.Group("/speaking/phrase-repetition")
+	speakingPhraseRepetition.Use(routes.ContentWriteAuth("speaking"))
+	{
+		speakingPhraseRepetition.POST("", routes.Wrap(m.PhraseRepetitionHandler.Create))
+		speakingPhraseRepetition.PUT("", routes.Wrap(m.PhraseRepetitionHandler.Update))
+		speakingPhraseRepetition.DELETE("/:id", routes.Wrap(m.PhraseRepetitionHandler.Delete))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Speaking - Paragraph Repetition
+	// ? ------------------------------------------------------------------------------
+	speakingParagraphRepetition := api.Group("/speaking/paragraph-repetition")
+	speakingParagraphRepetition.Use(routes.ContentWriteAuth("speaking"))
+	{
+		speakingParagraphRepetition.POST("", routes.Wrap(m.ParagraphRepetitionHandler.Create))
+		speakingParagraphRepetition.PUT("", routes.Wrap(m.ParagraphRepetitionHandler.Update))
+		speakingParagraphRepetition.DELETE("/:id", routes.Wrap(m.ParagraphRepetitionHandler.Delete))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Speaking - Open Paragraph
+	// ? ------------------------------------------------------------------------------
+	speakingOpenParagraph := api.Group("/speaking/open-paragraph")
+	speakingOpenParagraph.Use(routes.ContentWriteAuth("speaking"))
+	{
+		speakingOpenParagraph.POST("", routes.Wrap(m.OpenParagraphHandler.Create))
+		speakingOpenParagraph.PUT("", routes.Wrap(m.OpenParagraphHandler.Update))
+		speakingOpenParagraph.DELETE("/:id", routes.Wrap(m.OpenParagraphHandler.Delete))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Speaking - Conversational Repetition
+	// ? ------------------------------------------------------------------------------
+	speakingConversationalRepetition := api.Group("/speaking/conversational-repetition")
+	speakingConversationalRepetition.Use(routes.ContentWriteAuth("speaking"))
+	{
+		speakingConversationalRepetition.POST("", routes.Wrap(m.ConversationalRepetitionHandler.Create))
+		speakingConversationalRepetition.PUT("", routes.Wrap(m.ConversationalRepetitionHandler.Update))
+		speakingConversationalRepetition.DELETE("/:id", routes.Wrap(m.ConversationalRepetitionHandler.Delete))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Speaking - Conversational Repetition QA
+	// ? ------------------------------------------------------------------------------
+	speakingConversationalRepetitionQA := api.Group("/speaking/conversational-repetition-qa")
+	speakingConversationalRepetitionQA.Use(routes.ContentWriteAuth("speaking"))
+	{
+		speakingConversationalRepetitionQA.POST("", routes.Wrap(m.ConversationalRepetitionQAHandler.Create))
+		speakingConversationalRepetitionQA.PUT("", routes.Wrap(m.ConversationalRepetitionQAHandler.Update))
+		speakingConversationalRepetitionQA.DELETE("/:id", routes.Wrap(m.ConversationalRepetitionQAHandler.Delete))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Speaking - Conversational Open
+	// ? ------------------------------------------------------------------------------
+	speakingConversationalOpen := api.Group("/speaking/conversational-open")
+	speakingConversationalOpen.Use(routes.ContentWriteAuth("speaking"))
+	{
+		speakingConversationalOpen.POST("", routes.Wrap(m.ConversationalOpenHandler.Create))
+		speakingConversationalOpen.PUT("", routes.Wrap(m.ConversationalOpenHandler.Update))
+		speakingConversationalOpen.DELETE("/:id", routes.Wrap(m.ConversationalOpenHandler.Delete))
+	}
+}
@@ -0,0 +1,43 @@
+package di
+
+import (
+	courseRepo "fluencybe/internal/app/repository/course"
+	warmupSer "fluencybe/internal/app/service/warmup"
+	"fluencybe/internal/core/status"
+	"fluencybe/internal/infrastructure/router"
+	"fluencybe/pkg/cache"
+)
+
+// WarmupModule has no routes, the warm-up runs in the background and from the admin commands
+type WarmupModule struct {
+	Warmer *warmupSer.CacheWarmer
+}
+
+func (m *WarmupModule) Name() string {
+	return "warmup"
+}
+
+// Register builds the cache warmer over the question services of the registered skills, read
+// counts are kept in Redis. The services report their reads to the warmer, which is not started
+// here.
+func (m *WarmupModule) Register(deps *Deps, registry *Registry) error {
+	warmers := make(map[string]warmupSer.QuestionWarmer)
+	for _, skill := range registry.Skills() {
+		warmers[skill.Name] = skill.Service
+	}
+
+	m.Warmer = warmupSer.NewCacheWarmer(
+		courseRepo.NewLessonQuestionRepository(deps.GormDB, deps.Logger),
+		cache.NewPopularity(deps.Redis, status.GetRedisStatus),
+		warmers,
+		status.RedisReady(),
+		deps.Logger,
+	)
+
+	for _, skill := range registry.Skills() {
+		skill.Service.SetCacheWarmup(m.Warmer)
+	}
+	return nil
+}
+
+func (m *WarmupModule) Routes(routes *router.Routes) {}
@@ -0,0 +1,151 @@
+package di
+
+import (
+	"context"
+	writingHandler "fluencybe/internal/app/handler/writing"
+	writingHelper "fluencybe/internal/app/helper/writing"
+	searchClient "fluencybe/internal/app/opensearch"
+	writingRepo "fluencybe/internal/app/repository/writing"
+	searchSer "fluencybe/internal/app/service/search"
+	writingSer "fluencybe/internal/app/service/writing"
+	"fluencybe/internal/infrastructure/router"
+
+	"github.com/google/uuid"
+)
+
+type WritingModule struct {
+	QuestionHandler           *writingHandler.WritingQuestionHandler
+	SentenceCompletionHandler *writingHandler.WritingSentenceCompletionHandler
+	EssayHandler              *writingHandler.WritingEssayHandler
+}
+
+func (m *WritingModule) Name() string {
+	return "writing"
+}
+
+// Register builds the writing questions and adds the question service as the writing skill
+func (m *WritingModule) Register(deps *Deps, registry *Registry) error {
+	log := deps.Logger
+
+	// Repositories
+	questionRepo := writingRepo.NewWritingQuestionRepository(deps.GormDB, log)
+	essayRepo := writingRepo.NewWritingEssayRepository(deps.GormDB, log)
+	sentenceCompletionRepo := writingRepo.NewWritingSentenceCompletionRepository(deps.GormDB, log)
+
+	// Search
+	questionSearch := searchClient.NewWritingQuestionSearch(deps.OpenSearch, log)
+
+	// Services
+	essayService := writingSer.NewWritingEssayService(
+		essayRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	sentenceCompletionService := writingSer.NewWritingSentenceCompletionService(
+		sentenceCompletionRepo,
+		questionRepo,
+		log,
+		deps.Cache,
+		nil,
+	)
+
+	// Question Updator
+	questionUpdator := writingHelper.NewWritingQuestionUpdator(
+		log,
+		deps.Cache,
+		deps.OpenSearch,
+		sentenceCompletionService,
+		essayService,
+	)
+
+	// Set updator for all services
+	essayService.SetQuestionUpdator(questionUpdator)
+	sentenceCompletionService.SetQuestionUpdator(questionUpdator)
+
+	// Main question service
+	questionService := writingSer.NewWritingQuestionService(
+		questionRepo,
+		log,
+		deps.Cache,
+		questionSearch,
+		searchSer.NewQuestionSimilarityService(searchClient.NewQuestionSimilarity(deps.OpenSearch, log), log),
+		sentenceCompletionService,
+		essayService,
+		questionUpdator,
+	)
+
+	// Handlers
+	questionHandler := writingHandler.NewWritingQuestionHandler(
+		questionService,
+		sentenceCompletionService,
+		essayService,
+		log,
+	)
+
+	sentenceCompletionHandler := writingHandler.NewWritingSentenceCompletionHandler(
+		sentenceCompletionService,
+		log,
+	)
+
+	essayHandler := writingHandler.NewWritingEssayHandler(
+		essayService,
+		log,
+	)
+
+	*m = WritingModule{
+		QuestionHandler:           questionHandler,
+		SentenceCompletionHandler: sentenceCompletionHandler,
+		EssayHandler:              essayHandler,
+	}
+
+	return registry.AddSkill(QuestionSkill{
+		Name:    "writing",
+		Service: questionService,
+		Index:   questionSearch,
+		Bundle: func(ctx context.Context, ids []uuid.UUID) (interface{}, error) {
+			return questionService.GetWritingByListID(ctx, ids)
+		},
+	})
+}
+
+func (m *WritingModule) Routes(routes *router.Routes) {
+	api := routes.API
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Writing - WritingQuestion
+	// ? ------------------------------------------------------------------------------
+	writingQuestion := api.Group("/writing/question")
+	writingQuestion.POST("", routes.ContentWriteAuth("writing"), routes.Wrap(m.QuestionHandler.CreateWritingQuestion))
+	writingQuestion.GET("/:id", routes.ContentReadAuth(), routes.Wrap(m.QuestionHandler.GetWritingQuestionDetail))
+	writingQuestion.PUT("/:id", routes.ContentWriteAuth("writing"), routes.Wrap(m.QuestionHandler.UpdateWritingQuestion))
+	writingQuestion.DELETE("/:id", routes.ContentWriteAuth("writing"), routes.Wrap(m.QuestionHandler.DeleteWritingQuestion))
+	writingQuestion.POST("/get-new-updates", routes.ContentReadAuth(), routes.Wrap(m.QuestionHandler.GetListNewWritingQuestionByListVersionAndID))
+	writingQuestion.POST("/get-by-list-id", routes.ContentReadAuth(), routes.Wrap(m.QuestionHandler.GetListWritingByListID))
+	writingQuestion.GET("/search", routes.ContentReadAuth(), routes.Wrap(m.QuestionHandler.GetListWritingQuestiondetailPaganationWithFilter))
+	writingQuestion.DELETE("/delete-all", routes.DeveloperAuth(), routes.Wrap(m.QuestionHandler.DeleteAllWritingData))
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Writing - Sentence Completion
+	// ? ------------------------------------------------------------------------------
+	writingSentenceCompletion := api.Group("/writing/sentence-completion")
+	writingSentenceCompletion.Use(routes.ContentWriteAuth("writing"))
+	{
+		writingSentenceCompletion.POST("", routes.Wrap(m.SentenceCompletionHandler.Create))
+		writingSentenceCompletion.PUT("", routes.Wrap(m.SentenceCompletionHandler.Update))
+		writingSentenceCompletion.DELETE("/:id", routes.Wrap(m.SentenceCompletionHandler.Delete))
+	}
+
+	// ? ------------------------------------------------------------------------------
+	// ? - Writing - Essay
+	// ? ------------------------------------------------------------------------------
+	writingEssay := api.Group("/writing/essay")
+	writingEssay.Use(routes.ContentWriteAuth("writing"))
+	{
+		writingEssay.POST("", routes.Wrap(m.EssayHandler.Create))
+		writingEssay.PUT("", routes.Wrap(m.EssayHandler.Update))
+		writingEssay.DELETE("/:id", routes.Wrap(m.EssayHandler.Delete))
+	}
+}
@@ -0,0 +1,224 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"fluencybe/internal/core/config"
+	constants "fluencybe/internal/core/constants"
+	"fluencybe/internal/core/status"
+	"fluencybe/migrations"
+	"fluencybe/pkg/logger"
+	"fluencybe/pkg/migrate"
+	"time"
+)
+
+// Check and report statuses
+const (
+	StatusUp       = "up"
+	StatusDegraded = "degraded"
+	StatusDown     = "down"
+)
+
+// Dependencies checked for readiness
+const (
+	DependencyPostgres   = "postgres"
+	DependencyRedis      = "redis"
+	DependencyOpenSearch = "opensearch"
+	DependencyMigrations = "migrations"
+)
+
+// Check is the state of one dependency. Required dependencies fail readiness while down.
+type Check struct {
+	Status    string                 `json:"status"`
+	Required  bool                   `json:"required"`
+	CheckedAt *time.Time             `json:"checked_at,omitempty"`
+	Error     string                 `json:"error,omitempty"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+}
+
+// Report is down when a required dependency is down, degraded when any other check is not up
+type Report struct {
+	Status    string           `json:"status"`
+	CheckedAt time.Time        `json:"checked_at"`
+	Checks    map[string]Check `json:"checks"`
+}
+
+// Ready reports whether the instance should receive traffic
+func (r *Report) Ready() bool {
+	return r.Status != StatusDown
+}
+
+// Checker reports the dependencies of the service. Postgres and the migrations are checked on
+// every call, Redis and OpenSearch are read from the background checks of the status package.
+type Checker struct {
+	db         *sql.DB
+	migrations *migrate.Runner
+	policy     config.HealthConfig
+	required   map[string]bool
+	startedAt  time.Time
+}
+
+func NewChecker(db *sql.DB, policy config.HealthConfig, logger *logger.PrettyLogger) (*Checker, error) {
+	runner, err := migrate.NewRunner(db, migrations.FS, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	required := make(map[string]bool, len(policy.HardDependencies))
+	for _, dependency := range policy.HardDependencies {
+		required[dependency] = true
+	}
+
+	return &Checker{
+		db:         db,
+		migrations: runner,
+		policy:     policy,
+		required:   required,
+		startedAt:  time.Now().UTC(),
+	}, nil
+}
+
+// Live only tells that the process is serving requests, it never looks at a dependency so an
+// outage does not get every replica restarted
+func (c *Checker) Live() map[string]interface{} {
+	return map[string]interface{}{
+		"status":     StatusUp,
+		"started_at": c.startedAt,
+		"uptime":     time.Since(c.startedAt).Round(time.Second).String(),
+	}
+}
+
+// Ready checks every dependency and applies the readiness policy. It does not log, the status
+// package already reports Redis and OpenSearch failures and probes would repeat them.
+func (c *Checker) Ready(ctx context.Context) *Report {
+	ctx, cancel := context.WithTimeout(ctx, constants.HealthCheckTimeout)
+	defer cancel()
+
+	now := time.Now().UTC()
+	report := &Report{
+		Status:    StatusUp,
+		CheckedAt: now,
+		Checks: map[string]Check{
+			DependencyPostgres:   c.checkPostgres(ctx, now),
+			DependencyRedis:      c.checkState(status.GetRedisState(), now),
+			DependencyOpenSearch: c.checkState(status.GetOpenSearchState(), now),
+			DependencyMigrations: c.checkMigrations(ctx, now),
+		},
+	}
+
+	for name, check := range report.Checks {
+		check.Required = c.required[name]
+		report.Checks[name] = check
+
+		switch {
+		case check.Status == StatusDown && check.Required:
+			report.Status = StatusDown
+		case check.Status != StatusUp && report.Status == StatusUp:
+			report.Status = StatusDegraded
+		}
+	}
+
+	return report
+}
+
+// checkPostgres pings the database and reports the pool usage, a saturated pool is degraded
+// rather than down since requests still get through, only slower
+func (c *Checker) checkPostgres(ctx context.Context, now time.Time) Check {
+	stats := c.db.Stats()
+	check := Check{
+		Status:    StatusUp,
+		CheckedAt: &now,
+		Details: map[string]interface{}{
+			"open_connections": stats.OpenConnections,
+			"in_use":           stats.InUse,
+			"idle":             stats.Idle,
+			"max_open":         stats.MaxOpenConnections,
+			"wait_count":       stats.WaitCount,
+			"wait_duration":    stats.WaitDuration.String(),
+		},
+	}
+
+	if err := c.db.PingContext(ctx); err != nil {
+		check.Status = StatusDown
+		check.Error = err.Error()
+		return check
+	}
+
+	// Without a limit on open connections the pool cannot saturate
+	if stats.MaxOpenConnections > 0 {
+		saturation := float64(stats.InUse) / float64(stats.MaxOpenConnections)
+		check.Details["saturation"] = saturation
+		if saturation >= c.policy.PoolSaturation {
+			check.Status = StatusDegraded
+			check.Error = "connection pool is saturated"
+		}
+	}
+	return check
+}
+
+// checkState turns the last background check of a dependency into a check, one that has not run
+// yet or is older than StaleAfter counts as down
+func (c *Checker) checkState(state status.DependencyState, now time.Time) Check {
+	if state.CheckedAt.IsZero() {
+		return Check{Status: StatusDown, Error: "not checked yet"}
+	}
+
+	checkedAt := state.CheckedAt
+	check := Check{
+		Status:    StatusUp,
+		CheckedAt: &checkedAt,
+		Error:     state.Error,
+	}
+	switch {
+	case !state.Healthy:
+		check.Status = StatusDown
+	case now.Sub(state.CheckedAt) > c.policy.StaleAfter:
+		check.Status = StatusDown
+		check.Error = "last check is stale"
+	}
+	return check
+}
+
+// checkMigrations compares the applied migrations with the ones embedded in this build. A
+// pending or modified migration is down, the handlers expect the schema of this build. Versions
+// unknown to this build are only degraded, a newer replica migrates ahead during a rolling deploy.
+func (c *Checker) checkMigrations(ctx context.Context, now time.Time) Check {
+	check := Check{
+		Status:    StatusUp,
+		CheckedAt: &now,
+	}
+
+	statuses, err := c.migrations.Status(ctx)
+	if err != nil {
+		check.Status = StatusDown
+		check.Error = err.Error()
+		return check
+	}
+
+	pending, modified, unknown := []string{}, []string{}, []string{}
+	for _, migration := range statuses {
+		switch {
+		case migration.Unknown:
+			unknown = append(unknown, migration.String())
+		case migration.Modified:
+			modified = append(modified, migration.String())
+		case migration.AppliedAt == nil:
+			pending = append(pending, migration.String())
+		}
+	}
+	check.Details = map[string]interface{}{
+		"pending":  pending,
+		"modified": modified,
+		"unknown":  unknown,
+	}
+
+	switch {
+	case len(pending) > 0 || len(modified) > 0:
+		check.Status = StatusDown
+		check.Error = "database schema does not match this build"
+	case len(unknown) > 0:
+		check.Status = StatusDegraded
+		check.Error = "database schema is ahead of this build"
+	}
+	return check
+}
@@ -0,0 +1,49 @@
+package metrics
+
+import (
+	"fluencybe/pkg/logger"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	logQueuedDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "log", "queued_entries"),
+		"Log entries waiting to be written, by sink.",
+		[]string{"sink"}, nil,
+	)
+	logDroppedDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "log", "dropped_total"),
+		"Log entries dropped because the queue of the sink was full, by sink.",
+		[]string{"sink"}, nil,
+	)
+	logFailedDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "log", "failed_total"),
+		"Log entries the sink failed to write, by sink.",
+		[]string{"sink"}, nil,
+	)
+)
+
+// logSinkCollector reads the sink counters of the logger at scrape time
+type logSinkCollector struct {
+	log *logger.PrettyLogger
+}
+
+func (c logSinkCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- logQueuedDesc
+	ch <- logDroppedDesc
+	ch <- logFailedDesc
+}
+
+func (c logSinkCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, stats := range c.log.SinkStats() {
+		ch <- prometheus.MustNewConstMetric(logQueuedDesc, prometheus.GaugeValue, float64(stats.Queued), stats.Name)
+		ch <- prometheus.MustNewConstMetric(logDroppedDesc, prometheus.CounterValue, float64(stats.Dropped), stats.Name)
+		ch <- prometheus.MustNewConstMetric(logFailedDesc, prometheus.CounterValue, float64(stats.Failed), stats.Name)
+	}
+}
+
+// RegisterLogSinks exports the queue length and the dropped and failed entries of the log sinks
+func RegisterLogSinks(log *logger.PrettyLogger) {
+	Registry.MustRegister(logSinkCollector{log: log})
+}
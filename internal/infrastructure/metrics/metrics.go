@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	constants "fluencybe/internal/core/constants"
 	"fluencybe/pkg/cache"
 	"fluencybe/pkg/logger"
 )
@@ -178,30 +179,39 @@ func NewMetrics(logger *logger.PrettyLogger, cache cache.Cache) *Metrics {
 	}
 }
 
+// StartMetricsCollection starts the Discord sink, an optional report posted every
+// constants.MetricsCollectionInterval when IS_METRIC_BACKEND_TO_DISCORD is YES. Prometheus scrapes
+// /metrics on its own and needs no collection loop.
 func (m *Metrics) StartMetricsCollection() {
-	// Log initial start
+	if !m.discordEnabled() {
+		m.logger.Info("METRICS_START", map[string]interface{}{
+			"discord": false,
+		}, "Serving metrics on /metrics, Discord sink disabled")
+		return
+	}
+
 	m.logger.Info("METRICS_START", map[string]interface{}{
-		"interval": "10s",
-	}, "Starting metrics collection")
+		"discord":  true,
+		"interval": constants.MetricsCollectionInterval.String(),
+	}, "Serving metrics on /metrics, starting Discord sink")
 
 	// Collect metrics immediately on start
 	m.collectAndSendMetrics()
 
 	// Then start the ticker for subsequent collections
 	go func() {
-		ticker := time.NewTicker(10 * time.Second)
+		ticker := time.NewTicker(constants.MetricsCollectionInterval)
 		for range ticker.C {
 			m.collectAndSendMetrics()
 		}
 	}()
 }
 
-func (m *Metrics) collectAndSendMetrics() {
-	// Check if metrics should be sent to Discord
-	if os.Getenv("IS_METRIC_BACKEND_TO_DISCORD") != "YES" {
-		return
-	}
+func (m *Metrics) discordEnabled() bool {
+	return os.Getenv("IS_METRIC_BACKEND_TO_DISCORD") == "YES" && m.webhookURL != ""
+}
 
+func (m *Metrics) collectAndSendMetrics() {
 	var memStats runtime.MemStats
 	runtime.ReadMemStats(&memStats)
 
@@ -0,0 +1,108 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "fluencybe"
+
+// Registry holds every metric exposed on /metrics
+var Registry = prometheus.NewRegistry()
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "HTTP requests handled, by method, route and status.",
+	}, []string{"method", "route", "status"})
+
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "HTTP request latency, by method, route and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	cacheReads = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cache",
+		Name:      "reads_total",
+		Help:      "Detail cache reads, by adapter and result (hit, miss or negative for a known missing id).",
+	}, []string{"adapter", "result"})
+
+	searchDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "opensearch",
+		Name:      "request_duration_seconds",
+		Help:      "OpenSearch request latency, by operation.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation"})
+
+	searchErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "opensearch",
+		Name:      "request_errors_total",
+		Help:      "OpenSearch requests that failed or returned an error status other than 404, by operation.",
+	}, []string{"operation"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests,
+		httpDuration,
+		cacheReads,
+		searchDuration,
+		searchErrors,
+	)
+}
+
+// Handler serves the metrics in the Prometheus exposition format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// RegisterDB exports the connection pool stats of db under the db_name label
+func RegisterDB(db *sql.DB, name string) {
+	if db == nil {
+		return
+	}
+	Registry.MustRegister(collectors.NewDBStatsCollector(db, name))
+}
+
+// ObserveHTTPRequest records a handled request, route is the route pattern, not the raw path
+func ObserveHTTPRequest(method, route string, status int, duration time.Duration) {
+	code := strconv.Itoa(status)
+	httpRequests.WithLabelValues(method, route, code).Inc()
+	httpDuration.WithLabelValues(method, route, code).Observe(duration.Seconds())
+}
+
+// Cache read results
+const (
+	CacheHit      = "hit"
+	CacheMiss     = "miss"
+	CacheNegative = "negative"
+)
+
+// ObserveCacheRead records a detail read of a cache adapter
+func ObserveCacheRead(adapter, result string) {
+	cacheReads.WithLabelValues(adapter, result).Inc()
+}
+
+// ObserveSearchRequest records an OpenSearch request
+func ObserveSearchRequest(operation string, duration time.Duration, failed bool) {
+	searchDuration.WithLabelValues(operation).Observe(duration.Seconds())
+	if failed {
+		searchErrors.WithLabelValues(operation).Inc()
+	}
+}
@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// searchTransport times the requests sent to OpenSearch
+type searchTransport struct {
+	next http.RoundTripper
+}
+
+// SearchTransport wraps the transport of the OpenSearch client to record request metrics
+func SearchTransport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &searchTransport{next: next}
+}
+
+func (t *searchTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	res, err := t.next.RoundTrip(req)
+
+	// A 404 is an answer, a missing document or index is expected by the callers
+	failed := err != nil || (res.StatusCode >= 400 && res.StatusCode != http.StatusNotFound)
+	ObserveSearchRequest(SearchOperation(req), time.Since(start), failed)
+	return res, err
+}
+
+// SearchOperation names a request after its first API segment such as _search or _bulk, requests
+// on an index itself are named after their method
+func SearchOperation(req *http.Request) string {
+	path := strings.Trim(req.URL.Path, "/")
+	if path == "" {
+		return "info"
+	}
+	for _, segment := range strings.Split(path, "/") {
+		if strings.HasPrefix(segment, "_") {
+			return strings.TrimPrefix(segment, "_")
+		}
+	}
+	return "index_" + strings.ToLower(req.Method)
+}
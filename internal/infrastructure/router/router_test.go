@@ -0,0 +1,75 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	constants "fluencybe/internal/core/constants"
+	"fluencybe/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// newLoginRouter serves the login route behind the rate limiter only, the auth class has the
+// smallest budget
+func newLoginRouter(t *testing.T, trustedProxies []string) *Router {
+	t.Helper()
+	r, err := NewRouter(nil, nil, trustedProxies)
+	if err != nil {
+		t.Fatalf("NewRouter: %v", err)
+	}
+	r.Use(middleware.RateLimitMiddleware(r.limiter))
+	r.POST("/v1/user/login", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	return r
+}
+
+func login(r *Router, remoteAddr string, forwardedFor string) int {
+	req := httptest.NewRequest(http.MethodPost, "/v1/user/login", nil)
+	req.RemoteAddr = remoteAddr
+	if forwardedFor != "" {
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w.Code
+}
+
+func TestSpoofedForwardedForDoesNotResetBudget(t *testing.T) {
+	r := newLoginRouter(t, nil)
+
+	for i := 0; i < constants.RateLimitAuthRequests; i++ {
+		if code := login(r, "203.0.113.7:4000", fmt.Sprintf("198.51.100.%d", i)); code != http.StatusOK {
+			t.Fatalf("request %d: got status %d, want %d", i, code, http.StatusOK)
+		}
+	}
+	if code := login(r, "203.0.113.7:4000", "198.51.100.250"); code != http.StatusTooManyRequests {
+		t.Fatalf("got status %d after the budget was spent, want %d", code, http.StatusTooManyRequests)
+	}
+}
+
+func TestTrustedProxyForwardsClientAddress(t *testing.T) {
+	r := newLoginRouter(t, []string{"10.0.0.1"})
+
+	for i := 0; i < constants.RateLimitAuthRequests; i++ {
+		if code := login(r, "10.0.0.1:4000", "198.51.100.1"); code != http.StatusOK {
+			t.Fatalf("request %d: got status %d, want %d", i, code, http.StatusOK)
+		}
+	}
+	if code := login(r, "10.0.0.1:4000", "198.51.100.1"); code != http.StatusTooManyRequests {
+		t.Fatalf("got status %d after the budget was spent, want %d", code, http.StatusTooManyRequests)
+	}
+	// Another client behind the same proxy has its own budget
+	if code := login(r, "10.0.0.1:4000", "198.51.100.2"); code != http.StatusOK {
+		t.Fatalf("got status %d for another client, want %d", code, http.StatusOK)
+	}
+}
+
+func TestNewRouterRejectsInvalidProxy(t *testing.T) {
+	if _, err := NewRouter(nil, nil, []string{"not-an-address"}); err == nil {
+		t.Fatal("NewRouter accepted an invalid trusted proxy")
+	}
+}
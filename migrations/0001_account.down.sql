@@ -0,0 +1,22 @@
+--! =================================================================
+--! CLEANUP SCRIPT FOR ACCOUNT MODULE
+--! =================================================================
+
+--! =================================================================
+--! Drop tables
+--! =================================================================
+-- Triggers and indexes are dropped with their tables
+DROP TABLE IF EXISTS developer_api_keys;
+DROP TABLE IF EXISTS refresh_tokens;
+DROP TABLE IF EXISTS user_action_tokens;
+DROP TABLE IF EXISTS user_identities;
+DROP TABLE IF EXISTS developers;
+DROP TABLE IF EXISTS users;
+
+--! =================================================================
+--! Drop functions
+--! =================================================================
+DROP FUNCTION IF EXISTS check_email_unique_across_tables();
+DROP FUNCTION IF EXISTS update_updated_at_column();
+DROP FUNCTION IF EXISTS is_valid_username(TEXT);
+DROP FUNCTION IF EXISTS is_valid_email(TEXT);
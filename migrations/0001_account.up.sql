@@ -0,0 +1,191 @@
+--! =================================================================
+--! COMMON FUNCTIONS
+--! =================================================================
+-- Function kiểm tra email hợp lệ
+CREATE OR REPLACE FUNCTION is_valid_email(email TEXT)
+RETURNS BOOLEAN AS $$
+BEGIN
+    RETURN email ~* '^[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}$';
+END;
+$$ LANGUAGE plpgsql;
+
+-- Function kiểm tra username hợp lệ
+CREATE OR REPLACE FUNCTION is_valid_username(username TEXT) 
+RETURNS BOOLEAN AS $$
+BEGIN
+    RETURN username ~* '^[a-zA-Z0-9_]{3,30}$';
+END;
+$$ LANGUAGE plpgsql;
+
+-- Function cập nhật updated_at
+CREATE OR REPLACE FUNCTION update_updated_at_column()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = CURRENT_TIMESTAMP;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+--! =================================================================
+--! USERS TABLE
+--! =================================================================
+CREATE TABLE IF NOT EXISTS users (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    email TEXT NOT NULL CHECK (is_valid_email(email)),
+    username TEXT NOT NULL CHECK (is_valid_username(username)),
+    password TEXT NOT NULL CHECK (length(password) >= 60),
+    type VARCHAR(25) NOT NULL CHECK (type IN ('basic', 'google', 'facebook', 'github', 'other')),
+    email_verified_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT users_email_unique UNIQUE (email),
+    CONSTRAINT users_username_unique UNIQUE (username)
+);
+
+-- Cột mới cho database đã tạo trước khi có xác thực email
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email_verified_at TIMESTAMP;
+
+-- Indexes
+CREATE INDEX idx_users_email ON users(email);
+CREATE INDEX idx_users_username ON users(username);
+CREATE INDEX idx_users_type ON users(type);
+
+-- Trigger for updated_at
+CREATE TRIGGER update_users_updated_at
+    BEFORE UPDATE ON users
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+--! =================================================================
+--! DEVELOPERS TABLE
+--! =================================================================
+CREATE TABLE IF NOT EXISTS developers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    email TEXT NOT NULL CHECK (is_valid_email(email)),
+    username TEXT NOT NULL CHECK (is_valid_username(username)),
+    password TEXT NOT NULL CHECK (length(password) >= 60),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT developers_email_unique UNIQUE (email),
+    CONSTRAINT developers_username_unique UNIQUE (username)
+);
+
+-- Indexes
+CREATE INDEX idx_developers_email ON developers(email);
+CREATE INDEX idx_developers_username ON developers(username);
+
+-- Trigger for updated_at
+CREATE TRIGGER update_developers_updated_at
+    BEFORE UPDATE ON developers
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+--! =================================================================
+--! USER IDENTITIES TABLE
+--! =================================================================
+-- Liên kết user với tài khoản ở OAuth provider (google, facebook, github)
+CREATE TABLE IF NOT EXISTS user_identities (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    provider VARCHAR(25) NOT NULL,
+    provider_subject TEXT NOT NULL,
+    email TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT user_identities_provider_subject_unique UNIQUE (provider, provider_subject)
+);
+
+-- Indexes
+CREATE INDEX idx_user_identities_user_id ON user_identities(user_id);
+
+--! =================================================================
+--! USER ACTION TOKENS TABLE
+--! =================================================================
+-- Token gửi qua email (xác thực email, đặt lại mật khẩu), chỉ lưu hash và dùng một lần
+CREATE TABLE IF NOT EXISTS user_action_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    purpose VARCHAR(25) NOT NULL CHECK (purpose IN ('verify_email', 'reset_password')),
+    token_hash TEXT NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT user_action_tokens_token_hash_unique UNIQUE (token_hash)
+);
+
+-- Indexes
+CREATE INDEX idx_user_action_tokens_user_purpose ON user_action_tokens(user_id, purpose);
+
+--! =================================================================
+--! REFRESH TOKENS TABLE
+--! =================================================================
+-- Mỗi lần refresh sẽ tạo token mới trong cùng session_id, token cũ bị revoke
+-- subject_id trỏ tới users hoặc developers tùy theo role nên không dùng FK
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    session_id UUID NOT NULL,
+    subject_id UUID NOT NULL,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('user', 'developer')),
+    token_hash TEXT NOT NULL,
+    user_agent TEXT NOT NULL DEFAULT '',
+    ip_address VARCHAR(64) NOT NULL DEFAULT '',
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT refresh_tokens_token_hash_unique UNIQUE (token_hash)
+);
+
+-- Indexes
+CREATE INDEX idx_refresh_tokens_session_id ON refresh_tokens(session_id);
+CREATE INDEX idx_refresh_tokens_subject ON refresh_tokens(subject_id, role);
+CREATE INDEX idx_refresh_tokens_expires_at ON refresh_tokens(expires_at);
+
+--! =================================================================
+--! DEVELOPER API KEYS TABLE
+--! =================================================================
+-- API key cho pipeline nhập nội dung, chỉ lưu hash, key_prefix dùng để hiển thị
+-- scopes: 'read' hoặc 'write:<grammar|listening|reading|speaking|writing|course>'
+CREATE TABLE IF NOT EXISTS developer_api_keys (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    developer_id UUID NOT NULL REFERENCES developers(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    key_prefix VARCHAR(16) NOT NULL,
+    key_hash TEXT NOT NULL,
+    scopes TEXT[] NOT NULL CHECK (cardinality(scopes) > 0),
+    last_used_at TIMESTAMP,
+    expires_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT developer_api_keys_key_hash_unique UNIQUE (key_hash)
+);
+
+-- Indexes
+CREATE INDEX idx_developer_api_keys_developer_id ON developer_api_keys(developer_id);
+
+--! =================================================================
+--! ADDITIONAL CONSTRAINTS
+--! =================================================================
+-- Prevent duplicate emails across both tables
+CREATE OR REPLACE FUNCTION check_email_unique_across_tables()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM users WHERE email = NEW.email
+        UNION
+        SELECT 1 FROM developers WHERE email = NEW.email AND id != NEW.id
+    ) THEN
+        RAISE EXCEPTION 'Email already exists in users or developers table';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Apply cross-table email check triggers
+CREATE TRIGGER check_users_email
+    BEFORE INSERT OR UPDATE ON users
+    FOR EACH ROW
+    EXECUTE FUNCTION check_email_unique_across_tables();
+
+CREATE TRIGGER check_developers_email
+    BEFORE INSERT OR UPDATE ON developers
+    FOR EACH ROW
+    EXECUTE FUNCTION check_email_unique_across_tables();
@@ -82,12 +82,5 @@ DROP TYPE IF EXISTS grammar_question_type;
 
 -- Drop functions
 DROP FUNCTION IF EXISTS grammar_question_version_update();
-
--- Clear all comments
-COMMENT ON TABLE grammar_questions IS NULL;
-COMMENT ON TABLE grammar_fill_in_the_blank_questions IS NULL;
-COMMENT ON TABLE grammar_choice_one_questions IS NULL;
-COMMENT ON TABLE grammar_error_identifications IS NULL;
-COMMENT ON TABLE grammar_sentence_transformations IS NULL;
-COMMENT ON COLUMN grammar_questions.version IS NULL;
-COMMENT ON COLUMN grammar_questions.id IS NULL;
\ No newline at end of file
+-- Created here first and shared by the later skills, which are rolled back before this one
+DROP FUNCTION IF EXISTS update_updated_at();
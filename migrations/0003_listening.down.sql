@@ -71,5 +71,4 @@ DROP TABLE IF EXISTS listening_matchings CASCADE;
 DROP TABLE IF EXISTS listening_questions CASCADE;
 
 -- Drop all functions
-DROP FUNCTION IF EXISTS update_updated_at();
 DROP FUNCTION IF EXISTS listening_question_version_update();
@@ -71,5 +71,4 @@ DROP TABLE IF EXISTS reading_matchings CASCADE;
 DROP TABLE IF EXISTS reading_questions CASCADE;
 
 -- Drop all functions
-DROP FUNCTION IF EXISTS update_updated_at();
 DROP FUNCTION IF EXISTS reading_question_version_update();
@@ -113,25 +113,7 @@ DROP TABLE IF EXISTS speaking_word_repetitions CASCADE;
 DROP TABLE IF EXISTS speaking_questions CASCADE;
 
 -- Drop all functions
-DROP FUNCTION IF EXISTS update_updated_at();
 DROP FUNCTION IF EXISTS speaking_question_version_update();
 
 -- Drop ENUM type
 DROP TYPE IF EXISTS speaking_question_type;
-
--- Clear all comments
-COMMENT ON TABLE speaking_questions IS NULL;
-COMMENT ON TABLE speaking_word_repetitions IS NULL;
-COMMENT ON TABLE speaking_phrase_repetitions IS NULL;
-COMMENT ON TABLE speaking_paragraph_repetitions IS NULL;
-COMMENT ON TABLE speaking_open_paragraphs IS NULL;
-COMMENT ON TABLE speaking_picture_descriptions IS NULL;
-COMMENT ON TABLE speaking_conversational_repetitions IS NULL;
-COMMENT ON TABLE speaking_conversational_opens IS NULL;
-
-COMMENT ON COLUMN speaking_questions.version IS NULL;
-COMMENT ON COLUMN speaking_questions.id IS NULL;
-COMMENT ON COLUMN speaking_questions.type IS NULL;
-COMMENT ON COLUMN speaking_questions.topic IS NULL;
-COMMENT ON COLUMN speaking_questions.instruction IS NULL;
-COMMENT ON COLUMN speaking_questions.max_time IS NULL;
\ No newline at end of file
@@ -43,14 +43,3 @@ DROP TYPE IF EXISTS writing_question_type;
 
 -- Drop functions
 DROP FUNCTION IF EXISTS writing_question_version_update();
-
--- Clear all comments
-COMMENT ON TABLE writing_questions IS NULL;
-COMMENT ON TABLE writing_sentence_completions IS NULL;
-COMMENT ON TABLE writing_essays IS NULL;
-COMMENT ON COLUMN writing_questions.version IS NULL;
-COMMENT ON COLUMN writing_questions.id IS NULL;
-COMMENT ON COLUMN writing_questions.type IS NULL;
-COMMENT ON COLUMN writing_questions.topic IS NULL;
-COMMENT ON COLUMN writing_questions.instruction IS NULL;
-COMMENT ON COLUMN writing_questions.max_time IS NULL;
\ No newline at end of file
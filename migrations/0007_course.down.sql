@@ -15,9 +15,6 @@ DROP TABLE IF EXISTS courses CASCADE;
 --! =================================================================
 --! Drop functions
 --! =================================================================
--- Drop timestamp function
-DROP FUNCTION IF EXISTS update_updated_at() CASCADE;
-
 -- Drop sequence management functions
 DROP FUNCTION IF EXISTS get_next_lesson_sequence(UUID) CASCADE;
 DROP FUNCTION IF EXISTS get_next_lesson_question_sequence(UUID) CASCADE;
@@ -66,26 +63,3 @@ DROP TRIGGER IF EXISTS trigger_course_books_updated_at ON course_books CASCADE;
 DROP TRIGGER IF EXISTS trigger_course_others_updated_at ON course_others CASCADE;
 DROP TRIGGER IF EXISTS trigger_lessons_updated_at ON lessons CASCADE;
 DROP TRIGGER IF EXISTS trigger_lesson_questions_updated_at ON lesson_questions CASCADE;
-
---! =================================================================
---! Drop comments
---! =================================================================
--- Drop table comments
-COMMENT ON TABLE courses IS NULL;
-COMMENT ON TABLE course_books IS NULL;
-COMMENT ON TABLE course_others IS NULL;
-COMMENT ON TABLE lessons IS NULL;
-COMMENT ON TABLE lesson_questions IS NULL;
-
--- Drop column comments
-COMMENT ON COLUMN courses.type IS NULL;
-COMMENT ON COLUMN courses.skills IS NULL;
-COMMENT ON COLUMN courses.band IS NULL;
-
--- Drop function comments
-COMMENT ON FUNCTION get_next_lesson_sequence(UUID) IS NULL;
-COMMENT ON FUNCTION get_next_lesson_question_sequence(UUID) IS NULL;
-COMMENT ON FUNCTION resequence_lessons() IS NULL;
-COMMENT ON FUNCTION resequence_lesson_questions() IS NULL;
-COMMENT ON FUNCTION swap_lesson_sequence(UUID, UUID) IS NULL;
-COMMENT ON FUNCTION swap_lesson_question_sequence(UUID, UUID) IS NULL;
\ No newline at end of file
@@ -97,6 +97,29 @@ CREATE INDEX IF NOT EXISTS idx_lesson_questions_lesson_id ON lesson_questions(le
 CREATE INDEX IF NOT EXISTS idx_lesson_questions_question_id ON lesson_questions(question_id);
 CREATE INDEX IF NOT EXISTS idx_lesson_questions_sequence ON lesson_questions(sequence);
 
+--! =================================================================
+--! COURSE BUNDLES - Gói nội dung offline của khóa học
+--! =================================================================
+CREATE TABLE IF NOT EXISTS course_bundles (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    course_id UUID NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+    version INT NOT NULL CHECK (version > 0),
+    -- Checksum của nội dung (khóa học + câu hỏi), dùng để bỏ qua bản build không có thay đổi
+    content_checksum VARCHAR(64) NOT NULL,
+    -- Checksum của file nén, trả về làm ETag khi tải xuống
+    archive_checksum VARCHAR(64) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    question_count INT NOT NULL DEFAULT 0,
+    media_count INT NOT NULL DEFAULT 0,
+    manifest JSONB NOT NULL,
+    archive BYTEA NOT NULL,
+    created_by UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT unique_course_bundle_version UNIQUE(course_id, version)
+);
+
+CREATE INDEX IF NOT EXISTS idx_course_bundles_course_id ON course_bundles(course_id, version DESC);
+
 --! =================================================================
 --! FUNCTIONS - Quản lý sequence và timestamp
 --! =================================================================
@@ -337,6 +360,9 @@ IS 'Bảng chứa thông tin về các bài học trong khóa học';
 COMMENT ON TABLE lesson_questions 
 IS 'Bảng liên kết giữa bài học và câu hỏi';
 
+COMMENT ON TABLE course_bundles 
+IS 'Bảng chứa các phiên bản gói nội dung offline (tar.gz) của khóa học';
+
 COMMENT ON COLUMN courses.type 
 IS 'Loại khóa học: book (sách giáo trình) hoặc other (khác)';
 
@@ -0,0 +1,24 @@
+--! =================================================================
+--! CLEANUP SCRIPT FOR WIKI MODULE
+--! =================================================================
+
+--! =================================================================
+--! Drop indexes
+--! =================================================================
+DROP INDEX IF EXISTS idx_wiki_one_main_definition_per_phrase;
+DROP INDEX IF EXISTS idx_wiki_phrases_unique_phrase;
+DROP INDEX IF EXISTS idx_wiki_one_main_definition_per_word;
+DROP INDEX IF EXISTS idx_wiki_words_unique_word;
+
+--! =================================================================
+--! Drop tables
+--! =================================================================
+-- Triggers and the remaining indexes are dropped with their tables, update_updated_at() belongs to grammar
+DROP TABLE IF EXISTS wiki_phrase_definition_samples;
+DROP TABLE IF EXISTS wiki_phrase_definitions;
+DROP TABLE IF EXISTS wiki_phrases;
+DROP TABLE IF EXISTS wiki_word_antonyms;
+DROP TABLE IF EXISTS wiki_word_synonyms;
+DROP TABLE IF EXISTS wiki_word_definition_samples;
+DROP TABLE IF EXISTS wiki_word_definitions;
+DROP TABLE IF EXISTS wiki_words;
@@ -6,12 +6,11 @@ CREATE TABLE IF NOT EXISTS wiki_words (
     word TEXT NOT NULL CHECK (length(trim(word)) BETWEEN 1 AND 100),
     pronunciation TEXT NOT NULL CHECK (length(trim(pronunciation)) > 0),
     created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
-    CONSTRAINT unique_word UNIQUE (LOWER(word))
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
 );
 
--- Optimize word search
-CREATE INDEX IF NOT EXISTS idx_wiki_words_word ON wiki_words(LOWER(word));
+-- Words are unique regardless of case, the index also serves word search
+CREATE UNIQUE INDEX IF NOT EXISTS idx_wiki_words_unique_word ON wiki_words(LOWER(word));
 
 --! =================================================================
 --! WORD DEFINITIONS - Định nghĩa từ
@@ -22,11 +21,14 @@ CREATE TABLE IF NOT EXISTS wiki_word_definitions (
     means TEXT[] NOT NULL CHECK (array_length(means, 1) > 0),
     is_main_definition BOOLEAN NOT NULL DEFAULT FALSE,
     created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
-    CONSTRAINT one_main_definition_per_word UNIQUE (wiki_word_id, is_main_definition) 
-    WHERE is_main_definition = TRUE
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
 );
 
+-- Create a partial unique index instead of the WHERE constraint
+CREATE UNIQUE INDEX IF NOT EXISTS idx_wiki_one_main_definition_per_word 
+ON wiki_word_definitions (wiki_word_id) 
+WHERE is_main_definition;
+
 -- Optimize definition lookups
 CREATE INDEX IF NOT EXISTS idx_wiki_word_definitions_word_id 
 ON wiki_word_definitions(wiki_word_id);
@@ -82,20 +84,25 @@ CREATE TABLE IF NOT EXISTS wiki_phrases (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
     phrase TEXT NOT NULL CHECK (length(trim(phrase)) BETWEEN 2 AND 255),
     created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
-    CONSTRAINT unique_phrase UNIQUE (LOWER(phrase))
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
 );
 
+-- Phrases are unique regardless of case
+CREATE UNIQUE INDEX IF NOT EXISTS idx_wiki_phrases_unique_phrase ON wiki_phrases(LOWER(phrase));
+
 CREATE TABLE IF NOT EXISTS wiki_phrase_definitions (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
     wiki_phrase_id UUID NOT NULL REFERENCES wiki_phrases(id) ON DELETE CASCADE,
-    means TEXT[] NOT NULL CHECK (array_length(means, 1) > 0),    is_main_definition BOOLEAN NOT NULL DEFAULT FALSE,
+    means TEXT[] NOT NULL CHECK (array_length(means, 1) > 0),
+    is_main_definition BOOLEAN NOT NULL DEFAULT FALSE,
     created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
-    CONSTRAINT one_main_definition_per_phrase UNIQUE (wiki_phrase_id, is_main_definition) 
-    WHERE is_main_definition = TRUE
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
 );
 
+CREATE UNIQUE INDEX IF NOT EXISTS idx_wiki_one_main_definition_per_phrase 
+ON wiki_phrase_definitions (wiki_phrase_id) 
+WHERE is_main_definition;
+
 CREATE TABLE IF NOT EXISTS wiki_phrase_definition_samples (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
     wiki_phrase_definition_id UUID NOT NULL REFERENCES wiki_phrase_definitions(id) ON DELETE CASCADE,
@@ -121,6 +128,8 @@ END;
 $$ LANGUAGE plpgsql;
 
 DO $$ 
+DECLARE
+    tbl RECORD;
 BEGIN
     FOR tbl IN 
         SELECT tablename 
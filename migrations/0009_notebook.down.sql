@@ -0,0 +1,11 @@
+--! =================================================================
+--! CLEANUP SCRIPT FOR NOTEBOOK MODULE
+--! =================================================================
+
+--! =================================================================
+--! Drop tables
+--! =================================================================
+-- Triggers and indexes are dropped with their tables, update_updated_at() belongs to grammar
+DROP TABLE IF EXISTS notebook_phrases;
+DROP TABLE IF EXISTS notebook_words;
+DROP TABLE IF EXISTS notebooks;
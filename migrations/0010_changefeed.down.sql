@@ -0,0 +1,18 @@
+--! =================================================================
+--! CLEANUP SCRIPT FOR CHANGE FEED
+--! =================================================================
+
+--! =================================================================
+--! Drop triggers
+--! =================================================================
+DROP TRIGGER IF EXISTS trigger_grammar_questions_change_log ON grammar_questions;
+DROP TRIGGER IF EXISTS trigger_listening_questions_change_log ON listening_questions;
+DROP TRIGGER IF EXISTS trigger_reading_questions_change_log ON reading_questions;
+DROP TRIGGER IF EXISTS trigger_speaking_questions_change_log ON speaking_questions;
+DROP TRIGGER IF EXISTS trigger_writing_questions_change_log ON writing_questions;
+
+--! =================================================================
+--! Drop tables and functions
+--! =================================================================
+DROP TABLE IF EXISTS question_changes;
+DROP FUNCTION IF EXISTS log_question_change();
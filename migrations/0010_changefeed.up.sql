@@ -0,0 +1,70 @@
+--! =================================================================
+--! CHANGE FEED - Nhật ký thay đổi câu hỏi cho đồng bộ offline
+--! =================================================================
+-- Chạy sau các file grammar.sql, listening.sql, reading.sql, speaking.sql, writing.sql
+-- Mỗi lần thêm, sửa, xóa câu hỏi (kể cả qua bảng con, nhờ trigger version) đều ghi một dòng
+
+CREATE TABLE IF NOT EXISTS question_changes (
+    seq BIGSERIAL PRIMARY KEY,
+    skill VARCHAR(20) NOT NULL CHECK (skill IN ('grammar', 'listening', 'reading', 'speaking', 'writing')),
+    question_id UUID NOT NULL,
+    operation VARCHAR(10) NOT NULL CHECK (operation IN ('created', 'updated', 'deleted')),
+    version INTEGER NOT NULL,
+    -- Transaction ghi dòng này, dùng để không trả về thay đổi của transaction chưa commit
+    txid xid8 NOT NULL DEFAULT pg_current_xact_id(),
+    changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_question_changes_cursor ON question_changes(txid, seq);
+CREATE INDEX IF NOT EXISTS idx_question_changes_skill_cursor ON question_changes(skill, txid, seq);
+
+-- Function ghi nhật ký, TG_ARGV[0] là tên kỹ năng
+CREATE OR REPLACE FUNCTION log_question_change()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO question_changes (skill, question_id, operation, version)
+        VALUES (TG_ARGV[0], NEW.id, 'created', NEW.version);
+    ELSIF TG_OP = 'UPDATE' THEN
+        IF NEW IS DISTINCT FROM OLD THEN
+            INSERT INTO question_changes (skill, question_id, operation, version)
+            VALUES (TG_ARGV[0], NEW.id, 'updated', NEW.version);
+        END IF;
+    ELSIF TG_OP = 'DELETE' THEN
+        INSERT INTO question_changes (skill, question_id, operation, version)
+        VALUES (TG_ARGV[0], OLD.id, 'deleted', OLD.version);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+--! =================================================================
+--! TRIGGERS và dữ liệu ban đầu
+--! =================================================================
+-- Câu hỏi đã có trước khi tạo bảng được ghi là 'created' một lần duy nhất
+DO $$
+DECLARE
+    skill_name TEXT;
+BEGIN
+    FOREACH skill_name IN ARRAY ARRAY['grammar', 'listening', 'reading', 'speaking', 'writing']
+    LOOP
+        EXECUTE format('DROP TRIGGER IF EXISTS trigger_%s_questions_change_log ON %I;',
+            skill_name, skill_name || '_questions');
+        EXECUTE format('
+            CREATE TRIGGER trigger_%s_questions_change_log
+            AFTER INSERT OR UPDATE OR DELETE ON %I
+            FOR EACH ROW
+            EXECUTE FUNCTION log_question_change(%L);',
+            skill_name, skill_name || '_questions', skill_name);
+
+        IF NOT EXISTS (SELECT 1 FROM question_changes WHERE skill = skill_name) THEN
+            EXECUTE format('
+                INSERT INTO question_changes (skill, question_id, operation, version)
+                SELECT %L, id, ''created'', version FROM %I ORDER BY created_at;',
+                skill_name, skill_name || '_questions');
+        END IF;
+    END LOOP;
+END $$;
+
+COMMENT ON TABLE question_changes
+IS 'Nhật ký thay đổi câu hỏi theo thứ tự (txid, seq), phục vụ đồng bộ tăng dần cho client offline';
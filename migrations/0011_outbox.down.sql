@@ -0,0 +1,19 @@
+--! =================================================================
+--! CLEANUP SCRIPT FOR OUTBOX
+--! =================================================================
+
+--! =================================================================
+--! Drop triggers
+--! =================================================================
+DROP TRIGGER IF EXISTS trigger_grammar_questions_outbox ON grammar_questions;
+DROP TRIGGER IF EXISTS trigger_listening_questions_outbox ON listening_questions;
+DROP TRIGGER IF EXISTS trigger_reading_questions_outbox ON reading_questions;
+DROP TRIGGER IF EXISTS trigger_speaking_questions_outbox ON speaking_questions;
+DROP TRIGGER IF EXISTS trigger_writing_questions_outbox ON writing_questions;
+
+--! =================================================================
+--! Drop tables and functions
+--! =================================================================
+-- Events still pending are lost, let the relay drain the table first
+DROP TABLE IF EXISTS outbox_events;
+DROP FUNCTION IF EXISTS enqueue_question_outbox_event();
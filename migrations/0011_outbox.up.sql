@@ -0,0 +1,63 @@
+--! =================================================================
+--! OUTBOX - Hàng đợi đồng bộ Redis và OpenSearch
+--! =================================================================
+-- Chạy sau các file grammar.sql, listening.sql, reading.sql, speaking.sql, writing.sql
+-- Sự kiện được ghi bởi trigger trong cùng transaction với thay đổi câu hỏi,
+-- relay nền đọc bảng này để cập nhật cache và index, có thử lại khi lỗi
+
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id BIGSERIAL PRIMARY KEY,
+    aggregate_type VARCHAR(20) NOT NULL CHECK (aggregate_type IN ('grammar', 'listening', 'reading', 'speaking', 'writing')),
+    aggregate_id UUID NOT NULL,
+    event_type VARCHAR(10) NOT NULL CHECK (event_type IN ('upsert', 'delete')),
+    version INTEGER NOT NULL,
+    status VARCHAR(10) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'done', 'dead')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    -- Thời điểm sớm nhất được xử lý, cũng dùng làm lease khi relay đang giữ sự kiện
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    processed_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending ON outbox_events(next_attempt_at, id) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_outbox_events_dead ON outbox_events(id) WHERE status = 'dead';
+
+-- Function ghi sự kiện, TG_ARGV[0] là tên kỹ năng
+CREATE OR REPLACE FUNCTION enqueue_question_outbox_event()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        INSERT INTO outbox_events (aggregate_type, aggregate_id, event_type, version)
+        VALUES (TG_ARGV[0], OLD.id, 'delete', OLD.version);
+    ELSIF TG_OP = 'INSERT' OR NEW IS DISTINCT FROM OLD THEN
+        INSERT INTO outbox_events (aggregate_type, aggregate_id, event_type, version)
+        VALUES (TG_ARGV[0], NEW.id, 'upsert', NEW.version);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+--! =================================================================
+--! TRIGGERS
+--! =================================================================
+-- Thay đổi ở bảng con đã tăng version của câu hỏi cha nên chỉ cần trigger trên bảng *_questions
+DO $$
+DECLARE
+    skill_name TEXT;
+BEGIN
+    FOREACH skill_name IN ARRAY ARRAY['grammar', 'listening', 'reading', 'speaking', 'writing']
+    LOOP
+        EXECUTE format('DROP TRIGGER IF EXISTS trigger_%s_questions_outbox ON %I;',
+            skill_name, skill_name || '_questions');
+        EXECUTE format('
+            CREATE TRIGGER trigger_%s_questions_outbox
+            AFTER INSERT OR UPDATE OR DELETE ON %I
+            FOR EACH ROW
+            EXECUTE FUNCTION enqueue_question_outbox_event(%L);',
+            skill_name, skill_name || '_questions', skill_name);
+    END LOOP;
+END $$;
+
+COMMENT ON TABLE outbox_events
+IS 'Sự kiện đồng bộ cache và search của câu hỏi, ghi cùng transaction với dữ liệu gốc; status dead là hàng đợi lỗi cho developer xem và thử lại';
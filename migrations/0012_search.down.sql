@@ -0,0 +1,27 @@
+--! =================================================================
+--! CLEANUP SCRIPT FOR POSTGRES FULL-TEXT SEARCH
+--! =================================================================
+
+--! =================================================================
+--! Drop indexes
+--! =================================================================
+DROP INDEX IF EXISTS idx_grammar_questions_instruction_fts;
+DROP INDEX IF EXISTS idx_grammar_questions_instruction_trgm;
+DROP INDEX IF EXISTS idx_listening_questions_instruction_fts;
+DROP INDEX IF EXISTS idx_listening_questions_instruction_trgm;
+DROP INDEX IF EXISTS idx_listening_questions_transcript_fts;
+DROP INDEX IF EXISTS idx_listening_questions_transcript_trgm;
+DROP INDEX IF EXISTS idx_reading_questions_instruction_fts;
+DROP INDEX IF EXISTS idx_reading_questions_instruction_trgm;
+DROP INDEX IF EXISTS idx_reading_questions_title_fts;
+DROP INDEX IF EXISTS idx_reading_questions_title_trgm;
+DROP INDEX IF EXISTS idx_reading_questions_passages_fts;
+DROP INDEX IF EXISTS idx_reading_questions_passages_trgm;
+DROP INDEX IF EXISTS idx_reading_questions_topic_fts;
+DROP INDEX IF EXISTS idx_reading_questions_topic_trgm;
+
+--! =================================================================
+--! Drop functions
+--! =================================================================
+-- pg_trgm is left installed, dropping an extension needs more rights than creating its indexes
+DROP FUNCTION IF EXISTS search_text_array(TEXT[]);
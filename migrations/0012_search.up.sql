@@ -0,0 +1,53 @@
+--! =================================================================
+--! SEARCH - Tìm kiếm toàn văn trên Postgres khi OpenSearch gặp sự cố
+--! =================================================================
+-- Chạy sau các file grammar.sql, listening.sql, reading.sql, speaking.sql, writing.sql
+-- Mỗi trường văn bản có một index tsvector (khớp theo từ) và một index trigram (khớp gần đúng),
+-- biểu thức index phải giống hệt biểu thức trong QuestionFullTextRepository
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- array_to_string không phải IMMUTABLE nên không dùng trực tiếp trong index được
+CREATE OR REPLACE FUNCTION search_text_array(items TEXT[])
+RETURNS TEXT AS $$
+    SELECT coalesce(array_to_string(items, ' '), '');
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE;
+
+--! Grammar
+CREATE INDEX IF NOT EXISTS idx_grammar_questions_instruction_fts
+ON grammar_questions USING GIN(to_tsvector('simple', instruction));
+CREATE INDEX IF NOT EXISTS idx_grammar_questions_instruction_trgm
+ON grammar_questions USING GIN(instruction gin_trgm_ops);
+
+--! Listening
+CREATE INDEX IF NOT EXISTS idx_listening_questions_instruction_fts
+ON listening_questions USING GIN(to_tsvector('simple', instruction));
+CREATE INDEX IF NOT EXISTS idx_listening_questions_instruction_trgm
+ON listening_questions USING GIN(instruction gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS idx_listening_questions_transcript_fts
+ON listening_questions USING GIN(to_tsvector('simple', transcript));
+CREATE INDEX IF NOT EXISTS idx_listening_questions_transcript_trgm
+ON listening_questions USING GIN(transcript gin_trgm_ops);
+
+--! Reading
+CREATE INDEX IF NOT EXISTS idx_reading_questions_instruction_fts
+ON reading_questions USING GIN(to_tsvector('simple', instruction));
+CREATE INDEX IF NOT EXISTS idx_reading_questions_instruction_trgm
+ON reading_questions USING GIN(instruction gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS idx_reading_questions_title_fts
+ON reading_questions USING GIN(to_tsvector('simple', title));
+CREATE INDEX IF NOT EXISTS idx_reading_questions_title_trgm
+ON reading_questions USING GIN(title gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS idx_reading_questions_passages_fts
+ON reading_questions USING GIN(to_tsvector('simple', search_text_array(passages)));
+CREATE INDEX IF NOT EXISTS idx_reading_questions_passages_trgm
+ON reading_questions USING GIN(search_text_array(passages) gin_trgm_ops);
+
+-- Chủ đề dùng cho tham số query tìm kiếm tự do, bộ lọc topic vẫn so khớp chính xác qua idx_reading_questions_topic
+CREATE INDEX IF NOT EXISTS idx_reading_questions_topic_fts
+ON reading_questions USING GIN(to_tsvector('simple', search_text_array(topic::TEXT[])));
+CREATE INDEX IF NOT EXISTS idx_reading_questions_topic_trgm
+ON reading_questions USING GIN(search_text_array(topic::TEXT[]) gin_trgm_ops);
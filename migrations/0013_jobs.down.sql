@@ -0,0 +1,5 @@
+--! =================================================================
+--! CLEANUP SCRIPT FOR JOBS
+--! =================================================================
+-- Jobs still queued are lost, let the workers drain the table first
+DROP TABLE IF EXISTS jobs;
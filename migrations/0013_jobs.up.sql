@@ -0,0 +1,29 @@
+--! =================================================================
+--! JOBS - Hàng đợi công việc chạy nền
+--! =================================================================
+-- Công việc dài (reindex, build bundle, import dữ liệu) được ghi vào bảng này và
+-- các worker nền nhận xử lý, có giới hạn song song, thử lại khi lỗi và báo tiến độ
+
+CREATE TABLE IF NOT EXISTS jobs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    type VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}',
+    status VARCHAR(10) NOT NULL DEFAULT 'queued' CHECK (status IN ('queued', 'running', 'succeeded', 'failed')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    max_attempts INTEGER NOT NULL CHECK (max_attempts > 0),
+    -- Thời điểm sớm nhất được xử lý, khi đang chạy là hạn lease được heartbeat gia hạn
+    run_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    progress INTEGER NOT NULL DEFAULT 0 CHECK (progress BETWEEN 0 AND 100),
+    progress_message TEXT,
+    result JSONB,
+    last_error TEXT,
+    created_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    started_at TIMESTAMP WITH TIME ZONE,
+    finished_at TIMESTAMP WITH TIME ZONE
+);
+
+-- Công việc đang chạy cũng nằm trong index để nhận lại khi lease hết hạn
+CREATE INDEX IF NOT EXISTS idx_jobs_due ON jobs(run_at, created_at) WHERE status IN ('queued', 'running');
+CREATE INDEX IF NOT EXISTS idx_jobs_created_at ON jobs(created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_jobs_finished_at ON jobs(finished_at) WHERE status IN ('succeeded', 'failed');
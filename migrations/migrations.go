@@ -0,0 +1,8 @@
+// Package migrations embeds the database schema. Every change is a <version>_<name>.up.sql file
+// with a matching .down.sql that reverts it, applied in version order by pkg/migrate.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
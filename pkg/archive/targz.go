@@ -0,0 +1,86 @@
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"time"
+)
+
+// File is an entry of a tar.gz archive
+type File struct {
+	Name string
+	Data []byte
+}
+
+// MaxFileSize guards ReadTarGz against archives that expand to unreasonable sizes
+const MaxFileSize = 256 << 20
+
+// WriteTarGz packs files in order into a gzip compressed tar archive. Entries use modTime
+// so that the same content always produces the same archive.
+func WriteTarGz(files []File, modTime time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+	gz, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	tw := tar.NewWriter(gz)
+
+	for _, file := range files {
+		header := &tar.Header{
+			Name:    file.Name,
+			Mode:    0644,
+			Size:    int64(len(file.Data)),
+			ModTime: modTime,
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return nil, fmt.Errorf("failed to write header for %s: %w", file.Name, err)
+		}
+		if _, err := tw.Write(file.Data); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", file.Name, err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ReadTarGz unpacks the regular files of a gzip compressed tar archive, keyed by name
+func ReadTarGz(data []byte) (map[string][]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	files := make(map[string][]byte)
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		if header.Size > MaxFileSize {
+			return nil, fmt.Errorf("archive entry %s is too large", header.Name)
+		}
+
+		content, err := io.ReadAll(io.LimitReader(tr, MaxFileSize))
+		if err != nil {
+			return nil, err
+		}
+		files[header.Name] = content
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/redis/go-redis/extra/redisotel/v9"
 	"github.com/redis/go-redis/v9"
 )
 
@@ -45,6 +46,13 @@ func NewRedisClient(cfg config.RedisConfig, log *logger.PrettyLogger) (*RedisCli
 
 	client := redis.NewClient(opts)
 
+	// Commands carry cached payloads and session data, spans only name the command
+	if err := redisotel.InstrumentTracing(client, redisotel.WithDBStatement(false)); err != nil {
+		log.Warning("REDIS_TRACING_ERROR", map[string]interface{}{
+			"error": err.Error(),
+		}, "Failed to instrument Redis client for tracing")
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
 
@@ -0,0 +1,244 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	constants "fluencybe/internal/core/constants"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// MemoryCache is a bounded in-process LRU cache with an expiry per entry. Misses return redis.Nil
+// so callers treat it like RedisClient.
+type MemoryCache struct {
+	capacity int
+	items    map[string]*list.Element
+	order    *list.List // most recently used first
+	size     int64      // bytes held by keys and values
+	mu       sync.Mutex
+
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+type memoryEntry struct {
+	key       string
+	value     string
+	expiresAt time.Time
+}
+
+func NewMemoryCache(capacity int) *MemoryCache {
+	return &MemoryCache{
+		capacity: capacity,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+func (c *MemoryCache) Get(ctx context.Context, key string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.lookup(key)
+	if !ok {
+		c.misses.Add(1)
+		return "", redis.Nil
+	}
+	c.hits.Add(1)
+	return entry.value, nil
+}
+
+func (c *MemoryCache) Set(ctx context.Context, key string, value string, expiration time.Duration) error {
+	if expiration == 0 {
+		expiration = constants.CacheDefaultTTL
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.set(key, value, time.Now().Add(expiration))
+	return nil
+}
+
+func (c *MemoryCache) Delete(ctx context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.items[key]; ok {
+		c.remove(element)
+	}
+	return nil
+}
+
+func (c *MemoryCache) DeletePattern(ctx context.Context, pattern string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, element := range c.items {
+		if matchPattern(pattern, key) {
+			c.remove(element)
+		}
+	}
+	return nil
+}
+
+func (c *MemoryCache) BatchSet(ctx context.Context, items map[string]string, expiration time.Duration) error {
+	if expiration == 0 {
+		expiration = constants.CacheDefaultTTL
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := time.Now().Add(expiration)
+	for key, value := range items {
+		c.set(key, value, expiresAt)
+	}
+	return nil
+}
+
+// Info reports the entry count and size in the layout of the Redis INFO command
+func (c *MemoryCache) Info(ctx context.Context, sections ...string) *redis.StringCmd {
+	c.mu.Lock()
+	entries, size := len(c.items), c.size
+	c.mu.Unlock()
+
+	cmd := redis.NewStringCmd(ctx, "info")
+	cmd.SetVal(fmt.Sprintf("# Memory\r\nused_memory:%d\r\n# Keyspace\r\nkeys:%d\r\nkeyspace_hits:%d\r\nkeyspace_misses:%d\r\n",
+		size, entries, c.hits.Load(), c.misses.Load()))
+	return cmd
+}
+
+func (c *MemoryCache) GetMetrics() *CacheMetrics {
+	c.mu.Lock()
+	size := c.size
+	c.mu.Unlock()
+
+	return &CacheMetrics{
+		Hits:        c.hits.Load(),
+		Misses:      c.misses.Load(),
+		MemoryUsage: size,
+	}
+}
+
+func (c *MemoryCache) Keys(ctx context.Context, pattern string) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	var keys []string
+	for key, element := range c.items {
+		if element.Value.(*memoryEntry).expiresAt.After(now) && matchPattern(pattern, key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// TTL returns the time left before key expires, -2 when it does not exist as Redis does
+func (c *MemoryCache) TTL(ctx context.Context, key string) (time.Duration, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.lookup(key)
+	if !ok {
+		return -2, nil
+	}
+	return time.Until(entry.expiresAt), nil
+}
+
+// Len returns the number of entries held, expired ones included until they are evicted
+func (c *MemoryCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.items)
+}
+
+// Purge drops every entry
+func (c *MemoryCache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.items = make(map[string]*list.Element)
+	c.order.Init()
+	c.size = 0
+}
+
+// lookup returns the live entry of key and marks it used, an expired entry is removed
+func (c *MemoryCache) lookup(key string) (*memoryEntry, bool) {
+	element, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*memoryEntry)
+	if !entry.expiresAt.After(time.Now()) {
+		c.remove(element)
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return entry, true
+}
+
+func (c *MemoryCache) set(key, value string, expiresAt time.Time) {
+	if element, ok := c.items[key]; ok {
+		entry := element.Value.(*memoryEntry)
+		c.size += int64(len(value) - len(entry.value))
+		entry.value = value
+		entry.expiresAt = expiresAt
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&memoryEntry{key: key, value: value, expiresAt: expiresAt})
+	c.size += int64(len(key) + len(value))
+
+	for c.capacity > 0 && len(c.items) > c.capacity {
+		c.remove(c.order.Back())
+	}
+}
+
+func (c *MemoryCache) remove(element *list.Element) {
+	entry := element.Value.(*memoryEntry)
+	c.order.Remove(element)
+	delete(c.items, entry.key)
+	c.size -= int64(len(entry.key) + len(entry.value))
+}
+
+// matchPattern reports whether key matches a Redis glob pattern, supporting *, ? and \ escapes
+func matchPattern(pattern, key string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for len(pattern) > 0 && pattern[0] == '*' {
+				pattern = pattern[1:]
+			}
+			if len(pattern) == 0 {
+				return true
+			}
+			for i := 0; i <= len(key); i++ {
+				if matchPattern(pattern, key[i:]) {
+					return true
+				}
+			}
+			return false
+		case '?':
+			if len(key) == 0 {
+				return false
+			}
+		default:
+			if pattern[0] == '\\' && len(pattern) > 1 {
+				pattern = pattern[1:]
+			}
+			if len(key) == 0 || key[0] != pattern[0] {
+				return false
+			}
+		}
+		pattern, key = pattern[1:], key[1:]
+	}
+	return len(key) == 0
+}
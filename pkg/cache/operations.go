@@ -17,6 +17,7 @@ type Cache interface {
 	Info(ctx context.Context, sections ...string) *redis.StringCmd
 	GetMetrics() *CacheMetrics
 	Keys(ctx context.Context, pattern string) ([]string, error)
+	TTL(ctx context.Context, key string) (time.Duration, error)
 }
 
 func (c *RedisClient) Get(ctx context.Context, key string) (string, error) {
@@ -66,3 +67,8 @@ func (c *RedisClient) Keys(ctx context.Context, pattern string) ([]string, error
 	}
 	return keys, nil
 }
+
+// TTL returns the time left before key expires, negative when it has no expiry or does not exist
+func (c *RedisClient) TTL(ctx context.Context, key string) (time.Duration, error) {
+	return c.Client.TTL(ctx, key).Result()
+}
@@ -0,0 +1,113 @@
+package cache
+
+import (
+	"context"
+	"sync"
+
+	constants "fluencybe/internal/core/constants"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Popularity counts reads per skill and keeps the totals in Redis sorted sets shared by every
+// replica. Record only touches memory, Flush adds the counts to Redis in one pipeline.
+type Popularity struct {
+	remote    *RedisClient
+	available func() bool
+
+	mu     sync.Mutex
+	counts map[string]map[string]int
+}
+
+// NewPopularity stores counts in remote, available reports whether Redis is reachable
+func NewPopularity(remote *RedisClient, available func() bool) *Popularity {
+	return &Popularity{
+		remote:    remote,
+		available: available,
+		counts:    make(map[string]map[string]int),
+	}
+}
+
+// Record counts one read of id
+func (p *Popularity) Record(skill, id string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	counts, ok := p.counts[skill]
+	if !ok {
+		counts = make(map[string]int)
+		p.counts[skill] = counts
+	}
+	// Without Redis the counts would grow without bound, new ids are dropped past the cap
+	if _, ok := counts[id]; ok || len(counts) < constants.CachePopularityMaxTracked {
+		counts[id]++
+	}
+}
+
+// Flush adds the recorded counts to Redis and trims every set to its highest scores. The counts
+// are kept for the next flush while Redis is down.
+func (p *Popularity) Flush(ctx context.Context) error {
+	if p.remote == nil || !p.available() {
+		return nil
+	}
+
+	p.mu.Lock()
+	pending := p.counts
+	p.counts = make(map[string]map[string]int)
+	p.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	pipe := p.remote.Pipeline()
+	for skill, counts := range pending {
+		key := constants.CachePopularityKeyPrefix + skill
+		for id, count := range counts {
+			pipe.ZIncrBy(ctx, key, float64(count), id)
+		}
+		pipe.ZRemRangeByRank(ctx, key, 0, -constants.CachePopularityMaxTracked-1)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		p.restore(pending)
+		return err
+	}
+	return nil
+}
+
+// Top returns up to limit ids of skill, the most read first
+func (p *Popularity) Top(ctx context.Context, skill string, limit int) ([]string, error) {
+	if p.remote == nil || !p.available() {
+		return nil, nil
+	}
+	ids, err := p.remote.ZRevRange(ctx, constants.CachePopularityKeyPrefix+skill, 0, int64(limit)-1).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	return ids, err
+}
+
+// Forget drops the counts of skill, its questions are gone
+func (p *Popularity) Forget(ctx context.Context, skill string) error {
+	p.mu.Lock()
+	delete(p.counts, skill)
+	p.mu.Unlock()
+
+	if p.remote == nil || !p.available() {
+		return nil
+	}
+	return p.remote.Del(ctx, constants.CachePopularityKeyPrefix+skill).Err()
+}
+
+func (p *Popularity) restore(pending map[string]map[string]int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for skill, counts := range pending {
+		for id, count := range counts {
+			if p.counts[skill] == nil {
+				p.counts[skill] = make(map[string]int)
+			}
+			p.counts[skill][id] += count
+		}
+	}
+}
@@ -0,0 +1,275 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	constants "fluencybe/internal/core/constants"
+	"fluencybe/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// TieredCache serves reads from an in-process MemoryCache in front of Redis. Writes go to both
+// tiers and are published on constants.CacheInvalidationChannel so the other replicas drop their
+// local copy. Invalidations sent while a replica is cut off from Redis are lost, so local entries
+// live at most constants.CacheLocalTTL and the local tier is purged when Redis comes back. While
+// Redis is down the local tier keeps serving on its own.
+type TieredCache struct {
+	local     *MemoryCache
+	remote    *RedisClient
+	available func() bool
+	logger    *logger.PrettyLogger
+	// origin tells the messages of this replica apart from the others
+	origin   string
+	remoteUp atomic.Bool
+
+	cancel context.CancelFunc
+	done   chan struct{}
+	mu     sync.Mutex
+}
+
+type invalidation struct {
+	Origin   string   `json:"origin"`
+	Keys     []string `json:"keys,omitempty"`
+	Patterns []string `json:"patterns,omitempty"`
+}
+
+// NewTieredCache layers a MemoryCache over remote, available reports whether Redis is reachable
+func NewTieredCache(remote *RedisClient, available func() bool, log *logger.PrettyLogger) *TieredCache {
+	return &TieredCache{
+		local:     NewMemoryCache(constants.CacheLocalMaxEntries),
+		remote:    remote,
+		available: available,
+		logger:    log,
+		origin:    uuid.NewString(),
+	}
+}
+
+// Local returns the in-process tier
+func (c *TieredCache) Local() *MemoryCache {
+	return c.local
+}
+
+// Start listens for the invalidations published by the other replicas
+func (c *TieredCache) Start() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cancel != nil || c.remote == nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+	c.done = make(chan struct{})
+
+	c.logger.Info("CACHE_INVALIDATION_START", map[string]interface{}{
+		"channel":     constants.CacheInvalidationChannel,
+		"max_entries": constants.CacheLocalMaxEntries,
+		"local_ttl":   constants.CacheLocalTTL.String(),
+	}, "Listening for cache invalidations")
+
+	go c.run(ctx)
+}
+
+// Stop ends the invalidation listener
+func (c *TieredCache) Stop() {
+	c.mu.Lock()
+	cancel, done := c.cancel, c.done
+	c.cancel = nil
+	c.mu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+}
+
+func (c *TieredCache) run(ctx context.Context) {
+	defer close(c.done)
+
+	// The subscription reconnects on its own after Redis comes back
+	sub := c.remote.Subscribe(ctx, constants.CacheInvalidationChannel)
+	defer sub.Close()
+
+	messages := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case message, ok := <-messages:
+			if !ok {
+				return
+			}
+			c.apply(ctx, message.Payload)
+		}
+	}
+}
+
+func (c *TieredCache) apply(ctx context.Context, payload string) {
+	var message invalidation
+	if err := json.Unmarshal([]byte(payload), &message); err != nil {
+		c.logger.Warning("tiered_cache.invalidation", map[string]interface{}{
+			"error": err.Error(),
+		}, "Ignoring malformed cache invalidation")
+		return
+	}
+	if message.Origin == c.origin {
+		return
+	}
+
+	for _, key := range message.Keys {
+		c.local.Delete(ctx, key)
+	}
+	for _, pattern := range message.Patterns {
+		c.local.DeletePattern(ctx, pattern)
+	}
+}
+
+// remoteReady reports whether Redis can be used. The local tier is purged when Redis comes back
+// since it may hold entries other replicas changed in the meantime.
+func (c *TieredCache) remoteReady() bool {
+	up := c.remote != nil && c.available()
+	if !up {
+		c.remoteUp.Store(false)
+		return false
+	}
+	if !c.remoteUp.Swap(true) {
+		c.local.Purge()
+	}
+	return true
+}
+
+func (c *TieredCache) publish(ctx context.Context, message invalidation) {
+	message.Origin = c.origin
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return
+	}
+	if err := c.remote.Publish(ctx, constants.CacheInvalidationChannel, payload).Err(); err != nil {
+		c.logger.Warning("tiered_cache.publish", map[string]interface{}{
+			"error": err.Error(),
+		}, "Failed to publish cache invalidation")
+	}
+}
+
+// localTTL caps the expiry of a local entry
+func localTTL(expiration time.Duration) time.Duration {
+	if expiration <= 0 || expiration > constants.CacheLocalTTL {
+		return constants.CacheLocalTTL
+	}
+	return expiration
+}
+
+func (c *TieredCache) Get(ctx context.Context, key string) (string, error) {
+	if value, err := c.local.Get(ctx, key); err == nil {
+		return value, nil
+	}
+	if !c.remoteReady() {
+		return "", redis.Nil
+	}
+
+	pipe := c.remote.Pipeline()
+	get := pipe.Get(ctx, key)
+	ttl := pipe.PTTL(ctx, key)
+	pipe.Exec(ctx)
+
+	value, err := get.Result()
+	if err != nil {
+		return "", err
+	}
+	// The local copy must not outlive the remote entry
+	c.local.Set(ctx, key, value, localTTL(ttl.Val()))
+	return value, nil
+}
+
+func (c *TieredCache) Set(ctx context.Context, key string, value string, expiration time.Duration) error {
+	c.local.Set(ctx, key, value, localTTL(expiration))
+	if !c.remoteReady() {
+		return nil
+	}
+
+	if err := c.remote.Set(ctx, key, value, expiration); err != nil {
+		return err
+	}
+	c.publish(ctx, invalidation{Keys: []string{key}})
+	return nil
+}
+
+func (c *TieredCache) Delete(ctx context.Context, key string) error {
+	c.local.Delete(ctx, key)
+	if !c.remoteReady() {
+		return nil
+	}
+
+	if err := c.remote.Delete(ctx, key); err != nil {
+		return err
+	}
+	c.publish(ctx, invalidation{Keys: []string{key}})
+	return nil
+}
+
+func (c *TieredCache) DeletePattern(ctx context.Context, pattern string) error {
+	c.local.DeletePattern(ctx, pattern)
+	if !c.remoteReady() {
+		return nil
+	}
+
+	if err := c.remote.DeletePattern(ctx, pattern); err != nil {
+		return err
+	}
+	c.publish(ctx, invalidation{Patterns: []string{pattern}})
+	return nil
+}
+
+func (c *TieredCache) BatchSet(ctx context.Context, items map[string]string, expiration time.Duration) error {
+	c.local.BatchSet(ctx, items, localTTL(expiration))
+	if !c.remoteReady() {
+		return nil
+	}
+
+	if err := c.remote.BatchSet(ctx, items, expiration); err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	c.publish(ctx, invalidation{Keys: keys})
+	return nil
+}
+
+func (c *TieredCache) Info(ctx context.Context, sections ...string) *redis.StringCmd {
+	if c.remote == nil {
+		return c.local.Info(ctx, sections...)
+	}
+	return c.remote.Info(ctx, sections...)
+}
+
+func (c *TieredCache) GetMetrics() *CacheMetrics {
+	if c.remote == nil {
+		return c.local.GetMetrics()
+	}
+	return c.remote.GetMetrics()
+}
+
+// Keys lists the keys of Redis, or of the local tier while Redis is down
+func (c *TieredCache) Keys(ctx context.Context, pattern string) ([]string, error) {
+	if !c.remoteReady() {
+		return c.local.Keys(ctx, pattern)
+	}
+	return c.remote.Keys(ctx, pattern)
+}
+
+// TTL returns the time left on the Redis entry, or on the local one while Redis is down
+func (c *TieredCache) TTL(ctx context.Context, key string) (time.Duration, error) {
+	if !c.remoteReady() {
+		return c.local.TTL(ctx, key)
+	}
+	return c.remote.TTL(ctx, key)
+}
@@ -31,6 +31,7 @@ func SetupCORS(router *gin.Engine) {
 			"X-Requested-With",
 			"X-Auth-Token",
 			"X-Access-Token",
+			"X-Request-ID",
 			"Access-Control-Allow-Origin",
 			"Access-Control-Allow-Headers",
 			"Access-Control-Allow-Methods",
@@ -39,6 +40,7 @@ func SetupCORS(router *gin.Engine) {
 			"Content-Length",
 			"Access-Control-Allow-Origin",
 			"Access-Control-Allow-Headers",
+			"X-Request-ID",
 		},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
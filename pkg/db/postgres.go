@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fluencybe/internal/core/config"
 	"fluencybe/pkg/logger"
+	"fluencybe/pkg/tracing"
 	"fmt"
 	"time"
 
@@ -77,5 +78,9 @@ func NewGormDBConnection(cfg config.DBConfig, log *logger.PrettyLogger) (*gorm.D
 		return nil, fmt.Errorf("failed to initialize GORM: %w", err)
 	}
 
+	if err := gormDB.Use(tracing.NewGormPlugin()); err != nil {
+		return nil, fmt.Errorf("failed to register GORM tracing: %w", err)
+	}
+
 	return gormDB, nil
 }
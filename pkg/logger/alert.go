@@ -0,0 +1,315 @@
+package logger
+
+import (
+	"errors"
+	"fluencybe/internal/core/config"
+	"fluencybe/internal/core/constants"
+	"fmt"
+	"math"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AlertSink sends entries to Discord as alerts. The first entry of an event code is sent at once,
+// repeats within the group window are counted and sent as one summary when the window ends. A
+// Success entry with the event code of an open alert closes it with a recovery notice.
+type AlertSink struct {
+	routes    map[LogLevel]config.AlertRoute
+	minLevel  LogLevel
+	window    time.Duration
+	rateLimit float64
+	client    *http.Client
+
+	mu       sync.Mutex
+	groups   map[string]*alertGroup
+	buckets  map[string]*alertBucket
+	deferred []alertMessage
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// alertGroup tracks an event code from its first entry until it recovers or goes quiet
+type alertGroup struct {
+	level     LogLevel
+	started   time.Time
+	lastSeen  time.Time
+	windowEnd time.Time
+	total     int
+	// pending counts the entries not reported yet
+	pending int
+	last    Record
+}
+
+type alertMessage struct {
+	route   config.AlertRoute
+	embed   DiscordEmbed
+	mention bool
+	// throttled runs instead of sending when the webhook is over its rate limit
+	throttled func()
+}
+
+// alertBucket refills rateLimit tokens per minute, one message takes one token
+type alertBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+func NewAlertSink(cfg config.AlertConfig, minLevel LogLevel) (*AlertSink, error) {
+	routes := make(map[LogLevel]config.AlertRoute, len(cfg.Routes))
+	for name, route := range cfg.Routes {
+		level, ok := ParseLevel(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown alert level %q", name)
+		}
+		routes[level] = route
+	}
+
+	s := &AlertSink{
+		routes:    routes,
+		minLevel:  minLevel,
+		window:    cfg.GroupWindow,
+		rateLimit: float64(cfg.RateLimit),
+		client:    &http.Client{Timeout: constants.LogDiscordTimeout},
+		groups:    make(map[string]*alertGroup),
+		buckets:   make(map[string]*alertBucket),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	go s.run()
+	return s, nil
+}
+
+func (s *AlertSink) Name() string {
+	return "discord"
+}
+
+func (s *AlertSink) Write(batch []Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	var messages []alertMessage
+	for _, record := range batch {
+		key := alertKey(record)
+
+		if record.Level == LevelSuccess {
+			if group, ok := s.groups[key]; ok {
+				delete(s.groups, key)
+				// The repeats since the last summary go out before the recovery
+				if group.pending > 0 {
+					messages = append(messages, s.summaryMessage(group, now, true))
+				}
+				messages = append(messages, s.recoveryMessage(group, record, now))
+				continue
+			}
+		}
+
+		if record.Level < s.minLevel {
+			continue
+		}
+		route, ok := s.routes[record.Level]
+		if !ok {
+			continue
+		}
+
+		group, ok := s.groups[key]
+		if !ok {
+			group = &alertGroup{level: record.Level, started: now}
+			s.groups[key] = group
+		}
+		group.total++
+		group.lastSeen = now
+		group.last = record
+		if record.Level > group.level {
+			group.level = record.Level
+		}
+
+		// Repeats inside the window wait for the summary
+		if ok && (group.pending > 0 || now.Before(group.windowEnd)) {
+			group.pending++
+			continue
+		}
+		group.windowEnd = now.Add(s.window)
+		messages = append(messages, alertMessage{
+			route:     route,
+			embed:     discordEmbed(record),
+			mention:   true,
+			throttled: func() { group.pending++ },
+		})
+	}
+
+	return s.send(messages, now)
+}
+
+// Close sends the summaries still pending
+func (s *AlertSink) Close() error {
+	close(s.stop)
+	<-s.done
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	err := s.flush(time.Now(), true)
+	s.client.CloseIdleConnections()
+	return err
+}
+
+func (s *AlertSink) run() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(constants.AlertFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case now := <-ticker.C:
+			s.mu.Lock()
+			err := s.flush(now, false)
+			s.mu.Unlock()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "log sink %s: %v\n", s.Name(), err)
+			}
+		}
+	}
+}
+
+// flush sends the summaries of the groups whose window ended, or of every group when force is set
+func (s *AlertSink) flush(now time.Time, force bool) error {
+	messages := s.deferred
+	s.deferred = nil
+
+	for key, group := range s.groups {
+		idle := now.Sub(group.lastSeen) > constants.AlertIdleTTL
+		if !idle && !force && now.Before(group.windowEnd) {
+			continue
+		}
+		// A group that went quiet is dropped with a last summary of its pending repeats
+		if idle {
+			delete(s.groups, key)
+		}
+		if group.pending > 0 {
+			messages = append(messages, s.summaryMessage(group, now, idle))
+		}
+	}
+
+	return s.send(messages, now)
+}
+
+// summaryMessage reports the pending repeats of group. The summary of a closed group is deferred
+// when throttled since the group no longer holds the count.
+func (s *AlertSink) summaryMessage(group *alertGroup, now time.Time, closed bool) alertMessage {
+	repeats := group.pending
+	group.pending = 0
+	group.windowEnd = now.Add(s.window)
+
+	embed := discordEmbed(group.last)
+	embed.Title = fmt.Sprintf("%s (repeated %d times in the last %s)", group.last.Message, repeats, s.window)
+	if closed {
+		embed.Title = fmt.Sprintf("%s (%d more occurrences)", group.last.Message, repeats)
+	}
+	embed.Fields = append(embed.Fields,
+		DiscordField{Name: "Occurrences", Value: fmt.Sprintf("%d since <t:%d:R>", group.total, group.started.Unix()), Inline: true},
+	)
+	message := alertMessage{route: s.routes[group.level], embed: embed}
+	message.throttled = func() {
+		if closed {
+			s.postpone(message)
+			return
+		}
+		group.pending += repeats
+	}
+	return message
+}
+
+func (s *AlertSink) recoveryMessage(group *alertGroup, record Record, now time.Time) alertMessage {
+	embed := discordEmbed(record)
+	embed.Fields = append(embed.Fields,
+		DiscordField{Name: "Occurrences", Value: fmt.Sprintf("%d", group.total), Inline: true},
+		DiscordField{Name: "Duration", Value: now.Sub(group.started).Round(time.Second).String(), Inline: true},
+	)
+
+	message := alertMessage{route: s.routes[group.level], embed: embed}
+	message.throttled = func() { s.postpone(message) }
+	return message
+}
+
+// postpone keeps a throttled message for the next flush
+func (s *AlertSink) postpone(message alertMessage) {
+	if len(s.deferred) < constants.AlertMaxDeferred {
+		s.deferred = append(s.deferred, message)
+	}
+}
+
+// send posts the messages of each webhook in order, up to ten embeds per post
+func (s *AlertSink) send(messages []alertMessage, now time.Time) error {
+	var errs []error
+	for len(messages) > 0 {
+		webhookURL := messages[0].route.WebhookURL
+		var chunk, rest []alertMessage
+		for _, message := range messages {
+			if message.route.WebhookURL == webhookURL && len(chunk) < discordMaxEmbeds {
+				chunk = append(chunk, message)
+			} else {
+				rest = append(rest, message)
+			}
+		}
+		messages = rest
+
+		if !s.take(webhookURL, now) {
+			for _, message := range chunk {
+				message.throttled()
+			}
+			continue
+		}
+
+		var mentions []string
+		embeds := make([]DiscordEmbed, 0, len(chunk))
+		for _, message := range chunk {
+			embeds = append(embeds, message.embed)
+			if message.mention && message.route.Mention != "" && !containsString(mentions, message.route.Mention) {
+				mentions = append(mentions, message.route.Mention)
+			}
+		}
+		if err := postDiscord(s.client, webhookURL, strings.Join(mentions, " "), embeds); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *AlertSink) take(webhookURL string, now time.Time) bool {
+	bucket, ok := s.buckets[webhookURL]
+	if !ok {
+		bucket = &alertBucket{tokens: s.rateLimit, updated: now}
+		s.buckets[webhookURL] = bucket
+	}
+	bucket.tokens = math.Min(s.rateLimit, bucket.tokens+now.Sub(bucket.updated).Minutes()*s.rateLimit)
+	bucket.updated = now
+	if bucket.tokens < 1 {
+		return false
+	}
+	bucket.tokens--
+	return true
+}
+
+// alertKey groups entries by event code, entries without one by message
+func alertKey(record Record) string {
+	if record.EventCode != "" {
+		return record.EventCode
+	}
+	return record.Message
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
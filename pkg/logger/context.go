@@ -0,0 +1,40 @@
+package logger
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type requestIDKey struct{}
+
+// ContextWithRequestID stores the id of the request being served
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext returns the request id stored by ContextWithRequestID, or ""
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// WithContext returns a logger that stamps its entries with the request id and the trace of ctx
+func (l *PrettyLogger) WithContext(ctx context.Context) *PrettyLogger {
+	if ctx == nil {
+		return l
+	}
+	requestID := RequestIDFromContext(ctx)
+	spanContext := trace.SpanContextFromContext(ctx)
+	if requestID == "" && !spanContext.IsValid() {
+		return l
+	}
+
+	derived := l.derive()
+	derived.requestID = requestID
+	if spanContext.IsValid() {
+		derived.traceID = spanContext.TraceID().String()
+		derived.spanID = spanContext.SpanID().String()
+	}
+	return derived
+}
@@ -0,0 +1,136 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type DiscordWebhook struct {
+	Content string         `json:"content"`
+	Embeds  []DiscordEmbed `json:"embeds,omitempty"`
+}
+
+type DiscordEmbed struct {
+	Title       string         `json:"title,omitempty"`
+	Description string         `json:"description,omitempty"`
+	Color       int            `json:"color,omitempty"`
+	Fields      []DiscordField `json:"fields,omitempty"`
+	Timestamp   string         `json:"timestamp,omitempty"`
+	Footer      *DiscordFooter `json:"footer,omitempty"`
+	Author      *DiscordAuthor `json:"author,omitempty"`
+}
+
+type DiscordField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline,omitempty"`
+}
+
+type DiscordFooter struct {
+	Text    string `json:"text"`
+	IconURL string `json:"icon_url,omitempty"`
+}
+
+type DiscordAuthor struct {
+	Name    string `json:"name"`
+	IconURL string `json:"icon_url,omitempty"`
+}
+
+// discordMaxEmbeds is the number of embeds Discord accepts in one webhook message
+const discordMaxEmbeds = 10
+
+// postDiscord sends one webhook message, content carries the mentions
+func postDiscord(client *http.Client, webhookURL string, content string, embeds []DiscordEmbed) error {
+	payload, err := json.Marshal(DiscordWebhook{Content: content, Embeds: embeds})
+	if err != nil {
+		return fmt.Errorf("failed to marshal Discord webhook: %w", err)
+	}
+
+	resp, err := client.Post(webhookURL, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to send to Discord: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("discord webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func discordEmbed(record Record) DiscordEmbed {
+	levelColor := 0x7289DA
+	levelEmoji := "i️"
+
+	switch record.Level {
+	case LevelCritical:
+		levelColor = 0xFF0000
+		levelEmoji = "🔴"
+	case LevelError:
+		levelColor = 0xFF0000
+		levelEmoji = "🔴"
+	case LevelWarning:
+		levelColor = 0xFFA500
+		levelEmoji = "⚠️"
+	case LevelInfo:
+		levelColor = 0x00FF00
+	case LevelSuccess:
+		levelColor = 0x00FF00
+		levelEmoji = "✅"
+	case LevelDebug:
+		levelColor = 0x7289DA
+		levelEmoji = "🔍"
+	}
+
+	var fieldsList []DiscordField
+	if record.EventCode != "" {
+		fieldsList = append(fieldsList, DiscordField{
+			Name:   "Event Code",
+			Value:  fmt.Sprintf("`%s`", record.EventCode),
+			Inline: true,
+		})
+	}
+
+	fieldsList = append(fieldsList, DiscordField{
+		Name:   "Timestamp",
+		Value:  fmt.Sprintf("<t:%d:F>", record.Timestamp.Unix()),
+		Inline: true,
+	})
+
+	if record.RequestID != "" {
+		fieldsList = append(fieldsList, DiscordField{
+			Name:   "Request ID",
+			Value:  fmt.Sprintf("`%s`", record.RequestID),
+			Inline: true,
+		})
+	}
+
+	for k, v := range record.Fields {
+		value := fmt.Sprintf("%v", v)
+		if strings.ContainsAny(value, "{}[]()") || strings.Contains(k, "id") {
+			value = fmt.Sprintf("`%s`", value)
+		}
+		fieldsList = append(fieldsList, DiscordField{
+			Name:   strings.Title(k),
+			Value:  value,
+			Inline: true,
+		})
+	}
+
+	return DiscordEmbed{
+		Author: &DiscordAuthor{
+			Name: fmt.Sprintf("%s %s", levelEmoji, strings.ToUpper(levelToString(record.Level))),
+		},
+		Title:  record.Message,
+		Color:  levelColor,
+		Fields: fieldsList,
+		Footer: &DiscordFooter{
+			Text: fmt.Sprintf("Service: %s", record.Service),
+		},
+		Timestamp: record.Timestamp.Format(time.RFC3339),
+	}
+}
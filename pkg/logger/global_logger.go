@@ -18,6 +18,10 @@ type GlobalLoggerOptions struct {
 	Output   io.Writer
 	Service  string
 	Colorful bool
+	// JSON prints JSON lines instead of text, it only applies to the default stdout sink
+	JSON bool
+	// Sinks receive every entry, a single stdout sink writing to Output is used when empty
+	Sinks []*AsyncSink
 }
 
 // DefaultLoggerOptions returns the default options for the global logger
@@ -33,12 +37,21 @@ func DefaultLoggerOptions() GlobalLoggerOptions {
 // InitGlobalLogger initializes the global logger with custom options
 func InitGlobalLogger(opts GlobalLoggerOptions) *PrettyLogger {
 	defaultOnce.Do(func() {
+		if opts.Output == nil {
+			opts.Output = os.Stdout
+		}
+		sinks := opts.Sinks
+		if len(sinks) == 0 {
+			sinks = []*AsyncSink{NewAsyncSink(NewWriterSink("stdout", opts.Output, opts.JSON, opts.Colorful), defaultBufferSize)}
+		}
 		defaultLogger = &PrettyLogger{
 			level:        opts.Level,
 			output:       opts.Output,
 			service:      opts.Service,
 			colorful:     opts.Colorful,
+			json:         opts.JSON,
 			activeLevels: make(map[LogLevel]bool),
+			sinks:        sinks,
 		}
 
 		// Parse LOG_LEVELS environment variable
@@ -80,11 +93,7 @@ func GetGlobalLogger() *PrettyLogger {
 
 // WithService creates a new logger instance with a different service name but sharing the same configuration
 func WithService(serviceName string) *PrettyLogger {
-	parent := GetGlobalLogger()
-	return &PrettyLogger{
-		level:    parent.level,
-		output:   parent.output,
-		service:  serviceName,
-		colorful: parent.colorful,
-	}
+	logger := GetGlobalLogger().derive()
+	logger.service = serviceName
+	return logger
 }
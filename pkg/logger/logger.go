@@ -1,11 +1,11 @@
 package logger
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"net/http"
 	"os"
 	"runtime"
 	"strings"
@@ -31,39 +31,15 @@ type PrettyLogger struct {
 	output       io.Writer
 	service      string
 	colorful     bool
+	json         bool
 	mu           sync.Mutex
 	activeLevels map[LogLevel]bool
-}
-
-type DiscordWebhook struct {
-	Content string         `json:"content"`
-	Embeds  []DiscordEmbed `json:"embeds,omitempty"`
-}
-
-type DiscordEmbed struct {
-	Title       string         `json:"title,omitempty"`
-	Description string         `json:"description,omitempty"`
-	Color       int            `json:"color,omitempty"`
-	Fields      []DiscordField `json:"fields,omitempty"`
-	Timestamp   string         `json:"timestamp,omitempty"`
-	Footer      *DiscordFooter `json:"footer,omitempty"`
-	Author      *DiscordAuthor `json:"author,omitempty"`
-}
-
-type DiscordField struct {
-	Name   string `json:"name"`
-	Value  string `json:"value"`
-	Inline bool   `json:"inline,omitempty"`
-}
-
-type DiscordFooter struct {
-	Text    string `json:"text"`
-	IconURL string `json:"icon_url,omitempty"`
-}
-
-type DiscordAuthor struct {
-	Name    string `json:"name"`
-	IconURL string `json:"icon_url,omitempty"`
+	// sinks are shared by every logger derived from this one
+	sinks []*AsyncSink
+	// requestID, traceID and spanID come from the context given to WithContext
+	requestID string
+	traceID   string
+	spanID    string
 }
 
 type LogEntry struct {
@@ -74,6 +50,16 @@ type LogEntry struct {
 	Message   string
 }
 
+// Record is an entry as handed to the sinks
+type Record struct {
+	LogEntry
+	Service   string
+	Caller    string
+	RequestID string
+	TraceID   string
+	SpanID    string
+}
+
 func NewPrettyLogger(service string, level LogLevel, colorful bool) *PrettyLogger {
 	return &PrettyLogger{
 		level:        level,
@@ -81,7 +67,47 @@ func NewPrettyLogger(service string, level LogLevel, colorful bool) *PrettyLogge
 		service:      service,
 		colorful:     colorful,
 		activeLevels: make(map[LogLevel]bool),
+		sinks:        []*AsyncSink{NewAsyncSink(NewWriterSink("stdout", os.Stdout, false, colorful), defaultBufferSize)},
+	}
+}
+
+// derive copies the logger settings, the sinks stay shared
+func (l *PrettyLogger) derive() *PrettyLogger {
+	return &PrettyLogger{
+		level:        l.level,
+		output:       l.output,
+		service:      l.service,
+		colorful:     l.colorful,
+		json:         l.json,
+		activeLevels: l.activeLevels,
+		sinks:        l.sinks,
+		requestID:    l.requestID,
+		traceID:      l.traceID,
+		spanID:       l.spanID,
+	}
+}
+
+// JSON reports whether stdout gets JSON lines instead of text
+func (l *PrettyLogger) JSON() bool {
+	return l.json
+}
+
+// SinkStats reports the queue length and the dropped and failed entries of every sink
+func (l *PrettyLogger) SinkStats() []SinkStats {
+	stats := make([]SinkStats, 0, len(l.sinks))
+	for _, sink := range l.sinks {
+		stats = append(stats, sink.Stats())
 	}
+	return stats
+}
+
+// Close flushes the queued entries and closes the sinks, entries logged afterwards are dropped
+func (l *PrettyLogger) Close(ctx context.Context) error {
+	var errs []error
+	for _, sink := range l.sinks {
+		errs = append(errs, sink.Close(ctx))
+	}
+	return errors.Join(errs...)
 }
 
 func (l *PrettyLogger) SetLevel(level LogLevel) {
@@ -144,132 +170,54 @@ func (l *PrettyLogger) Critical(eventCode string, fields map[string]interface{},
 	if !l.checkLogLevel(LevelCritical) {
 		return
 	}
-	l.log(LevelCritical, eventCode, fields, message, false)
+	l.log(LevelCritical, eventCode, fields, message)
 }
 
-func (l *PrettyLogger) log(level LogLevel, eventCode string, fields map[string]interface{}, message string, systemWideAlert ...bool) {
+func (l *PrettyLogger) log(level LogLevel, eventCode string, fields map[string]interface{}, message string) {
 	if level < l.level {
 		return
 	}
 
-	entry := LogEntry{
-		Timestamp: time.Now().UTC(),
-		Level:     level,
-		EventCode: eventCode,
-		Fields:    fields,
-		Message:   message,
+	_, file, line, _ := runtime.Caller(2)
+	record := Record{
+		LogEntry: LogEntry{
+			Timestamp: time.Now().UTC(),
+			Level:     level,
+			EventCode: eventCode,
+			Fields:    fields,
+			Message:   message,
+		},
+		Service:   l.service,
+		Caller:    fmt.Sprintf("%s:%d", shortenFilePath(file), line),
+		RequestID: l.requestID,
+		TraceID:   l.traceID,
+		SpanID:    l.spanID,
 	}
 
-	l.mu.Lock()
-	defer l.mu.Unlock()
-
-	env := os.Getenv("ENV")
-	if env == "production" {
-		l.logToDiscord(entry, len(systemWideAlert) > 0 && systemWideAlert[0])
-	} else {
-		_, file, line, _ := runtime.Caller(2)
-		caller := fmt.Sprintf("%s:%d", shortenFilePath(file), line)
-		if l.colorful {
-			l.printColorful(entry, caller)
-		} else {
-			l.printPlain(entry, caller)
-		}
+	// Sinks queue the record and write it from their own goroutine, logging never waits on I/O
+	for _, sink := range l.sinks {
+		sink.Enqueue(record)
 	}
 }
 
-func (l *PrettyLogger) logToDiscord(entry LogEntry, systemWideAlert bool) {
-	webhookURL := os.Getenv("DISCORD_ACCOUNT_WEBHOOK_URL")
-	if webhookURL == "" {
-		return
-	}
-
-	levelColor := 0x7289DA
-	levelEmoji := "i️"
-	mentionContent := ""
-
-	switch entry.Level {
-	case LevelCritical:
-		levelColor = 0xFF0000
-		levelEmoji = "🔴"
-		mentionContent = "<@&1335118454113566720>"
-	case LevelError:
-		levelColor = 0xFF0000
-		levelEmoji = "🔴"
-	case LevelWarning:
-		levelColor = 0xFFA500
-		levelEmoji = "⚠️"
-	case LevelInfo:
-		levelColor = 0x00FF00
-	case LevelSuccess:
-		levelColor = 0x00FF00
-		levelEmoji = "✅"
-	case LevelDebug:
-		levelColor = 0x7289DA
-		levelEmoji = "🔍"
-	}
-
-	var fieldsList []DiscordField
-	if entry.EventCode != "" {
-		fieldsList = append(fieldsList, DiscordField{
-			Name:   "Event Code",
-			Value:  fmt.Sprintf("`%s`", entry.EventCode),
-			Inline: true,
-		})
+// recordFields lists the fields of a text line, the correlation ids first
+func recordFields(record Record) []string {
+	fields := make([]string, 0, len(record.Fields)+2)
+	if record.RequestID != "" {
+		fields = append(fields, "request_id="+record.RequestID)
 	}
-
-	fieldsList = append(fieldsList, DiscordField{
-		Name:   "Timestamp",
-		Value:  fmt.Sprintf("<t:%d:F>", entry.Timestamp.Unix()),
-		Inline: true,
-	})
-
-	for k, v := range entry.Fields {
-		value := fmt.Sprintf("%v", v)
-		if strings.ContainsAny(value, "{}[]()") || strings.Contains(k, "id") {
-			value = fmt.Sprintf("`%s`", value)
-		}
-		fieldsList = append(fieldsList, DiscordField{
-			Name:   strings.Title(k),
-			Value:  value,
-			Inline: true,
-		})
-	}
-
-	webhook := DiscordWebhook{
-		Content: mentionContent,
-		Embeds: []DiscordEmbed{
-			{
-				Author: &DiscordAuthor{
-					Name: fmt.Sprintf("%s %s", levelEmoji, strings.ToUpper(levelToString(entry.Level))),
-				},
-				Title:  entry.Message,
-				Color:  levelColor,
-				Fields: fieldsList,
-				Footer: &DiscordFooter{
-					Text: fmt.Sprintf("Service: %s", l.service),
-				},
-				Timestamp: entry.Timestamp.Format(time.RFC3339),
-			},
-		},
-	}
-
-	payload, err := json.Marshal(webhook)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error marshaling Discord webhook: %v\n", err)
-		return
+	if record.TraceID != "" {
+		fields = append(fields, "trace_id="+record.TraceID)
 	}
-
-	resp, err := http.Post(webhookURL, "application/json", bytes.NewBuffer(payload))
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error sending to Discord: %v\n", err)
-		return
+	for k, v := range record.Fields {
+		fields = append(fields, fmt.Sprintf("%s=%v", k, v))
 	}
-	defer resp.Body.Close()
+	return fields
 }
 
-func (l *PrettyLogger) printColorful(entry LogEntry, caller string) {
-	timestamp := entry.Timestamp.Format("2006-01-02 15:04:05.000")
-	levelStr := strings.ToUpper(levelToString(entry.Level))
+func formatColorful(record Record) string {
+	timestamp := record.Timestamp.Format("2006-01-02 15:04:05.000")
+	levelStr := strings.ToUpper(levelToString(record.Level))
 
 	timestampColor := color.New(color.FgHiBlue).Add(color.Faint)
 	serviceColor := color.New(color.FgHiMagenta)
@@ -279,7 +227,7 @@ func (l *PrettyLogger) printColorful(entry LogEntry, caller string) {
 	eventCodeColor := color.New(color.FgCyan)
 
 	var levelColor *color.Color
-	switch entry.Level {
+	switch record.Level {
 	case LevelDebug:
 		levelColor = color.New(color.FgHiCyan)
 	case LevelInfo:
@@ -296,9 +244,9 @@ func (l *PrettyLogger) printColorful(entry LogEntry, caller string) {
 
 	coloredTimestamp := timestampColor.Sprint(timestamp)
 	coloredLevel := levelColor.Sprint(levelStr)
-	coloredService := serviceColor.Sprintf("[%s]", l.service)
-	coloredCaller := callerColor.Sprint(caller)
-	coloredMessage := messageColor.Sprint(entry.Message)
+	coloredService := serviceColor.Sprintf("[%s]", record.Service)
+	coloredCaller := callerColor.Sprint(record.Caller)
+	coloredMessage := messageColor.Sprint(record.Message)
 
 	logLine := fmt.Sprintf("%s [%s] %s %s - %s",
 		coloredTimestamp,
@@ -308,46 +256,96 @@ func (l *PrettyLogger) printColorful(entry LogEntry, caller string) {
 		coloredMessage,
 	)
 
-	if len(entry.Fields) > 0 {
-		fields := make([]string, 0, len(entry.Fields))
-		for k, v := range entry.Fields {
-			fields = append(fields, fmt.Sprintf("%s=%v", k, v))
-		}
+	if fields := recordFields(record); len(fields) > 0 {
 		logLine += fieldsColor.Sprintf(" | %s", strings.Join(fields, " "))
 	}
 
-	if entry.EventCode != "" {
-		logLine = eventCodeColor.Sprintf("[%s] ", entry.EventCode) + logLine
+	if record.EventCode != "" {
+		logLine = eventCodeColor.Sprintf("[%s] ", record.EventCode) + logLine
 	}
 
-	fmt.Fprintln(l.output, logLine)
+	return logLine
 }
 
-func (l *PrettyLogger) printPlain(entry LogEntry, caller string) {
-	timestamp := entry.Timestamp.Format("2006-01-02 15:04:05.000")
-	levelStr := strings.ToUpper(levelToString(entry.Level))
+func formatPlain(record Record) string {
+	timestamp := record.Timestamp.Format("2006-01-02 15:04:05.000")
+	levelStr := strings.ToUpper(levelToString(record.Level))
 
 	logLine := fmt.Sprintf("%s [%s] [%s] %s - %s",
 		timestamp,
 		levelStr,
-		l.service,
-		caller,
-		entry.Message,
+		record.Service,
+		record.Caller,
+		record.Message,
 	)
 
-	if len(entry.Fields) > 0 {
-		fields := make([]string, 0, len(entry.Fields))
-		for k, v := range entry.Fields {
-			fields = append(fields, fmt.Sprintf("%s=%v", k, v))
-		}
+	if fields := recordFields(record); len(fields) > 0 {
 		logLine += fmt.Sprintf(" | %s", strings.Join(fields, " "))
 	}
 
-	if entry.EventCode != "" {
-		logLine = fmt.Sprintf("[%s] %s", entry.EventCode, logLine)
+	if record.EventCode != "" {
+		logLine = fmt.Sprintf("[%s] %s", record.EventCode, logLine)
 	}
 
-	fmt.Fprintln(l.output, logLine)
+	return logLine
+}
+
+type jsonRecord struct {
+	Time      string                 `json:"time"`
+	Level     string                 `json:"level"`
+	Service   string                 `json:"service"`
+	Caller    string                 `json:"caller,omitempty"`
+	Event     string                 `json:"event,omitempty"`
+	Message   string                 `json:"message"`
+	RequestID string                 `json:"request_id,omitempty"`
+	TraceID   string                 `json:"trace_id,omitempty"`
+	SpanID    string                 `json:"span_id,omitempty"`
+	Fields    map[string]interface{} `json:"fields,omitempty"`
+}
+
+func formatJSON(record Record) []byte {
+	line := jsonRecord{
+		Time:      record.Timestamp.Format(time.RFC3339Nano),
+		Level:     levelToString(record.Level),
+		Service:   record.Service,
+		Caller:    record.Caller,
+		Event:     record.EventCode,
+		Message:   record.Message,
+		RequestID: record.RequestID,
+		TraceID:   record.TraceID,
+		SpanID:    record.SpanID,
+		Fields:    record.Fields,
+	}
+	payload, err := json.Marshal(line)
+	if err != nil {
+		// A field that does not marshal is written as its text form
+		line.Fields = make(map[string]interface{}, len(record.Fields))
+		for k, v := range record.Fields {
+			line.Fields[k] = fmt.Sprintf("%v", v)
+		}
+		payload, _ = json.Marshal(line)
+	}
+	return payload
+}
+
+// ParseLevel reads a level name as used in LOG_LEVELS
+func ParseLevel(name string) (LogLevel, bool) {
+	switch strings.ToUpper(strings.TrimSpace(name)) {
+	case "DEBUG":
+		return LevelDebug, true
+	case "INFO":
+		return LevelInfo, true
+	case "SUCCESS":
+		return LevelSuccess, true
+	case "WARN", "WARNING":
+		return LevelWarning, true
+	case "ERROR":
+		return LevelError, true
+	case "CRITICAL":
+		return LevelCritical, true
+	default:
+		return LevelInfo, false
+	}
 }
 
 func levelToString(level LogLevel) string {
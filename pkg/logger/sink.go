@@ -0,0 +1,171 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"fluencybe/internal/core/constants"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"sync"
+	"sync/atomic"
+)
+
+const defaultBufferSize = 4096
+
+// Sink writes batches of records, it is only called from the goroutine of its AsyncSink and must
+// not keep the batch after returning
+type Sink interface {
+	Name() string
+	Write(batch []Record) error
+	Close() error
+}
+
+// SinkStats are the counters of a sink
+type SinkStats struct {
+	Name    string
+	Queued  int
+	Dropped uint64
+	Failed  uint64
+}
+
+// AsyncSink queues records for a sink and writes them in batches from its own goroutine. A record
+// arriving while the queue is full is dropped and counted rather than blocking the caller.
+type AsyncSink struct {
+	sink    Sink
+	queue   chan Record
+	dropped atomic.Uint64
+	failed  atomic.Uint64
+	done    chan struct{}
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+func NewAsyncSink(sink Sink, bufferSize int) *AsyncSink {
+	if bufferSize <= 0 {
+		bufferSize = defaultBufferSize
+	}
+	s := &AsyncSink{
+		sink:  sink,
+		queue: make(chan Record, bufferSize),
+		done:  make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+// Enqueue hands a record to the sink without waiting. The fields are copied, the caller may
+// change its map while the record is still queued.
+func (s *AsyncSink) Enqueue(record Record) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		s.dropped.Add(1)
+		return
+	}
+	record.Fields = maps.Clone(record.Fields)
+	select {
+	case s.queue <- record:
+	default:
+		s.dropped.Add(1)
+	}
+}
+
+func (s *AsyncSink) Stats() SinkStats {
+	return SinkStats{
+		Name:    s.sink.Name(),
+		Queued:  len(s.queue),
+		Dropped: s.dropped.Load(),
+		Failed:  s.failed.Load(),
+	}
+}
+
+// Close writes the records still queued and closes the sink, it gives up when ctx is done
+func (s *AsyncSink) Close(ctx context.Context) error {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.queue)
+	}
+	s.mu.Unlock()
+
+	select {
+	case <-s.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("log sink %s: %w", s.sink.Name(), ctx.Err())
+	}
+}
+
+func (s *AsyncSink) run() {
+	defer close(s.done)
+
+	batch := make([]Record, 0, constants.LogSinkBatchSize)
+	for record := range s.queue {
+		batch = append(batch[:0], record)
+		// Take whatever else is already queued, a busy logger writes fewer, larger batches
+	drain:
+		for len(batch) < constants.LogSinkBatchSize {
+			select {
+			case next, ok := <-s.queue:
+				if !ok {
+					break drain
+				}
+				batch = append(batch, next)
+			default:
+				break drain
+			}
+		}
+
+		if err := s.sink.Write(batch); err != nil {
+			s.failed.Add(uint64(len(batch)))
+			// The logger cannot log its own failures, stderr is the last resort
+			fmt.Fprintf(os.Stderr, "log sink %s: %v\n", s.sink.Name(), err)
+		}
+	}
+
+	if err := s.sink.Close(); err != nil {
+		fmt.Fprintf(os.Stderr, "log sink %s: %v\n", s.sink.Name(), err)
+	}
+}
+
+// WriterSink prints records to a writer as text or JSON lines
+type WriterSink struct {
+	name     string
+	output   io.Writer
+	json     bool
+	colorful bool
+	buf      bytes.Buffer
+}
+
+func NewWriterSink(name string, output io.Writer, json bool, colorful bool) *WriterSink {
+	return &WriterSink{name: name, output: output, json: json, colorful: colorful}
+}
+
+func (s *WriterSink) Name() string {
+	return s.name
+}
+
+func (s *WriterSink) Write(batch []Record) error {
+	s.buf.Reset()
+	for _, record := range batch {
+		switch {
+		case s.json:
+			s.buf.Write(formatJSON(record))
+		case s.colorful:
+			s.buf.WriteString(formatColorful(record))
+		default:
+			s.buf.WriteString(formatPlain(record))
+		}
+		s.buf.WriteByte('\n')
+	}
+	_, err := s.output.Write(s.buf.Bytes())
+	return err
+}
+
+// Close leaves the writer open, it belongs to the caller
+func (s *WriterSink) Close() error {
+	return nil
+}
@@ -0,0 +1,47 @@
+package logger
+
+import (
+	"fluencybe/internal/core/config"
+	"fmt"
+	"io"
+)
+
+// NewSinks builds the sinks listed in cfg, stdout is written to output
+func NewSinks(cfg config.LogConfig, output io.Writer, colorful bool) ([]*AsyncSink, error) {
+	sinks := make([]*AsyncSink, 0, len(cfg.Sinks))
+	seen := make(map[string]bool, len(cfg.Sinks))
+	for _, name := range cfg.Sinks {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		switch name {
+		case "stdout":
+			sinks = append(sinks, NewAsyncSink(NewWriterSink(name, output, cfg.Format == "json", colorful), cfg.BufferSize))
+		case "file":
+			sink, err := NewFileSink(cfg.FilePath, cfg.FileMaxSizeMB, cfg.FileMaxBackups)
+			if err != nil {
+				return nil, err
+			}
+			sinks = append(sinks, NewAsyncSink(sink, cfg.BufferSize))
+		case "discord":
+			// Without a webhook there is nowhere to post, as before
+			if len(cfg.Alerts.Routes) == 0 {
+				continue
+			}
+			level, ok := ParseLevel(cfg.DiscordLevel)
+			if !ok {
+				return nil, fmt.Errorf("unknown Discord log level %q", cfg.DiscordLevel)
+			}
+			sink, err := NewAlertSink(cfg.Alerts, level)
+			if err != nil {
+				return nil, err
+			}
+			sinks = append(sinks, NewAsyncSink(sink, cfg.BufferSize))
+		default:
+			return nil, fmt.Errorf("unknown log sink %q", name)
+		}
+	}
+	return sinks, nil
+}
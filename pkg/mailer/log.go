@@ -0,0 +1,58 @@
+package mailer
+
+import (
+	"context"
+	"encoding/json"
+	"fluencybe/pkg/logger"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// LogMailer does not deliver anything, messages are written to the logger and,
+// when a file path is set, appended to that file as JSON lines. Used for local development.
+type LogMailer struct {
+	logger   *logger.PrettyLogger
+	filePath string
+	mu       sync.Mutex
+}
+
+func NewLogMailer(log *logger.PrettyLogger, filePath string) *LogMailer {
+	return &LogMailer{
+		logger:   log,
+		filePath: filePath,
+	}
+}
+
+func (m *LogMailer) Send(ctx context.Context, msg Message) error {
+	m.logger.Info("MAILER_LOG_SEND", map[string]interface{}{
+		"to":      msg.To,
+		"subject": msg.Subject,
+		"body":    msg.Body,
+	}, "Email captured by log mailer")
+
+	if m.filePath == "" {
+		return nil
+	}
+
+	line, err := json.Marshal(struct {
+		Message
+		SentAt time.Time `json:"sent_at"`
+	}{msg, time.Now().UTC()})
+	if err != nil {
+		return fmt.Errorf("failed to marshal email: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	f, err := os.OpenFile(m.filePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open mail file: %w", err)
+	}
+	defer f.Close()
+
+	_, err = f.Write(append(line, '\n'))
+	return err
+}
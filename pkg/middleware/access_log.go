@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"time"
+
+	"fluencybe/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AccessLogMiddleware logs every request through the logger, it replaces the text lines of
+// gin.Logger when the output is JSON
+func AccessLogMiddleware(log *logger.PrettyLogger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		log.WithContext(c.Request.Context()).Info("http.request", map[string]interface{}{
+			"method":      c.Request.Method,
+			"route":       c.FullPath(),
+			"path":        c.Request.URL.Path,
+			"status":      c.Writer.Status(),
+			"duration_ms": time.Since(start).Milliseconds(),
+			"client_ip":   c.ClientIP(),
+			"bytes":       c.Writer.Size(),
+		}, "Request handled")
+	}
+}
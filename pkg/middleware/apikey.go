@@ -0,0 +1,134 @@
+package middleware
+
+import (
+	"context"
+	"database/sql"
+	"fluencybe/internal/core/session"
+	"fluencybe/pkg/utils"
+	"net/http"
+	"strings"
+	"time"
+
+	constants "fluencybe/internal/core/constants"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lib/pq"
+)
+
+// APIKeyAuthMiddleware authenticates requests that carry an X-API-Key header as the developer
+// owning the key. GET and HEAD requests need the read scope, other methods need writeScope.
+// Requests without the header are handed to fallback, one of the JWT middlewares.
+func APIKeyAuthMiddleware(db *sql.DB, writeScope string, fallback gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rawKey := c.GetHeader(constants.APIKeyHeader)
+		if rawKey == "" {
+			fallback(c)
+			return
+		}
+
+		ctx := context.WithValue(c.Request.Context(), constants.GinContextKey, c)
+		c.Request = c.Request.WithContext(ctx)
+
+		key, err := resolveAPIKey(ctx, db, rawKey)
+		if err != nil {
+			c.JSON(401, StandardResponse{
+				Success: false,
+				Error:   "Error verifying api key",
+			})
+			c.Abort()
+			return
+		}
+
+		if key == nil {
+			c.JSON(401, StandardResponse{
+				Success: false,
+				Error:   constants.ErrInvalidAPIKey.Error(),
+			})
+			c.Abort()
+			return
+		}
+
+		requiredScope := writeScope
+		if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+			requiredScope = constants.ScopeRead
+		}
+		if !hasScope(key.Scopes, requiredScope) {
+			c.JSON(403, StandardResponse{
+				Success: false,
+				Error:   constants.ErrInsufficientScope.Error() + ": " + requiredScope,
+			})
+			c.Abort()
+			return
+		}
+
+		c.Set("user_id", key.DeveloperID)
+		c.Set("role", constants.RoleDeveloper)
+		c.Set("api_key_id", key.ID)
+		c.Next()
+	}
+}
+
+// resolveAPIKey returns nil without error when the key is unknown, revoked or expired
+func resolveAPIKey(ctx context.Context, db *sql.DB, rawKey string) (*session.APIKey, error) {
+	if !strings.HasPrefix(rawKey, constants.APIKeyPrefix) {
+		return nil, nil
+	}
+
+	keyHash := utils.HashToken(rawKey)
+	now := time.Now()
+
+	key := session.LookupAPIKey(keyHash)
+	if key == nil {
+		query := `
+			SELECT k.id, k.developer_id, k.scopes, k.expires_at, k.last_used_at
+			FROM developer_api_keys k
+			JOIN developers d ON d.id = k.developer_id
+			WHERE k.key_hash = $1 AND k.revoked_at IS NULL
+			AND (k.expires_at IS NULL OR k.expires_at > NOW())
+		`
+
+		var lastUsedAt sql.NullTime
+		loaded := session.APIKey{}
+		err := db.QueryRowContext(ctx, query, keyHash).Scan(
+			&loaded.ID,
+			&loaded.DeveloperID,
+			pq.Array(&loaded.Scopes),
+			&loaded.ExpiresAt,
+			&lastUsedAt,
+		)
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if lastUsedAt.Valid {
+			loaded.LastUsedAt = lastUsedAt.Time
+		}
+
+		session.StoreAPIKey(keyHash, loaded)
+		key = &loaded
+	}
+
+	if key.ExpiresAt != nil && now.After(*key.ExpiresAt) {
+		session.InvalidateAPIKey(keyHash)
+		return nil, nil
+	}
+
+	// last_used_at only needs minute precision, skip the write for busy keys.
+	// A failed write is not a reason to reject an otherwise valid key.
+	if now.Sub(key.LastUsedAt) >= constants.APIKeyLastUsedResolution && session.TouchAPIKey(keyHash, now) {
+		db.ExecContext(ctx, "UPDATE developer_api_keys SET last_used_at = $2 WHERE id = $1", key.ID, now.UTC())
+	}
+
+	return key, nil
+}
+
+func hasScope(scopes []string, required string) bool {
+	for _, scope := range scopes {
+		if scope == required {
+			return true
+		}
+	}
+	return false
+}
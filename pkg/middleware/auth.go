@@ -5,9 +5,9 @@ import (
 	"database/sql"
 	"fluencybe/pkg/utils"
 	"strings"
-	"sync"
 
 	constants "fluencybe/internal/core/constants"
+	"fluencybe/internal/core/session"
 
 	"github.com/gin-gonic/gin"
 )
@@ -32,10 +32,60 @@ func validateToken(c *gin.Context) (*utils.Claims, error) {
 	return utils.ValidateJWT(tokenString)
 }
 
-var (
-	userCache    = make(map[string]bool)
-	userCacheMux sync.RWMutex
-)
+// verifySession checks that the session of the token has not been revoked and that
+// its subject still exists, results are cached for a short time to spare the database
+func verifySession(ctx context.Context, db *sql.DB, claims *utils.Claims) (bool, error) {
+	if claims.SessionID == "" {
+		return false, nil
+	}
+
+	if active, found := session.Lookup(claims.SessionID); found {
+		return active, nil
+	}
+
+	subjectTable := "users"
+	if claims.Role == constants.RoleDeveloper {
+		subjectTable = "developers"
+	}
+
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM refresh_tokens
+			WHERE session_id = $1 AND subject_id = $2 AND role = $3
+			AND revoked_at IS NULL AND expires_at > NOW()
+		) AND EXISTS(SELECT 1 FROM ` + subjectTable + ` WHERE id = $2)
+	`
+
+	var active bool
+	if err := db.QueryRowContext(ctx, query, claims.SessionID, claims.UserID, claims.Role).Scan(&active); err != nil {
+		return false, err
+	}
+
+	session.Store(claims.SessionID, claims.UserID, active)
+	return active, nil
+}
+
+func abortInvalidSession(c *gin.Context, active bool, err error) bool {
+	if err != nil {
+		c.JSON(401, StandardResponse{
+			Success: false,
+			Error:   "Error verifying session",
+		})
+		c.Abort()
+		return true
+	}
+
+	if !active {
+		c.JSON(401, StandardResponse{
+			Success: false,
+			Error:   constants.ErrSessionRevoked.Error(),
+		})
+		c.Abort()
+		return true
+	}
+
+	return false
+}
 
 func UserAuthMiddleware(db *sql.DB) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -61,37 +111,14 @@ func UserAuthMiddleware(db *sql.DB) gin.HandlerFunc {
 			return
 		}
 
-		userCacheMux.RLock()
-		exists, found := userCache[claims.UserID]
-		userCacheMux.RUnlock()
-
-		if !found {
-			err = db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM users WHERE id = $1)", claims.UserID).Scan(&exists)
-			if err != nil {
-				c.JSON(401, StandardResponse{
-					Success: false,
-					Error:   "Error verifying user",
-				})
-				c.Abort()
-				return
-			}
-
-			userCacheMux.Lock()
-			userCache[claims.UserID] = exists
-			userCacheMux.Unlock()
-		}
-
-		if !exists {
-			c.JSON(401, StandardResponse{
-				Success: false,
-				Error:   "User not found",
-			})
-			c.Abort()
+		active, err := verifySession(ctx, db, claims)
+		if abortInvalidSession(c, active, err) {
 			return
 		}
 
 		c.Set("user_id", claims.UserID)
 		c.Set("role", claims.Role)
+		c.Set("session_id", claims.SessionID)
 		c.Next()
 	}
 }
@@ -120,19 +147,14 @@ func DeveloperAuthMiddleware(db *sql.DB) gin.HandlerFunc {
 			return
 		}
 
-		var exists bool
-		err = db.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM developers WHERE id = $1)", claims.UserID).Scan(&exists)
-		if err != nil || !exists {
-			c.JSON(401, StandardResponse{
-				Success: false,
-				Error:   "Developer not found",
-			})
-			c.Abort()
+		active, err := verifySession(ctx, db, claims)
+		if abortInvalidSession(c, active, err) {
 			return
 		}
 
 		c.Set("user_id", claims.UserID)
 		c.Set("role", claims.Role)
+		c.Set("session_id", claims.SessionID)
 		c.Next()
 	}
 }
@@ -161,26 +183,14 @@ func UserOrDeveloperAuthMiddleware(db *sql.DB) gin.HandlerFunc {
 			return
 		}
 
-		var exists bool
-		var query string
-		if claims.Role == constants.RoleUser {
-			query = "SELECT EXISTS(SELECT 1 FROM users WHERE id = $1)"
-		} else {
-			query = "SELECT EXISTS(SELECT 1 FROM developers WHERE id = $1)"
-		}
-
-		err = db.QueryRowContext(ctx, query, claims.UserID).Scan(&exists)
-		if err != nil || !exists {
-			c.JSON(401, StandardResponse{
-				Success: false,
-				Error:   "User/Developer not found",
-			})
-			c.Abort()
+		active, err := verifySession(ctx, db, claims)
+		if abortInvalidSession(c, active, err) {
 			return
 		}
 
 		c.Set("user_id", claims.UserID)
 		c.Set("role", claims.Role)
+		c.Set("session_id", claims.SessionID)
 		c.Next()
 	}
 }
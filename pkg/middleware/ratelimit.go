@@ -0,0 +1,109 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	constants "fluencybe/internal/core/constants"
+	"fluencybe/internal/core/session"
+	"fluencybe/pkg/ratelimit"
+	"fluencybe/pkg/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RateLimitPolicy is the budget shared by every route of a class
+type RateLimitPolicy struct {
+	Class  string
+	Limit  int
+	Window time.Duration
+}
+
+var (
+	AuthRateLimit  = RateLimitPolicy{Class: "auth", Limit: constants.RateLimitAuthRequests, Window: constants.RateLimitDuration}
+	ReadRateLimit  = RateLimitPolicy{Class: "read", Limit: constants.RateLimitReadRequests, Window: constants.RateLimitDuration}
+	WriteRateLimit = RateLimitPolicy{Class: "write", Limit: constants.RateLimitWriteRequests, Window: constants.RateLimitDuration}
+)
+
+// authPaths are the unauthenticated account endpoints, matched on the path after /v1/{user|developer}
+var authPaths = map[string]bool{
+	"/register":            true,
+	"/login":               true,
+	"/refresh":             true,
+	"/verify-email":        true,
+	"/verify-email/resend": true,
+	"/password/forgot":     true,
+	"/password/reset":      true,
+}
+
+// RateLimitMiddleware limits each client per route class. Clients are identified by an API key
+// the API key middleware resolved, then by the subject of a valid JWT, then by IP address.
+func RateLimitMiddleware(limiter *ratelimit.Limiter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		policy := classifyRequest(c.Request)
+		key := policy.Class + ":" + clientIdentity(c)
+
+		result := limiter.Allow(c.Request.Context(), key, policy.Limit, policy.Window)
+		resetSeconds := int(math.Ceil(result.ResetAfter.Seconds()))
+
+		header := c.Writer.Header()
+		header.Set("RateLimit-Limit", strconv.Itoa(result.Limit))
+		header.Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		header.Set("RateLimit-Reset", strconv.Itoa(resetSeconds))
+		header.Set("RateLimit-Policy", fmt.Sprintf("%d;w=%d", policy.Limit, int(policy.Window.Seconds())))
+
+		if !result.Allowed {
+			header.Set("Retry-After", strconv.Itoa(max(resetSeconds, 1)))
+			c.JSON(http.StatusTooManyRequests, StandardResponse{
+				Success: false,
+				Error:   "Too many requests",
+			})
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+func classifyRequest(r *http.Request) RateLimitPolicy {
+	path := strings.TrimSuffix(r.URL.Path, "/")
+
+	for _, prefix := range []string{"/v1/user", "/v1/developer"} {
+		if rest, ok := strings.CutPrefix(path, prefix); ok {
+			if authPaths[rest] || strings.HasPrefix(rest, "/oauth/") {
+				return AuthRateLimit
+			}
+		}
+	}
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return ReadRateLimit
+	default:
+		return WriteRateLimit
+	}
+}
+
+// clientIdentity does not hit the database, a revoked session keeps its budget until the token expires.
+// An API key only counts once the API key middleware has resolved it into the session cache, so
+// random keys cannot buy a fresh budget per request. Unknown keys are limited by IP.
+func clientIdentity(c *gin.Context) string {
+	if rawKey := c.GetHeader(constants.APIKeyHeader); rawKey != "" {
+		key := session.LookupAPIKey(utils.HashToken(rawKey))
+		if key != nil && (key.ExpiresAt == nil || time.Now().Before(*key.ExpiresAt)) {
+			return "key:" + key.ID
+		}
+	}
+
+	if token, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer "); ok {
+		if claims, err := utils.ValidateJWT(token); err == nil {
+			return claims.Role + ":" + claims.UserID
+		}
+	}
+
+	return "ip:" + c.ClientIP()
+}
@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"fluencybe/internal/core/constants"
+	"fluencybe/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RequestIDMiddleware keeps the X-Request-ID sent by a proxy or client, or assigns a new one, and
+// stores it in the request context for the logger. The id is echoed in the response.
+func RequestIDMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(constants.RequestIDHeader)
+		if !validRequestID(requestID) {
+			requestID = uuid.NewString()
+		}
+		c.Header(constants.RequestIDHeader, requestID)
+
+		ctx := logger.ContextWithRequestID(c.Request.Context(), requestID)
+		trace.SpanFromContext(ctx).SetAttributes(attribute.String("http.request_id", requestID))
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
+
+// validRequestID accepts printable ASCII without spaces, the id ends up in logs and headers
+func validRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > constants.RequestIDMaxLength {
+		return false
+	}
+	for i := 0; i < len(requestID); i++ {
+		if requestID[i] <= ' ' || requestID[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,395 @@
+package migrate
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fluencybe/internal/core/constants"
+	"fluencybe/pkg/logger"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+var (
+	ErrChecksumMismatch = errors.New("applied migration no longer matches its file")
+	ErrUnknownMigration = errors.New("database has a migration this build does not know")
+	ErrIrreversible     = errors.New("migration has no down script")
+)
+
+var fileName = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Migration is one schema change, Checksum is the SHA-256 of its up script
+type Migration struct {
+	Version  int64
+	Name     string
+	Up       string
+	Down     string
+	Checksum string
+}
+
+func (m Migration) String() string {
+	return fmt.Sprintf("%04d_%s", m.Version, m.Name)
+}
+
+// Status tells whether a migration is applied. Modified is set when its up script changed after
+// it was applied, Unknown when the database has a version no file describes.
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+	Modified  bool
+	Unknown   bool
+}
+
+// querier is satisfied by both *sql.DB and *sql.Conn
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+type Runner struct {
+	db         *sql.DB
+	migrations []Migration
+	logger     *logger.PrettyLogger
+}
+
+func NewRunner(db *sql.DB, fsys fs.FS, logger *logger.PrettyLogger) (*Runner, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Runner{db: db, migrations: migrations, logger: logger}, nil
+}
+
+// Load reads the migrations of fsys sorted by version, any other file is an error so a misnamed
+// script is not silently skipped
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list migrations: %w", err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		match := fileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("migration file %q is not named <version>_<name>.(up|down).sql", entry.Name())
+		}
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("migration file %q has an invalid version", entry.Name())
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration version %d is used by both %q and %q", version, migration.Name, match[2])
+		}
+
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %q: %w", entry.Name(), err)
+		}
+		if match[3] == "up" {
+			migration.Up = string(content)
+			sum := sha256.Sum256(content)
+			migration.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" {
+			return nil, fmt.Errorf("migration %s has no up script", migration)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// Status lists every known migration in version order, followed by the unknown ones. It only
+// reads, without the migrations table every migration is pending.
+func (r *Runner) Status(ctx context.Context) ([]Status, error) {
+	return r.status(ctx, r.db)
+}
+
+// Pending returns the migrations not applied yet. It fails when an applied migration was modified
+// or is unknown, the schema is then not the one this build expects. Like Status it only reads.
+func (r *Runner) Pending(ctx context.Context) ([]Migration, error) {
+	return r.pending(ctx, r.db)
+}
+
+// Up applies the pending migrations up to target, or all of them when target is 0. Each one runs
+// in its own transaction together with its schema_migrations row.
+func (r *Runner) Up(ctx context.Context, target int64) ([]Migration, error) {
+	var done []Migration
+	err := r.withLock(ctx, func(conn *sql.Conn) error {
+		if err := ensureTable(ctx, conn); err != nil {
+			return err
+		}
+		pending, err := r.pending(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range pending {
+			if target > 0 && migration.Version > target {
+				break
+			}
+			start := time.Now()
+			if err := r.apply(ctx, conn, migration.Up, func(tx *sql.Tx) error {
+				_, err := tx.ExecContext(ctx,
+					"INSERT INTO "+constants.MigrationTable+" (version, name, checksum) VALUES ($1, $2, $3)",
+					migration.Version, migration.Name, migration.Checksum)
+				return err
+			}); err != nil {
+				return fmt.Errorf("migration %s failed: %w", migration, err)
+			}
+			r.logger.Info("migrate.up", map[string]interface{}{
+				"version":     migration.Version,
+				"name":        migration.Name,
+				"duration_ms": time.Since(start).Milliseconds(),
+			}, "Applied migration")
+			done = append(done, migration)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Down reverts the latest steps applied migrations, newest first
+func (r *Runner) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var done []Migration
+	err := r.withLock(ctx, func(conn *sql.Conn) error {
+		if err := ensureTable(ctx, conn); err != nil {
+			return err
+		}
+		statuses, err := r.status(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if err := verify(statuses); err != nil {
+			return err
+		}
+
+		for i := len(statuses) - 1; i >= 0 && len(done) < steps; i-- {
+			migration := statuses[i]
+			if migration.AppliedAt == nil {
+				continue
+			}
+			if migration.Down == "" {
+				return fmt.Errorf("migration %s: %w", migration.Migration, ErrIrreversible)
+			}
+			start := time.Now()
+			if err := r.apply(ctx, conn, migration.Down, func(tx *sql.Tx) error {
+				_, err := tx.ExecContext(ctx,
+					"DELETE FROM "+constants.MigrationTable+" WHERE version = $1", migration.Version)
+				return err
+			}); err != nil {
+				return fmt.Errorf("reverting migration %s failed: %w", migration.Migration, err)
+			}
+			r.logger.Info("migrate.down", map[string]interface{}{
+				"version":     migration.Version,
+				"name":        migration.Name,
+				"duration_ms": time.Since(start).Milliseconds(),
+			}, "Reverted migration")
+			done = append(done, migration.Migration)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Baseline records the migrations up to version as applied without running them, for databases
+// whose schema was created by hand before the migrations existed
+func (r *Runner) Baseline(ctx context.Context, version int64) ([]Migration, error) {
+	var done []Migration
+	err := r.withLock(ctx, func(conn *sql.Conn) error {
+		if err := ensureTable(ctx, conn); err != nil {
+			return err
+		}
+		pending, err := r.pending(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range pending {
+			if migration.Version > version {
+				break
+			}
+			if _, err := conn.ExecContext(ctx,
+				"INSERT INTO "+constants.MigrationTable+" (version, name, checksum) VALUES ($1, $2, $3)",
+				migration.Version, migration.Name, migration.Checksum); err != nil {
+				return fmt.Errorf("failed to record migration %s: %w", migration, err)
+			}
+			done = append(done, migration)
+		}
+		return nil
+	})
+	return done, err
+}
+
+func (r *Runner) pending(ctx context.Context, q querier) ([]Migration, error) {
+	statuses, err := r.status(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	if err := verify(statuses); err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, status := range statuses {
+		if status.AppliedAt == nil {
+			pending = append(pending, status.Migration)
+		}
+	}
+	return pending, nil
+}
+
+func (r *Runner) status(ctx context.Context, q querier) ([]Status, error) {
+	exists, err := tableExists(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		statuses := make([]Status, 0, len(r.migrations))
+		for _, migration := range r.migrations {
+			statuses = append(statuses, Status{Migration: migration})
+		}
+		return statuses, nil
+	}
+
+	rows, err := q.QueryContext(ctx,
+		"SELECT version, name, checksum, applied_at FROM "+constants.MigrationTable+" ORDER BY version")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[int64]Status)
+	var unknown []Status
+	known := make(map[int64]bool, len(r.migrations))
+	for _, migration := range r.migrations {
+		known[migration.Version] = true
+	}
+	for rows.Next() {
+		var status Status
+		var appliedAt time.Time
+		if err := rows.Scan(&status.Version, &status.Name, &status.Checksum, &appliedAt); err != nil {
+			return nil, fmt.Errorf("failed to read applied migrations: %w", err)
+		}
+		status.AppliedAt = &appliedAt
+		if !known[status.Version] {
+			status.Unknown = true
+			unknown = append(unknown, status)
+			continue
+		}
+		applied[status.Version] = status
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+
+	statuses := make([]Status, 0, len(r.migrations)+len(unknown))
+	for _, migration := range r.migrations {
+		status := Status{Migration: migration}
+		if row, ok := applied[migration.Version]; ok {
+			status.AppliedAt = row.AppliedAt
+			status.Modified = row.Checksum != migration.Checksum
+		}
+		statuses = append(statuses, status)
+	}
+	return append(statuses, unknown...), nil
+}
+
+// apply runs script and record in one transaction
+func (r *Runner) apply(ctx context.Context, conn *sql.Conn, script string, record func(*sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		return err
+	}
+	if err := record(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// withLock runs fn on a single connection holding the migration advisory lock
+func (r *Runner) withLock(ctx context.Context, fn func(*sql.Conn) error) error {
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get a database connection: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", constants.MigrationLockID); err != nil {
+		return fmt.Errorf("failed to take the migration lock: %w", err)
+	}
+	// The lock belongs to the session, it must be released before the connection returns to the pool
+	defer conn.ExecContext(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock($1)", constants.MigrationLockID)
+
+	return fn(conn)
+}
+
+// tableExists tells whether the migrations table was created, a fresh database has none
+func tableExists(ctx context.Context, q querier) (bool, error) {
+	rows, err := q.QueryContext(ctx, "SELECT to_regclass($1) IS NOT NULL", constants.MigrationTable)
+	if err != nil {
+		return false, fmt.Errorf("failed to look up %s: %w", constants.MigrationTable, err)
+	}
+	defer rows.Close()
+
+	var exists bool
+	if rows.Next() {
+		if err := rows.Scan(&exists); err != nil {
+			return false, fmt.Errorf("failed to look up %s: %w", constants.MigrationTable, err)
+		}
+	}
+	return exists, rows.Err()
+}
+
+func ensureTable(ctx context.Context, q querier) error {
+	_, err := q.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS `+constants.MigrationTable+` (
+		version BIGINT PRIMARY KEY,
+		name TEXT NOT NULL,
+		checksum TEXT NOT NULL,
+		applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	)`)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", constants.MigrationTable, err)
+	}
+	return nil
+}
+
+func verify(statuses []Status) error {
+	var errs []error
+	for _, status := range statuses {
+		switch {
+		case status.Unknown:
+			errs = append(errs, fmt.Errorf("version %d (%s): %w", status.Version, status.Name, ErrUnknownMigration))
+		case status.Modified:
+			errs = append(errs, fmt.Errorf("%s: %w", status.Migration, ErrChecksumMismatch))
+		}
+	}
+	return errors.Join(errs...)
+}
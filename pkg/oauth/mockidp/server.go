@@ -0,0 +1,166 @@
+package mockidp
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fluencybe/pkg/oauth"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// Server is a minimal in-process OAuth2/OIDC identity provider. Every authorization
+// request is approved for the configured identity, it is meant for tests and local development.
+type Server struct {
+	URL string
+
+	identity oauth.Identity
+	listener net.Listener
+	server   *http.Server
+
+	mu     sync.Mutex
+	codes  map[string]grant
+	tokens map[string]oauth.Identity
+}
+
+// grant is an issued authorization code, challenge is the PKCE S256 challenge it was requested with
+type grant struct {
+	identity  oauth.Identity
+	challenge string
+}
+
+// Start listens on a random loopback port and serves the authorize, token and userinfo endpoints
+func Start(identity oauth.Identity) (*Server, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		URL:      "http://" + listener.Addr().String(),
+		identity: identity,
+		listener: listener,
+		codes:    make(map[string]grant),
+		tokens:   make(map[string]oauth.Identity),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/authorize", s.handleAuthorize)
+	mux.HandleFunc("/token", s.handleToken)
+	mux.HandleFunc("/userinfo", s.handleUserInfo)
+	s.server = &http.Server{Handler: mux}
+
+	go s.server.Serve(listener)
+	return s, nil
+}
+
+func (s *Server) Close() error {
+	return s.server.Close()
+}
+
+// SetIdentity changes the identity returned for the next authorizations
+func (s *Server) SetIdentity(identity oauth.Identity) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.identity = identity
+}
+
+// ProviderConfig returns a provider configuration pointing at this server
+func (s *Server) ProviderConfig(redirectURL string) oauth.ProviderConfig {
+	return oauth.ProviderConfig{
+		Name:         oauth.ProviderMock,
+		ClientID:     "mock-client",
+		ClientSecret: "mock-secret",
+		RedirectURL:  redirectURL,
+		AuthURL:      s.URL + "/authorize",
+		TokenURL:     s.URL + "/token",
+		UserInfoURL:  s.URL + "/userinfo",
+	}
+}
+
+func (s *Server) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	redirectURI := r.URL.Query().Get("redirect_uri")
+	target, err := url.Parse(redirectURI)
+	if err != nil || redirectURI == "" {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+
+	if r.URL.Query().Get("code_challenge_method") != "S256" || r.URL.Query().Get("code_challenge") == "" {
+		http.Error(w, "an S256 code_challenge is required", http.StatusBadRequest)
+		return
+	}
+
+	code := randomString()
+	s.mu.Lock()
+	s.codes[code] = grant{identity: s.identity, challenge: r.URL.Query().Get("code_challenge")}
+	s.mu.Unlock()
+
+	query := target.Query()
+	query.Set("code", code)
+	query.Set("state", r.URL.Query().Get("state"))
+	target.RawQuery = query.Encode()
+	http.Redirect(w, r, target.String(), http.StatusFound)
+}
+
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+
+	code := r.PostForm.Get("code")
+	s.mu.Lock()
+	grant, ok := s.codes[code]
+	delete(s.codes, code)
+	ok = ok && grant.challenge == oauth2.S256ChallengeFromVerifier(r.PostForm.Get("code_verifier"))
+	token := randomString()
+	if ok {
+		s.tokens[token] = grant.identity
+	}
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token": token,
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+	})
+}
+
+func (s *Server) handleUserInfo(w http.ResponseWriter, r *http.Request) {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	s.mu.Lock()
+	identity, ok := s.tokens[token]
+	s.mu.Unlock()
+
+	if !ok {
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"sub":            identity.Subject,
+		"email":          identity.Email,
+		"email_verified": identity.EmailVerified,
+		"name":           identity.Name,
+	})
+}
+
+func randomString() string {
+	buf := make([]byte, 16)
+	rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
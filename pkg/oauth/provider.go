@@ -0,0 +1,293 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	ProviderGoogle   = "google"
+	ProviderFacebook = "facebook"
+	ProviderGithub   = "github"
+	ProviderMock     = "mock"
+)
+
+var (
+	ErrUnknownProvider  = errors.New("unknown oauth provider")
+	ErrEmailNotVerified = errors.New("provider did not return a verified email")
+	ErrCodeRejected     = errors.New("provider rejected the authorization code")
+)
+
+// Identity is the normalized profile returned by a provider after a successful exchange
+type Identity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+type ProviderConfig struct {
+	Name         string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	AuthURL      string
+	TokenURL     string
+	UserInfoURL  string
+	Scopes       []string
+}
+
+type identityFetcher func(ctx context.Context, client *http.Client, userInfoURL string) (*Identity, error)
+
+type Provider struct {
+	name        string
+	oauth       *oauth2.Config
+	userInfoURL string
+	fetch       identityFetcher
+}
+
+// NewProvider builds a provider, endpoints and scopes not set in cfg fall back to the provider defaults
+func NewProvider(cfg ProviderConfig) (*Provider, error) {
+	defaults, fetch, ok := providerDefaults(cfg.Name)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProvider, cfg.Name)
+	}
+	if cfg.ClientID == "" {
+		return nil, fmt.Errorf("client id is required for provider %s", cfg.Name)
+	}
+
+	if cfg.AuthURL == "" {
+		cfg.AuthURL = defaults.AuthURL
+	}
+	if cfg.TokenURL == "" {
+		cfg.TokenURL = defaults.TokenURL
+	}
+	if cfg.UserInfoURL == "" {
+		cfg.UserInfoURL = defaults.UserInfoURL
+	}
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = defaults.Scopes
+	}
+
+	return &Provider{
+		name: cfg.Name,
+		oauth: &oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Scopes:       cfg.Scopes,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  cfg.AuthURL,
+				TokenURL: cfg.TokenURL,
+			},
+		},
+		userInfoURL: cfg.UserInfoURL,
+		fetch:       fetch,
+	}, nil
+}
+
+func (p *Provider) Name() string {
+	return p.name
+}
+
+// NewVerifier returns a PKCE code verifier, only its S256 challenge is sent to the provider
+func NewVerifier() string {
+	return oauth2.GenerateVerifier()
+}
+
+func (p *Provider) AuthCodeURL(state string, verifier string) string {
+	return p.oauth.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier))
+}
+
+// Exchange trades the authorization code for a token and loads the identity behind it, verifier
+// is the one whose challenge was sent with the authorization request
+func (p *Provider) Exchange(ctx context.Context, code string, verifier string) (*Identity, error) {
+	ctx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	token, err := p.oauth.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		// A 4xx is the provider refusing this code (expired, reused, wrong verifier), not an outage
+		var retrieveErr *oauth2.RetrieveError
+		if errors.As(err, &retrieveErr) && retrieveErr.Response != nil && retrieveErr.Response.StatusCode < http.StatusInternalServerError {
+			return nil, fmt.Errorf("%w: %v", ErrCodeRejected, err)
+		}
+		return nil, fmt.Errorf("failed to exchange code: %w", err)
+	}
+
+	identity, err := p.fetch(ctx, p.oauth.Client(ctx, token), p.userInfoURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch identity: %w", err)
+	}
+
+	identity.Provider = p.name
+	identity.Email = strings.ToLower(strings.TrimSpace(identity.Email))
+	return identity, nil
+}
+
+// Registry holds the providers enabled in the configuration
+type Registry struct {
+	providers map[string]*Provider
+}
+
+func NewRegistry(configs []ProviderConfig) (*Registry, error) {
+	registry := &Registry{providers: make(map[string]*Provider)}
+	for _, cfg := range configs {
+		provider, err := NewProvider(cfg)
+		if err != nil {
+			return nil, err
+		}
+		registry.providers[cfg.Name] = provider
+	}
+	return registry, nil
+}
+
+func (r *Registry) Get(name string) (*Provider, error) {
+	provider, ok := r.providers[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProvider, name)
+	}
+	return provider, nil
+}
+
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+	return names
+}
+
+func providerDefaults(name string) (ProviderConfig, identityFetcher, bool) {
+	switch name {
+	case ProviderGoogle:
+		return ProviderConfig{
+			AuthURL:     "https://accounts.google.com/o/oauth2/v2/auth",
+			TokenURL:    "https://oauth2.googleapis.com/token",
+			UserInfoURL: "https://openidconnect.googleapis.com/v1/userinfo",
+			Scopes:      []string{"openid", "email", "profile"},
+		}, fetchOIDCIdentity, true
+	case ProviderFacebook:
+		return ProviderConfig{
+			AuthURL:     "https://www.facebook.com/v19.0/dialog/oauth",
+			TokenURL:    "https://graph.facebook.com/v19.0/oauth/access_token",
+			UserInfoURL: "https://graph.facebook.com/me?fields=id,name,email",
+			Scopes:      []string{"email", "public_profile"},
+		}, fetchFacebookIdentity, true
+	case ProviderGithub:
+		return ProviderConfig{
+			AuthURL:     "https://github.com/login/oauth/authorize",
+			TokenURL:    "https://github.com/login/oauth/access_token",
+			UserInfoURL: "https://api.github.com/user",
+			Scopes:      []string{"read:user", "user:email"},
+		}, fetchGithubIdentity, true
+	case ProviderMock:
+		// Endpoints come from the in-process mock identity provider
+		return ProviderConfig{
+			Scopes: []string{"openid", "email", "profile"},
+		}, fetchOIDCIdentity, true
+	}
+	return ProviderConfig{}, nil, false
+}
+
+func getJSON(ctx context.Context, client *http.Client, url string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func fetchOIDCIdentity(ctx context.Context, client *http.Client, userInfoURL string) (*Identity, error) {
+	var info struct {
+		Sub           string `json:"sub"`
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+		Name          string `json:"name"`
+	}
+	if err := getJSON(ctx, client, userInfoURL, &info); err != nil {
+		return nil, err
+	}
+
+	return &Identity{
+		Subject:       info.Sub,
+		Email:         info.Email,
+		EmailVerified: info.EmailVerified,
+		Name:          info.Name,
+	}, nil
+}
+
+func fetchFacebookIdentity(ctx context.Context, client *http.Client, userInfoURL string) (*Identity, error) {
+	var info struct {
+		ID    string `json:"id"`
+		Email string `json:"email"`
+		Name  string `json:"name"`
+	}
+	if err := getJSON(ctx, client, userInfoURL, &info); err != nil {
+		return nil, err
+	}
+
+	// Graph API only exposes emails the account has confirmed
+	return &Identity{
+		Subject:       info.ID,
+		Email:         info.Email,
+		EmailVerified: info.Email != "",
+		Name:          info.Name,
+	}, nil
+}
+
+func fetchGithubIdentity(ctx context.Context, client *http.Client, userInfoURL string) (*Identity, error) {
+	var info struct {
+		ID    int64  `json:"id"`
+		Login string `json:"login"`
+		Name  string `json:"name"`
+	}
+	if err := getJSON(ctx, client, userInfoURL, &info); err != nil {
+		return nil, err
+	}
+
+	// The profile email may be empty or unverified, the emails endpoint is authoritative
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := getJSON(ctx, client, strings.TrimSuffix(userInfoURL, "/")+"/emails", &emails); err != nil {
+		return nil, err
+	}
+
+	identity := &Identity{
+		Subject: fmt.Sprintf("%d", info.ID),
+		Name:    info.Name,
+	}
+	if identity.Name == "" {
+		identity.Name = info.Login
+	}
+	for _, e := range emails {
+		if e.Primary && e.Verified {
+			identity.Email = e.Email
+			identity.EmailVerified = true
+			break
+		}
+	}
+	return identity, nil
+}
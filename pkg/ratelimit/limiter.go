@@ -0,0 +1,181 @@
+package ratelimit
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	constants "fluencybe/internal/core/constants"
+	"fluencybe/internal/core/status"
+	"fluencybe/pkg/cache"
+	"fluencybe/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// Result describes the state of a client budget after a request was counted
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	ResetAfter time.Duration
+}
+
+// slidingWindowScript keeps one sorted set member per accepted request, scored by its time in ms.
+// Rejected requests are not recorded so a client hammering the API recovers after one window.
+var slidingWindowScript = redis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+
+redis.call('ZREMRANGEBYSCORE', key, '-inf', now - window)
+local count = redis.call('ZCARD', key)
+local allowed = 0
+if count < limit then
+	redis.call('ZADD', key, now, ARGV[4])
+	count = count + 1
+	allowed = 1
+end
+redis.call('PEXPIRE', key, window)
+
+local reset = window
+local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+if oldest[2] then
+	reset = tonumber(oldest[2]) + window - now
+end
+return {allowed, count, reset}
+`)
+
+// Limiter counts requests in Redis while it is healthy and in process memory otherwise.
+// The memory fallback is per instance, so budgets are only shared across replicas through Redis.
+type Limiter struct {
+	redis  *cache.RedisClient
+	memory *memoryStore
+	logger *logger.PrettyLogger
+}
+
+func NewLimiter(redisClient *cache.RedisClient) *Limiter {
+	return &Limiter{
+		redis:  redisClient,
+		memory: newMemoryStore(constants.RateLimitMaxMemoryKeys),
+		logger: logger.GetGlobalLogger(),
+	}
+}
+
+// Allow counts one request for key against limit requests per window
+func (l *Limiter) Allow(ctx context.Context, key string, limit int, window time.Duration) Result {
+	if l.redis != nil && status.GetRedisStatus() {
+		result, err := l.allowRedis(ctx, key, limit, window)
+		if err == nil {
+			return result
+		}
+		l.logger.Warning("rate_limiter.redis", map[string]interface{}{
+			"error": err.Error(),
+		}, "Redis rate limit failed, using in-memory limiter")
+	}
+	return l.memory.allow(key, limit, window, time.Now())
+}
+
+func (l *Limiter) allowRedis(ctx context.Context, key string, limit int, window time.Duration) (Result, error) {
+	now := time.Now().UnixMilli()
+	values, err := slidingWindowScript.Run(ctx, l.redis.Client,
+		[]string{constants.RateLimitRedisKeyPrefix + key},
+		now,
+		window.Milliseconds(),
+		limit,
+		fmt.Sprintf("%d-%s", now, uuid.NewString()),
+	).Int64Slice()
+	if err != nil {
+		return Result{}, err
+	}
+	if len(values) != 3 {
+		return Result{}, fmt.Errorf("unexpected rate limit script reply: %v", values)
+	}
+
+	return Result{
+		Allowed:    values[0] == 1,
+		Limit:      limit,
+		Remaining:  max(limit-int(values[1]), 0),
+		ResetAfter: time.Duration(values[2]) * time.Millisecond,
+	}, nil
+}
+
+// window is a sliding window counter: the previous fixed window is weighted by how much of it
+// still overlaps the sliding window, which keeps memory constant per client
+type window struct {
+	key      string
+	start    time.Time
+	previous int
+	current  int
+}
+
+// memoryStore holds at most maxEntries clients, the least recently seen one is evicted first so
+// a flood of new identities cannot grow it without bound
+type memoryStore struct {
+	mu         sync.Mutex
+	windows    map[string]*list.Element
+	recent     *list.List
+	maxEntries int
+}
+
+func newMemoryStore(maxEntries int) *memoryStore {
+	return &memoryStore{
+		windows:    make(map[string]*list.Element),
+		recent:     list.New(),
+		maxEntries: max(maxEntries, 1),
+	}
+}
+
+func (m *memoryStore) allow(key string, limit int, size time.Duration, now time.Time) Result {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var w *window
+	if element, ok := m.windows[key]; ok {
+		m.recent.MoveToFront(element)
+		w = element.Value.(*window)
+	} else {
+		for len(m.windows) >= m.maxEntries {
+			m.evictOldestLocked()
+		}
+		w = &window{key: key, start: now.Truncate(size)}
+		m.windows[key] = m.recent.PushFront(w)
+	}
+
+	switch elapsed := now.Sub(w.start); {
+	case elapsed >= 2*size:
+		w.start, w.previous, w.current = now.Truncate(size), 0, 0
+	case elapsed >= size:
+		w.start, w.previous, w.current = w.start.Add(size), w.current, 0
+	}
+
+	elapsed := now.Sub(w.start)
+	weight := 1 - float64(elapsed)/float64(size)
+	used := int(math.Ceil(float64(w.previous)*weight)) + w.current
+
+	result := Result{
+		Limit:      limit,
+		ResetAfter: size - elapsed,
+	}
+	if used < limit {
+		w.current++
+		used++
+		result.Allowed = true
+	}
+	result.Remaining = max(limit-used, 0)
+	return result
+}
+
+func (m *memoryStore) evictOldestLocked() {
+	oldest := m.recent.Back()
+	if oldest == nil {
+		return
+	}
+	m.recent.Remove(oldest)
+	delete(m.windows, oldest.Value.(*window).key)
+}
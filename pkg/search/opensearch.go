@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"fluencybe/internal/core/config"
+	"fluencybe/internal/infrastructure/metrics"
 	"fluencybe/pkg/logger"
 	"fmt"
 	"net/http"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/opensearch-project/opensearch-go/v2"
 	"github.com/opensearch-project/opensearch-go/v2/opensearchapi"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 func NewOpenSearchClient(cfg config.OpenSearchConfig, log *logger.PrettyLogger) (*opensearch.Client, error) {
@@ -25,7 +27,11 @@ func NewOpenSearchClient(cfg config.OpenSearchConfig, log *logger.PrettyLogger)
 		Addresses: []string{"https://" + cfg.Host + ":" + cfg.Port},
 		Username:  cfg.Username,
 		Password:  cfg.Password,
-		Transport: transport,
+		Transport: otelhttp.NewTransport(metrics.SearchTransport(transport),
+			otelhttp.WithSpanNameFormatter(func(_ string, req *http.Request) string {
+				return "opensearch " + metrics.SearchOperation(req)
+			}),
+		),
 	})
 	if err != nil {
 		log.Critical("OPENSEARCH_CONNECTION_ERROR", map[string]interface{}{
@@ -0,0 +1,88 @@
+package similarity
+
+import (
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// Shingles returns the set of k-word shingles of text, words are lowercased and punctuation is
+// dropped. A text shorter than k words is a single shingle.
+func Shingles(text string, k int) map[string]struct{} {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	shingles := make(map[string]struct{})
+	if len(words) == 0 {
+		return shingles
+	}
+	if len(words) < k {
+		shingles[strings.Join(words, " ")] = struct{}{}
+		return shingles
+	}
+	for i := 0; i+k <= len(words); i++ {
+		shingles[strings.Join(words[i:i+k], " ")] = struct{}{}
+	}
+	return shingles
+}
+
+// Signature is the MinHash signature of a set of shingles, two signatures built with the same
+// size estimate the Jaccard similarity of their sets
+type Signature []uint64
+
+// NewSignature builds a signature of the given size, each slot is the minimum of one hash
+// function over the shingles
+func NewSignature(shingles map[string]struct{}, size int) Signature {
+	signature := make(Signature, size)
+	for i := range signature {
+		signature[i] = math.MaxUint64
+	}
+
+	for shingle := range shingles {
+		h := fnv.New64a()
+		h.Write([]byte(shingle))
+		base := h.Sum64()
+		for i := range signature {
+			if v := mix(base + uint64(i)*0x9e3779b97f4a7c15); v < signature[i] {
+				signature[i] = v
+			}
+		}
+	}
+	return signature
+}
+
+// Similarity estimates the Jaccard similarity of the two sets, it is 0 when either set was empty
+func (s Signature) Similarity(other Signature) float64 {
+	if len(s) == 0 || len(s) != len(other) || s.empty() || other.empty() {
+		return 0
+	}
+
+	equal := 0
+	for i := range s {
+		if s[i] == other[i] {
+			equal++
+		}
+	}
+	return float64(equal) / float64(len(s))
+}
+
+func (s Signature) empty() bool {
+	for _, v := range s {
+		if v != math.MaxUint64 {
+			return false
+		}
+	}
+	return true
+}
+
+// mix is the splitmix64 finalizer, it spreads the seeded hashes so the slots are independent
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -1,6 +1,11 @@
 package utils
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
 	"fluencybe/internal/core/constants"
 	"os"
 	"time"
@@ -9,8 +14,9 @@ import (
 )
 
 type Claims struct {
-	UserID string `json:"user_id"`
-	Role   string `json:"role"`
+	UserID    string `json:"user_id"`
+	Role      string `json:"role"`
+	SessionID string `json:"sid"`
 	jwt.RegisteredClaims
 }
 
@@ -22,12 +28,14 @@ func getJWTSecret() []byte {
 	return []byte(secret)
 }
 
-func GenerateJWT(userID string, role string) (string, error) {
+// GenerateJWT issues a short-lived access token bound to a refresh token session
+func GenerateJWT(userID string, role string, sessionID string) (string, time.Time, error) {
 	secretKey := getJWTSecret()
 	expirationTime := time.Now().Add(constants.JWTAccessTokenTTL)
 	claims := &Claims{
-		UserID: userID,
-		Role:   role,
+		UserID:    userID,
+		Role:      role,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -36,7 +44,11 @@ func GenerateJWT(userID string, role string) (string, error) {
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(secretKey)
+	signed, err := token.SignedString(secretKey)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return signed, expirationTime, nil
 }
 
 func ValidateJWT(tokenString string) (*Claims, error) {
@@ -59,3 +71,59 @@ func ValidateJWT(tokenString string) (*Claims, error) {
 
 	return nil, jwt.ErrSignatureInvalid
 }
+
+// GenerateOpaqueToken returns a random URL-safe token, used for refresh tokens
+func GenerateOpaqueToken() (string, error) {
+	buf := make([]byte, constants.RefreshTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// HashToken returns the hex encoded SHA-256 of a token so only hashes are persisted
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+type stateClaims struct {
+	Purpose string `json:"purpose"`
+	jwt.RegisteredClaims
+}
+
+// GenerateStateToken signs a short-lived value bound to a purpose and a nonce, used as the OAuth
+// state parameter
+func GenerateStateToken(purpose string, nonce string, ttl time.Duration) (string, error) {
+	claims := &stateClaims{
+		Purpose: purpose,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        nonce,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			Issuer:    constants.JWTIssuer,
+		},
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(getJWTSecret())
+}
+
+// ValidateStateToken checks the signature and expiry of a state and that it was generated for
+// purpose and nonce
+func ValidateStateToken(state string, purpose string, nonce string) error {
+	claims := &stateClaims{}
+	token, err := jwt.ParseWithClaims(state, claims, func(token *jwt.Token) (interface{}, error) {
+		if token.Method.Alg() != constants.JWTAlgorithm {
+			return nil, jwt.ErrSignatureInvalid
+		}
+		return getJWTSecret(), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if !token.Valid || claims.Purpose != purpose || nonce == "" || subtle.ConstantTimeCompare([]byte(claims.ID), []byte(nonce)) != 1 {
+		return jwt.ErrTokenInvalidClaims
+	}
+	return nil
+}